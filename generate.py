@@ -20,7 +20,10 @@ TEMPLATES = {
         "resource_vm_device.go",
         "resource_uploadable.go",
         "action_resource.go",
+        "action_native.go",
         "action_uploadable.go",
+        "list_resource.go",
+        "list_resource_doc.md",
         "resource_doc.md",
         "datasource.go",
         "datasource_doc.md",
@@ -121,15 +124,334 @@ def merge_anyof_schema(schema):
     return props, req
 
 
+# ============ Validators ============
+
+VALIDATOR_PKGS = {
+    "String": "stringvalidator",
+    "Int64": "int64validator",
+    "Float64": "float64validator",
+    "Bool": "boolvalidator",
+    "List": "listvalidator",
+}
+
+JSON_TYPES = {
+    "String": "string",
+    "Int64": "integer",
+    "Float64": "number",
+    "Bool": "boolean",
+    "List": "array",
+}
+
+
+def get_constraint_schema(prop, tf_type):
+    """Pick the (anyOf) branch whose constraints apply to the generated type."""
+    if not isinstance(prop, dict):
+        return {}
+    if "anyOf" in prop:
+        for v in prop["anyOf"]:
+            if isinstance(v, dict) and v.get("type") == JSON_TYPES.get(tf_type):
+                return v
+        return {}
+    return prop
+
+
+def collect_enum(name, prop, tf_type, variants=None):
+    """Collect enum values for a property across anyOf branches and variants.
+
+    Returns an empty list when any branch of the generated type is free-form,
+    since a OneOf validator would then reject valid input.
+    """
+    candidates = [
+        v["properties"][name]
+        for v in variants or []
+        if name in v.get("properties", {})
+    ] or [prop]
+
+    json_type = JSON_TYPES.get(tf_type)
+    values = []
+    for cand in candidates:
+        cand = cand[0] if isinstance(cand, list) and cand else cand
+        if not isinstance(cand, dict):
+            continue
+        branches = cand.get("anyOf", []) + cand.get("oneOf", []) or [cand]
+        for b in branches:
+            if not isinstance(b, dict):
+                continue
+            if "const" in b:
+                values.append(b["const"])
+            elif "enum" in b:
+                values.extend(b["enum"])
+            elif b.get("type") == json_type:
+                return []
+
+    want = int if tf_type == "Int64" else str
+    return list(
+        dict.fromkeys(
+            v for v in values if isinstance(v, want) and not isinstance(v, bool)
+        )
+    )
+
+
+def go_regexp_supported(pattern):
+    """RE2 has no lookaround or backreferences; skip patterns that need them."""
+    if "`" in pattern:
+        return False
+    return not any(
+        tok in pattern for tok in ("(?=", "(?!", "(?<=", "(?<!", "(?P=")
+    ) and not any(f"\\{d}" in pattern for d in "123456789")
+
+
+def gen_conflicts(variants):
+    """Map each variant-exclusive property to the exclusive properties of the other variants."""
+    if not variants:
+        return {}
+    all_sets = [set(v.get("properties", {})) for v in variants]
+    common = set.intersection(*all_sets) if all_sets else set()
+    exclusive = [s - common for s in all_sets]
+    owners = {}
+    for i, props in enumerate(exclusive):
+        for p in props:
+            owners.setdefault(p, set()).add(i)
+
+    conflicts = {}
+    for p, mine in owners.items():
+        others = {
+            q
+            for q, theirs in owners.items()
+            if q != p and not (mine & theirs)
+        }
+        if others:
+            conflicts[p] = sorted(others)
+    return conflicts
+
+
+def gen_validators(name, prop, tf_type, variants=None, conflicts=None, requires=None):
+    """Build plan-time validators from the spec constraints of a property."""
+    pkg = VALIDATOR_PKGS.get(tf_type)
+    if not pkg:
+        return []
+    c = get_constraint_schema(prop, tf_type)
+    out = []
+
+    if tf_type in ("String", "Int64"):
+        enum = collect_enum(name, prop, tf_type, variants)
+        if enum:
+            vals = ", ".join(json.dumps(v) for v in enum)
+            out.append(f"{pkg}.OneOf({vals})")
+
+    if tf_type in ("Int64", "Float64"):
+        lo, hi = c.get("minimum"), c.get("maximum")
+        # Exclusive bounds only translate exactly for integers
+        if tf_type == "Int64" and lo is None and "exclusiveMinimum" in c:
+            lo = c["exclusiveMinimum"] + 1
+        if tf_type == "Int64" and hi is None and "exclusiveMaximum" in c:
+            hi = c["exclusiveMaximum"] - 1
+        if lo is not None and hi is not None:
+            out.append(f"{pkg}.Between({lo}, {hi})")
+        elif lo is not None:
+            out.append(f"{pkg}.AtLeast({lo})")
+        elif hi is not None:
+            out.append(f"{pkg}.AtMost({hi})")
+
+    if tf_type == "String":
+        lo, hi = c.get("minLength"), c.get("maxLength")
+        if lo and hi is not None:
+            out.append(f"{pkg}.LengthBetween({lo}, {hi})")
+        elif lo:
+            out.append(f"{pkg}.LengthAtLeast({lo})")
+        elif hi is not None:
+            out.append(f"{pkg}.LengthAtMost({hi})")
+        pattern = c.get("pattern")
+        if pattern and go_regexp_supported(pattern):
+            msg = f"must match {pattern}".replace("\\", "\\\\").replace('"', '\\"')
+            out.append(f'{pkg}.RegexMatches(regexp.MustCompile(`{pattern}`), "{msg}")')
+
+    if tf_type == "List":
+        lo, hi = c.get("minItems"), c.get("maxItems")
+        if lo and hi is not None:
+            out.append(f"{pkg}.SizeBetween({lo}, {hi})")
+        elif lo:
+            out.append(f"{pkg}.SizeAtLeast({lo})")
+        elif hi is not None:
+            out.append(f"{pkg}.SizeAtMost({hi})")
+
+    for dep in (requires or {}).get(name, []):
+        out.append(f'{pkg}.AlsoRequires(path.MatchRoot("{dep.lower()}"))')
+    if conflicts and name in conflicts:
+        paths = ", ".join(f'path.MatchRoot("{q.lower()}")' for q in conflicts[name])
+        out.append(f"{pkg}.ConflictsWith({paths})")
+
+    return out
+
+
+def validator_imports(code):
+    """Imports required by the validators emitted into generated code."""
+    imports = []
+    if "Validators:" in code:
+        imports.append('"github.com/hashicorp/terraform-plugin-framework/schema/validator"')
+    for pkg in VALIDATOR_PKGS.values():
+        if f"{pkg}." in code:
+            imports.append(
+                f'"github.com/hashicorp/terraform-plugin-framework-validators/{pkg}"'
+            )
+    if "regexp.MustCompile" in code:
+        imports.append('"regexp"')
+    return imports
+
+
+# ============ Defaults ============
+
+PLAN_MODIFIER_PKGS = {
+    "String": "stringplanmodifier",
+    "Int64": "int64planmodifier",
+    "Float64": "float64planmodifier",
+    "Bool": "boolplanmodifier",
+    "List": "listplanmodifier",
+}
+
+# Types whose create-only attributes force replacement
+REPLACE_TYPES = ("String", "Int64", "Bool")
+
+# Defaults that the server resolves at runtime rather than storing verbatim
+DYNAMIC_DEFAULTS = ("INHERIT",)
+
+
+def get_static_default(prop, tf_type):
+    """Return a Go schema default for a static spec default, or None."""
+    if not isinstance(prop, dict) or prop.get("default") is None:
+        return None
+    d = prop["default"]
+    if tf_type == "String" and isinstance(d, str) and not is_complex_object(prop):
+        if d in DYNAMIC_DEFAULTS:
+            return None
+        return f"stringdefault.StaticString({json.dumps(d)})"
+    if tf_type == "Int64" and isinstance(d, int) and not isinstance(d, bool):
+        return f"int64default.StaticInt64({d})"
+    if tf_type == "Float64" and isinstance(d, (int, float)) and not isinstance(d, bool):
+        return f"float64default.StaticFloat64({d})"
+    if tf_type == "Bool" and isinstance(d, bool):
+        return f"booldefault.StaticBool({str(d).lower()})"
+    if tf_type == "List" and isinstance(d, list) and all(isinstance(i, str) for i in d):
+        if is_complex_object(prop):
+            return None
+        vals = ", ".join(f"types.StringValue({json.dumps(i)})" for i in d)
+        return f"listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{{{vals}}}))"
+    return None
+
+
+def schema_imports(code):
+    """Imports required by the defaults and plan modifiers in a generated schema."""
+    imports = []
+    if "PlanModifiers:" in code:
+        imports.append(
+            '"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"'
+        )
+    for pkg in list(PLAN_MODIFIER_PKGS.values()) + [
+        "stringdefault",
+        "int64default",
+        "float64default",
+        "booldefault",
+        "listdefault",
+    ]:
+        if f"{pkg}." in code:
+            imports.append(
+                f'"github.com/hashicorp/terraform-plugin-framework/resource/schema/{pkg}"'
+            )
+    return imports + validator_imports(code)
+
+
+# ============ Schema Versions ============
+
+# Attribute types of every generated resource as of its current schema version.
+# Checked in so that regenerating against a newer TrueNAS spec can tell which
+# attributes changed type and bump the version for existing state.
+SCHEMA_VERSIONS_FILE = Path("schema_versions.json")
+
+
+def load_schema_versions():
+    if SCHEMA_VERSIONS_FILE.exists():
+        return json.loads(SCHEMA_VERSIONS_FILE.read_text())
+    return {}
+
+
+def save_schema_versions(versions):
+    SCHEMA_VERSIONS_FILE.write_text(
+        json.dumps(versions, indent=2, sort_keys=True) + "\n"
+    )
+
+
+def model_attr_types(fields):
+    """Map attribute name to framework type from generated struct fields."""
+    types_by_name = {}
+    for line in fields.splitlines():
+        parts = line.split()
+        if len(parts) == 3 and parts[1].startswith("types."):
+            types_by_name[parts[2][len('`tfsdk:"') : -2]] = parts[1][len("types.") :]
+    return types_by_name
+
+
+def schema_version(tf_name, attr_types, versions):
+    """Return the schema version for a resource, bumping it on breaking changes.
+
+    Added attributes decode from old state as null, so only attributes that
+    changed type or disappeared require a new version.
+    """
+    entry = versions.get(tf_name)
+    if entry is None:
+        versions[tf_name] = {"version": 0, "attributes": attr_types}
+        return 0
+    previous = entry["attributes"]
+    if any(attr_types.get(k) != t for k, t in previous.items()):
+        changed = sorted(k for k, t in previous.items() if attr_types.get(k) != t)
+        print(
+            f"  {tf_name}: schema version {entry['version'] + 1} ({', '.join(changed)})",
+            file=sys.stderr,
+        )
+        entry["version"] += 1
+    entry["attributes"] = attr_types
+    return entry["version"]
+
+
+def gen_state_upgraders(version):
+    """Map every prior schema version to the generic JSON state upgrader."""
+    if version == 0:
+        return "\treturn map[int64]resource.StateUpgrader{}"
+    lines = ["\treturn map[int64]resource.StateUpgrader{"]
+    for v in range(version):
+        lines.append(f"\t\t{v}: {{StateUpgrader: upgradeStateFromJSON}},")
+    lines.append("\t}")
+    return "\n".join(lines)
+
+
 # ============ Schema Generation ============
 
 
-def gen_schema_attrs(properties, required, has_start=False, create_only=None):
-    """Generate schema attributes."""
+def gen_schema_attrs(
+    properties,
+    required,
+    has_start=False,
+    create_only=None,
+    variants=None,
+    requires=None,
+    singleton=False,
+    sensitive=None,
+    lookup_key=None,
+):
+    """Generate schema attributes.
+
+    Singleton settings resources take no server defaults: an attribute left out
+    of the configuration keeps whatever value the server already has. Data
+    sources with a lookup_key are read by either the ID or that attribute.
+    """
     create_only = create_only or set()
+    sensitive = sensitive or set()
+    conflicts = gen_conflicts(variants)
     lines = []
+    is_ds = not has_start and not required and not singleton
 
-    if not has_start and not required:  # datasource
+    if is_ds and lookup_key:
+        lines.append(LOOKUP_ID_ATTR.format(key=lookup_key))
+    elif is_ds:
         lines.append(
             '\t\t\t"id": schema.StringAttribute{Required: true, Description: "Resource ID"},'
         )
@@ -145,7 +467,7 @@ def gen_schema_attrs(properties, required, has_start=False, create_only=None):
 
     for name, prop in properties.items():
         if name == "id":
-            if not has_start and not required:
+            if is_ds:
                 continue
             tf_type = get_tf_type(prop)
             lines.append(
@@ -167,7 +489,9 @@ def gen_schema_attrs(properties, required, has_start=False, create_only=None):
 
         lines.append(f'\t\t\t"{attr_name}": schema.{tf_type}Attribute{{')
 
-        if not has_start and not required:  # datasource
+        if is_ds:
+            if name == lookup_key:
+                lines.append("\t\t\t\tOptional: true,")
             lines.append("\t\t\t\tComputed: true,")
         else:
             is_auto = (
@@ -181,21 +505,52 @@ def gen_schema_attrs(properties, required, has_start=False, create_only=None):
             else:
                 lines.append(f"\t\t\t\tRequired: {str(is_req).lower()},")
                 lines.append(f"\t\t\t\tOptional: {str(not is_req).lower()},")
+                if not is_req:
+                    lines.append("\t\t\t\tComputed: true,")
 
+        if name in sensitive:
+            lines.append("\t\t\t\tSensitive: true,")
         if tf_type == "List":
             lines.append("\t\t\t\tElementType: types.StringType,")
         lines.append(f'\t\t\t\tDescription: "{desc}",')
 
-        # Plan modifiers for create-only fields
-        if name in create_only and name != "name":
-            mod_map = {
-                "String": "stringplanmodifier",
-                "Int64": "int64planmodifier",
-                "Bool": "boolplanmodifier",
+        # Server defaults: static ones become Default, the rest keep prior state
+        modifiers = []
+        is_resource = not is_ds
+        if is_resource and not is_req:
+            # Discriminators default differently per variant; let the server decide
+            variant_defaults = {
+                json.dumps(v["properties"][name].get("default"), sort_keys=True)
+                for v in variants or []
+                if name in v.get("properties", {})
             }
-            if tf_type in mod_map:
+            default = (
+                get_static_default(prop, tf_type)
+                if len(variant_defaults) <= 1 and not singleton
+                else None
+            )
+            if default:
+                lines.append(f"\t\t\t\tDefault: {default},")
+            elif tf_type in PLAN_MODIFIER_PKGS:
+                modifiers.append(f"{PLAN_MODIFIER_PKGS[tf_type]}.UseStateForUnknown()")
+
+        # Plan modifiers for create-only fields
+        if name in create_only and name != "name" and tf_type in REPLACE_TYPES:
+            modifiers.append(f"{PLAN_MODIFIER_PKGS[tf_type]}.RequiresReplace()")
+
+        if modifiers:
+            lines.append(
+                f"\t\t\t\tPlanModifiers: []planmodifier.{tf_type}{{{', '.join(modifiers)}}},"
+            )
+
+        # Plan-time validators from spec constraints (resources only)
+        if is_resource:
+            validators = gen_validators(
+                name, prop, tf_type, variants, conflicts, requires
+            )
+            if validators:
                 lines.append(
-                    f"\t\t\t\tPlanModifiers: []planmodifier.{tf_type}{{{mod_map[tf_type]}.RequiresReplace()}},"
+                    f"\t\t\t\tValidators: []validator.{tf_type}{{{', '.join(validators)}}},"
                 )
 
         lines.append("\t\t\t},")
@@ -235,7 +590,7 @@ def gen_create_params(properties):
         field = to_field_name(name)
         tf_type = get_tf_type(prop)
 
-        lines.append(f"\tif !data.{field}.IsNull() {{")
+        lines.append(f"\tif !data.{field}.IsNull() && !data.{field}.IsUnknown() {{")
 
         if tf_type == "Bool":
             lines.append(f'\t\tparams["{name}"] = data.{field}.ValueBool()')
@@ -291,13 +646,14 @@ def gen_create_params(properties):
 # ============ Read Mapping ============
 
 
-def gen_read_mapping(properties, skip_id=False, create_only=None, required=None):
-    """Generate code to map API response to state."""
+def read_fields(properties, create_only=None, required=None):
+    """Fields read back into state.
+
+    For datasources (required=None), read all fields.
+    For resources (required=set), only read required fields + name/type.
+    """
     create_only = create_only or set()
-    lines = []
 
-    # For datasources (required=None), read all fields
-    # For resources (required=set), only read required fields + name/type
     def should_read_field(name):
         if name in ("provider", "id"):
             return False
@@ -307,7 +663,19 @@ def gen_read_mapping(properties, skip_id=False, create_only=None, required=None)
             return False
         return True
 
-    fields_to_read = [n for n in properties if should_read_field(n)]
+    return [n for n in properties if should_read_field(n)]
+
+
+def gen_read_mapping(
+    properties, skip_id=False, create_only=None, required=None, json_objects=False
+):
+    """Generate code to map API response to state.
+
+    With json_objects, object-valued fields are stored as their JSON encoding,
+    matching what the create/update parameters expect.
+    """
+    lines = []
+    fields_to_read = read_fields(properties, create_only, required)
     has_fields = not skip_id or bool(fields_to_read)
 
     if has_fields:
@@ -336,72 +704,139 @@ def gen_read_mapping(properties, skip_id=False, create_only=None, required=None)
 
     for name in fields_to_read:
         prop = properties[name]
-
-        field = to_field_name(name)
-        tf_type = get_tf_type(prop)
-
         lines.append(f'\t\tif v, ok := resultMap["{name}"]; ok && v != nil {{')
-
-        if tf_type == "Bool":
+        if json_objects and get_tf_type(prop) == "String" and is_complex_object(prop):
+            field = f"data.{to_field_name(name)}"
             lines.append(
-                f"\t\t\tif bv, ok := v.(bool); ok {{ data.{field} = types.BoolValue(bv) }}"
+                f"\t\t\tif b, err := json.Marshal(v); err == nil {{ {field} = types.StringValue(string(b)) }}"
             )
-        elif tf_type == "Int64":
-            lines.extend(
-                [
-                    f"\t\t\tswitch val := v.(type) {{",
-                    f"\t\t\tcase float64:",
-                    f"\t\t\t\tdata.{field} = types.Int64Value(int64(val))",
-                    f"\t\t\tcase map[string]interface{{}}:",
-                    f'\t\t\t\tif parsed, ok := val["parsed"]; ok && parsed != nil {{',
-                    f"\t\t\t\t\tif fv, ok := parsed.(float64); ok {{ data.{field} = types.Int64Value(int64(fv)) }}",
-                    f"\t\t\t\t}}",
-                    f"\t\t\t}}",
-                ]
-            )
-        elif tf_type == "Float64":
+        else:
+            lines.extend(gen_field_mapping(name, prop))
+        lines.append("\t\t}")
+
+    return "\n".join(lines)
+
+
+def gen_field_mapping(name, prop, target="data", indent="\t\t\t"):
+    """Generate code converting API value `v` into the model field."""
+    field = f"{target}.{to_field_name(name)}"
+    tf_type = get_tf_type(prop)
+    lines = []
+
+    if tf_type == "Bool":
+        lines.append(f"if bv, ok := v.(bool); ok {{ {field} = types.BoolValue(bv) }}")
+    elif tf_type == "Int64":
+        lines.extend(
+            [
+                f"switch val := v.(type) {{",
+                f"case float64:",
+                f"\t{field} = types.Int64Value(int64(val))",
+                f"case map[string]interface{{}}:",
+                f'\tif parsed, ok := val["parsed"]; ok && parsed != nil {{',
+                f"\t\tif fv, ok := parsed.(float64); ok {{ {field} = types.Int64Value(int64(fv)) }}",
+                f"\t}}",
+                f"}}",
+            ]
+        )
+    elif tf_type == "Float64":
+        lines.append(f"if fv, ok := v.(float64); ok {{ {field} = types.Float64Value(fv) }}")
+    elif tf_type == "List":
+        if is_complex_object(prop):
+            # Object items are stored as JSON, the form create/update parse
+            set_item = "b, _ := json.Marshal(item); strVals[i] = types.StringValue(string(b))"
+        else:
+            set_item = 'strVals[i] = types.StringValue(fmt.Sprintf("%v", item))'
+        lines.extend(
+            [
+                f"if arr, ok := v.([]interface{{}}); ok {{",
+                f"\tstrVals := make([]attr.Value, len(arr))",
+                f"\tfor i, item := range arr {{ {set_item} }}",
+                f"\t{field}, _ = types.ListValue(types.StringType, strVals)",
+                f"}}",
+            ]
+        )
+    else:
+        lines.extend(
+            [
+                f"switch val := v.(type) {{",
+                f"case string:",
+                f"\t{field} = types.StringValue(val)",
+                f"case map[string]interface{{}}:",
+                f'\tif strVal, ok := val["value"]; ok && strVal != nil {{',
+                f'\t\t{field} = types.StringValue(fmt.Sprintf("%v", strVal))',
+                f"\t}}",
+                f"default:",
+                f'\t{field} = types.StringValue(fmt.Sprintf("%v", v))',
+                f"}}",
+            ]
+        )
+
+    return [indent + line for line in lines]
+
+
+def gen_computed_mapping(properties, required):
+    """Generate code filling attributes left unknown at plan time from an API response."""
+    names = [
+        n for n in properties if n not in ("provider", "id") and n not in required
+    ]
+    if not names:
+        return "\t_ = result // No computed fields"
+
+    lines = ["\tresultMap, _ := result.(map[string]interface{})"]
+    for name in names:
+        prop = properties[name]
+        field = f"data.{to_field_name(name)}"
+        tf_type = get_tf_type(prop)
+        null = "types.ListNull(types.StringType)" if tf_type == "List" else f"types.{tf_type}Null()"
+        lines.extend(
+            [
+                f"\tif {field}.IsUnknown() {{",
+                f"\t\t{field} = {null}",
+                f'\t\tif v, ok := resultMap["{name}"]; ok && v != nil {{',
+            ]
+        )
+        if tf_type == "String" and is_complex_object(prop):
             lines.append(
-                f"\t\t\tif fv, ok := v.(float64); ok {{ data.{field} = types.Float64Value(fv) }}"
-            )
-        elif tf_type == "List":
-            lines.extend(
-                [
-                    f"\t\t\tif arr, ok := v.([]interface{{}}); ok {{",
-                    f"\t\t\t\tstrVals := make([]attr.Value, len(arr))",
-                    f'\t\t\t\tfor i, item := range arr {{ strVals[i] = types.StringValue(fmt.Sprintf("%v", item)) }}',
-                    f"\t\t\t\tdata.{field}, _ = types.ListValue(types.StringType, strVals)",
-                    f"\t\t\t}}",
-                ]
+                f"\t\t\tif b, err := json.Marshal(v); err == nil {{ {field} = types.StringValue(string(b)) }}"
             )
         else:
-            lines.extend(
-                [
-                    f"\t\t\tswitch val := v.(type) {{",
-                    f"\t\t\tcase string:",
-                    f"\t\t\t\tdata.{field} = types.StringValue(val)",
-                    f"\t\t\tcase map[string]interface{{}}:",
-                    f'\t\t\t\tif strVal, ok := val["value"]; ok && strVal != nil {{',
-                    f'\t\t\t\t\tdata.{field} = types.StringValue(fmt.Sprintf("%v", strVal))',
-                    f"\t\t\t\t}}",
-                    f"\t\t\tdefault:",
-                    f'\t\t\t\tdata.{field} = types.StringValue(fmt.Sprintf("%v", v))',
-                    f"\t\t\t}}",
-                ]
-            )
+            lines.extend(gen_field_mapping(name, prop))
+        lines.extend(["\t\t}", "\t}"])
+    return "\n".join(lines)
 
-        lines.append("\t\t}")
 
-    return "\n".join(lines)
+# ============ Timeouts ============
+
+TIMEOUTS_IMPORT = '"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"'
+
+
+def gen_timeout(op, is_job):
+    """Timeout lookup for one CRUD operation of a job-backed resource.
+
+    Returns the code resolving the timeout, the client method and the extra
+    call argument. Job calls default to the provider's job timeout, plain
+    calls to its RPC timeout.
+    """
+    var = f"{op.lower()}Timeout"
+    default = "r.client.JobTimeout()" if is_job else "r.client.RPCTimeout()"
+    code = f"""\t{var}, diags := data.Timeouts.{op}(ctx, {default})
+\tresp.Diagnostics.Append(diags...)
+\tif resp.Diagnostics.HasError() {{
+\t\treturn
+\t}}
+"""
+    call = "CallWithJobTimeout" if is_job else "CallWithTimeout"
+    return code, call, f", {var}"
 
 
 # ============ Resource Generation ============
 
 
-def gen_resource(base_name, methods):
-    """Generate resource file from method specs."""
+def resource_properties(base_name, methods):
+    """Schema, properties, required names, create-only names and update
+    properties of a resource, or None when it can't be generated."""
     create_spec = methods.get(f"{base_name}.create", {})
     update_spec = methods.get(f"{base_name}.update", {})
-    delete_spec = methods.get(f"{base_name}.delete", {})
 
     method_spec = create_spec or update_spec
     if not method_spec or not method_spec.get("accepts"):
@@ -417,13 +852,6 @@ def gen_resource(base_name, methods):
     if not properties:
         return None
 
-    # Detect ID type
-    id_is_string = any(
-        spec.get("accepts", [{}])[0].get("type") == "string"
-        for spec in [update_spec, delete_spec]
-        if spec
-    )
-
     # Detect create-only fields
     update_props = {}
     if update_spec and len(update_spec.get("accepts", [])) >= 2:
@@ -440,6 +868,134 @@ def gen_resource(base_name, methods):
         else set(properties.keys())
     )
     properties = {**properties, **update_props}
+    return schema, properties, required, create_only, update_props
+
+
+# Resources with a resource identity and a list resource for `terraform query`:
+# the API fields identifying an instance, and the field it is listed by. Types
+# without a stable natural key are identified by their ID. A key reported
+# under another field is given as (key, field).
+RESOURCE_IDENTITIES = {
+    "pool.dataset": (("pool", "name"), "name"),
+    "sharing.nfs": (("path",), "path"),
+    "sharing.smb": (("name",), "name"),
+    "user": (("username",), "username"),
+    "group": (("name",), "name"),
+    "pool.snapshottask": (("id",), "dataset"),
+    "replication": (("name",), "name"),
+    "vm": (("name",), "name"),
+    "app": (("name",), "name"),
+    "iscsi.target": (("name",), "name"),
+    "iscsi.extent": (("name",), "name"),
+    "iscsi.portal": (("id",), "comment"),
+    "iscsi.initiator": (("id",), "comment"),
+    "iscsi.auth": (("id",), "user"),
+    "iscsi.targetextent": (("target", "extent"), "id"),
+    "cronjob": (("id",), "description"),
+    "pool": (("name",), "name"),
+    "pool.snapshot": (("dataset", ("name", "snapshot_name")), "name"),
+    "api_key": (("name",), "name"),
+    "certificate": (("name",), "name"),
+    "cloudsync.credentials": (("name",), "name"),
+    "filesystem.acltemplate": (("name",), "name"),
+    "kerberos.keytab": (("name",), "name"),
+    "kerberos.realm": (("realm",), "realm"),
+    "keychaincredential": (("name",), "name"),
+    "interface": (("name",), "name"),
+    "nvmet.host": (("hostnqn",), "hostnqn"),
+    "nvmet.subsys": (("name",), "name"),
+    "privilege": (("name",), "name"),
+    "reporting.exporters": (("name",), "name"),
+    "tunable": (("var",), "var"),
+    "virt.instance": (("name",), "name"),
+    "virt.volume": (("name",), "name"),
+    "acme.dns.authenticator": (("name",), "name"),
+    "app.registry": (("name",), "name"),
+}
+
+
+def identity_keys(base_name):
+    """(key, API field) pairs of a resource identity."""
+    return [
+        k if isinstance(k, tuple) else (k, k) for k in RESOURCE_IDENTITIES[base_name][0]
+    ]
+
+
+def identity_key_is_int(key, properties):
+    return key != "id" and key in properties and get_tf_type(properties[key]) == "Int64"
+
+
+def identity_var(resource_name):
+    return resource_name[0].lower() + resource_name[1:] + "Identity"
+
+
+def identity_keys_go(base_name, properties):
+    """Go literal of the identity keys of a resource."""
+    keys = []
+    for k, field in identity_keys(base_name):
+        lit = f'Name: "{k}"'
+        if field != k:
+            lit += f', Field: "{field}"'
+        if identity_key_is_int(k, properties):
+            lit += ", Int: true"
+        keys.append("{" + lit + "}")
+    return "[]identityKey{" + ", ".join(keys) + "}"
+
+
+def gen_identity(base_name, resource_name, tf_name, properties, update_props):
+    """Template values adding a resource identity, empty for resources without one."""
+    if base_name not in RESOURCE_IDENTITIES:
+        return {
+            "metadata_extra": "",
+            "import_state": 'resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)',
+            "identity_methods": "",
+            "set_identity": "",
+        }
+    keys = [k for k, _ in identity_keys(base_name)]
+    var = identity_var(resource_name)
+    mutable = [k for k in keys if k in update_props]
+    metadata_extra = (
+        f"\n\t// {', '.join(mutable)} can be changed in place, changing the identity"
+        "\n\tresp.ResourceBehavior.MutableIdentity = true"
+        if mutable
+        else ""
+    )
+    identity_methods = f"""
+var _ resource.ResourceWithIdentity = &{resource_name}Resource{{}}
+
+// {var} lists the API fields that identify a {tf_name}
+var {var} = {identity_keys_go(base_name, properties)}
+
+func (r *{resource_name}Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {{
+\tresp.IdentitySchema = identitySchema({var})
+}}
+"""
+    return {
+        "metadata_extra": metadata_extra,
+        "import_state": f'importByIdentity(ctx, r.client, "{base_name}.query", {var}, req, resp)',
+        "identity_methods": identity_methods,
+        "set_identity": f"\n\tresp.Diagnostics.Append(setIdentity(ctx, resp.Identity, {var}, result)...)",
+    }
+
+
+def gen_resource(base_name, methods, versions=None):
+    """Generate resource file from method specs."""
+    create_spec = methods.get(f"{base_name}.create", {})
+    update_spec = methods.get(f"{base_name}.update", {})
+    delete_spec = methods.get(f"{base_name}.delete", {})
+
+    method_spec = create_spec or update_spec
+    parsed = resource_properties(base_name, methods)
+    if not parsed:
+        return None
+    schema, properties, required, create_only, update_props = parsed
+
+    # Detect ID type
+    id_is_string = any(
+        spec.get("accepts", [{}])[0].get("type") == "string"
+        for spec in [update_spec, delete_spec]
+        if spec
+    )
 
     # Lifecycle
     has_start = f"{base_name}.start" in methods and base_name != "app"
@@ -512,46 +1068,65 @@ def gen_resource(base_name, methods):
         or (has_start and not id_is_string)
         or (has_stop and not id_is_string)
     )
-    has_list = any(
-        get_tf_type(p) == "List"
-        and (n not in create_only or n in ("name", "type"))
-        and n in required
-        for n, p in properties.items()
+    # Schema and state mapping
+    variants = schema.get("anyOf")
+    requires = dict(schema.get("dependentRequired", {}))
+    for v in variants or []:
+        requires.update(v.get("dependentRequired", {}))
+    schema_attrs = gen_schema_attrs(
+        properties, required, has_start, create_only, variants, requires
+    )
+    read_mapping = gen_read_mapping(
+        properties, create_only=create_only, required=set(required)
     )
-    has_json = has_complex_objects(properties)
+    computed_mapping = gen_computed_mapping(properties, required)
+    generated = schema_attrs + read_mapping + computed_mapping
 
     imports = []
     if needs_strconv:
         imports.append('"strconv"')
-    if has_list and required:
+    if "attr." in generated:
         imports.append('"github.com/hashicorp/terraform-plugin-framework/attr"')
-    if has_json:
+    if has_complex_objects(properties) or "json." in computed_mapping:
         imports.append('"encoding/json"')
     if has_stop:
         imports.append('"time"')
-
-    # Plan modifiers
-    mods = {
-        get_tf_type(properties[f])
-        for f in create_only
-        if f != "name" and f in properties
-    }
-    if mods & {"String", "Int64", "Bool"}:
-        imports.append(
-            '"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"'
-        )
-    for t, m in [
-        ("String", "stringplanmodifier"),
-        ("Int64", "int64planmodifier"),
-        ("Bool", "boolplanmodifier"),
+    # Identity-based import does not need path
+    if base_name not in RESOURCE_IDENTITIES or "path." in schema_attrs:
+        imports.append('"github.com/hashicorp/terraform-plugin-framework/path"')
+    s_imports = schema_imports(schema_attrs)
+    imports.extend(s_imports)
+
+    # Job-backed resources get a timeouts block passed down to the job wait
+    job_backed = create_is_job or update_is_job or delete_is_job
+    op_timeouts = {}
+    for op, is_job in [
+        ("Create", create_is_job),
+        ("Read", False),
+        ("Update", update_is_job),
+        ("Delete", delete_is_job),
     ]:
-        if t in mods:
-            imports.append(
-                f'"github.com/hashicorp/terraform-plugin-framework/resource/schema/{m}"'
-            )
+        if job_backed:
+            op_timeouts[op] = gen_timeout(op, is_job)
+        else:
+            op_timeouts[op] = ("", "CallWithJob" if is_job else "Call", "")
+    schema_blocks = ""
+    if job_backed:
+        imports.append(TIMEOUTS_IMPORT)
+        schema_blocks = """
+\t\tBlocks: map[string]schema.Block{
+\t\t\t"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+\t\t},"""
 
     extra_imports = "\n\t".join(imports)
 
+    fields = gen_fields(properties, has_start)
+    version = schema_version(
+        tf_name, model_attr_types(fields), versions if versions is not None else {}
+    )
+    if job_backed:
+        fields += '\n\tTimeouts timeouts.Value `tfsdk:"timeouts"`'
+
     template = (
         TEMPLATES["resource_vm_device.go"]
         if api_name == "vm.device"
@@ -563,52 +1138,356 @@ def gen_resource(base_name, methods):
         name=tf_name,
         api_name=api_name,
         description=desc,
-        fields=gen_fields(properties, has_start),
-        schema_attrs=gen_schema_attrs(properties, required, has_start, create_only),
+        fields=fields,
+        schema_attrs=schema_attrs,
+        schema_version=version,
+        state_upgraders=gen_state_upgraders(version),
         create_params=gen_create_params(properties),
         update_params=gen_create_params(update_props or properties),
-        read_mapping=gen_read_mapping(
-            properties, create_only=create_only, required=set(required)
-        ),
+        read_mapping=read_mapping,
+        computed_mapping=computed_mapping,
         lifecycle_code=lifecycle,
         predelete_code=predelete,
         id_read_code=id_read,
         id_update_code=id_update,
         id_delete_code=id_delete,
         extra_imports=extra_imports,
-        create_call="CallWithJob" if create_is_job else "Call",
-        update_call="CallWithJob" if update_is_job else "Call",
-        delete_call="CallWithJob" if delete_is_job else "Call",
+        schema_imports="\n\t".join(s_imports),
+        schema_blocks=schema_blocks,
+        create_timeout=op_timeouts["Create"][0],
+        create_call=op_timeouts["Create"][1],
+        create_timeout_arg=op_timeouts["Create"][2],
+        read_timeout=op_timeouts["Read"][0],
+        read_call=op_timeouts["Read"][1],
+        read_timeout_arg=op_timeouts["Read"][2],
+        update_timeout=op_timeouts["Update"][0],
+        update_call=op_timeouts["Update"][1],
+        update_timeout_arg=op_timeouts["Update"][2],
+        delete_timeout=op_timeouts["Delete"][0],
+        delete_call=op_timeouts["Delete"][1],
+        delete_timeout_arg=op_timeouts["Delete"][2],
+        **gen_identity(base_name, resource_name, tf_name, properties, update_props),
     )
 
 
-# ============ Action Resource Generation ============
+# Resources written by hand instead of generated, mapped to the function that
+# copies an API result into their model. Their list resources call it instead
+# of the generated mapping, and they keep their own schema versions.
+HAND_WRITTEN_RESOURCES = {
+    "pool": "setPoolState",
+}
 
 
-def gen_action_resource(method_name, method_spec):
-    """Generate action resource."""
-    parts = method_name.split(".")
-    if len(parts) < 2:
+def gen_list_resource(base_name, methods):
+    """Generate the list resource of a resource with an identity."""
+    parsed = resource_properties(base_name, methods)
+    if base_name not in RESOURCE_IDENTITIES or not parsed:
         return None
+    _, properties, required, create_only, _ = parsed
 
-    accepts = method_spec.get("accepts", [])
-    if not accepts:
+    resource_name = base_name.replace(".", "_").title().replace("_", "")
+    read_lines = []
+    if base_name in HAND_WRITTEN_RESOURCES:
+        read_lines.append(
+            f"\t\tresult.Diagnostics.Append({HAND_WRITTEN_RESOURCES[base_name]}(ctx, resultMap, &data)...)"
+        )
+    else:
+        for name in read_fields(properties, create_only, set(required)):
+            read_lines.append(f'\t\tif v, ok := resultMap["{name}"]; ok && v != nil {{')
+            read_lines.extend(gen_field_mapping(name, properties[name]))
+            read_lines.append("\t\t}")
+    read_mapping = "\n".join(read_lines)
+
+    code = TEMPLATES["list_resource.go"]
+    for k, v in {
+        "{resource_name}": resource_name,
+        "{name}": base_name.replace(".", "_"),
+        "{api_name}": base_name,
+        "{display_field}": RESOURCE_IDENTITIES[base_name][1],
+        "{identity_var}": identity_var(resource_name),
+        "{read_mapping}": read_mapping,
+        "{extra_imports}": "".join(
+            f"\n\t{imp}"
+            for imp, used in [
+                ('"encoding/json"', "json." in read_mapping),
+                ('"github.com/hashicorp/terraform-plugin-framework/attr"', "attr." in read_mapping),
+            ]
+            if used
+        ),
+    }.items():
+        code = code.replace(k, v)
+    return code
+
+
+# ============ Singleton Resource Generation ============
+
+# Settings namespaces with a single instance, read through <ns>.config and
+# changed through <ns>.update. Each becomes a truenas_<ns>_config resource.
+SINGLETON_RESOURCES = {
+    "ftp",
+    "iscsi.global",
+    "nfs",
+    "nvmet.global",
+    "smb",
+    "snmp",
+    "ssh",
+    "ups",
+    "mail",
+    "network.configuration",
+    "system.advanced",
+    "system.general",
+    "systemdataset",
+}
+
+SINGLETON_EXAMPLES = {
+    "ftp": ['port = 21', 'clients = 10', 'defaultroot = true'],
+    "iscsi.global": ['basename = "iqn.2005-10.org.freenas.ctl"', 'listen_port = 3260'],
+    "nfs": ['protocols = ["NFSV4"]', 'v4_domain = "example.com"'],
+    "nvmet.global": ['basenqn = "nqn.2011-06.com.truenas"', 'kernel = true'],
+    "smb": ['netbiosname = "truenas"', 'workgroup = "WORKGROUP"', 'enable_smb1 = false'],
+    "snmp": ['location = "Rack 4"', 'contact = "admin@example.com"', 'community = "public"'],
+    "ssh": ['tcpport = 22', 'passwordauth = false'],
+    "ups": ['mode = "MASTER"', 'identifier = "ups"', 'driver = "usbhid-ups$PRO"', 'port = "auto"'],
+    "mail": ['fromemail = "nas@example.com"', 'outgoingserver = "smtp.example.com"', 'port = 587', 'security = "TLS"'],
+    "network.configuration": ['hostname = "nas"', 'domain = "example.com"', 'ipv4gateway = "192.168.1.1"', 'nameserver1 = "192.168.1.1"'],
+    "system.advanced": ['serialconsole = true', 'serialspeed = "115200"', 'syslogserver = "logs.example.com:514"', 'kernel_extra_options = "mitigations=off"'],
+    "system.general": ['timezone = "Europe/Berlin"', 'language = "en"', 'ui_httpsredirect = true'],
+    "systemdataset": ['pool = "tank"'],
+}
+
+# Settings holding secrets; plans and CLI output hide their values
+SINGLETON_SENSITIVE = {
+    "mail": {"oauth", "pass"},
+    "snmp": {"v3_password", "v3_privpassphrase"},
+    "system.advanced": {"sed_passwd"},
+    "ups": {"monpwd", "rmonitor_password"},
+}
+
+# Hand-written hooks run around <ns>.update. Each entry is the code to run
+# before the update (Update only; Create already holds the prior settings in
+# `original`) and after it, then before and after the update that puts the
+# original settings back on destroy.
+SINGLETON_HOOKS = {
+    "system.general": (
+        """
+\t// UI settings only take effect once the web UI restarts; keep the current
+\t// values to tell whether that is needed
+\toriginal, err := r.client.Call("system.general.config", []interface{}{})
+\tif err != nil {
+\t\tresp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to read system_general_config: %s", err))
+\t\treturn
+\t}
+""",
+        """
+\tif err := restartUIIfChanged(r.client, original, result); err != nil {
+\t\tresp.Diagnostics.AddError("UI Restart Error", err.Error())
+\t\treturn
+\t}
+""",
+        """
+\t// Restored UI settings need a web UI restart too
+\tcurrent, err := r.client.Call("system.general.config", []interface{}{})
+\tif err != nil {
+\t\tresp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to read system_general_config: %s", err))
+\t\treturn
+\t}
+
+""",
+        """
+\tif err := restartUIIfChanged(r.client, current, result); err != nil {
+\t\tresp.Diagnostics.AddError("UI Restart Error", err.Error())
+\t\treturn
+\t}
+""",
+    ),
+}
+
+
+def singleton_name(base_name):
+    """Dotted name of a singleton resource: smb -> smb.config.
+
+    Namespaces already called "configuration" drop it, so network.configuration
+    becomes truenas_network_config rather than network_configuration_config.
+    """
+    if base_name.endswith(".configuration"):
+        base_name = base_name.rsplit(".", 1)[0]
+    return f"{base_name}.config"
+
+
+def gen_singleton_resource(base_name, methods, versions=None):
+    """Generate a settings resource for a namespace without create/delete."""
+    update_spec = methods.get(f"{base_name}.update", {})
+    if not update_spec.get("accepts") or f"{base_name}.config" not in methods:
         return None
 
-    properties = {p.get("_name_", ""): p for p in accepts if p.get("_name_")}
+    schema = update_spec["accepts"][0]
+    properties, _ = merge_anyof_schema(schema)
+    properties = {k: v for k, v in properties.items() if k != "id"}
     if not properties:
         return None
 
-    resource_name = "Action" + "".join(p.title() for p in parts)
-    resource_type = f"action_{method_name.replace('.', '_')}"
+    resource_name = singleton_name(base_name).replace(".", "_").title().replace("_", "")
+    tf_name = singleton_name(base_name).replace(".", "_")
     desc = (
-        (method_spec.get("description") or f"Execute {method_name}")
-        .replace("\n", " ")
-        .replace('"', '\\"')[:200]
-        .strip()
+        (update_spec.get("description") or f"Manages TrueNAS {base_name} settings")
+        .split("\n")[0][:200]
+        .replace('"', '\\"')
     )
 
-    # Fields
+    schema_attrs = gen_schema_attrs(
+        properties, [], singleton=True, sensitive=SINGLETON_SENSITIVE.get(base_name)
+    )
+    schema_attrs += """
+\t\t\t"restore_on_destroy": schema.BoolAttribute{
+\t\t\t\tOptional: true,
+\t\t\t\tComputed: true,
+\t\t\t\tDefault: booldefault.StaticBool(false),
+\t\t\t\tDescription: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+\t\t\t},"""
+    read_mapping = gen_read_mapping(properties, skip_id=True, json_objects=True)
+    computed_mapping = gen_computed_mapping(properties, [])
+    pre_update, post_update, pre_restore, post_restore = SINGLETON_HOOKS.get(
+        base_name, ("", "", "", "")
+    )
+    generated = schema_attrs + read_mapping + computed_mapping
+
+    imports = []
+    if "attr." in generated:
+        imports.append('"github.com/hashicorp/terraform-plugin-framework/attr"')
+    imports.extend(schema_imports(schema_attrs))
+
+    fields = gen_fields(properties) + '\n\tRestoreOnDestroy types.Bool `tfsdk:"restore_on_destroy"`'
+    version = schema_version(
+        tf_name, model_attr_types(fields), versions if versions is not None else {}
+    )
+
+    return TEMPLATES["resource_update_only.go"].format(
+        resource_name=resource_name,
+        name=tf_name,
+        api_name=base_name,
+        description=desc,
+        fields=fields,
+        schema_attrs=schema_attrs,
+        schema_version=version,
+        state_upgraders=gen_state_upgraders(version),
+        update_params=gen_create_params(properties),
+        read_mapping=read_mapping,
+        computed_mapping=computed_mapping,
+        update_call="CallWithJob" if update_spec.get("job") else "Call",
+        restore_fields=", ".join(f'"{k}"' for k in properties),
+        pre_update=pre_update,
+        post_update=post_update,
+        pre_restore=pre_restore,
+        restore_result="result" if post_restore else "_",
+        post_restore=post_restore,
+        extra_imports="\n\t".join(imports),
+    )
+
+
+# ============ Action Resource Generation ============
+
+
+def action_type_name(method_name):
+    """Terraform type name (without provider prefix) of the native action."""
+    return method_name.replace(".", "_")
+
+
+# Methods that run as background jobs although the spec doesn't flag them
+JOB_METHODS = {"pool.scrub.run"}
+
+
+def is_job_method(method_name, method_spec):
+    return bool(method_spec.get("job")) or method_name in JOB_METHODS
+
+
+ACTION_JOB_REFRESH = """	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Pick up the outcome of a job that was started without waiting
+	if data.State.ValueString() == actionStateRunning && !data.JobID.IsNull() {
+		job, err := r.client.GetJob(int(data.JobID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to Refresh Job", fmt.Sprintf("Job %d: %s", data.JobID.ValueInt64(), err.Error()))
+			return
+		}
+		data.State = types.StringValue(job.State)
+		data.Progress = types.Float64Value(job.Progress)
+		data.Error = types.StringValue(job.Error)
+		if job.State == "SUCCESS" {
+			data.Result = actionResult(job.Result)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+"""
+
+ACTION_JOB_HANDLING = """	// Background job - wait for completion unless told not to
+	if jobID, ok := result.(float64); ok {
+		data.JobID = types.Int64Value(int64(jobID))
+		data.Result = types.StringValue("")
+		data.Error = types.StringValue("")
+		if !data.Wait.IsNull() && !data.Wait.ValueBool() {
+			data.State = types.StringValue(actionStateRunning)
+			data.Progress = types.Float64Value(0)
+			return diags
+		}
+
+		jobResult, err := r.client.WaitForJob(int(jobID), {timeout})
+		if err != nil {
+			data.State = types.StringValue("FAILED")
+			data.Progress = types.Float64Value(0)
+			data.Error = types.StringValue(err.Error())
+			diags.AddError("Job Failed", err.Error())
+			return diags
+		}
+		data.State = types.StringValue(jobResult.State)
+		data.Progress = types.Float64Value(jobResult.Progress)
+		data.Result = actionResult(jobResult.Result)
+		return diags
+	}
+"""
+
+
+NATIVE_ACTION_JOB_WAIT = """
+	// Background job - wait for completion, forwarding progress to Terraform
+	if jobID, ok := result.(float64); ok {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("{method_name}: started job %d", int(jobID))})
+		_, err := a.client.WaitForJobWithProgress(int(jobID), invokeTimeout, func(percent float64, description string) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("{method_name}: %.0f%% %s", percent, description)})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Job Failed", fmt.Sprintf("{method_name} job %d: %s", int(jobID), err.Error()))
+		}
+	}
+"""
+
+
+def action_job_parts(is_job, timeout="timeout"):
+    """Template values that only job-backed action resources get."""
+    if not is_job:
+        return {
+            "{read_comment}": "Actions are immutable - just return current state",
+            "{wait_field}": "",
+            "{wait_attr}": "",
+            "{job_refresh}": "",
+            "{job_handling}": "",
+        }
+    return {
+        "{read_comment}": "Actions are immutable; only a job that wasn't waited for is refreshed",
+        "{wait_field}": '\tWait types.Bool `tfsdk:"wait"`\n',
+        "{wait_attr}": '\t\t\t"wait": actionWaitAttribute(),\n',
+        "{job_refresh}": ACTION_JOB_REFRESH,
+        "{job_handling}": ACTION_JOB_HANDLING.replace("{timeout}", timeout),
+    }
+
+
+def action_args(properties):
+    """Argument names of an action resource, as a Go string list."""
+    return ", ".join(f'"{n}"' for n in properties)
+
+
+def action_params(properties):
+    """Model fields, schema attributes and positional params for an action."""
     fields = "\n".join(
         f'\t{"".join(p.title() for p in n.split("_"))} types.{get_tf_type(p) if get_tf_type(p) not in ("List", "Object") else "String"} `tfsdk:"{n}"`'
         for n, p in properties.items()
@@ -671,16 +1550,85 @@ def gen_action_resource(method_name, method_spec):
                     ]
                 )
 
+    return fields, "\n".join(schema_lines), "\n".join(param_lines), needs_json
+
+
+def action_properties(method_spec):
+    accepts = method_spec.get("accepts", [])
+    return {p.get("_name_", ""): p for p in accepts if p.get("_name_")}
+
+
+def action_description(method_name, method_spec):
+    return (
+        (method_spec.get("description") or f"Execute {method_name}")
+        .replace("\n", " ")
+        .replace('"', '\\"')[:200]
+        .strip()
+    )
+
+
+def gen_action_resource(method_name, method_spec):
+    """Generate action resource."""
+    parts = method_name.split(".")
+    if len(parts) < 2:
+        return None
+
+    properties = action_properties(method_spec)
+    if not properties:
+        return None
+
+    resource_name = "Action" + "".join(p.title() for p in parts)
+    resource_type = f"action_{method_name.replace('.', '_')}"
+    fields, schema_attrs, param_building, needs_json = action_params(properties)
+    is_job = is_job_method(method_name, method_spec)
+    deprecation = (
+        f"Use the truenas_{action_type_name(method_name)} action instead, from lifecycle.action_trigger "
+        f"or terraform apply -invoke. This resource will be removed in a future release."
+    )
+
     code = TEMPLATES["action_resource.go"]
     for k, v in {
         "{resource_name}": resource_name,
         "{resource_type_name}": resource_type,
         "{fields}": fields,
-        "{schema_attrs}": "\n".join(schema_lines),
-        "{param_building}": "\n".join(param_lines),
+        "{schema_attrs}": schema_attrs,
+        "{param_building}": param_building,
+        "{action_args}": action_args(properties),
         "{method_name}": method_name,
-        "{description}": desc,
-        "{is_job}": "true" if method_spec.get("job") else "false",
+        "{description}": action_description(method_name, method_spec),
+        "{deprecation}": deprecation,
+        "{default_timeout}": "r.client.JobTimeout()" if is_job else "r.client.RPCTimeout()",
+        "{extra_imports}": '\n\t"encoding/json"' if needs_json else "",
+        **action_job_parts(is_job),
+    }.items():
+        code = code.replace(k, v)
+    return code
+
+
+def gen_native_action(method_name, method_spec):
+    """Generate a native Terraform action (Terraform 1.14+) for a one-shot method."""
+    parts = method_name.split(".")
+    if len(parts) < 2:
+        return None
+
+    properties = action_properties(method_spec)
+    if not properties:
+        return None
+
+    fields, schema_attrs, param_building, needs_json = action_params(properties)
+    is_job = is_job_method(method_name, method_spec)
+    code = TEMPLATES["action_native.go"]
+    for k, v in {
+        "{action_name}": "".join(p.title() for p in parts),
+        "{action_type_name}": action_type_name(method_name),
+        "{fields}": fields,
+        "{schema_attrs}": schema_attrs,
+        "{param_building}": param_building,
+        "{result_var}": "result" if is_job else "_",
+        "{job_wait}": NATIVE_ACTION_JOB_WAIT if is_job else "",
+        "{method_name}": method_name,
+        "{description}": action_description(method_name, method_spec),
+        "{default_timeout}": "a.client.JobTimeout()" if is_job else "a.client.RPCTimeout()",
         "{extra_imports}": '\n\t"encoding/json"' if needs_json else "",
     }.items():
         code = code.replace(k, v)
@@ -811,11 +1759,13 @@ def gen_uploadable_resource(method_name, method_spec, is_action=False):
         "{fields}": fields,
         "{schema_attrs}": "\n".join(schema_lines),
         "{param_building}": "\n".join(param_lines),
+        "{action_args}": action_args({**properties, "file_content": {}}),
         "{method_name}": method_name,
         "{endpoint_path}": endpoint,
         "{description}": desc,
-        "{is_job}": "true" if method_spec.get("job") else "false",
         "{id_generation}": id_gen,
+        # Uploads always start a job
+        **action_job_parts(is_action, "r.client.JobTimeout()"),
         "{extra_imports}": '\n\t"encoding/json"' if needs_json else "",
     }.items():
         template = template.replace(k, v)
@@ -825,6 +1775,34 @@ def gen_uploadable_resource(method_name, method_spec, is_action=False):
 # ============ Data Source Generation ============
 
 
+# Data sources that can also be looked up by a natural key instead of the ID
+NATURAL_KEYS = {
+    "user": "username",
+    "group": "name",
+    "pool": "name",
+    "vm": "name",
+    "service": "service",
+}
+NATURAL_KEY_EXAMPLES = {
+    "user": "alice",
+    "group": "builtin_users",
+    "pool": "tank",
+    "vm": "web01",
+    "service": "ssh",
+}
+
+
+# ID attribute of a data source that can also be read by the natural key {key}
+LOOKUP_ID_ATTR = """\t\t\t"id": schema.StringAttribute{{
+\t\t\t\tOptional:    true,
+\t\t\t\tComputed:    true,
+\t\t\t\tDescription: "Resource ID. Exactly one of `id` or `{key}` must be set.",
+\t\t\t\tValidators: []validator.String{{
+\t\t\t\t\tstringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("{key}")),
+\t\t\t\t}},
+\t\t\t}},"""
+
+
 def gen_datasource(base_name, methods):
     """Generate data source."""
     get_spec = methods.get(f"{base_name}.get_instance", {})
@@ -855,9 +1833,32 @@ def gen_datasource(base_name, methods):
         id_param = "data.ID.ValueString()"
         extra_imports = ""
 
-    # Add attr import if any List fields exist
+    # Add attr import if any List fields exist, json if any hold objects
     if any(get_tf_type(p) == "List" for p in properties.values()):
         extra_imports += '\n\t"github.com/hashicorp/terraform-plugin-framework/attr"'
+    if any(get_tf_type(p) == "List" and is_complex_object(p) for p in properties.values()):
+        extra_imports += '\n\t"encoding/json"'
+
+    key = NATURAL_KEYS.get(base_name)
+    if key not in properties:
+        key = None
+    schema_attrs = gen_schema_attrs(properties, [], False, lookup_key=key)
+    lookup = f'\tresult, err := d.client.Call("{base_name}.get_instance", {id_param})'
+    if key:
+        # Exactly one of id or the natural key; the other is computed
+        key_field = to_field_name(key)
+        lookup = f"""\tvar result interface{{}}
+\tvar err error
+\tif !data.{key_field}.IsNull() {{
+\t\tresult, err = queryOne(d.client, "{base_name}.query", "{key}", data.{key_field}.ValueString())
+\t}} else {{
+\t\tresult, err = d.client.Call("{base_name}.get_instance", {id_param})
+\t}}"""
+        extra_imports += (
+            '\n\t"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"'
+            '\n\t"github.com/hashicorp/terraform-plugin-framework/path"'
+            '\n\t"github.com/hashicorp/terraform-plugin-framework/schema/validator"'
+        )
 
     return TEMPLATES["datasource.go"].format(
         resource_name=resource_name,
@@ -865,19 +1866,31 @@ def gen_datasource(base_name, methods):
         api_name=base_name,
         description=desc,
         fields=gen_fields(properties, False),
-        schema_attrs=gen_schema_attrs(properties, [], False),
-        read_mapping=gen_read_mapping(properties, skip_id=True),
+        schema_attrs=schema_attrs,
+        read_mapping=gen_read_mapping(properties, skip_id=key is None),
         extra_imports=extra_imports,
-        id_param=id_param,
+        lookup_code=lookup,
     )
 
 
-def gen_query_datasource(base_name, methods):
-    """Generate query data source."""
-    query_spec = methods.get(f"{base_name}.query", {})
+def plural_name(tf_name):
+    """Name of the query data source for a namespace.
+
+    Names that already end in "s" (sharing_nfs, reporting_exporters) get a
+    "_list" suffix instead of a doubled "s".
+    """
+    if tf_name.endswith("s"):
+        return tf_name + "_list"
+    if tf_name.endswith("y") and tf_name[-2:-1] not in "aeiou":
+        return tf_name[:-1] + "ies"
+    return tf_name + "s"
+
+
+def query_item_properties(query_spec):
+    """Properties of the items returned by a *.query method."""
     returns = query_spec.get("returns", [])
     if not returns:
-        return None
+        return {}
 
     schema = returns[0] if isinstance(returns, list) else returns
     if "anyOf" in schema:
@@ -886,18 +1899,24 @@ def gen_query_datasource(base_name, methods):
                 schema = v
                 break
     if schema.get("type") != "array":
-        return None
+        return {}
 
     items = schema.get("items", {})
     items = items[0] if isinstance(items, list) else items
-    properties = {
+    return {
         k: v for k, v in items.get("properties", {}).items() if get_tf_type(v) != "List"
     }
+
+
+def gen_query_datasource(base_name, methods):
+    """Generate query data source."""
+    query_spec = methods.get(f"{base_name}.query", {})
+    properties = query_item_properties(query_spec)
     if not properties:
         return None
 
-    resource_name = base_name.replace(".", "_").title().replace("_", "") + "s"
-    tf_name = base_name.replace(".", "_") + "s"
+    tf_name = plural_name(base_name.replace(".", "_"))
+    resource_name = tf_name.title().replace("_", "")
     desc = (
         (query_spec.get("description") or f"Query {tf_name}")
         .split("\n")[0][:200]
@@ -963,6 +1982,31 @@ def gen_query_datasource(base_name, methods):
 # ============ Documentation Generation ============
 
 
+def identity_import_docs(base_name, properties):
+    """Import-by-identity section of a resource's documentation."""
+    if base_name not in RESOURCE_IDENTITIES:
+        return ""
+    name = base_name.replace(".", "_")
+    values = []
+    for k, _ in identity_keys(base_name):
+        is_int = identity_key_is_int(k, properties)
+        values.append(f"    {k} = {'1' if is_int else chr(34) + 'example' + chr(34)}")
+    return f"""
+Or import by identity (Terraform 1.12+):
+
+```terraform
+import {{
+  to = truenas_{name}.example
+  identity = {{
+{chr(10).join(values)}
+  }}
+}}
+```
+
+Use the [`truenas_{name}`](../list-resources/{name}.md) list resource with `terraform query` to generate these for existing objects.
+"""
+
+
 def gen_resource_docs(
     base_name, properties, required, description, methods, anyof_variants=None
 ):
@@ -1111,8 +2155,57 @@ resource "truenas_{tf_name}" "example" {{
         optional_args=chr(10).join(opt_args) or "- None",
         variant_examples=variant_examples,
         generic_example=generic_example,
+        identity_import=identity_import_docs(base_name, properties),
+    )
+
+    Path("docs/resources").mkdir(parents=True, exist_ok=True)
+    Path(f"docs/resources/{tf_name}.md").write_text(doc)
+
+
+def gen_singleton_docs(base_name, methods):
+    """Generate documentation for a singleton settings resource."""
+    tf_name = singleton_name(base_name).replace(".", "_")
+    spec = methods[f"{base_name}.update"]
+    properties, _ = merge_anyof_schema(spec["accepts"][0])
+    description = (spec.get("description") or f"Manages TrueNAS {base_name} settings").split(
+        "\n"
+    )[0][:200]
+
+    example_lines = [f"  {line}" for line in SINGLETON_EXAMPLES.get(base_name, [])]
+    opt_args = []
+    for n, p in sorted(properties.items()):
+        if n == "id":
+            continue
+        tf_type = get_tf_type(p)
+        desc = p.get("description", "").replace("\n", " ")[:200]
+        if "enum" in p:
+            desc += f" Valid values: {', '.join(f'`{v}`' for v in p['enum'][:10])}"
+        if n in SINGLETON_SENSITIVE.get(base_name, set()):
+            tf_type += ", Sensitive"
+        opt_args.append(f"- `{n}` ({tf_type}) - {desc}")
+    opt_args.append(
+        "- `restore_on_destroy` (Bool) - On destroy, put back the settings captured before the first apply instead of leaving them as they are. Default: `false`"
     )
 
+    generic_example = f"""
+## Example Usage
+
+Attributes left out keep their current value on the server.
+
+```terraform
+resource "truenas_{tf_name}" "example" {{
+{chr(10).join(example_lines)}
+}}
+```
+"""
+    doc = TEMPLATES["resource_doc.md"].format(
+        resource_type=tf_name,
+        description=description,
+        required_args="- None",
+        optional_args="\n".join(opt_args),
+        variant_examples="",
+        generic_example=generic_example,
+    )
     Path("docs/resources").mkdir(parents=True, exist_ok=True)
     Path(f"docs/resources/{tf_name}.md").write_text(doc)
 
@@ -1126,19 +2219,86 @@ def gen_datasource_docs(base_name, properties, description):
         if n != "id" and isinstance(p, dict)
     ]
 
+    key = NATURAL_KEYS.get(base_name)
+    if key in properties:
+        attrs = [a for a in attrs if not a.startswith(f"- `{key}` (")]
+        example = f'data "truenas_{tf_name}" "example" {{\n  {key} = "{NATURAL_KEY_EXAMPLES[base_name]}"\n}}'
+        lookup_attrs = (
+            "### Optional\n\n"
+            f"- `id` (String) The ID of the {tf_name} to retrieve. Exactly one of `id` or `{key}` must be set.\n"
+            f"- `{key}` (String) Look the {tf_name} up by `{key}` instead of ID. Fails unless exactly one {tf_name} matches."
+        )
+    else:
+        example = f'data "truenas_{tf_name}" "example" {{\n  id = "1"\n}}'
+        lookup_attrs = f"### Required\n\n- `id` (String) The ID of the {tf_name} to retrieve."
+
     doc = TEMPLATES["datasource_doc.md"].format(
         resource_type=tf_name,
         description=description,
         name=tf_name,
+        example=example,
+        lookup_attrs=lookup_attrs,
         attrs=chr(10).join(attrs) or "- None",
     )
     Path("docs/data-sources").mkdir(parents=True, exist_ok=True)
     Path(f"docs/data-sources/{tf_name}.md").write_text(doc)
 
 
-def gen_action_docs(method_name, properties, description):
+def gen_query_datasource_docs(base_name, properties, description):
+    """Generate query data source documentation."""
+    name = base_name.replace(".", "_")
+    attrs = [
+        f"- `{n}` ({get_tf_type(p)}) - {p.get('description', '')[:200].replace(chr(10), ' ').strip()}"
+        for n, p in sorted(properties.items())
+        if n != "provider" and isinstance(p, dict)
+    ]
+
+    doc = TEMPLATES["datasource_query_doc.md"].format(
+        resource_type=plural_name(name),
+        description=description,
+        name=name,
+        attrs=chr(10).join(attrs) or "- None",
+    )
+    Path("docs/data-sources").mkdir(parents=True, exist_ok=True)
+    Path(f"docs/data-sources/{plural_name(name)}.md").write_text(doc)
+
+
+def identity_attr_docs(base_name, properties):
+    lines = []
+    for k, field in identity_keys(base_name):
+        is_int = identity_key_is_int(k, properties)
+        desc = "The ID of the resource." if k == "id" else f"The `{field}` the API reports for it."
+        lines.append(f"- `{k}` ({'Number' if is_int else 'String'}) {desc}")
+    return "\n".join(lines)
+
+
+def gen_list_resource_docs(base_name, properties):
+    """Generate list resource documentation."""
+    name = base_name.replace(".", "_")
+    doc = TEMPLATES["list_resource_doc.md"].format(
+        name=name,
+        api_name=base_name,
+        display_field=RESOURCE_IDENTITIES[base_name][1],
+        identity_attrs=identity_attr_docs(base_name, properties),
+    )
+    Path("docs/list-resources").mkdir(parents=True, exist_ok=True)
+    Path(f"docs/list-resources/{name}.md").write_text(doc)
+
+
+def gen_action_docs(method_name, properties, description, is_job=False):
     """Generate action documentation."""
     resource_name = f"action_{method_name.replace('.', '_')}"
+    wait_doc = (
+        "- `wait` (Bool) Wait for the background job to finish. When `false` the job is only started, "
+        "`state` is `RUNNING` and later refreshes pick up its outcome. Default: `true`\n"
+        if is_job
+        else ""
+    )
+    job_note = (
+        "- The background job is monitored until completion; set `wait = false` to only start it"
+        if is_job
+        else "- The method returns immediately, so `state` is `SUCCESS` once it has been called"
+    )
 
     example = f'resource "truenas_{resource_name}" "example" {{\n'
     for n, p in properties.items():
@@ -1170,6 +2330,8 @@ description: |-
 
 This is an action resource that executes the `{method_name}` operation. Actions are triggered on resource creation and cannot be undone on destroy.
 
+~> **Deprecated:** Use the [`truenas_{action_type_name(method_name)}`](../actions/{action_type_name(method_name)}.md) action instead (Terraform 1.14+). This resource will be removed in a future release.
+
 ## Example Usage
 
 ```terraform
@@ -1182,31 +2344,114 @@ This is an action resource that executes the `{method_name}` operation. Actions
 
 {chr(10).join(schema_lines) or "None"}
 
+### Optional
+
+- `triggers` (Map of String) Arbitrary values; when any of them changes the resource is replaced, which runs the action again.
+- `when` (String) When to run the action: `create`, `destroy` or `both`. Default: `create`
+{wait_doc}- `timeouts` (Block) `create` and `delete` durations, e.g. `"30m"`. Default: the provider's `{"job_timeout" if is_job else "rpc_timeout"}`
+
 ### Computed Outputs
 
 - `action_id` (String) Unique identifier for this action execution
 - `job_id` (Int64) Background job ID (if applicable)
-- `state` (String) Job state: SUCCESS, FAILED, or RUNNING
+- `state` (String) Job state: SUCCESS, FAILED, RUNNING, or SKIPPED when the action only runs on destroy
 - `progress` (Float64) Job progress percentage (0-100)
-- `result` (String) Action result data
+- `result` (String) Action result, JSON-encoded; read it with `jsondecode()`
 - `error` (String) Error message if action failed
 
 ## Notes
 
-- Actions execute immediately when the resource is created
-- Background jobs are monitored until completion
-- Progress updates are logged during execution
-- The resource cannot be updated - changes force recreation
+- With `when = "create"` (the default) the action runs when the resource is created
+- With `when = "destroy"` or `"both"` it runs when the resource is destroyed, e.g. for teardown steps
+- Changing `triggers`, or an argument while the action runs on create, replaces the resource and runs the action again; other changes are recorded without running it
+{job_note}
 - Destroying the resource does not undo the action
 """
     Path("docs/resources").mkdir(parents=True, exist_ok=True)
     Path(f"docs/resources/{resource_name}.md").write_text(doc)
 
 
+def gen_native_action_docs(method_name, properties, description, is_job):
+    """Generate native action documentation."""
+    name = action_type_name(method_name)
+
+    args = []
+    for n, p in properties.items():
+        if p.get("_required_"):
+            tf_type = get_tf_type(p)
+            val = {"String": '"value"', "Int64": "1", "Bool": "true"}.get(
+                tf_type, '"value"'
+            )
+            args.append(f"    {n} = {val}")
+    config = "\n".join(args)
+    job_note = (
+        " The action waits for the background job and reports its progress while Terraform runs."
+        if is_job
+        else ""
+    )
+
+    schema_lines = []
+    for n, p in properties.items():
+        tf_type = get_tf_type(p)
+        req = "Required" if p.get("_required_") else "Optional"
+        desc = p.get("description", "").replace("\n", " ")[:200]
+        schema_lines.append(f"- `{n}` ({tf_type}, {req}) {desc}")
+
+    doc = f"""---
+page_title: "truenas_{name} Action - terraform-provider-truenas"
+subcategory: ""
+description: |-
+  {description}
+---
+
+# truenas_{name} (Action)
+
+{description}
+
+Runs `{method_name}` when invoked. Requires Terraform 1.14 or later.{job_note}
+
+## Example Usage
+
+```terraform
+action "truenas_{name}" "example" {{
+  config {{
+{config}
+  }}
+}}
+
+resource "terraform_data" "example" {{
+  lifecycle {{
+    action_trigger {{
+      events  = [after_create]
+      actions = [action.truenas_{name}.example]
+    }}
+  }}
+}}
+```
+
+Or run it on demand:
+
+```shell
+terraform apply -invoke=action.truenas_{name}.example
+```
+
+## Schema
+
+### Arguments
+
+{chr(10).join(schema_lines) or "None"}
+- `timeouts` (Block, Optional) `invoke` duration, e.g. `"30m"`. Default: the provider's `{"job_timeout" if is_job else "rpc_timeout"}`
+"""
+    Path("docs/actions").mkdir(parents=True, exist_ok=True)
+    Path(f"docs/actions/{name}.md").write_text(doc)
+
+
 # ============ Provider Generation ============
 
 
-def gen_provider(resources, datasources, actions, uploadables):
+def gen_provider(
+    resources, datasources, actions, uploadables, native_actions=(), list_resources=()
+):
     """Generate provider.go."""
     with open("templates/provider.go.tmpl") as f:
         template = f.read()
@@ -1231,11 +2476,45 @@ def gen_provider(resources, datasources, actions, uploadables):
     code = code.replace(
         "{{datasource_list}}", ",\n\t\t".join(ds_funcs) + ("," if ds_funcs else "")
     )
+    native_funcs = [
+        f"New{''.join(p.title() for p in a.split('.'))}Action" for a in native_actions
+    ]
+    code = code.replace(
+        "{{action_list}}",
+        ",\n\t\t".join(native_funcs) + ("," if native_funcs else ""),
+    )
+
+    list_funcs = [
+        f"New{r.replace('.', '_').title().replace('_', '')}ListResource"
+        for r in list_resources
+    ]
+    code = code.replace(
+        "{{list_resource_list}}",
+        ",\n\t\t".join(list_funcs) + ("," if list_funcs else ""),
+    )
 
     Path("internal/provider/provider.go").write_text(code)
     print("✅ Generated provider.go", file=sys.stderr)
 
 
+# Method names that only read, the ones the client allows in read-only mode
+# besides get_* methods, which IsReadOnlyMethod allows by name
+READ_ONLY_SUFFIXES = ("query", "config", "info")
+
+
+def gen_readonly_methods(methods):
+    """Generate the client's list of read-only methods from the spec."""
+    with open("templates/readonly_methods.go.tmpl") as f:
+        template = f.read()
+
+    names = sorted(m for m in methods if m.rsplit(".", 1)[-1] in READ_ONLY_SUFFIXES)
+    code = template.replace(
+        "{{readonly_methods}}", "\n".join(f'\t"{m}": true,' for m in names)
+    )
+    Path("internal/client/readonly_methods_generated.go").write_text(code)
+    print(f"✅ Generated {len(names)} read-only methods", file=sys.stderr)
+
+
 # ============ Main ============
 
 
@@ -1249,15 +2528,20 @@ def main():
     print(f"Methods: {len(methods)}", file=sys.stderr)
 
     output_dir = Path("internal/provider")
+    # Namespaces the generator can't model; manage them with truenas_generic
     skip = {"nvmet.port"}
 
     # Resources
     resources = [m[:-7] for m in methods if m.endswith(".create")]
     generated_resources = []
+    versions = load_schema_versions()
     for base in resources:
         if base in skip:
             continue
-        code = gen_resource(base, methods)
+        if base in HAND_WRITTEN_RESOURCES:
+            generated_resources.append(base)
+            continue
+        code = gen_resource(base, methods, versions)
         if code:
             (output_dir / f"resource_{base.replace('.', '_')}_generated.go").write_text(
                 code
@@ -1278,8 +2562,34 @@ def main():
                 ]
                 gen_resource_docs(base, props, req, desc, methods, schema.get("anyOf"))
 
+    # Singleton settings resources
+    for base in sorted(SINGLETON_RESOURCES):
+        code = gen_singleton_resource(base, methods, versions)
+        if code:
+            name = singleton_name(base)
+            (
+                output_dir / f"resource_{name.replace('.', '_')}_generated.go"
+            ).write_text(code)
+            generated_resources.append(name)
+            gen_singleton_docs(base, methods)
+
+    save_schema_versions(versions)
     print(f"✅ Generated {len(generated_resources)} resources", file=sys.stderr)
 
+    # List resources for terraform query
+    generated_lists = []
+    for base in sorted(RESOURCE_IDENTITIES):
+        if base not in generated_resources:
+            continue
+        code = gen_list_resource(base, methods)
+        if code:
+            (
+                output_dir / f"list_resource_{base.replace('.', '_')}_generated.go"
+            ).write_text(code)
+            generated_lists.append(base)
+            gen_list_resource_docs(base, resource_properties(base, methods)[1])
+    print(f"✅ Generated {len(generated_lists)} list resources", file=sys.stderr)
+
     # Actions
     action_keywords = [
         "start",
@@ -1296,7 +2606,7 @@ def main():
     uploadable_actions = {"mail.send", "support.attach_ticket"}
     skip_uploadable = {"pool.dataset.encryption_summary"}
 
-    generated_actions, generated_uploadables = [], []
+    generated_actions, generated_uploadables, generated_native = [], [], []
 
     for method, spec in methods.items():
         if any(
@@ -1326,7 +2636,8 @@ def main():
                     generated_uploadables.append(method)
             continue
 
-        if spec.get("job") or any(k in method.split(".")[-1] for k in action_keywords):
+        is_job = is_job_method(method, spec)
+        if is_job or any(k in method.split(".")[-1] for k in action_keywords):
             code = gen_action_resource(method, spec)
             if code:
                 (
@@ -1340,27 +2651,34 @@ def main():
                     if p.get("_name_")
                 }
                 desc = (spec.get("description") or f"Execute {method}").replace("\n", " ").strip()
-                gen_action_docs(method, props, desc)
+                gen_action_docs(method, props, desc, is_job)
+
+                code = gen_native_action(method, spec)
+                if code:
+                    (
+                        output_dir
+                        / f"native_action_{method.replace('.', '_')}_generated.go"
+                    ).write_text(code)
+                    generated_native.append(method)
+                    gen_native_action_docs(method, props, desc, is_job)
 
     print(
-        f"✅ Generated {len(generated_actions)} actions, {len(generated_uploadables)} uploadables",
+        f"✅ Generated {len(generated_actions)} actions ({len(generated_native)} native), {len(generated_uploadables)} uploadables",
         file=sys.stderr,
     )
 
     # Data sources
-    ds_candidates = [
-        "vm",
-        "pool",
-        "pool.dataset",
-        "disk",
-        "user",
-        "group",
-        "interface",
-        "service",
-    ]
+    # Data sources: every namespace with get_instance or query
+    ds_bases = sorted(
+        {
+            m.rsplit(".", 1)[0]
+            for m in methods
+            if m.endswith(".get_instance") or m.endswith(".query")
+        }
+    )
     generated_ds, generated_query = [], []
 
-    for base in ds_candidates:
+    for base in ds_bases:
         if f"{base}.get_instance" in methods:
             code = gen_datasource(base, methods)
             if code:
@@ -1376,16 +2694,22 @@ def main():
                     gen_datasource_docs(
                         base,
                         schema.get("properties", {}),
-                        (spec.get("description") or f"Get {base}").split("\n")[0][:200],
+                        (spec.get("description") or f"Retrieves TrueNAS {base.replace('.', '_')} data").split("\n")[0][:200],
                     )
 
         if f"{base}.query" in methods:
             code = gen_query_datasource(base, methods)
             if code:
-                (
-                    output_dir / f"datasource_{base.replace('.', '_')}s_generated.go"
-                ).write_text(code)
-                generated_query.append(base + "s")
+                plural = plural_name(base.replace(".", "_"))
+                (output_dir / f"datasource_{plural}_generated.go").write_text(code)
+                generated_query.append(plural)
+
+                spec = methods[f"{base}.query"]
+                gen_query_datasource_docs(
+                    base,
+                    query_item_properties(spec),
+                    (spec.get("description") or f"Query {plural}").split("\n")[0][:200],
+                )
 
     print(
         f"✅ Generated {len(generated_ds)} datasources, {len(generated_query)} query datasources",
@@ -1397,7 +2721,10 @@ def main():
         generated_ds + generated_query,
         generated_actions,
         generated_uploadables,
+        generated_native,
+        generated_lists,
     )
+    gen_readonly_methods(methods)
 
 
 if __name__ == "__main__":
@@ -2,7 +2,9 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,15 +12,17 @@ import (
 	"mime/multipart"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
 	host           string
-	token          string
+	tokenSource    TokenSource
 	conn           *websocket.Conn
 	httpClient     *http.Client
 	mu             sync.Mutex
@@ -28,8 +32,19 @@ type Client struct {
 	nextID         int
 	connected      bool
 	connGeneration int
+	rpcTimeout     time.Duration
+	jobTimeout     time.Duration
+	readOnly       bool
+	jobMethods     map[string]map[string]bool
 }
 
+// Default timeouts, overridable per provider via SetTimeouts
+const (
+	DefaultRPCTimeout    = 30 * time.Second
+	DefaultJobTimeout    = 5 * time.Minute
+	DefaultUploadTimeout = 30 * time.Second
+)
+
 type DDPEvent struct {
 	Msg        string                 `json:"msg"`
 	Collection string                 `json:"collection,omitempty"`
@@ -55,20 +70,131 @@ type DDPResponse struct {
 }
 
 func NewClient(host, token string) (*Client, error) {
+	return NewClientWithTokenSource(host, StaticToken(token))
+}
+
+// NewClientWithTokenSource is NewClient for a token that may change, such as
+// one read from a file or printed by a credential helper
+func NewClientWithTokenSource(host string, tokenSource TokenSource) (*Client, error) {
 	return &Client{
 		host:          host,
-		token:         token,
+		tokenSource:   tokenSource,
 		requests:      make(map[string]chan DDPResponse),
 		subscriptions: make(map[string]chan DDPEvent),
 		httpClient: &http.Client{
 			Transport: &http.Transport{
 				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 			},
-			Timeout: 30 * time.Second,
+			Timeout: DefaultUploadTimeout,
 		},
+		rpcTimeout: DefaultRPCTimeout,
+		jobTimeout: DefaultJobTimeout,
+		jobMethods: make(map[string]map[string]bool),
 	}, nil
 }
 
+// SetTimeouts overrides the default RPC, job and upload timeouts. Zero values
+// keep the current setting.
+func (c *Client) SetTimeouts(rpc, job, upload time.Duration) {
+	if rpc > 0 {
+		c.rpcTimeout = rpc
+	}
+	if job > 0 {
+		c.jobTimeout = job
+	}
+	if upload > 0 {
+		c.httpClient.Timeout = upload
+	}
+}
+
+// RPCTimeout returns how long a single call waits for its response
+func (c *Client) RPCTimeout() time.Duration {
+	return c.rpcTimeout
+}
+
+// JobTimeout returns how long CallWithJob waits for a job to finish
+func (c *Client) JobTimeout() time.Duration {
+	return c.jobTimeout
+}
+
+// Host returns the host, and port if any, the client connects to
+func (c *Client) Host() string {
+	return c.host
+}
+
+// PeerCertificate returns the certificate the server presented on the current
+// connection, or nil when not connected
+func (c *Client) PeerCertificate() *x509.Certificate {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == nil {
+		return nil
+	}
+	tlsConn, ok := c.conn.UnderlyingConn().(*tls.Conn)
+	if !ok {
+		return nil
+	}
+	certs := tlsConn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil
+	}
+	return certs[0]
+}
+
+// SetReadOnly makes the client refuse every method that may change the
+// system, see IsReadOnlyMethod
+func (c *Client) SetReadOnly(readOnly bool) {
+	c.readOnly = readOnly
+}
+
+// ReadOnly reports whether the client only calls read-only methods
+func (c *Client) ReadOnly() bool {
+	return c.readOnly
+}
+
+// readOnlyExtraMethods are read-only methods outside the spec's query,
+// config and info methods that the provider calls on refresh or that
+// truenas_rpc allows
+var readOnlyExtraMethods = map[string]bool{
+	"app.available":            true,
+	"app.categories":           true,
+	"core.subscribe":           true, // job progress events
+	"directoryservices.status": true, // truenas_directoryservices health
+	"disk.temperatures":        true,
+	"pool.dataset.details":     true,
+	"smb.status":               true,
+	"system.boot_id":           true,
+	"system.build_time":        true,
+	"system.host_id":           true,
+	"system.product_type":      true,
+	"system.ready":             true,
+	"system.release_notes_url": true,
+	"system.state":             true, // wait_for_ready
+	"system.version":           true,
+	"system.version_short":     true,
+}
+
+// IsReadOnlyMethod reports whether method only reads, and so may be called
+// in read-only mode or from truenas_rpc. Besides the listed methods, every
+// get_* method such as get_instance only reads.
+func IsReadOnlyMethod(method string) bool {
+	if readOnlyMethods[method] || readOnlyExtraMethods[method] {
+		return true
+	}
+	i := strings.LastIndex(method, ".")
+	return i > 0 && strings.HasPrefix(method[i+1:], "get_")
+}
+
+// ReadOnlyError is returned for calls refused in read-only mode
+type ReadOnlyError struct {
+	Method string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("the provider is in read-only mode (read_only or TRUENAS_READ_ONLY) and refused to call %s, "+
+		"which is not a query, get_*, config or info method and may change the system", e.Method)
+}
+
 func (c *Client) connect() error {
 	// Note: reconnectMu should be held by caller (ensureConnected)
 
@@ -78,8 +204,12 @@ func (c *Client) connect() error {
 		TLSClientConfig:  &tls.Config{InsecureSkipVerify: true},
 	}
 
+	token, err := c.tokenSource.Token()
+	if err != nil {
+		return fmt.Errorf("unable to get API token: %v", err)
+	}
 	headers := http.Header{}
-	headers.Set("Authorization", "Bearer "+c.token)
+	headers.Set("Authorization", "Bearer "+token)
 
 	url := fmt.Sprintf("wss://%s/websocket", c.host)
 	conn, _, err := dialer.Dial(url, headers)
@@ -134,7 +264,7 @@ func (c *Client) connect() error {
 	authMsg := DDPMessage{
 		Msg:    "method",
 		Method: "auth.login_with_api_key",
-		Params: []interface{}{c.token},
+		Params: []interface{}{token},
 		ID:     id,
 	}
 
@@ -259,6 +389,56 @@ func (c *Client) ensureConnected() error {
 	return c.connect()
 }
 
+// Reconnect drops the current connection and dials again until the server
+// accepts it or timeout passes. Used after changes that restart the web UI.
+func (c *Client) Reconnect(timeout time.Duration) error {
+	c.mu.Lock()
+	c.connected = false
+	c.mu.Unlock()
+
+	deadline := time.Now().Add(timeout)
+	for {
+		err := c.ensureConnected()
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("server did not accept a connection within %v: %v", timeout, err)
+		}
+		log.Printf("Reconnect failed, retrying: %v", err)
+		time.Sleep(2 * time.Second)
+	}
+}
+
+// readyPollInterval is how often WaitForReady asks for the system state
+var readyPollInterval = 5 * time.Second
+
+// WaitForReady polls system.state until middleware reports READY, timeout
+// passes or ctx is done. After a reboot the websocket accepts connections
+// before middleware has started, so failed connections and calls count as not
+// ready yet.
+func (c *Client) WaitForReady(ctx context.Context, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		state, err := c.Call("system.state", []interface{}{})
+		if err == nil && state == "READY" {
+			return nil
+		}
+		if err == nil {
+			err = fmt.Errorf("system state is %v", state)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("middleware not ready within %v: %v", timeout, err)
+		}
+		tflog.Debug(ctx, "Waiting for middleware to be ready", map[string]interface{}{"error": err.Error()})
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("stopped waiting for middleware to be ready: %v", ctx.Err())
+		case <-time.After(readyPollInterval):
+		}
+	}
+}
+
 // InitialConnect establishes the initial connection during provider setup
 func (c *Client) InitialConnect() error {
 	c.reconnectMu.Lock()
@@ -267,6 +447,13 @@ func (c *Client) InitialConnect() error {
 }
 
 func (c *Client) call(method string, params interface{}) (*DDPResponse, error) {
+	return c.callTimeout(method, params, c.rpcTimeout)
+}
+
+func (c *Client) callTimeout(method string, params interface{}, timeout time.Duration) (*DDPResponse, error) {
+	if c.readOnly && !IsReadOnlyMethod(method) {
+		return nil, &ReadOnlyError{Method: method}
+	}
 	if err := c.ensureConnected(); err != nil {
 		return nil, err
 	}
@@ -294,15 +481,20 @@ func (c *Client) call(method string, params interface{}) (*DDPResponse, error) {
 	select {
 	case response := <-respChan:
 		return &response, nil
-	case <-time.After(30 * time.Second):
+	case <-time.After(timeout):
 		c.mu.Lock()
 		delete(c.requests, id)
 		c.mu.Unlock()
-		return nil, fmt.Errorf("request timeout")
+		return nil, fmt.Errorf("request timeout after %v", timeout)
 	}
 }
 
 func (c *Client) Call(method string, params interface{}) (interface{}, error) {
+	return c.CallWithTimeout(method, params, c.rpcTimeout)
+}
+
+// CallWithTimeout is Call with an explicit limit on how long to wait for the response
+func (c *Client) CallWithTimeout(method string, params interface{}, timeout time.Duration) (interface{}, error) {
 	// For DDP protocol, params should be wrapped in array unless already an array
 	var ddpParams interface{}
 
@@ -333,7 +525,7 @@ func (c *Client) Call(method string, params interface{}) (interface{}, error) {
 		}
 	}
 
-	response, err := c.call(method, ddpParams)
+	response, err := c.callTimeout(method, ddpParams, timeout)
 	if err != nil {
 		return nil, err
 	}
@@ -380,8 +572,72 @@ func formatTrueNASError(err interface{}) string {
 	return fmt.Sprintf("%v", err)
 }
 
+// IsJobMethod reports whether method runs as a background job, as listed by
+// core.get_methods. The methods of a namespace are looked up once per client.
+func (c *Client) IsJobMethod(method string) (bool, error) {
+	namespace := method
+	if i := strings.LastIndex(method, "."); i > 0 {
+		namespace = method[:i]
+	}
+	c.mu.Lock()
+	jobs, ok := c.jobMethods[namespace]
+	c.mu.Unlock()
+	if !ok {
+		specs, err := c.methodSpecs(namespace)
+		if err != nil {
+			return false, err
+		}
+		jobs = make(map[string]bool, len(specs))
+		for name, spec := range specs {
+			specMap, _ := spec.(map[string]interface{})
+			jobs[name], _ = specMap["job"].(bool)
+		}
+		c.mu.Lock()
+		c.jobMethods[namespace] = jobs
+		c.mu.Unlock()
+	}
+	return jobs[method], nil
+}
+
+// ArgDefault returns the server's default for the positional argument index
+// of method, as listed by core.get_methods. ok is false when it has none.
+func (c *Client) ArgDefault(method string, index int) (value interface{}, ok bool, err error) {
+	namespace := method
+	if i := strings.LastIndex(method, "."); i > 0 {
+		namespace = method[:i]
+	}
+	specs, err := c.methodSpecs(namespace)
+	if err != nil {
+		return nil, false, err
+	}
+	spec, _ := specs[method].(map[string]interface{})
+	accepts, _ := spec["accepts"].([]interface{})
+	if index >= len(accepts) {
+		return nil, false, nil
+	}
+	arg, _ := accepts[index].(map[string]interface{})
+	value, ok = arg["default"]
+	return value, ok, nil
+}
+
+// methodSpecs returns core.get_methods for namespace, keyed by method name
+func (c *Client) methodSpecs(namespace string) (map[string]interface{}, error) {
+	result, err := c.Call("core.get_methods", []interface{}{namespace})
+	if err != nil {
+		return nil, err
+	}
+	specs, _ := result.(map[string]interface{})
+	return specs, nil
+}
+
 // CallWithJob calls a method that returns a job ID and waits for completion
 func (c *Client) CallWithJob(method string, params interface{}) (interface{}, error) {
+	return c.CallWithJobTimeout(method, params, c.jobTimeout)
+}
+
+// CallWithJobTimeout is CallWithJob with an explicit limit on how long to wait
+// for the job to finish
+func (c *Client) CallWithJobTimeout(method string, params interface{}, timeout time.Duration) (interface{}, error) {
 	result, err := c.Call(method, params)
 	if err != nil {
 		return nil, err
@@ -400,7 +656,7 @@ func (c *Client) CallWithJob(method string, params interface{}) (interface{}, er
 	}
 
 	// Wait for job completion using WebSocket events
-	jobResult, err := c.WaitForJob(jobID, 5*time.Minute)
+	jobResult, err := c.WaitForJob(jobID, timeout)
 	if err != nil {
 		return nil, fmt.Errorf("job wait failed: %v", err)
 	}
@@ -410,6 +666,10 @@ func (c *Client) CallWithJob(method string, params interface{}) (interface{}, er
 
 // UploadFile performs a multipart file upload to the specified endpoint
 func (c *Client) UploadFile(endpoint string, jsonData map[string]interface{}, fileContent []byte, filename string) (interface{}, error) {
+	// Uploads always change something
+	if c.readOnly {
+		return nil, &ReadOnlyError{Method: endpoint}
+	}
 	var buf bytes.Buffer
 	writer := multipart.NewWriter(&buf)
 
@@ -443,7 +703,11 @@ func (c *Client) UploadFile(endpoint string, jsonData map[string]interface{}, fi
 	}
 
 	req.Header.Set("Content-Type", writer.FormDataContentType())
-	req.Header.Set("Authorization", "Bearer "+c.token)
+	token, err := c.tokenSource.Token()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get API token: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
 
 	// Execute request
 	resp, err := c.httpClient.Do(req)
@@ -487,8 +751,48 @@ type JobResult struct {
 	Error    string
 }
 
+// GetJob returns the current state of a background job
+func (c *Client) GetJob(jobID int) (*JobResult, error) {
+	result, err := c.Call("core.get_jobs", []interface{}{
+		[]interface{}{[]interface{}{"id", "=", jobID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	jobs, ok := result.([]interface{})
+	if !ok || len(jobs) == 0 {
+		return nil, fmt.Errorf("job %d not found", jobID)
+	}
+	fields, ok := jobs[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected job %d format: %T", jobID, jobs[0])
+	}
+	return parseJob(jobID, fields), nil
+}
+
+// parseJob converts a core.get_jobs entry into a JobResult
+func parseJob(jobID int, fields map[string]interface{}) *JobResult {
+	job := &JobResult{ID: jobID, Result: fields["result"]}
+	job.State, _ = fields["state"].(string)
+	job.Error, _ = fields["error"].(string)
+	if progress, ok := fields["progress"].(map[string]interface{}); ok {
+		job.Progress, _ = progress["percent"].(float64)
+	}
+	return job
+}
+
 // WaitForJob subscribes to job events and waits for completion
 func (c *Client) WaitForJob(jobID int, timeout time.Duration) (*JobResult, error) {
+	return c.WaitForJobWithProgress(jobID, timeout, nil)
+}
+
+// JobProgressFunc receives a job's progress percentage and description
+// whenever either changes
+type JobProgressFunc func(percent float64, description string)
+
+// WaitForJobWithProgress is WaitForJob that also reports progress updates
+// to onProgress, if set
+func (c *Client) WaitForJobWithProgress(jobID int, timeout time.Duration, onProgress JobProgressFunc) (*JobResult, error) {
 	// Subscribe to job updates
 	subID := fmt.Sprintf("job_%d", jobID)
 	eventChan := make(chan DDPEvent, 10)
@@ -516,6 +820,7 @@ func (c *Client) WaitForJob(jobID int, timeout time.Duration) (*JobResult, error
 
 	// Wait for job completion
 	deadline := time.Now().Add(timeout)
+	lastPct, lastDesc := -1.0, ""
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
 
@@ -538,10 +843,16 @@ func (c *Client) WaitForJob(jobID int, timeout time.Duration) (*JobResult, error
 				state, _ := event.Fields["state"].(string)
 				progress, _ := event.Fields["progress"].(map[string]interface{})
 				progressPct := 0.0
+				progressDesc := ""
 				if progress != nil {
 					if pct, ok := progress["percent"].(float64); ok {
 						progressPct = pct
 					}
+					progressDesc, _ = progress["description"].(string)
+				}
+				if onProgress != nil && (progressPct != lastPct || progressDesc != lastDesc) {
+					lastPct, lastDesc = progressPct, progressDesc
+					onProgress(progressPct, progressDesc)
 				}
 
 				log.Printf("Job %d: state=%s progress=%.1f%%", jobID, state, progressPct)
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSetTimeouts(t *testing.T) {
+	c, err := NewClient("127.0.0.1", "token")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if c.RPCTimeout() != DefaultRPCTimeout || c.JobTimeout() != DefaultJobTimeout || c.httpClient.Timeout != DefaultUploadTimeout {
+		t.Fatal("Expected default timeouts on a new client")
+	}
+
+	c.SetTimeouts(time.Minute, 0, 10*time.Minute)
+	if c.RPCTimeout() != time.Minute {
+		t.Errorf("Expected RPC timeout 1m, got %v", c.RPCTimeout())
+	}
+	if c.JobTimeout() != DefaultJobTimeout {
+		t.Errorf("Expected zero job timeout to keep the default, got %v", c.JobTimeout())
+	}
+	if c.httpClient.Timeout != 10*time.Minute {
+		t.Errorf("Expected upload timeout 10m, got %v", c.httpClient.Timeout)
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	c, err := NewClient("127.0.0.1", "token")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	c.SetReadOnly(true)
+
+	// Refused before connecting, so no server is needed
+	_, err = c.Call("pool.create", map[string]interface{}{"name": "tank"})
+	var roErr *ReadOnlyError
+	if !errors.As(err, &roErr) || roErr.Method != "pool.create" {
+		t.Fatalf("Expected a ReadOnlyError for pool.create, got %v", err)
+	}
+	if _, err := c.UploadFile("/_upload", map[string]interface{}{}, nil, "file"); !errors.As(err, &roErr) {
+		t.Errorf("Expected uploads to be refused, got %v", err)
+	}
+
+	tests := map[string]bool{
+		"pool.query":               true,
+		"user.get_instance":        true,
+		"smb.config":               true,
+		"system.info":              true,
+		"core.get_jobs":            true,
+		"core.get_methods":         true,
+		"directoryservices.status": true,
+		"system.state":             true,
+		"pool.dataset.details":     true,
+		"port.get_in_use":          true,
+		"user.get_user_obj":        true,
+		"pool.create":              false,
+		"pool.scrub.run":           false,
+		"pool.dataset.delete":      false,
+		"auth.generate_token":      false,
+		"service.control":          false,
+		"system.general.update":    false,
+		"get_instance":             false,
+		"query":                    false,
+		"":                         false,
+	}
+	for method, want := range tests {
+		if got := IsReadOnlyMethod(method); got != want {
+			t.Errorf("IsReadOnlyMethod(%q) = %v, expected %v", method, got, want)
+		}
+	}
+}
+
+func TestWaitForReady_Timeout(t *testing.T) {
+	interval := readyPollInterval
+	readyPollInterval = 10 * time.Millisecond
+	defer func() { readyPollInterval = interval }()
+
+	// Nothing listens there, like a box that is still rebooting
+	c, err := NewClient("127.0.0.1:1", "token")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	err = c.WaitForReady(context.Background(), 50*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "not ready within") {
+		t.Errorf("Expected a not-ready error, got %v", err)
+	}
+
+	// Cancelling stops the wait before the timeout, e.g. on Ctrl-C
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	err = c.WaitForReady(ctx, time.Hour)
+	if err == nil || !strings.Contains(err.Error(), "context canceled") {
+		t.Errorf("Expected a cancelled wait, got %v", err)
+	}
+	if time.Since(start) > time.Minute {
+		t.Errorf("Expected the wait to stop at once, took %v", time.Since(start))
+	}
+}
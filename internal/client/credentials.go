@@ -0,0 +1,241 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenSource returns the API token to authenticate with. The client asks for
+// it on every (re)connect and upload, so rotated tokens are picked up.
+type TokenSource interface {
+	Token() (string, error)
+}
+
+// StaticToken is a token given inline or through TRUENAS_TOKEN
+type StaticToken string
+
+func (t StaticToken) Token() (string, error) {
+	return string(t), nil
+}
+
+// FileToken reads the token from a file, e.g. one kept up to date by Vault
+// agent. The file is read again every time the token is needed.
+type FileToken string
+
+func (f FileToken) Token() (string, error) {
+	b, err := os.ReadFile(string(f))
+	if err != nil {
+		return "", fmt.Errorf("reading token file: %v", err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", string(f))
+	}
+	return token, nil
+}
+
+// tokenCommandTimeout limits how long a credential helper may run
+const tokenCommandTimeout = time.Minute
+
+// tokenExpirySkew renews a command's token this long before it expires
+const tokenExpirySkew = time.Minute
+
+// CommandToken runs a credential helper and caches its token until the
+// expiry it reports. The helper prints either JSON such as
+// {"token": "...", "expiry": "2025-01-02T15:04:05Z"} or the bare token, as
+// `pass show` does; a bare token or one without expiry is kept for the
+// lifetime of the provider.
+type CommandToken struct {
+	Args []string
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+	now    func() time.Time
+}
+
+// tokenCommandOutput is the JSON a credential helper may print
+type tokenCommandOutput struct {
+	Token  string `json:"token"`
+	Expiry string `json:"expiry"`
+}
+
+func NewCommandToken(args []string) *CommandToken {
+	return &CommandToken{Args: args, now: time.Now}
+}
+
+func (c *CommandToken) Token() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && (c.expiry.IsZero() || c.now().Add(tokenExpirySkew).Before(c.expiry)) {
+		return c.token, nil
+	}
+	if len(c.Args) == 0 {
+		return "", fmt.Errorf("token command is empty")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), tokenCommandTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, c.Args[0], c.Args[1:]...)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("token command %s failed: %v", c.Args[0], err)
+	}
+
+	token, expiry, err := parseTokenCommandOutput(out)
+	if err != nil {
+		return "", fmt.Errorf("token command %s: %v", c.Args[0], err)
+	}
+	c.token, c.expiry = token, expiry
+	return token, nil
+}
+
+// parseTokenCommandOutput reads a credential helper's output, see CommandToken
+func parseTokenCommandOutput(out []byte) (string, time.Time, error) {
+	text := strings.TrimSpace(string(out))
+	if !strings.HasPrefix(text, "{") {
+		// Bare token: the first line, like `pass show` prints it
+		token, _, _ := strings.Cut(text, "\n")
+		token = strings.TrimSpace(token)
+		if token == "" {
+			return "", time.Time{}, fmt.Errorf("printed no token")
+		}
+		return token, time.Time{}, nil
+	}
+
+	var parsed tokenCommandOutput
+	if err := json.Unmarshal([]byte(text), &parsed); err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid JSON output: %v", err)
+	}
+	if parsed.Token == "" {
+		return "", time.Time{}, fmt.Errorf("printed no token")
+	}
+	var expiry time.Time
+	if parsed.Expiry != "" {
+		var err error
+		if expiry, err = time.Parse(time.RFC3339, parsed.Expiry); err != nil {
+			return "", time.Time{}, fmt.Errorf("invalid expiry %q, expected RFC 3339: %v", parsed.Expiry, err)
+		}
+	}
+	return parsed.Token, expiry, nil
+}
+
+// Profile is one section of the credentials file: a host and where to get its
+// token from
+type Profile struct {
+	Host         string
+	Token        string
+	TokenFile    string
+	TokenCommand []string
+}
+
+func (p Profile) tokenSources() int {
+	n := 0
+	for _, set := range []bool{p.Token != "", p.TokenFile != "", len(p.TokenCommand) > 0} {
+		if set {
+			n++
+		}
+	}
+	return n
+}
+
+// TokenSource returns where the profile's token comes from, or nil if it has
+// none
+func (p Profile) TokenSource() TokenSource {
+	switch {
+	case p.Token != "":
+		return StaticToken(p.Token)
+	case p.TokenFile != "":
+		return FileToken(p.TokenFile)
+	case len(p.TokenCommand) > 0:
+		return NewCommandToken(p.TokenCommand)
+	}
+	return nil
+}
+
+// DefaultCredentialsFile is ~/.config/truenas/credentials
+func DefaultCredentialsFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "truenas", "credentials"), nil
+}
+
+// LoadProfiles reads an INI-style credentials file:
+//
+//	[prod]
+//	host          = nas.example.com
+//	token_command = pass show truenas/prod
+//
+// token_command is split on whitespace and run without a shell.
+func LoadProfiles(path string) (map[string]Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	profiles := map[string]Profile{}
+	section := ""
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == "" {
+				return nil, fmt.Errorf("%s:%d: empty profile name", path, n)
+			}
+			if _, ok := profiles[section]; !ok {
+				profiles[section] = Profile{}
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key = value", path, n)
+		}
+		if section == "" {
+			return nil, fmt.Errorf("%s:%d: %s outside a [profile] section", path, n, strings.TrimSpace(key))
+		}
+		p := profiles[section]
+		value = strings.TrimSpace(value)
+		switch key = strings.TrimSpace(key); key {
+		case "host":
+			p.Host = value
+		case "token":
+			p.Token = value
+		case "token_file":
+			p.TokenFile = value
+		case "token_command":
+			p.TokenCommand = strings.Fields(value)
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown key %q, expected host, token, token_file or token_command", path, n, key)
+		}
+		profiles[section] = p
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for name, p := range profiles {
+		if p.tokenSources() > 1 {
+			return nil, fmt.Errorf("%s: profile %s sets more than one of token, token_file and token_command", path, name)
+		}
+	}
+	return profiles, nil
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileToken_Rotation(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "token")
+	source := FileToken(file)
+
+	if _, err := source.Token(); err == nil {
+		t.Error("Expected an error for a missing token file")
+	}
+	for _, want := range []string{"first", "second"} {
+		if err := os.WriteFile(file, []byte(want+"\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		got, err := source.Token()
+		if err != nil || got != want {
+			t.Errorf("Expected %s, got %q (%v)", want, got, err)
+		}
+	}
+}
+
+func TestParseTokenCommandOutput(t *testing.T) {
+	token, expiry, err := parseTokenCommandOutput([]byte("s3cret\nurl: https://nas\n"))
+	if err != nil || token != "s3cret" || !expiry.IsZero() {
+		t.Errorf("Expected bare token s3cret without expiry, got %q %v %v", token, expiry, err)
+	}
+
+	token, expiry, err = parseTokenCommandOutput([]byte(`{"token": "abc", "expiry": "2025-01-02T15:04:05Z"}`))
+	if err != nil || token != "abc" || !expiry.Equal(time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)) {
+		t.Errorf("Expected token abc with expiry, got %q %v %v", token, expiry, err)
+	}
+
+	for _, bad := range []string{"", `{"expiry": "2025-01-02T15:04:05Z"}`, `{"token": "abc", "expiry": "tomorrow"}`, `{"token":`} {
+		if _, _, err := parseTokenCommandOutput([]byte(bad)); err == nil {
+			t.Errorf("Expected an error for %q", bad)
+		}
+	}
+}
+
+func TestCommandToken_Expiry(t *testing.T) {
+	runs := filepath.Join(t.TempDir(), "runs")
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	source := NewCommandToken([]string{"sh", "-c", `echo run >> "$0"; echo '{"token": "abc", "expiry": "2025-01-01T13:00:00Z"}'`, runs})
+	source.now = func() time.Time { return now }
+
+	count := func() int {
+		b, _ := os.ReadFile(runs)
+		return strings.Count(string(b), "run")
+	}
+	for i := 0; i < 2; i++ {
+		if token, err := source.Token(); err != nil || token != "abc" {
+			t.Fatalf("Expected token abc, got %q (%v)", token, err)
+		}
+	}
+	if count() != 1 {
+		t.Errorf("Expected the token to be cached, helper ran %d times", count())
+	}
+
+	// Renewed shortly before it expires
+	now = now.Add(59*time.Minute + 30*time.Second)
+	if _, err := source.Token(); err != nil {
+		t.Fatal(err)
+	}
+	if count() != 2 {
+		t.Errorf("Expected the helper to run again near expiry, ran %d times", count())
+	}
+
+	if _, err := NewCommandToken([]string{"false"}).Token(); err == nil {
+		t.Error("Expected an error when the helper fails")
+	}
+}
+
+func TestLoadProfiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(content string) string {
+		file := filepath.Join(dir, "credentials")
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+
+	profiles, err := LoadProfiles(write(`
+# Lab box
+[default]
+host  = 192.168.1.10
+token = abc
+
+[prod]
+host          = nas.example.com
+token_command = pass show truenas/prod
+`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := map[string]Profile{
+		"default": {Host: "192.168.1.10", Token: "abc"},
+		"prod":    {Host: "nas.example.com", TokenCommand: []string{"pass", "show", "truenas/prod"}},
+	}
+	if !reflect.DeepEqual(profiles, want) {
+		t.Errorf("Expected %v, got %v", want, profiles)
+	}
+	if _, ok := profiles["prod"].TokenSource().(*CommandToken); !ok {
+		t.Errorf("Expected prod to use a command token, got %T", profiles["prod"].TokenSource())
+	}
+
+	for _, bad := range []string{
+		"host = nas\n",
+		"[lab]\nhost nas\n",
+		"[lab]\npassword = x\n",
+		"[lab]\ntoken = abc\ntoken_file = /run/token\n",
+	} {
+		if _, err := LoadProfiles(write(bad)); err == nil {
+			t.Errorf("Expected an error for %q", bad)
+		}
+	}
+}
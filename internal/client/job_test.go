@@ -48,3 +48,19 @@ func TestWaitForJob(t *testing.T) {
 		t.Errorf("Expected SUCCESS, got %s", jobResult.State)
 	}
 }
+
+func TestParseJob(t *testing.T) {
+	job := parseJob(7, map[string]interface{}{
+		"state":    "RUNNING",
+		"progress": map[string]interface{}{"percent": 42.0, "description": "scrubbing"},
+		"result":   nil,
+	})
+	if job.ID != 7 || job.State != "RUNNING" || job.Progress != 42 || job.Error != "" {
+		t.Errorf("Unexpected job: %+v", job)
+	}
+
+	job = parseJob(8, map[string]interface{}{"state": "FAILED", "error": "[EFAULT] boom"})
+	if job.State != "FAILED" || job.Error != "[EFAULT] boom" || job.Progress != 0 {
+		t.Errorf("Unexpected job: %+v", job)
+	}
+}
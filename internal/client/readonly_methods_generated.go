@@ -0,0 +1,78 @@
+package client
+
+// readOnlyMethods are the query, config and info methods of the API spec.
+// In read-only mode the client refuses every other method except get_*
+// methods and readOnlyExtraMethods.
+var readOnlyMethods = map[string]bool{
+	"acme.dns.authenticator.query": true,
+	"alertservice.query":           true,
+	"api_key.query":                true,
+	"app.image.query":              true,
+	"app.query":                    true,
+	"app.registry.query":           true,
+	"certificate.query":            true,
+	"cloud_backup.query":           true,
+	"cloudsync.credentials.query":  true,
+	"cloudsync.query":              true,
+	"cronjob.query":                true,
+	"directoryservices.config":     true,
+	"disk.query":                   true,
+	"docker.network.query":         true,
+	"fc.fc_host.query":             true,
+	"fcport.query":                 true,
+	"filesystem.acltemplate.query": true,
+	"ftp.config":                   true,
+	"group.query":                  true,
+	"initshutdownscript.query":     true,
+	"interface.query":              true,
+	"iscsi.auth.query":             true,
+	"iscsi.extent.query":           true,
+	"iscsi.global.config":          true,
+	"iscsi.initiator.query":        true,
+	"iscsi.portal.query":           true,
+	"iscsi.target.query":           true,
+	"iscsi.targetextent.query":     true,
+	"jbof.query":                   true,
+	"kerberos.keytab.query":        true,
+	"kerberos.realm.query":         true,
+	"keychaincredential.query":     true,
+	"mail.config":                  true,
+	"network.configuration.config": true,
+	"nfs.config":                   true,
+	"nvmet.global.config":          true,
+	"nvmet.host.query":             true,
+	"nvmet.host_subsys.query":      true,
+	"nvmet.namespace.query":        true,
+	"nvmet.port.query":             true,
+	"nvmet.port_subsys.query":      true,
+	"nvmet.subsys.query":           true,
+	"pool.dataset.query":           true,
+	"pool.query":                   true,
+	"pool.scrub.query":             true,
+	"pool.snapshot.query":          true,
+	"pool.snapshottask.query":      true,
+	"privilege.query":              true,
+	"replication.query":            true,
+	"reporting.exporters.query":    true,
+	"rsynctask.query":              true,
+	"service.query":                true,
+	"sharing.nfs.query":            true,
+	"sharing.smb.query":            true,
+	"smb.config":                   true,
+	"snmp.config":                  true,
+	"ssh.config":                   true,
+	"staticroute.query":            true,
+	"system.advanced.config":       true,
+	"system.general.config":        true,
+	"system.info":                  true,
+	"system.ntpserver.query":       true,
+	"systemdataset.config":         true,
+	"tunable.query":                true,
+	"ups.config":                   true,
+	"user.query":                   true,
+	"virt.instance.query":          true,
+	"virt.volume.query":            true,
+	"vm.device.query":              true,
+	"vm.query":                     true,
+	"vmware.query":                 true,
+}
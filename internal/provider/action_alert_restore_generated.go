@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,7 +18,9 @@ type ActionAlertRestoreResource struct {
 }
 
 type ActionAlertRestoreResourceModel struct {
-	Uuid types.String `tfsdk:"uuid"`
+	Uuid     types.String `tfsdk:"uuid"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	When     types.String `tfsdk:"when"`
 	// Computed outputs
 	ActionID types.String  `tfsdk:"action_id"`
 	JobID    types.Int64   `tfsdk:"job_id"`
@@ -24,6 +28,8 @@ type ActionAlertRestoreResourceModel struct {
 	Progress types.Float64 `tfsdk:"progress"`
 	Result   types.String  `tfsdk:"result"`
 	Error    types.String  `tfsdk:"error"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewActionAlertRestoreResource() resource.Resource {
@@ -37,8 +43,11 @@ func (r *ActionAlertRestoreResource) Metadata(ctx context.Context, req resource.
 func (r *ActionAlertRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Restore `id` alert which had been dismissed.",
+		DeprecationMessage:  "Use the truenas_alert_restore action instead, from lifecycle.action_trigger or terraform apply -invoke. This resource will be removed in a future release.",
 		Attributes: map[string]schema.Attribute{
-			"uuid": schema.StringAttribute{Required: true, MarkdownDescription: "UUID of the dismissed alert to restore."},
+			"uuid":     schema.StringAttribute{Required: true, MarkdownDescription: "UUID of the dismissed alert to restore."},
+			"triggers": actionTriggersAttribute(),
+			"when":     actionWhenAttribute(),
 			"action_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Action execution identifier",
@@ -49,7 +58,7 @@ func (r *ActionAlertRestoreResource) Schema(ctx context.Context, req resource.Sc
 			},
 			"state": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Job state: SUCCESS, FAILED, or RUNNING",
+				MarkdownDescription: "Job state: SUCCESS, FAILED, RUNNING, or SKIPPED when the action only runs on destroy",
 			},
 			"progress": schema.Float64Attribute{
 				Computed:            true,
@@ -57,13 +66,16 @@ func (r *ActionAlertRestoreResource) Schema(ctx context.Context, req resource.Sc
 			},
 			"result": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Action result data",
+				MarkdownDescription: "Action result, JSON-encoded",
 			},
 			"error": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Error message if action failed",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Delete: true}),
+		},
 	}
 }
 
@@ -86,42 +98,24 @@ func (r *ActionAlertRestoreResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
-	// Build parameters
-	params := []interface{}{}
-	params = append(params, data.Uuid.ValueString())
-
-	// Execute action
-	result, err := r.client.Call("alert.restore", params)
-	if err != nil {
-		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute alert.restore: %s", err.Error()))
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Check if result is a job ID
-	if jobID, ok := result.(float64); ok && false {
-		// Background job - wait for completion
-		data.JobID = types.Int64Value(int64(jobID))
-
-		jobResult, err := r.client.WaitForJob(int(jobID), 30*time.Minute)
-		if err != nil {
-			data.State = types.StringValue("FAILED")
-			data.Error = types.StringValue(err.Error())
-			resp.Diagnostics.AddError("Job Failed", err.Error())
-		} else {
-			data.State = types.StringValue(jobResult.State)
-			data.Progress = types.Float64Value(jobResult.Progress)
-			data.Result = types.StringValue(fmt.Sprintf("%v", jobResult.Result))
-			if jobResult.Error != "" {
-				data.Error = types.StringValue(jobResult.Error)
-			} else {
-				data.Error = types.StringValue("")
-			}
+	if actionRunsOn(data.When, actionWhenCreate) {
+		diags := r.execute(&data, createTimeout)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() && data.State.IsUnknown() {
+			return
 		}
 	} else {
-		// Immediate result
-		data.State = types.StringValue("SUCCESS")
-		data.Progress = types.Float64Value(100.0)
-		data.Result = types.StringValue(fmt.Sprintf("%v", result))
+		// Only runs on destroy
+		data.JobID = types.Int64Null()
+		data.State = types.StringValue(actionStateSkipped)
+		data.Progress = types.Float64Value(0)
+		data.Result = types.StringValue("")
 		data.Error = types.StringValue("")
 	}
 
@@ -136,10 +130,72 @@ func (r *ActionAlertRestoreResource) Read(ctx context.Context, req resource.Read
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 }
 
+// Update records changes that don't run the action again: when, wait and the
+// arguments of actions that only run on destroy. Other argument changes and
+// triggers replace the resource, which does.
 func (r *ActionAlertRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update Not Supported", "Actions cannot be updated, only recreated")
+	var data, state ActionAlertRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ActionID = state.ActionID
+	data.JobID = state.JobID
+	data.State = state.State
+	data.Progress = state.Progress
+	data.Result = state.Result
+	data.Error = state.Error
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ModifyPlan replaces the resource when an argument changes and the action
+// runs on create; see actionRequiresReplace
+func (r *ActionAlertRestoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	actionRequiresReplace(ctx, req, resp, []string{"uuid"})
 }
 
 func (r *ActionAlertRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No-op - actions cannot be undone
+	var data ActionAlertRestoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Actions cannot be undone; only run again when asked to on destroy
+	if !actionRunsOn(data.When, actionWhenDestroy) {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.execute(&data, deleteTimeout)...)
+}
+
+// execute calls alert.restore and records the outcome in data
+func (r *ActionAlertRestoreResource) execute(data *ActionAlertRestoreResourceModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.Uuid.ValueString())
+
+	// Execute action
+	result, err := r.client.CallWithTimeout("alert.restore", params, timeout)
+	if err != nil {
+		diags.AddError("Action Failed", fmt.Sprintf("Failed to execute alert.restore: %s", err.Error()))
+		return diags
+	}
+
+	// Immediate result
+	data.JobID = types.Int64Null()
+	data.State = types.StringValue("SUCCESS")
+	data.Progress = types.Float64Value(100.0)
+	data.Result = actionResult(result)
+	data.Error = types.StringValue("")
+	return diags
 }
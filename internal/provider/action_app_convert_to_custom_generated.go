@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,7 +18,10 @@ type ActionAppConvert_To_CustomResource struct {
 }
 
 type ActionAppConvert_To_CustomResourceModel struct {
-	AppName types.String `tfsdk:"app_name"`
+	AppName  types.String `tfsdk:"app_name"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	When     types.String `tfsdk:"when"`
+	Wait     types.Bool   `tfsdk:"wait"`
 	// Computed outputs
 	ActionID types.String  `tfsdk:"action_id"`
 	JobID    types.Int64   `tfsdk:"job_id"`
@@ -24,6 +29,8 @@ type ActionAppConvert_To_CustomResourceModel struct {
 	Progress types.Float64 `tfsdk:"progress"`
 	Result   types.String  `tfsdk:"result"`
 	Error    types.String  `tfsdk:"error"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewActionAppConvert_To_CustomResource() resource.Resource {
@@ -37,8 +44,12 @@ func (r *ActionAppConvert_To_CustomResource) Metadata(ctx context.Context, req r
 func (r *ActionAppConvert_To_CustomResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Convert `app_name` to a custom app.",
+		DeprecationMessage:  "Use the truenas_app_convert_to_custom action instead, from lifecycle.action_trigger or terraform apply -invoke. This resource will be removed in a future release.",
 		Attributes: map[string]schema.Attribute{
 			"app_name": schema.StringAttribute{Required: true, MarkdownDescription: "Name of the catalog application to convert to a custom application."},
+			"triggers": actionTriggersAttribute(),
+			"when":     actionWhenAttribute(),
+			"wait":     actionWaitAttribute(),
 			"action_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Action execution identifier",
@@ -49,7 +60,7 @@ func (r *ActionAppConvert_To_CustomResource) Schema(ctx context.Context, req res
 			},
 			"state": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Job state: SUCCESS, FAILED, or RUNNING",
+				MarkdownDescription: "Job state: SUCCESS, FAILED, RUNNING, or SKIPPED when the action only runs on destroy",
 			},
 			"progress": schema.Float64Attribute{
 				Computed:            true,
@@ -57,13 +68,16 @@ func (r *ActionAppConvert_To_CustomResource) Schema(ctx context.Context, req res
 			},
 			"result": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Action result data",
+				MarkdownDescription: "Action result, JSON-encoded",
 			},
 			"error": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Error message if action failed",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Delete: true}),
+		},
 	}
 }
 
@@ -86,42 +100,24 @@ func (r *ActionAppConvert_To_CustomResource) Create(ctx context.Context, req res
 		return
 	}
 
-	// Build parameters
-	params := []interface{}{}
-	params = append(params, data.AppName.ValueString())
-
-	// Execute action
-	result, err := r.client.Call("app.convert_to_custom", params)
-	if err != nil {
-		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute app.convert_to_custom: %s", err.Error()))
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Check if result is a job ID
-	if jobID, ok := result.(float64); ok && true {
-		// Background job - wait for completion
-		data.JobID = types.Int64Value(int64(jobID))
-
-		jobResult, err := r.client.WaitForJob(int(jobID), 30*time.Minute)
-		if err != nil {
-			data.State = types.StringValue("FAILED")
-			data.Error = types.StringValue(err.Error())
-			resp.Diagnostics.AddError("Job Failed", err.Error())
-		} else {
-			data.State = types.StringValue(jobResult.State)
-			data.Progress = types.Float64Value(jobResult.Progress)
-			data.Result = types.StringValue(fmt.Sprintf("%v", jobResult.Result))
-			if jobResult.Error != "" {
-				data.Error = types.StringValue(jobResult.Error)
-			} else {
-				data.Error = types.StringValue("")
-			}
+	if actionRunsOn(data.When, actionWhenCreate) {
+		diags := r.execute(&data, createTimeout)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() && data.State.IsUnknown() {
+			return
 		}
 	} else {
-		// Immediate result
-		data.State = types.StringValue("SUCCESS")
-		data.Progress = types.Float64Value(100.0)
-		data.Result = types.StringValue(fmt.Sprintf("%v", result))
+		// Only runs on destroy
+		data.JobID = types.Int64Null()
+		data.State = types.StringValue(actionStateSkipped)
+		data.Progress = types.Float64Value(0)
+		data.Result = types.StringValue("")
 		data.Error = types.StringValue("")
 	}
 
@@ -131,15 +127,121 @@ func (r *ActionAppConvert_To_CustomResource) Create(ctx context.Context, req res
 }
 
 func (r *ActionAppConvert_To_CustomResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// Actions are immutable - just return current state
+	// Actions are immutable; only a job that wasn't waited for is refreshed
 	var data ActionAppConvert_To_CustomResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Pick up the outcome of a job that was started without waiting
+	if data.State.ValueString() == actionStateRunning && !data.JobID.IsNull() {
+		job, err := r.client.GetJob(int(data.JobID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to Refresh Job", fmt.Sprintf("Job %d: %s", data.JobID.ValueInt64(), err.Error()))
+			return
+		}
+		data.State = types.StringValue(job.State)
+		data.Progress = types.Float64Value(job.Progress)
+		data.Error = types.StringValue(job.Error)
+		if job.State == "SUCCESS" {
+			data.Result = actionResult(job.Result)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
 }
 
+// Update records changes that don't run the action again: when, wait and the
+// arguments of actions that only run on destroy. Other argument changes and
+// triggers replace the resource, which does.
 func (r *ActionAppConvert_To_CustomResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update Not Supported", "Actions cannot be updated, only recreated")
+	var data, state ActionAppConvert_To_CustomResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ActionID = state.ActionID
+	data.JobID = state.JobID
+	data.State = state.State
+	data.Progress = state.Progress
+	data.Result = state.Result
+	data.Error = state.Error
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ModifyPlan replaces the resource when an argument changes and the action
+// runs on create; see actionRequiresReplace
+func (r *ActionAppConvert_To_CustomResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	actionRequiresReplace(ctx, req, resp, []string{"app_name"})
 }
 
 func (r *ActionAppConvert_To_CustomResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No-op - actions cannot be undone
+	var data ActionAppConvert_To_CustomResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Actions cannot be undone; only run again when asked to on destroy
+	if !actionRunsOn(data.When, actionWhenDestroy) {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.execute(&data, deleteTimeout)...)
+}
+
+// execute calls app.convert_to_custom and records the outcome in data
+func (r *ActionAppConvert_To_CustomResource) execute(data *ActionAppConvert_To_CustomResourceModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.AppName.ValueString())
+
+	// Execute action
+	result, err := r.client.CallWithTimeout("app.convert_to_custom", params, timeout)
+	if err != nil {
+		diags.AddError("Action Failed", fmt.Sprintf("Failed to execute app.convert_to_custom: %s", err.Error()))
+		return diags
+	}
+
+	// Background job - wait for completion unless told not to
+	if jobID, ok := result.(float64); ok {
+		data.JobID = types.Int64Value(int64(jobID))
+		data.Result = types.StringValue("")
+		data.Error = types.StringValue("")
+		if !data.Wait.IsNull() && !data.Wait.ValueBool() {
+			data.State = types.StringValue(actionStateRunning)
+			data.Progress = types.Float64Value(0)
+			return diags
+		}
+
+		jobResult, err := r.client.WaitForJob(int(jobID), timeout)
+		if err != nil {
+			data.State = types.StringValue("FAILED")
+			data.Progress = types.Float64Value(0)
+			data.Error = types.StringValue(err.Error())
+			diags.AddError("Job Failed", err.Error())
+			return diags
+		}
+		data.State = types.StringValue(jobResult.State)
+		data.Progress = types.Float64Value(jobResult.Progress)
+		data.Result = actionResult(jobResult.Result)
+		return diags
+	}
+
+	// Immediate result
+	data.JobID = types.Int64Null()
+	data.State = types.StringValue("SUCCESS")
+	data.Progress = types.Float64Value(100.0)
+	data.Result = actionResult(result)
+	data.Error = types.StringValue("")
+	return diags
 }
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,8 +18,11 @@ type ActionAppPull_ImagesResource struct {
 }
 
 type ActionAppPull_ImagesResourceModel struct {
-	AppName types.String `tfsdk:"app_name"`
-	Options types.String `tfsdk:"options"`
+	AppName  types.String `tfsdk:"app_name"`
+	Options  types.String `tfsdk:"options"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	When     types.String `tfsdk:"when"`
+	Wait     types.Bool   `tfsdk:"wait"`
 	// Computed outputs
 	ActionID types.String  `tfsdk:"action_id"`
 	JobID    types.Int64   `tfsdk:"job_id"`
@@ -25,6 +30,8 @@ type ActionAppPull_ImagesResourceModel struct {
 	Progress types.Float64 `tfsdk:"progress"`
 	Result   types.String  `tfsdk:"result"`
 	Error    types.String  `tfsdk:"error"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewActionAppPull_ImagesResource() resource.Resource {
@@ -38,9 +45,13 @@ func (r *ActionAppPull_ImagesResource) Metadata(ctx context.Context, req resourc
 func (r *ActionAppPull_ImagesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Pulls docker images for the specified app `name`.",
+		DeprecationMessage:  "Use the truenas_app_pull_images action instead, from lifecycle.action_trigger or terraform apply -invoke. This resource will be removed in a future release.",
 		Attributes: map[string]schema.Attribute{
 			"app_name": schema.StringAttribute{Required: true, MarkdownDescription: "Name of the application to pull images for."},
 			"options":  schema.StringAttribute{Optional: true, MarkdownDescription: "Options for pulling images including whether to redeploy."},
+			"triggers": actionTriggersAttribute(),
+			"when":     actionWhenAttribute(),
+			"wait":     actionWaitAttribute(),
 			"action_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Action execution identifier",
@@ -51,7 +62,7 @@ func (r *ActionAppPull_ImagesResource) Schema(ctx context.Context, req resource.
 			},
 			"state": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Job state: SUCCESS, FAILED, or RUNNING",
+				MarkdownDescription: "Job state: SUCCESS, FAILED, RUNNING, or SKIPPED when the action only runs on destroy",
 			},
 			"progress": schema.Float64Attribute{
 				Computed:            true,
@@ -59,13 +70,16 @@ func (r *ActionAppPull_ImagesResource) Schema(ctx context.Context, req resource.
 			},
 			"result": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Action result data",
+				MarkdownDescription: "Action result, JSON-encoded",
 			},
 			"error": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Error message if action failed",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Delete: true}),
+		},
 	}
 }
 
@@ -88,45 +102,24 @@ func (r *ActionAppPull_ImagesResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	// Build parameters
-	params := []interface{}{}
-	params = append(params, data.AppName.ValueString())
-	if !data.Options.IsNull() {
-		params = append(params, data.Options.ValueString())
-	}
-
-	// Execute action
-	result, err := r.client.Call("app.pull_images", params)
-	if err != nil {
-		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute app.pull_images: %s", err.Error()))
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Check if result is a job ID
-	if jobID, ok := result.(float64); ok && true {
-		// Background job - wait for completion
-		data.JobID = types.Int64Value(int64(jobID))
-
-		jobResult, err := r.client.WaitForJob(int(jobID), 30*time.Minute)
-		if err != nil {
-			data.State = types.StringValue("FAILED")
-			data.Error = types.StringValue(err.Error())
-			resp.Diagnostics.AddError("Job Failed", err.Error())
-		} else {
-			data.State = types.StringValue(jobResult.State)
-			data.Progress = types.Float64Value(jobResult.Progress)
-			data.Result = types.StringValue(fmt.Sprintf("%v", jobResult.Result))
-			if jobResult.Error != "" {
-				data.Error = types.StringValue(jobResult.Error)
-			} else {
-				data.Error = types.StringValue("")
-			}
+	if actionRunsOn(data.When, actionWhenCreate) {
+		diags := r.execute(&data, createTimeout)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() && data.State.IsUnknown() {
+			return
 		}
 	} else {
-		// Immediate result
-		data.State = types.StringValue("SUCCESS")
-		data.Progress = types.Float64Value(100.0)
-		data.Result = types.StringValue(fmt.Sprintf("%v", result))
+		// Only runs on destroy
+		data.JobID = types.Int64Null()
+		data.State = types.StringValue(actionStateSkipped)
+		data.Progress = types.Float64Value(0)
+		data.Result = types.StringValue("")
 		data.Error = types.StringValue("")
 	}
 
@@ -136,15 +129,124 @@ func (r *ActionAppPull_ImagesResource) Create(ctx context.Context, req resource.
 }
 
 func (r *ActionAppPull_ImagesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// Actions are immutable - just return current state
+	// Actions are immutable; only a job that wasn't waited for is refreshed
 	var data ActionAppPull_ImagesResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Pick up the outcome of a job that was started without waiting
+	if data.State.ValueString() == actionStateRunning && !data.JobID.IsNull() {
+		job, err := r.client.GetJob(int(data.JobID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to Refresh Job", fmt.Sprintf("Job %d: %s", data.JobID.ValueInt64(), err.Error()))
+			return
+		}
+		data.State = types.StringValue(job.State)
+		data.Progress = types.Float64Value(job.Progress)
+		data.Error = types.StringValue(job.Error)
+		if job.State == "SUCCESS" {
+			data.Result = actionResult(job.Result)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
 }
 
+// Update records changes that don't run the action again: when, wait and the
+// arguments of actions that only run on destroy. Other argument changes and
+// triggers replace the resource, which does.
 func (r *ActionAppPull_ImagesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update Not Supported", "Actions cannot be updated, only recreated")
+	var data, state ActionAppPull_ImagesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ActionID = state.ActionID
+	data.JobID = state.JobID
+	data.State = state.State
+	data.Progress = state.Progress
+	data.Result = state.Result
+	data.Error = state.Error
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ModifyPlan replaces the resource when an argument changes and the action
+// runs on create; see actionRequiresReplace
+func (r *ActionAppPull_ImagesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	actionRequiresReplace(ctx, req, resp, []string{"app_name", "options"})
 }
 
 func (r *ActionAppPull_ImagesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// No-op - actions cannot be undone
+	var data ActionAppPull_ImagesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Actions cannot be undone; only run again when asked to on destroy
+	if !actionRunsOn(data.When, actionWhenDestroy) {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.execute(&data, deleteTimeout)...)
+}
+
+// execute calls app.pull_images and records the outcome in data
+func (r *ActionAppPull_ImagesResource) execute(data *ActionAppPull_ImagesResourceModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.AppName.ValueString())
+	if !data.Options.IsNull() {
+		params = append(params, data.Options.ValueString())
+	}
+
+	// Execute action
+	result, err := r.client.CallWithTimeout("app.pull_images", params, timeout)
+	if err != nil {
+		diags.AddError("Action Failed", fmt.Sprintf("Failed to execute app.pull_images: %s", err.Error()))
+		return diags
+	}
+
+	// Background job - wait for completion unless told not to
+	if jobID, ok := result.(float64); ok {
+		data.JobID = types.Int64Value(int64(jobID))
+		data.Result = types.StringValue("")
+		data.Error = types.StringValue("")
+		if !data.Wait.IsNull() && !data.Wait.ValueBool() {
+			data.State = types.StringValue(actionStateRunning)
+			data.Progress = types.Float64Value(0)
+			return diags
+		}
+
+		jobResult, err := r.client.WaitForJob(int(jobID), timeout)
+		if err != nil {
+			data.State = types.StringValue("FAILED")
+			data.Progress = types.Float64Value(0)
+			data.Error = types.StringValue(err.Error())
+			diags.AddError("Job Failed", err.Error())
+			return diags
+		}
+		data.State = types.StringValue(jobResult.State)
+		data.Progress = types.Float64Value(jobResult.Progress)
+		data.Result = actionResult(jobResult.Result)
+		return diags
+	}
+
+	// Immediate result
+	data.JobID = types.Int64Null()
+	data.State = types.StringValue("SUCCESS")
+	data.Progress = types.Float64Value(100.0)
+	data.Result = actionResult(result)
+	data.Error = types.StringValue("")
+	return diags
 }
@@ -119,11 +119,11 @@ func (r *ActionConfigUploadResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	// Check if result is a job ID
-	if jobID, ok := result.(float64); ok && true {
+	if jobID, ok := result.(float64); ok {
 		// Background job - wait for completion
 		data.JobID = types.Int64Value(int64(jobID))
 
-		jobResult, err := r.client.WaitForJob(int(jobID), 30*time.Minute)
+		jobResult, err := r.client.WaitForJob(int(jobID), r.client.JobTimeout())
 		if err != nil {
 			data.State = types.StringValue("FAILED")
 			data.Error = types.StringValue(err.Error())
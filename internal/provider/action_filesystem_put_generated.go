@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,6 +26,8 @@ type ActionFilesystemPutResourceModel struct {
 	Progress types.Float64 `tfsdk:"progress"`
 	Result   types.String  `tfsdk:"result"`
 	Error    types.String  `tfsdk:"error"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewActionFilesystemPutResource() resource.Resource {
@@ -72,6 +75,9 @@ func (r *ActionFilesystemPutResource) Schema(ctx context.Context, req resource.S
 				MarkdownDescription: "Error message if action failed",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
+		},
 	}
 }
 
@@ -102,19 +108,25 @@ func (r *ActionFilesystemPutResource) Create(ctx context.Context, req resource.C
 		params = append(params, data.Options.ValueString())
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Execute action
-	result, err := r.client.Call("filesystem.put", params)
+	result, err := r.client.CallWithTimeout("filesystem.put", params, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute filesystem.put: %s", err.Error()))
 		return
 	}
 
 	// Check if result is a job ID
-	if jobID, ok := result.(float64); ok && true {
+	if jobID, ok := result.(float64); ok {
 		// Background job - wait for completion
 		data.JobID = types.Int64Value(int64(jobID))
 
-		jobResult, err := r.client.WaitForJob(int(jobID), 30*time.Minute)
+		jobResult, err := r.client.WaitForJob(int(jobID), createTimeout)
 		if err != nil {
 			data.State = types.StringValue("FAILED")
 			data.Error = types.StringValue(err.Error())
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// When an action resource runs its method
+const (
+	actionWhenCreate  = "create"
+	actionWhenDestroy = "destroy"
+	actionWhenBoth    = "both"
+)
+
+// actionStateSkipped is recorded when the action didn't run on create
+const actionStateSkipped = "SKIPPED"
+
+// actionStateRunning is recorded when a job was started without waiting for it
+const actionStateRunning = "RUNNING"
+
+// actionTriggersAttribute works like terraform_data's triggers_replace: any
+// change replaces the resource, running the action again
+func actionTriggersAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Optional:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Arbitrary values; when any of them changes the resource is replaced, which runs the action again.",
+		PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+	}
+}
+
+func actionWhenAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(actionWhenCreate),
+		MarkdownDescription: "When to run the action: `create`, `destroy` or `both`. Default: `create`",
+		Validators:          []validator.String{stringvalidator.OneOf(actionWhenCreate, actionWhenDestroy, actionWhenBoth)},
+	}
+}
+
+// actionRunsOn reports whether an action configured with when runs on event
+// (create or destroy). State written before when existed runs on create only.
+func actionRunsOn(when types.String, event string) bool {
+	w := when.ValueString()
+	if when.IsNull() || when.IsUnknown() || w == "" {
+		w = actionWhenCreate
+	}
+	return w == actionWhenBoth || w == event
+}
+
+// actionRequiresReplace replaces an action resource when one of its args
+// changes and it runs on create, so the action runs again with them. Args of
+// actions that only run on destroy are recorded in place, ready for destroy.
+func actionRequiresReplace(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, args []string) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var when types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("when"), &when)...)
+	if !actionRunsOn(when, actionWhenCreate) {
+		return
+	}
+	for _, name := range args {
+		var planned, prior attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planned)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &prior)...)
+		if planned != nil && !planned.Equal(prior) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root(name))
+		}
+	}
+}
+
+// actionWaitAttribute lets job-backed actions return as soon as the job starts
+func actionWaitAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		MarkdownDescription: "Wait for the background job to finish. When `false` the job is only started, `state` is `RUNNING` and later refreshes pick up its outcome. Default: `true`",
+	}
+}
+
+// actionResult encodes a method or job result as JSON, so it can be read with
+// jsondecode()
+func actionResult(v interface{}) types.String {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return types.StringValue(fmt.Sprintf("%v", v))
+	}
+	return types.StringValue(string(b))
+}
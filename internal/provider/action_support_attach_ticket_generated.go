@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,6 +22,9 @@ type ActionSupportAttach_TicketResourceModel struct {
 	Data types.String `tfsdk:"data"`
 	// File upload (optional)
 	FileContent types.String `tfsdk:"file_content"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	When        types.String `tfsdk:"when"`
+	Wait        types.Bool   `tfsdk:"wait"`
 	// Computed outputs
 	ActionID types.String  `tfsdk:"action_id"`
 	JobID    types.Int64   `tfsdk:"job_id"`
@@ -48,6 +52,9 @@ func (r *ActionSupportAttach_TicketResource) Schema(ctx context.Context, req res
 				Sensitive:           true,
 				MarkdownDescription: "Base64-encoded file content for upload (optional)",
 			},
+			"triggers": actionTriggersAttribute(),
+			"when":     actionWhenAttribute(),
+			"wait":     actionWaitAttribute(),
 			"action_id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Action execution identifier",
@@ -58,7 +65,7 @@ func (r *ActionSupportAttach_TicketResource) Schema(ctx context.Context, req res
 			},
 			"state": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Job state: SUCCESS, FAILED, or RUNNING",
+				MarkdownDescription: "Job state: SUCCESS, FAILED, RUNNING, or SKIPPED when the action only runs on destroy",
 			},
 			"progress": schema.Float64Attribute{
 				Computed:            true,
@@ -66,7 +73,7 @@ func (r *ActionSupportAttach_TicketResource) Schema(ctx context.Context, req res
 			},
 			"result": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Action result data",
+				MarkdownDescription: "Action result, JSON-encoded",
 			},
 			"error": schema.StringAttribute{
 				Computed:            true,
@@ -95,6 +102,93 @@ func (r *ActionSupportAttach_TicketResource) Create(ctx context.Context, req res
 		return
 	}
 
+	if actionRunsOn(data.When, actionWhenCreate) {
+		resp.Diagnostics.Append(r.execute(&data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// Only runs on destroy
+		data.JobID = types.Int64Null()
+		data.State = types.StringValue(actionStateSkipped)
+		data.Progress = types.Float64Value(0.0)
+		data.Result = types.StringValue("")
+		data.Error = types.StringValue("")
+	}
+
+	// Generate ID from timestamp
+	data.ActionID = types.StringValue(fmt.Sprintf("support.attach_ticket-%d", time.Now().Unix()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ActionSupportAttach_TicketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Actions are immutable; only a job that wasn't waited for is refreshed
+	var data ActionSupportAttach_TicketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Pick up the outcome of a job that was started without waiting
+	if data.State.ValueString() == actionStateRunning && !data.JobID.IsNull() {
+		job, err := r.client.GetJob(int(data.JobID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to Refresh Job", fmt.Sprintf("Job %d: %s", data.JobID.ValueInt64(), err.Error()))
+			return
+		}
+		data.State = types.StringValue(job.State)
+		data.Progress = types.Float64Value(job.Progress)
+		data.Error = types.StringValue(job.Error)
+		if job.State == "SUCCESS" {
+			data.Result = actionResult(job.Result)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+// Update records changes that don't run the action again: when, wait and the
+// arguments of actions that only run on destroy. Other argument changes and
+// triggers replace the resource, which does.
+func (r *ActionSupportAttach_TicketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ActionSupportAttach_TicketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ActionID = state.ActionID
+	data.JobID = state.JobID
+	data.State = state.State
+	data.Progress = state.Progress
+	data.Result = state.Result
+	data.Error = state.Error
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ModifyPlan replaces the resource when an argument changes and the action
+// runs on create; see actionRequiresReplace
+func (r *ActionSupportAttach_TicketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	actionRequiresReplace(ctx, req, resp, []string{"data", "file_content"})
+}
+
+func (r *ActionSupportAttach_TicketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ActionSupportAttach_TicketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Actions cannot be undone; only run again when asked to on destroy
+	if actionRunsOn(data.When, actionWhenDestroy) {
+		resp.Diagnostics.Append(r.execute(&data)...)
+	}
+}
+
+// execute uploads to support.attach_ticket and records the outcome in data
+func (r *ActionSupportAttach_TicketResource) execute(data *ActionSupportAttach_TicketResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	// Build parameters
 	params := make(map[string]interface{})
 	params["data"] = data.Data.ValueString()
@@ -105,8 +199,8 @@ func (r *ActionSupportAttach_TicketResource) Create(ctx context.Context, req res
 	if !data.FileContent.IsNull() {
 		fileContent, err = base64.StdEncoding.DecodeString(data.FileContent.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Invalid File Content", fmt.Sprintf("Failed to decode base64: %s", err.Error()))
-			return
+			diags.AddError("Invalid File Content", fmt.Sprintf("Failed to decode base64: %s", err.Error()))
+			return diags
 		}
 	}
 
@@ -114,37 +208,40 @@ func (r *ActionSupportAttach_TicketResource) Create(ctx context.Context, req res
 	endpoint := "/api/v2.0/support/attach_ticket"
 	result, err := r.client.UploadFile(endpoint, params, fileContent, "upload")
 	if err != nil {
-		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute support.attach_ticket: %s", err.Error()))
-		return
+		diags.AddError("Action Failed", fmt.Sprintf("Failed to execute support.attach_ticket: %s", err.Error()))
+		return diags
 	}
 
-	// Store job ID if returned
+	// Background job - wait for completion unless told not to
 	if jobID, ok := result.(float64); ok {
 		data.JobID = types.Int64Value(int64(jobID))
+		data.Result = types.StringValue("")
+		data.Error = types.StringValue("")
+		if !data.Wait.IsNull() && !data.Wait.ValueBool() {
+			data.State = types.StringValue(actionStateRunning)
+			data.Progress = types.Float64Value(0)
+			return diags
+		}
+
+		jobResult, err := r.client.WaitForJob(int(jobID), r.client.JobTimeout())
+		if err != nil {
+			data.State = types.StringValue("FAILED")
+			data.Progress = types.Float64Value(0)
+			data.Error = types.StringValue(err.Error())
+			diags.AddError("Job Failed", err.Error())
+			return diags
+		}
+		data.State = types.StringValue(jobResult.State)
+		data.Progress = types.Float64Value(jobResult.Progress)
+		data.Result = actionResult(jobResult.Result)
+		return diags
 	}
 
-	// Actions are fire-and-forget - mark as success immediately
-	data.State = types.StringValue("SUBMITTED")
-	data.Progress = types.Float64Value(0.0)
-	data.Result = types.StringValue(fmt.Sprintf("%v", result))
+	// Immediate result
+	data.JobID = types.Int64Null()
+	data.State = types.StringValue("SUCCESS")
+	data.Progress = types.Float64Value(100.0)
+	data.Result = actionResult(result)
 	data.Error = types.StringValue("")
-
-	// Generate ID from timestamp
-	data.ActionID = types.StringValue(fmt.Sprintf("support.attach_ticket-%d", time.Now().Unix()))
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
-
-func (r *ActionSupportAttach_TicketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// Actions are immutable - just return current state
-	var data ActionSupportAttach_TicketResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-}
-
-func (r *ActionSupportAttach_TicketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Actions cannot be updated - force recreation
-	resp.Diagnostics.AddError("Update Not Supported", "Actions cannot be updated. Please destroy and recreate the resource.")
-}
-
-func (r *ActionSupportAttach_TicketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Actions cannot be undone - just remove from state
+	return diags
 }
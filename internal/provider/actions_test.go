@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestProvider_Actions(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("GetProviderSchema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+	if len(resp.ActionSchemas) == 0 {
+		t.Fatal("Expected native actions")
+	}
+	for name, s := range resp.ActionSchemas {
+		found := false
+		for _, b := range s.Schema.Block.BlockTypes {
+			found = found || b.TypeName == "timeouts"
+		}
+		if !found {
+			t.Errorf("Expected %s to have a timeouts block", name)
+		}
+	}
+
+	// Every deprecated action resource points at a native action that exists
+	ctx := context.Background()
+	for _, f := range New("test")().(*TrueNASProvider).Resources(ctx) {
+		r := f()
+		var meta resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "truenas"}, &meta)
+		var sch resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &sch)
+		msg := sch.Schema.DeprecationMessage
+		if msg == "" || !strings.HasPrefix(meta.TypeName, "truenas_action_") {
+			continue
+		}
+		want := "truenas_" + strings.TrimPrefix(meta.TypeName, "truenas_action_")
+		if !strings.Contains(msg, want+" action") {
+			t.Errorf("%s deprecation should point at %s, got %q", meta.TypeName, want, msg)
+		}
+		if _, ok := resp.ActionSchemas[want]; !ok {
+			t.Errorf("%s is deprecated in favour of %s, which isn't registered", meta.TypeName, want)
+		}
+	}
+}
+
+func TestActions_Configure(t *testing.T) {
+	for _, f := range New("test")().(*TrueNASProvider).Actions(context.Background()) {
+		a := f().(action.ActionWithConfigure)
+		resp := &action.ConfigureResponse{}
+		a.Configure(context.Background(), action.ConfigureRequest{ProviderData: "not a client"}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("Expected %T to reject unexpected provider data", a)
+		}
+	}
+}
+
+func TestActionRunsOn(t *testing.T) {
+	cases := []struct {
+		when    types.String
+		create  bool
+		destroy bool
+	}{
+		{types.StringNull(), true, false},
+		{types.StringValue(actionWhenCreate), true, false},
+		{types.StringValue(actionWhenDestroy), false, true},
+		{types.StringValue(actionWhenBoth), true, true},
+	}
+	for _, c := range cases {
+		if got := actionRunsOn(c.when, actionWhenCreate); got != c.create {
+			t.Errorf("actionRunsOn(%s, create) = %v, want %v", c.when, got, c.create)
+		}
+		if got := actionRunsOn(c.when, actionWhenDestroy); got != c.destroy {
+			t.Errorf("actionRunsOn(%s, destroy) = %v, want %v", c.when, got, c.destroy)
+		}
+	}
+}
+
+func TestActionResources_ArgumentChange(t *testing.T) {
+	ctx := context.Background()
+	r := &ActionPoolScrubRunResource{}
+	var sch resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &sch)
+
+	modifyPlan := func(when, priorName, name string) resource.ModifyPlanResponse {
+		build := func(n string) tfsdk.Plan {
+			res := tfsdk.Resource{Schema: sch.Schema}
+			if diags := nullAttributes(ctx, &res); diags.HasError() {
+				t.Fatal(diags)
+			}
+			plan := tfsdk.Plan{Schema: res.Schema, Raw: res.Raw}
+			var data ActionPoolScrubRunResourceModel
+			plan.Get(ctx, &data)
+			data.Name = types.StringValue(n)
+			data.When = types.StringValue(when)
+			if diags := plan.Set(ctx, &data); diags.HasError() {
+				t.Fatal(diags)
+			}
+			return plan
+		}
+		prior := build(priorName)
+		resp := resource.ModifyPlanResponse{Plan: build(name)}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: prior.Schema, Raw: prior.Raw},
+			Plan:  resp.Plan,
+		}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatal(resp.Diagnostics)
+		}
+		return resp
+	}
+
+	// A changed pool must be scrubbed, so the resource is replaced
+	resp := modifyPlan(actionWhenCreate, "tank", "backup")
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("name")) {
+		t.Errorf("Expected a changed name to require replacement, got %v", resp.RequiresReplace)
+	}
+	if resp = modifyPlan(actionWhenBoth, "tank", "backup"); len(resp.RequiresReplace) != 1 {
+		t.Errorf("Expected when = both to require replacement, got %v", resp.RequiresReplace)
+	}
+	// Destroy-time arguments are only recorded until destroy
+	if resp = modifyPlan(actionWhenDestroy, "tank", "backup"); len(resp.RequiresReplace) != 0 {
+		t.Errorf("Expected no replacement for a destroy-time action, got %v", resp.RequiresReplace)
+	}
+	if resp = modifyPlan(actionWhenCreate, "tank", "tank"); len(resp.RequiresReplace) != 0 {
+		t.Errorf("Expected no replacement without changes, got %v", resp.RequiresReplace)
+	}
+}
+
+func TestActionResources_TriggersAndWhen(t *testing.T) {
+	ctx := context.Background()
+	for _, f := range New("test")().(*TrueNASProvider).Resources(ctx) {
+		r := f()
+		var meta resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "truenas"}, &meta)
+		if !strings.HasPrefix(meta.TypeName, "truenas_action_") {
+			continue
+		}
+		var sch resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &sch)
+		if sch.Diagnostics.HasError() {
+			t.Fatalf("%s: %v", meta.TypeName, sch.Diagnostics)
+		}
+		if sch.Schema.DeprecationMessage == "" {
+			continue
+		}
+		for _, name := range []string{"triggers", "when"} {
+			if _, ok := sch.Schema.Attributes[name]; !ok {
+				t.Errorf("Expected %s to have a %s attribute", meta.TypeName, name)
+			}
+		}
+		if diags := sch.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Errorf("%s: %v", meta.TypeName, diags)
+		}
+	}
+}
+
+func TestActionResult(t *testing.T) {
+	cases := []struct {
+		in   interface{}
+		want string
+	}{
+		{nil, "null"},
+		{true, "true"},
+		{"done", `"done"`},
+		{map[string]interface{}{"id": 1.0, "name": "tank"}, `{"id":1,"name":"tank"}`},
+	}
+	for _, c := range cases {
+		if got := actionResult(c.in).ValueString(); got != c.want {
+			t.Errorf("actionResult(%v) = %s, want %s", c.in, got, c.want)
+		}
+	}
+}
+
+func TestActionResources_Wait(t *testing.T) {
+	ctx := context.Background()
+	for _, c := range []struct {
+		r    resource.Resource
+		wait bool
+	}{
+		{NewActionPoolScrubRunResource(), true},
+		{NewActionReplicationRunResource(), true},
+		{NewActionMailSendResource(), true},
+		{NewActionVmStartResource(), false},
+	} {
+		var sch resource.SchemaResponse
+		c.r.Schema(ctx, resource.SchemaRequest{}, &sch)
+		if _, ok := sch.Schema.Attributes["wait"]; ok != c.wait {
+			t.Errorf("%T: wait attribute present = %v, want %v", c.r, ok, c.wait)
+		}
+	}
+}
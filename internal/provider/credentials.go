@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// credentials is one place the host and token may be set
+type credentials struct {
+	host    string
+	token   client.TokenSource
+	profile bool
+}
+
+// resolveCredentials returns the host and token source to connect with. Each
+// is taken from the first of: the provider configuration, the profile chosen
+// by `profile` or TRUENAS_PROFILE, the TRUENAS_HOST, TRUENAS_TOKEN and
+// TRUENAS_TOKEN_FILE environment variables, and the default profile. A
+// profile's token is only used when the host is the profile's own, so it is
+// never sent to a host it wasn't issued for.
+func resolveCredentials(ctx context.Context, data TrueNASProviderModel) (string, client.TokenSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := credentials{host: data.Host.ValueString()}
+	switch {
+	case !data.Token.IsNull():
+		config.token = client.StaticToken(data.Token.ValueString())
+	case !data.TokenFile.IsNull():
+		config.token = client.FileToken(data.TokenFile.ValueString())
+	case !data.TokenCommand.IsNull():
+		var args []string
+		diags.Append(data.TokenCommand.ElementsAs(ctx, &args, false)...)
+		config.token = client.NewCommandToken(args)
+	}
+
+	env := credentials{host: os.Getenv("TRUENAS_HOST")}
+	if token := os.Getenv("TRUENAS_TOKEN"); token != "" {
+		env.token = client.StaticToken(token)
+	} else if file := os.Getenv("TRUENAS_TOKEN_FILE"); file != "" {
+		env.token = client.FileToken(file)
+	}
+
+	name := os.Getenv("TRUENAS_PROFILE")
+	if !data.Profile.IsNull() {
+		name = data.Profile.ValueString()
+	}
+	selected, fallback, err := loadProfile(name)
+	if err != nil {
+		diags.AddAttributeError(path.Root("profile"), "Invalid TrueNAS Credentials File", err.Error())
+		return "", nil, diags
+	}
+
+	sources := []credentials{config, selected, env, fallback}
+	var host string
+	for _, c := range sources {
+		if host == "" {
+			host = c.host
+		}
+	}
+	var token client.TokenSource
+	for _, c := range sources {
+		if token == nil && (!c.profile || c.host == host) {
+			token = c.token
+		}
+	}
+	return host, token, diags
+}
+
+// loadProfile reads the named profile from the credentials file, returned as
+// selected, or else the default profile if there is one, returned as fallback
+func loadProfile(name string) (selected, fallback credentials, err error) {
+	file := os.Getenv("TRUENAS_CREDENTIALS_FILE")
+	if file == "" {
+		if file, err = client.DefaultCredentialsFile(); err != nil {
+			if name == "" {
+				return selected, fallback, nil
+			}
+			return selected, fallback, err
+		}
+	}
+
+	profiles, err := client.LoadProfiles(file)
+	if errors.Is(err, fs.ErrNotExist) && name == "" {
+		// The credentials file is optional unless a profile is asked for
+		return selected, fallback, nil
+	}
+	if err != nil {
+		return selected, fallback, err
+	}
+
+	if name == "" {
+		p := profiles["default"]
+		return selected, credentials{host: p.Host, token: p.TokenSource(), profile: true}, nil
+	}
+	p, ok := profiles[name]
+	if !ok {
+		return selected, fallback, fmt.Errorf("profile %q not found in %s", name, file)
+	}
+	return credentials{host: p.Host, token: p.TokenSource(), profile: true}, fallback, nil
+}
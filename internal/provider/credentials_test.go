@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveCredentials(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	credentialsFile := filepath.Join(dir, "credentials")
+	if err := os.WriteFile(credentialsFile, []byte(`
+[default]
+host  = default.example.com
+token = default-token
+
+[prod]
+host       = prod.example.com
+token_file = `+tokenFile+`
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		env       map[string]string
+		data      TrueNASProviderModel
+		wantHost  string
+		wantToken string
+	}{
+		{
+			name:      "default profile",
+			wantHost:  "default.example.com",
+			wantToken: "default-token",
+		},
+		{
+			name:      "environment before default profile",
+			env:       map[string]string{"TRUENAS_HOST": "env.example.com", "TRUENAS_TOKEN": "env-token"},
+			wantHost:  "env.example.com",
+			wantToken: "env-token",
+		},
+		{
+			name:      "selected profile before environment",
+			env:       map[string]string{"TRUENAS_HOST": "env.example.com", "TRUENAS_PROFILE": "prod"},
+			wantHost:  "prod.example.com",
+			wantToken: "from-file",
+		},
+		{
+			name:      "configuration first",
+			env:       map[string]string{"TRUENAS_TOKEN": "env-token"},
+			data:      TrueNASProviderModel{Host: types.StringValue("config.example.com"), Profile: types.StringValue("prod"), TokenFile: types.StringValue(tokenFile)},
+			wantHost:  "config.example.com",
+			wantToken: "from-file",
+		},
+		{
+			name:     "default profile token kept to its host",
+			env:      map[string]string{"TRUENAS_HOST": "env.example.com"},
+			wantHost: "env.example.com",
+		},
+		{
+			name:     "selected profile token kept to its host",
+			data:     TrueNASProviderModel{Host: types.StringValue("config.example.com"), Profile: types.StringValue("prod")},
+			wantHost: "config.example.com",
+		},
+		{
+			name:      "profile token for the same host",
+			data:      TrueNASProviderModel{Host: types.StringValue("prod.example.com"), Profile: types.StringValue("prod")},
+			wantHost:  "prod.example.com",
+			wantToken: "from-file",
+		},
+		{
+			name:      "token command",
+			data:      TrueNASProviderModel{TokenCommand: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("echo"), types.StringValue(`{"token": "from-command"}`)})},
+			wantHost:  "default.example.com",
+			wantToken: "from-command",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"TRUENAS_HOST", "TRUENAS_TOKEN", "TRUENAS_TOKEN_FILE", "TRUENAS_PROFILE"} {
+				t.Setenv(name, tt.env[name])
+			}
+			t.Setenv("TRUENAS_CREDENTIALS_FILE", credentialsFile)
+			// Unset attributes are the zero values, which are null
+			host, source, diags := resolveCredentials(ctx, tt.data)
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			var token string
+			if source != nil {
+				var err error
+				if token, err = source.Token(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if host != tt.wantHost || token != tt.wantToken {
+				t.Errorf("Expected %s with %s, got %s with %s", tt.wantHost, tt.wantToken, host, token)
+			}
+		})
+	}
+}
+
+func TestResolveCredentials_MissingProfile(t *testing.T) {
+	t.Setenv("TRUENAS_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("TRUENAS_PROFILE", "")
+
+	// Without a profile the credentials file is optional
+	var data TrueNASProviderModel
+	if _, _, diags := resolveCredentials(context.Background(), data); diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+
+	data.Profile = types.StringValue("prod")
+	if _, _, diags := resolveCredentials(context.Background(), data); !diags.HasError() {
+		t.Error("Expected an error for a profile without a credentials file")
+	}
+}
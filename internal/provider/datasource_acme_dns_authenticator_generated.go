@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &AcmeDnsAuthenticatorDataSource{}
+
+func NewAcmeDnsAuthenticatorDataSource() datasource.DataSource {
+	return &AcmeDnsAuthenticatorDataSource{}
+}
+
+type AcmeDnsAuthenticatorDataSource struct {
+	client *client.Client
+}
+
+type AcmeDnsAuthenticatorDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Attributes types.String `tfsdk:"attributes"`
+	Name       types.String `tfsdk:"name"`
+}
+
+func (d *AcmeDnsAuthenticatorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_dns_authenticator"
+}
+
+func (d *AcmeDnsAuthenticatorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS acme_dns_authenticator data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"attributes": schema.StringAttribute{
+				Computed:    true,
+				Description: "Authentication credentials and configuration for the DNS provider.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human-readable name for the DNS authenticator.",
+			},
+		},
+	}
+}
+
+func (d *AcmeDnsAuthenticatorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *AcmeDnsAuthenticatorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AcmeDnsAuthenticatorDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("acme.dns.authenticator.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read acme_dns_authenticator: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["attributes"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Attributes = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Attributes = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Attributes = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
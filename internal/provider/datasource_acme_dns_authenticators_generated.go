@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &AcmeDnsAuthenticatorsDataSource{}
+
+func NewAcmeDnsAuthenticatorsDataSource() datasource.DataSource {
+	return &AcmeDnsAuthenticatorsDataSource{}
+}
+
+type AcmeDnsAuthenticatorsDataSource struct {
+	client *client.Client
+}
+
+type AcmeDnsAuthenticatorsDataSourceModel struct {
+	QueryArgsModel
+	Items types.List `tfsdk:"items"`
+}
+
+type AcmeDnsAuthenticatorsItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	Attributes types.String `tfsdk:"attributes"`
+	Name       types.String `tfsdk:"name"`
+}
+
+func (d *AcmeDnsAuthenticatorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_dns_authenticators"
+}
+
+func (d *AcmeDnsAuthenticatorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query acme_dns_authenticators",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of acme_dns_authenticators resources",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+						"attributes": schema.StringAttribute{
+							Computed:    true,
+							Description: "Authentication credentials and configuration for the DNS provider.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human-readable name for the DNS authenticator.",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (d *AcmeDnsAuthenticatorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *AcmeDnsAuthenticatorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AcmeDnsAuthenticatorsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("acme.dns.authenticator.query", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query acme_dns_authenticators: %s", err.Error()))
+		return
+	}
+
+	resultList, ok := result.([]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response as list")
+		return
+	}
+
+	// Convert results to items
+	items := make([]AcmeDnsAuthenticatorsItemModel, 0, len(resultList))
+	for _, item := range resultList {
+		resultMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemModel := AcmeDnsAuthenticatorsItemModel{}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			itemModel.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["attributes"]; ok && v != nil {
+			itemModel.Attributes = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["name"]; ok && v != nil {
+			itemModel.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		items = append(items, itemModel)
+	}
+
+	// Convert to types.List
+	itemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"attributes": types.StringType,
+			"id":         types.StringType,
+			"name":       types.StringType,
+		},
+	}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = itemsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
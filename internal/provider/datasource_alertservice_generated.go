@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &AlertserviceDataSource{}
+
+func NewAlertserviceDataSource() datasource.DataSource {
+	return &AlertserviceDataSource{}
+}
+
+type AlertserviceDataSource struct {
+	client *client.Client
+}
+
+type AlertserviceDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Attributes types.String `tfsdk:"attributes"`
+	Level      types.String `tfsdk:"level"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *AlertserviceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alertservice"
+}
+
+func (d *AlertserviceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS alertservice data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human-readable name for the alert service.",
+			},
+			"attributes": schema.StringAttribute{
+				Computed:    true,
+				Description: "Service-specific configuration attributes (credentials, endpoints, etc.).",
+			},
+			"level": schema.StringAttribute{
+				Computed:    true,
+				Description: "Minimum alert severity level that triggers notifications through this service.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the alert service is active and will send notifications.",
+			},
+		},
+	}
+}
+
+func (d *AlertserviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *AlertserviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AlertserviceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("alertservice.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read alertservice: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["attributes"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Attributes = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Attributes = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Attributes = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["level"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Level = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Level = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Level = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &ApiKeyDataSource{}
+
+func NewApiKeyDataSource() datasource.DataSource {
+	return &ApiKeyDataSource{}
+}
+
+type ApiKeyDataSource struct {
+	client *client.Client
+}
+
+type ApiKeyDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Username  types.String `tfsdk:"username"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Reset     types.Bool   `tfsdk:"reset"`
+}
+
+func (d *ApiKeyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (d *ApiKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS api_key data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human-readable name for the API key.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiration timestamp for the API key or `null` for no expiration.",
+			},
+			"reset": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to regenerate a new API key value for this entry.",
+			},
+		},
+	}
+}
+
+func (d *ApiKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ApiKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApiKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("api_key.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read api_key: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["username"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Username = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Username = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Username = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["expires_at"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.ExpiresAt = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.ExpiresAt = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.ExpiresAt = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["reset"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Reset = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
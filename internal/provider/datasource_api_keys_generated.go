@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &ApiKeysDataSource{}
+
+func NewApiKeysDataSource() datasource.DataSource {
+	return &ApiKeysDataSource{}
+}
+
+type ApiKeysDataSource struct {
+	client *client.Client
+}
+
+type ApiKeysDataSourceModel struct {
+	QueryArgsModel
+	Items types.List `tfsdk:"items"`
+}
+
+type ApiKeysItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Username  types.String `tfsdk:"username"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Reset     types.Bool   `tfsdk:"reset"`
+}
+
+func (d *ApiKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_keys"
+}
+
+func (d *ApiKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query api_keys",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of api_keys resources",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human-readable name for the API key.",
+						},
+						"username": schema.StringAttribute{
+							Computed:    true,
+							Description: "",
+						},
+						"expires_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Expiration timestamp for the API key or `null` for no expiration.",
+						},
+						"reset": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether to regenerate a new API key value for this entry.",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (d *ApiKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ApiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApiKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("api_key.query", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query api_keys: %s", err.Error()))
+		return
+	}
+
+	resultList, ok := result.([]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response as list")
+		return
+	}
+
+	// Convert results to items
+	items := make([]ApiKeysItemModel, 0, len(resultList))
+	for _, item := range resultList {
+		resultMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemModel := ApiKeysItemModel{}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			itemModel.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["name"]; ok && v != nil {
+			itemModel.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["username"]; ok && v != nil {
+			itemModel.Username = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["expires_at"]; ok && v != nil {
+			itemModel.ExpiresAt = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["reset"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.Reset = types.BoolValue(bv)
+			}
+		}
+		items = append(items, itemModel)
+	}
+
+	// Convert to types.List
+	itemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"expires_at": types.StringType,
+			"id":         types.StringType,
+			"name":       types.StringType,
+			"reset":      types.BoolType,
+			"username":   types.StringType,
+		},
+	}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = itemsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
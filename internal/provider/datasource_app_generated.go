@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &AppDataSource{}
+
+func NewAppDataSource() datasource.DataSource {
+	return &AppDataSource{}
+}
+
+type AppDataSource struct {
+	client *client.Client
+}
+
+type AppDataSourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	CustomApp                 types.Bool   `tfsdk:"custom_app"`
+	Values                    types.String `tfsdk:"values"`
+	CustomComposeConfig       types.String `tfsdk:"custom_compose_config"`
+	CustomComposeConfigString types.String `tfsdk:"custom_compose_config_string"`
+	CatalogApp                types.String `tfsdk:"catalog_app"`
+	AppName                   types.String `tfsdk:"app_name"`
+	Train                     types.String `tfsdk:"train"`
+	Version                   types.String `tfsdk:"version"`
+}
+
+func (d *AppDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app"
+}
+
+func (d *AppDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS app data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"custom_app": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to create a custom application (`true`) or install from catalog (`false`).",
+			},
+			"values": schema.StringAttribute{
+				Computed:    true,
+				Description: "Updated configuration values for the application.",
+			},
+			"custom_compose_config": schema.StringAttribute{
+				Computed:    true,
+				Description: "Updated Docker Compose configuration as a structured object.",
+			},
+			"custom_compose_config_string": schema.StringAttribute{
+				Computed:    true,
+				Description: "Updated Docker Compose configuration as a YAML string.",
+			},
+			"catalog_app": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the catalog application to install. Required when `custom_app` is `false`.",
+			},
+			"app_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Application name must have the following:  * Lowercase alphanumeric characters can be specified. * N",
+			},
+			"train": schema.StringAttribute{
+				Computed:    true,
+				Description: "The catalog train to install from.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of the application to install.",
+			},
+		},
+	}
+}
+
+func (d *AppDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *AppDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("app.get_instance", data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read app: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["custom_app"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.CustomApp = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["values"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Values = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Values = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Values = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["custom_compose_config"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.CustomComposeConfig = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.CustomComposeConfig = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.CustomComposeConfig = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["custom_compose_config_string"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.CustomComposeConfigString = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.CustomComposeConfigString = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.CustomComposeConfigString = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["catalog_app"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.CatalogApp = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.CatalogApp = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.CatalogApp = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["app_name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.AppName = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.AppName = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.AppName = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["train"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Train = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Train = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Train = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["version"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Version = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Version = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Version = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &AppImageDataSource{}
+
+func NewAppImageDataSource() datasource.DataSource {
+	return &AppImageDataSource{}
+}
+
+type AppImageDataSource struct {
+	client *client.Client
+}
+
+type AppImageDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	RepoTags        types.List   `tfsdk:"repo_tags"`
+	RepoDigests     types.List   `tfsdk:"repo_digests"`
+	Size            types.Int64  `tfsdk:"size"`
+	Created         types.String `tfsdk:"created"`
+	Dangling        types.Bool   `tfsdk:"dangling"`
+	UpdateAvailable types.Bool   `tfsdk:"update_available"`
+	ParsedRepoTags  types.String `tfsdk:"parsed_repo_tags"`
+}
+
+func (d *AppImageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_image"
+}
+
+func (d *AppImageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS app_image data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"repo_tags": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Repository tags of the image",
+			},
+			"repo_digests": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Repository digests of the image",
+			},
+			"size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Size of the image in bytes",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation time of the image",
+			},
+			"dangling": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Image is not referenced by any tag",
+			},
+			"update_available": schema.BoolAttribute{
+				Computed:    true,
+				Description: "A newer version of the image is available",
+			},
+			"parsed_repo_tags": schema.StringAttribute{
+				Computed:    true,
+				Description: "Parsed repository tags",
+			},
+		},
+	}
+}
+
+func (d *AppImageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *AppImageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppImageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("app.image.get_instance", data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read app_image: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["repo_tags"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.RepoTags, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["repo_digests"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.RepoDigests, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["size"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Size = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Size = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["created"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Created = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Created = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Created = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["dangling"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Dangling = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["update_available"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.UpdateAvailable = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["parsed_repo_tags"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.ParsedRepoTags = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.ParsedRepoTags = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.ParsedRepoTags = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
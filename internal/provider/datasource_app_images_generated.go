@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &AppImagesDataSource{}
+
+func NewAppImagesDataSource() datasource.DataSource {
+	return &AppImagesDataSource{}
+}
+
+type AppImagesDataSource struct {
+	client *client.Client
+}
+
+type AppImagesDataSourceModel struct {
+	QueryArgsModel
+	Items types.List `tfsdk:"items"`
+}
+
+type AppImagesItemModel struct {
+	ID              types.String `tfsdk:"id"`
+	Size            types.Int64  `tfsdk:"size"`
+	Created         types.String `tfsdk:"created"`
+	Dangling        types.Bool   `tfsdk:"dangling"`
+	UpdateAvailable types.Bool   `tfsdk:"update_available"`
+	ParsedRepoTags  types.String `tfsdk:"parsed_repo_tags"`
+}
+
+func (d *AppImagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_images"
+}
+
+func (d *AppImagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query app_images",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of app_images resources",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+						"size": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Size of the image in bytes",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation time of the image",
+						},
+						"dangling": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Image is not referenced by any tag",
+						},
+						"update_available": schema.BoolAttribute{
+							Computed:    true,
+							Description: "A newer version of the image is available",
+						},
+						"parsed_repo_tags": schema.StringAttribute{
+							Computed:    true,
+							Description: "Parsed repository tags",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (d *AppImagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *AppImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppImagesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("app.image.query", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query app_images: %s", err.Error()))
+		return
+	}
+
+	resultList, ok := result.([]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response as list")
+		return
+	}
+
+	// Convert results to items
+	items := make([]AppImagesItemModel, 0, len(resultList))
+	for _, item := range resultList {
+		resultMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemModel := AppImagesItemModel{}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			itemModel.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["size"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.Size = types.Int64Value(int64(fv))
+			}
+		}
+		if v, ok := resultMap["created"]; ok && v != nil {
+			itemModel.Created = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["dangling"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.Dangling = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["update_available"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.UpdateAvailable = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["parsed_repo_tags"]; ok && v != nil {
+			itemModel.ParsedRepoTags = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		items = append(items, itemModel)
+	}
+
+	// Convert to types.List
+	itemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"created":          types.StringType,
+			"dangling":         types.BoolType,
+			"id":               types.StringType,
+			"parsed_repo_tags": types.StringType,
+			"size":             types.Int64Type,
+			"update_available": types.BoolType,
+		},
+	}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = itemsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &AppRegistryDataSource{}
+
+func NewAppRegistryDataSource() datasource.DataSource {
+	return &AppRegistryDataSource{}
+}
+
+type AppRegistryDataSource struct {
+	client *client.Client
+}
+
+type AppRegistryDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	Uri         types.String `tfsdk:"uri"`
+}
+
+func (d *AppRegistryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_registry"
+}
+
+func (d *AppRegistryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS app_registry data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human-readable name for the container registry.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Optional description of the container registry or `null`.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "Username for registry authentication (masked for security).",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Description: "Password or access token for registry authentication (masked for security).",
+			},
+			"uri": schema.StringAttribute{
+				Computed:    true,
+				Description: "Container registry URI endpoint (defaults to Docker Hub).",
+			},
+		},
+	}
+}
+
+func (d *AppRegistryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *AppRegistryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppRegistryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("app.registry.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read app_registry: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["description"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Description = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Description = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["username"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Username = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Username = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Username = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["password"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Password = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Password = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Password = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["uri"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Uri = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Uri = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Uri = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &AppsDataSource{}
+
+func NewAppsDataSource() datasource.DataSource {
+	return &AppsDataSource{}
+}
+
+type AppsDataSource struct {
+	client *client.Client
+}
+
+type AppsDataSourceModel struct {
+	QueryArgsModel
+	Items types.List `tfsdk:"items"`
+}
+
+type AppsItemModel struct {
+	ID                        types.String `tfsdk:"id"`
+	CustomApp                 types.Bool   `tfsdk:"custom_app"`
+	Values                    types.String `tfsdk:"values"`
+	CustomComposeConfig       types.String `tfsdk:"custom_compose_config"`
+	CustomComposeConfigString types.String `tfsdk:"custom_compose_config_string"`
+	CatalogApp                types.String `tfsdk:"catalog_app"`
+	AppName                   types.String `tfsdk:"app_name"`
+	Train                     types.String `tfsdk:"train"`
+	Version                   types.String `tfsdk:"version"`
+}
+
+func (d *AppsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_apps"
+}
+
+func (d *AppsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query apps",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of apps resources",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+						"custom_app": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether to create a custom application (`true`) or install from catalog (`false`).",
+						},
+						"values": schema.StringAttribute{
+							Computed:    true,
+							Description: "Updated configuration values for the application.",
+						},
+						"custom_compose_config": schema.StringAttribute{
+							Computed:    true,
+							Description: "Updated Docker Compose configuration as a structured object.",
+						},
+						"custom_compose_config_string": schema.StringAttribute{
+							Computed:    true,
+							Description: "Updated Docker Compose configuration as a YAML string.",
+						},
+						"catalog_app": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the catalog application to install. Required when `custom_app` is `false`.",
+						},
+						"app_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Application name must have the following:  * Lowercase alphanumeric characters can be specified. * N",
+						},
+						"train": schema.StringAttribute{
+							Computed:    true,
+							Description: "The catalog train to install from.",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The version of the application to install.",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (d *AppsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *AppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AppsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("app.query", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query apps: %s", err.Error()))
+		return
+	}
+
+	resultList, ok := result.([]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response as list")
+		return
+	}
+
+	// Convert results to items
+	items := make([]AppsItemModel, 0, len(resultList))
+	for _, item := range resultList {
+		resultMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemModel := AppsItemModel{}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			itemModel.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["custom_app"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.CustomApp = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["values"]; ok && v != nil {
+			itemModel.Values = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["custom_compose_config"]; ok && v != nil {
+			itemModel.CustomComposeConfig = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["custom_compose_config_string"]; ok && v != nil {
+			itemModel.CustomComposeConfigString = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["catalog_app"]; ok && v != nil {
+			itemModel.CatalogApp = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["app_name"]; ok && v != nil {
+			itemModel.AppName = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["train"]; ok && v != nil {
+			itemModel.Train = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["version"]; ok && v != nil {
+			itemModel.Version = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		items = append(items, itemModel)
+	}
+
+	// Convert to types.List
+	itemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"app_name":                     types.StringType,
+			"catalog_app":                  types.StringType,
+			"custom_app":                   types.BoolType,
+			"custom_compose_config":        types.StringType,
+			"custom_compose_config_string": types.StringType,
+			"id":                           types.StringType,
+			"train":                        types.StringType,
+			"values":                       types.StringType,
+			"version":                      types.StringType,
+		},
+	}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = itemsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,485 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &CertificateDataSource{}
+
+func NewCertificateDataSource() datasource.DataSource {
+	return &CertificateDataSource{}
+}
+
+type CertificateDataSource struct {
+	client *client.Client
+}
+
+type CertificateDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	CreateType         types.String `tfsdk:"create_type"`
+	AddToTrustedStore  types.Bool   `tfsdk:"add_to_trusted_store"`
+	Certificate        types.String `tfsdk:"certificate"`
+	Privatekey         types.String `tfsdk:"privatekey"`
+	Csr                types.String `tfsdk:"csr"`
+	KeyLength          types.Int64  `tfsdk:"key_length"`
+	KeyType            types.String `tfsdk:"key_type"`
+	EcCurve            types.String `tfsdk:"ec_curve"`
+	Passphrase         types.String `tfsdk:"passphrase"`
+	City               types.String `tfsdk:"city"`
+	Common             types.String `tfsdk:"common"`
+	Country            types.String `tfsdk:"country"`
+	Email              types.String `tfsdk:"email"`
+	Organization       types.String `tfsdk:"organization"`
+	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
+	State              types.String `tfsdk:"state"`
+	DigestAlgorithm    types.String `tfsdk:"digest_algorithm"`
+	San                types.List   `tfsdk:"san"`
+	CertExtensions     types.String `tfsdk:"cert_extensions"`
+	AcmeDirectoryUri   types.String `tfsdk:"acme_directory_uri"`
+	CsrId              types.Int64  `tfsdk:"csr_id"`
+	Tos                types.Bool   `tfsdk:"tos"`
+	DnsMapping         types.String `tfsdk:"dns_mapping"`
+	RenewDays          types.Int64  `tfsdk:"renew_days"`
+}
+
+func (d *CertificateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (d *CertificateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS certificate data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Certificate name.",
+			},
+			"create_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of certificate creation operation.",
+			},
+			"add_to_trusted_store": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to add this certificate to the trusted certificate store.",
+			},
+			"certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "PEM-encoded certificate to import or `null`.",
+			},
+			"privatekey": schema.StringAttribute{
+				Computed:    true,
+				Description: "PEM-encoded private key to import or `null`.",
+			},
+			"csr": schema.StringAttribute{
+				Computed:    true,
+				Description: "PEM-encoded certificate signing request to import or `null`.",
+			},
+			"key_length": schema.Int64Attribute{
+				Computed:    true,
+				Description: "RSA key length in bits or `null`.",
+			},
+			"key_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of cryptographic key to generate.",
+			},
+			"ec_curve": schema.StringAttribute{
+				Computed:    true,
+				Description: "Elliptic curve to use for EC keys.",
+			},
+			"passphrase": schema.StringAttribute{
+				Computed:    true,
+				Description: "Passphrase to protect the private key or `null`.",
+			},
+			"city": schema.StringAttribute{
+				Computed:    true,
+				Description: "City or locality name for certificate subject or `null`.",
+			},
+			"common": schema.StringAttribute{
+				Computed:    true,
+				Description: "Common name for certificate subject or `null`.",
+			},
+			"country": schema.StringAttribute{
+				Computed:    true,
+				Description: "Country name for certificate subject or `null`.",
+			},
+			"email": schema.StringAttribute{
+				Computed:    true,
+				Description: "Email address for certificate subject or `null`.",
+			},
+			"organization": schema.StringAttribute{
+				Computed:    true,
+				Description: "Organization name for certificate subject or `null`.",
+			},
+			"organizational_unit": schema.StringAttribute{
+				Computed:    true,
+				Description: "Organizational unit for certificate subject or `null`.",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "State or province name for certificate subject or `null`.",
+			},
+			"digest_algorithm": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hash algorithm for certificate signing.",
+			},
+			"san": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Subject alternative names for the certificate.",
+			},
+			"cert_extensions": schema.StringAttribute{
+				Computed:    true,
+				Description: "Certificate extensions configuration.",
+			},
+			"acme_directory_uri": schema.StringAttribute{
+				Computed:    true,
+				Description: "ACME directory URI to be used for ACME certificate creation.",
+			},
+			"csr_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "CSR to be used for ACME certificate creation.",
+			},
+			"tos": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Set this when creating an ACME certificate to accept terms of service of the ACME service.",
+			},
+			"dns_mapping": schema.StringAttribute{
+				Computed:    true,
+				Description: "A mapping of domain to ACME DNS Authenticator ID for each domain listed in SAN or common name of the",
+			},
+			"renew_days": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Days before expiration to attempt renewal.",
+			},
+		},
+	}
+}
+
+func (d *CertificateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *CertificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CertificateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("certificate.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read certificate: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["create_type"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.CreateType = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.CreateType = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.CreateType = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["add_to_trusted_store"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.AddToTrustedStore = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["certificate"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Certificate = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Certificate = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Certificate = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["privatekey"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Privatekey = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Privatekey = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Privatekey = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["csr"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Csr = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Csr = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Csr = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["key_length"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.KeyLength = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.KeyLength = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["key_type"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.KeyType = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.KeyType = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.KeyType = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["ec_curve"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.EcCurve = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.EcCurve = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.EcCurve = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["passphrase"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Passphrase = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Passphrase = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Passphrase = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["city"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.City = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.City = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.City = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["common"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Common = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Common = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Common = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["country"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Country = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Country = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Country = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["email"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Email = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Email = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Email = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["organization"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Organization = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Organization = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Organization = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["organizational_unit"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.OrganizationalUnit = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.OrganizationalUnit = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.OrganizationalUnit = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["state"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.State = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.State = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.State = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["digest_algorithm"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.DigestAlgorithm = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.DigestAlgorithm = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.DigestAlgorithm = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["san"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.San, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["cert_extensions"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.CertExtensions = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.CertExtensions = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.CertExtensions = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["acme_directory_uri"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.AcmeDirectoryUri = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.AcmeDirectoryUri = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.AcmeDirectoryUri = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["csr_id"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.CsrId = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.CsrId = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["tos"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Tos = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["dns_mapping"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.DnsMapping = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.DnsMapping = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.DnsMapping = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["renew_days"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.RenewDays = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.RenewDays = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &CertificatesDataSource{}
+
+func NewCertificatesDataSource() datasource.DataSource {
+	return &CertificatesDataSource{}
+}
+
+type CertificatesDataSource struct {
+	client *client.Client
+}
+
+type CertificatesDataSourceModel struct {
+	QueryArgsModel
+	Items types.List `tfsdk:"items"`
+}
+
+type CertificatesItemModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	CreateType         types.String `tfsdk:"create_type"`
+	AddToTrustedStore  types.Bool   `tfsdk:"add_to_trusted_store"`
+	Certificate        types.String `tfsdk:"certificate"`
+	Privatekey         types.String `tfsdk:"privatekey"`
+	Csr                types.String `tfsdk:"csr"`
+	KeyLength          types.Int64  `tfsdk:"key_length"`
+	KeyType            types.String `tfsdk:"key_type"`
+	EcCurve            types.String `tfsdk:"ec_curve"`
+	Passphrase         types.String `tfsdk:"passphrase"`
+	City               types.String `tfsdk:"city"`
+	Common             types.String `tfsdk:"common"`
+	Country            types.String `tfsdk:"country"`
+	Email              types.String `tfsdk:"email"`
+	Organization       types.String `tfsdk:"organization"`
+	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
+	State              types.String `tfsdk:"state"`
+	DigestAlgorithm    types.String `tfsdk:"digest_algorithm"`
+	CertExtensions     types.String `tfsdk:"cert_extensions"`
+	AcmeDirectoryUri   types.String `tfsdk:"acme_directory_uri"`
+	CsrId              types.Int64  `tfsdk:"csr_id"`
+	Tos                types.Bool   `tfsdk:"tos"`
+	DnsMapping         types.String `tfsdk:"dns_mapping"`
+	RenewDays          types.Int64  `tfsdk:"renew_days"`
+}
+
+func (d *CertificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificates"
+}
+
+func (d *CertificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query certificates",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of certificates resources",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Certificate name.",
+						},
+						"create_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of certificate creation operation.",
+						},
+						"add_to_trusted_store": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether to add this certificate to the trusted certificate store.",
+						},
+						"certificate": schema.StringAttribute{
+							Computed:    true,
+							Description: "PEM-encoded certificate to import or `null`.",
+						},
+						"privatekey": schema.StringAttribute{
+							Computed:    true,
+							Description: "PEM-encoded private key to import or `null`.",
+						},
+						"csr": schema.StringAttribute{
+							Computed:    true,
+							Description: "PEM-encoded certificate signing request to import or `null`.",
+						},
+						"key_length": schema.Int64Attribute{
+							Computed:    true,
+							Description: "RSA key length in bits or `null`.",
+						},
+						"key_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of cryptographic key to generate.",
+						},
+						"ec_curve": schema.StringAttribute{
+							Computed:    true,
+							Description: "Elliptic curve to use for EC keys.",
+						},
+						"passphrase": schema.StringAttribute{
+							Computed:    true,
+							Description: "Passphrase to protect the private key or `null`.",
+						},
+						"city": schema.StringAttribute{
+							Computed:    true,
+							Description: "City or locality name for certificate subject or `null`.",
+						},
+						"common": schema.StringAttribute{
+							Computed:    true,
+							Description: "Common name for certificate subject or `null`.",
+						},
+						"country": schema.StringAttribute{
+							Computed:    true,
+							Description: "Country name for certificate subject or `null`.",
+						},
+						"email": schema.StringAttribute{
+							Computed:    true,
+							Description: "Email address for certificate subject or `null`.",
+						},
+						"organization": schema.StringAttribute{
+							Computed:    true,
+							Description: "Organization name for certificate subject or `null`.",
+						},
+						"organizational_unit": schema.StringAttribute{
+							Computed:    true,
+							Description: "Organizational unit for certificate subject or `null`.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "State or province name for certificate subject or `null`.",
+						},
+						"digest_algorithm": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hash algorithm for certificate signing.",
+						},
+						"cert_extensions": schema.StringAttribute{
+							Computed:    true,
+							Description: "Certificate extensions configuration.",
+						},
+						"acme_directory_uri": schema.StringAttribute{
+							Computed:    true,
+							Description: "ACME directory URI to be used for ACME certificate creation.",
+						},
+						"csr_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "CSR to be used for ACME certificate creation.",
+						},
+						"tos": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Set this when creating an ACME certificate to accept terms of service of the ACME service.",
+						},
+						"dns_mapping": schema.StringAttribute{
+							Computed:    true,
+							Description: "A mapping of domain to ACME DNS Authenticator ID for each domain listed in SAN or common name of the",
+						},
+						"renew_days": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Days before expiration to attempt renewal.",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (d *CertificatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *CertificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CertificatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("certificate.query", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query certificates: %s", err.Error()))
+		return
+	}
+
+	resultList, ok := result.([]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response as list")
+		return
+	}
+
+	// Convert results to items
+	items := make([]CertificatesItemModel, 0, len(resultList))
+	for _, item := range resultList {
+		resultMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemModel := CertificatesItemModel{}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			itemModel.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["name"]; ok && v != nil {
+			itemModel.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["create_type"]; ok && v != nil {
+			itemModel.CreateType = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["add_to_trusted_store"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.AddToTrustedStore = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["certificate"]; ok && v != nil {
+			itemModel.Certificate = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["privatekey"]; ok && v != nil {
+			itemModel.Privatekey = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["csr"]; ok && v != nil {
+			itemModel.Csr = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["key_length"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.KeyLength = types.Int64Value(int64(fv))
+			}
+		}
+		if v, ok := resultMap["key_type"]; ok && v != nil {
+			itemModel.KeyType = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["ec_curve"]; ok && v != nil {
+			itemModel.EcCurve = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["passphrase"]; ok && v != nil {
+			itemModel.Passphrase = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["city"]; ok && v != nil {
+			itemModel.City = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["common"]; ok && v != nil {
+			itemModel.Common = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["country"]; ok && v != nil {
+			itemModel.Country = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["email"]; ok && v != nil {
+			itemModel.Email = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["organization"]; ok && v != nil {
+			itemModel.Organization = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["organizational_unit"]; ok && v != nil {
+			itemModel.OrganizationalUnit = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["state"]; ok && v != nil {
+			itemModel.State = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["digest_algorithm"]; ok && v != nil {
+			itemModel.DigestAlgorithm = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["cert_extensions"]; ok && v != nil {
+			itemModel.CertExtensions = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["acme_directory_uri"]; ok && v != nil {
+			itemModel.AcmeDirectoryUri = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["csr_id"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.CsrId = types.Int64Value(int64(fv))
+			}
+		}
+		if v, ok := resultMap["tos"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.Tos = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["dns_mapping"]; ok && v != nil {
+			itemModel.DnsMapping = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["renew_days"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.RenewDays = types.Int64Value(int64(fv))
+			}
+		}
+		items = append(items, itemModel)
+	}
+
+	// Convert to types.List
+	itemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"acme_directory_uri":   types.StringType,
+			"add_to_trusted_store": types.BoolType,
+			"cert_extensions":      types.StringType,
+			"certificate":          types.StringType,
+			"city":                 types.StringType,
+			"common":               types.StringType,
+			"country":              types.StringType,
+			"create_type":          types.StringType,
+			"csr":                  types.StringType,
+			"csr_id":               types.Int64Type,
+			"digest_algorithm":     types.StringType,
+			"dns_mapping":          types.StringType,
+			"ec_curve":             types.StringType,
+			"email":                types.StringType,
+			"id":                   types.StringType,
+			"key_length":           types.Int64Type,
+			"key_type":             types.StringType,
+			"name":                 types.StringType,
+			"organization":         types.StringType,
+			"organizational_unit":  types.StringType,
+			"passphrase":           types.StringType,
+			"privatekey":           types.StringType,
+			"renew_days":           types.Int64Type,
+			"state":                types.StringType,
+			"tos":                  types.BoolType,
+		},
+	}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = itemsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &CloudBackupDataSource{}
+
+func NewCloudBackupDataSource() datasource.DataSource {
+	return &CloudBackupDataSource{}
+}
+
+type CloudBackupDataSource struct {
+	client *client.Client
+}
+
+type CloudBackupDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Path            types.String `tfsdk:"path"`
+	Credentials     types.Int64  `tfsdk:"credentials"`
+	Attributes      types.String `tfsdk:"attributes"`
+	Schedule        types.String `tfsdk:"schedule"`
+	PreScript       types.String `tfsdk:"pre_script"`
+	PostScript      types.String `tfsdk:"post_script"`
+	Snapshot        types.Bool   `tfsdk:"snapshot"`
+	Include         types.List   `tfsdk:"include"`
+	Exclude         types.List   `tfsdk:"exclude"`
+	Args            types.String `tfsdk:"args"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Password        types.String `tfsdk:"password"`
+	KeepLast        types.Int64  `tfsdk:"keep_last"`
+	TransferSetting types.String `tfsdk:"transfer_setting"`
+	AbsolutePaths   types.Bool   `tfsdk:"absolute_paths"`
+	CachePath       types.String `tfsdk:"cache_path"`
+	RateLimit       types.Int64  `tfsdk:"rate_limit"`
+}
+
+func (d *CloudBackupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_backup"
+}
+
+func (d *CloudBackupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS cloud_backup data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the task to display in the UI.",
+			},
+			"path": schema.StringAttribute{
+				Computed:    true,
+				Description: "The local path to back up beginning with `/mnt` or `/dev/zvol`.",
+			},
+			"credentials": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the cloud credential to use for each backup.",
+			},
+			"attributes": schema.StringAttribute{
+				Computed:    true,
+				Description: "Additional information for each backup, e.g. bucket name.",
+			},
+			"schedule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Cron schedule dictating when the task should run.",
+			},
+			"pre_script": schema.StringAttribute{
+				Computed:    true,
+				Description: "A Bash script to run immediately before every backup.",
+			},
+			"post_script": schema.StringAttribute{
+				Computed:    true,
+				Description: "A Bash script to run immediately after every backup if it succeeds.",
+			},
+			"snapshot": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to create a temporary snapshot of the dataset before every backup.",
+			},
+			"include": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Paths to pass to `restic backup --include`.",
+			},
+			"exclude": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Paths to pass to `restic backup --exclude`.",
+			},
+			"args": schema.StringAttribute{
+				Computed:    true,
+				Description: "(Slated for removal).",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Can enable/disable the task.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Description: "Password for the remote repository.",
+			},
+			"keep_last": schema.Int64Attribute{
+				Computed:    true,
+				Description: "How many of the most recent backup snapshots to keep after each backup.",
+			},
+			"transfer_setting": schema.StringAttribute{
+				Computed:    true,
+				Description: "* DEFAULT:     * pack size given by `$RESTIC_PACK_SIZE` (default 16 MiB)     * read concurrency give",
+			},
+			"absolute_paths": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Preserve absolute paths in each backup (cannot be set when `snapshot=True`).",
+			},
+			"cache_path": schema.StringAttribute{
+				Computed:    true,
+				Description: "Cache path. If not set, performance may degrade.",
+			},
+			"rate_limit": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Maximum upload/download rate in KiB/s. Passed to `restic --limit-upload` on `cloud_backup.sync` and ",
+			},
+		},
+	}
+}
+
+func (d *CloudBackupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *CloudBackupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CloudBackupDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("cloud_backup.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read cloud_backup: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["description"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Description = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Description = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["path"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Path = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Path = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Path = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["credentials"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Credentials = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Credentials = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["attributes"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Attributes = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Attributes = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Attributes = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["schedule"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Schedule = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Schedule = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Schedule = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["pre_script"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.PreScript = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.PreScript = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.PreScript = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["post_script"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.PostScript = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.PostScript = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.PostScript = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["snapshot"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Snapshot = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["include"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Include, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["exclude"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Exclude, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["args"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Args = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Args = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Args = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["password"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Password = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Password = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Password = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["keep_last"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.KeepLast = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.KeepLast = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["transfer_setting"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.TransferSetting = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.TransferSetting = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.TransferSetting = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["absolute_paths"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.AbsolutePaths = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["cache_path"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.CachePath = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.CachePath = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.CachePath = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["rate_limit"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.RateLimit = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.RateLimit = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
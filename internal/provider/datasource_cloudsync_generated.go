@@ -0,0 +1,404 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &CloudsyncDataSource{}
+
+func NewCloudsyncDataSource() datasource.DataSource {
+	return &CloudsyncDataSource{}
+}
+
+type CloudsyncDataSource struct {
+	client *client.Client
+}
+
+type CloudsyncDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Description        types.String `tfsdk:"description"`
+	Path               types.String `tfsdk:"path"`
+	Credentials        types.Int64  `tfsdk:"credentials"`
+	Attributes         types.String `tfsdk:"attributes"`
+	Schedule           types.String `tfsdk:"schedule"`
+	PreScript          types.String `tfsdk:"pre_script"`
+	PostScript         types.String `tfsdk:"post_script"`
+	Snapshot           types.Bool   `tfsdk:"snapshot"`
+	Include            types.List   `tfsdk:"include"`
+	Exclude            types.List   `tfsdk:"exclude"`
+	Args               types.String `tfsdk:"args"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Bwlimit            types.List   `tfsdk:"bwlimit"`
+	Transfers          types.Int64  `tfsdk:"transfers"`
+	Direction          types.String `tfsdk:"direction"`
+	TransferMode       types.String `tfsdk:"transfer_mode"`
+	Encryption         types.Bool   `tfsdk:"encryption"`
+	FilenameEncryption types.Bool   `tfsdk:"filename_encryption"`
+	EncryptionPassword types.String `tfsdk:"encryption_password"`
+	EncryptionSalt     types.String `tfsdk:"encryption_salt"`
+	CreateEmptySrcDirs types.Bool   `tfsdk:"create_empty_src_dirs"`
+	FollowSymlinks     types.Bool   `tfsdk:"follow_symlinks"`
+}
+
+func (d *CloudsyncDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudsync"
+}
+
+func (d *CloudsyncDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS cloudsync data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the task to display in the UI.",
+			},
+			"path": schema.StringAttribute{
+				Computed:    true,
+				Description: "The local path to back up beginning with `/mnt` or `/dev/zvol`.",
+			},
+			"credentials": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the cloud credential.",
+			},
+			"attributes": schema.StringAttribute{
+				Computed:    true,
+				Description: "Additional information for each backup, e.g. bucket name.",
+			},
+			"schedule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Cron schedule dictating when the task should run.",
+			},
+			"pre_script": schema.StringAttribute{
+				Computed:    true,
+				Description: "A Bash script to run immediately before every backup.",
+			},
+			"post_script": schema.StringAttribute{
+				Computed:    true,
+				Description: "A Bash script to run immediately after every backup if it succeeds.",
+			},
+			"snapshot": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to create a temporary snapshot of the dataset before every backup.",
+			},
+			"include": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Paths to pass to `restic backup --include`.",
+			},
+			"exclude": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Paths to pass to `restic backup --exclude`.",
+			},
+			"args": schema.StringAttribute{
+				Computed:    true,
+				Description: "(Slated for removal).",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Can enable/disable the task.",
+			},
+			"bwlimit": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Schedule of bandwidth limits.",
+			},
+			"transfers": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Maximum number of parallel file transfers. `null` for default.",
+			},
+			"direction": schema.StringAttribute{
+				Computed:    true,
+				Description: "Direction of the cloud sync operation.  * `PUSH`: Upload local files to cloud storage * `PULL`: Down",
+			},
+			"transfer_mode": schema.StringAttribute{
+				Computed:    true,
+				Description: "How files are transferred between local and cloud storage.  * `SYNC`: Synchronize directories (add n",
+			},
+			"encryption": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to encrypt files before uploading to cloud storage.",
+			},
+			"filename_encryption": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to encrypt filenames in addition to file contents.",
+			},
+			"encryption_password": schema.StringAttribute{
+				Computed:    true,
+				Description: "Password for client-side encryption. Empty string if encryption is disabled.",
+			},
+			"encryption_salt": schema.StringAttribute{
+				Computed:    true,
+				Description: "Salt value for encryption key derivation. Empty string if encryption is disabled.",
+			},
+			"create_empty_src_dirs": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to create empty directories in the destination that exist in the source.",
+			},
+			"follow_symlinks": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to follow symbolic links and sync the files they point to.",
+			},
+		},
+	}
+}
+
+func (d *CloudsyncDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *CloudsyncDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CloudsyncDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("cloudsync.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read cloudsync: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["description"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Description = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Description = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["path"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Path = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Path = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Path = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["credentials"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Credentials = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Credentials = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["attributes"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Attributes = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Attributes = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Attributes = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["schedule"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Schedule = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Schedule = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Schedule = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["pre_script"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.PreScript = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.PreScript = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.PreScript = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["post_script"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.PostScript = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.PostScript = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.PostScript = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["snapshot"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Snapshot = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["include"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Include, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["exclude"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Exclude, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["args"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Args = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Args = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Args = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["bwlimit"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				b, _ := json.Marshal(item)
+				strVals[i] = types.StringValue(string(b))
+			}
+			data.Bwlimit, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["transfers"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Transfers = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Transfers = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["direction"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Direction = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Direction = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Direction = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["transfer_mode"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.TransferMode = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.TransferMode = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.TransferMode = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["encryption"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Encryption = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["filename_encryption"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.FilenameEncryption = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["encryption_password"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.EncryptionPassword = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.EncryptionPassword = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.EncryptionPassword = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["encryption_salt"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.EncryptionSalt = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.EncryptionSalt = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.EncryptionSalt = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["create_empty_src_dirs"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.CreateEmptySrcDirs = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["follow_symlinks"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.FollowSymlinks = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &CronjobDataSource{}
+
+func NewCronjobDataSource() datasource.DataSource {
+	return &CronjobDataSource{}
+}
+
+type CronjobDataSource struct {
+	client *client.Client
+}
+
+type CronjobDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Stderr      types.Bool   `tfsdk:"stderr"`
+	Stdout      types.Bool   `tfsdk:"stdout"`
+	Schedule    types.String `tfsdk:"schedule"`
+	Command     types.String `tfsdk:"command"`
+	Description types.String `tfsdk:"description"`
+	User        types.String `tfsdk:"user"`
+}
+
+func (d *CronjobDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cronjob"
+}
+
+func (d *CronjobDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS cronjob data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the cron job is active and will be executed.",
+			},
+			"stderr": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to IGNORE standard error (if `false`, it will be added to email).",
+			},
+			"stdout": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to IGNORE standard output (if `false`, it will be added to email).",
+			},
+			"schedule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Cron schedule configuration for when the job runs.",
+			},
+			"command": schema.StringAttribute{
+				Computed:    true,
+				Description: "Shell command or script to execute.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human-readable description of what this cron job does.",
+			},
+			"user": schema.StringAttribute{
+				Computed:    true,
+				Description: "System user account to run the command as.",
+			},
+		},
+	}
+}
+
+func (d *CronjobDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *CronjobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CronjobDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("cronjob.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read cronjob: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["stderr"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Stderr = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["stdout"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Stdout = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["schedule"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Schedule = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Schedule = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Schedule = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["command"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Command = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Command = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Command = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["description"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Description = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Description = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["user"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.User = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.User = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.User = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
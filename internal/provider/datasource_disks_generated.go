@@ -23,6 +23,7 @@ type DisksDataSource struct {
 }
 
 type DisksDataSourceModel struct {
+	QueryArgsModel
 	Items types.List `tfsdk:"items"`
 }
 
@@ -159,6 +160,9 @@ func (d *DisksDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 			},
 		},
 	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (d *DisksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -175,9 +179,18 @@ func (d *DisksDataSource) Configure(ctx context.Context, req datasource.Configur
 
 func (d *DisksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data DisksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Call query method with empty filters to get all items
-	result, err := d.client.Call("disk.query", []interface{}{})
+	result, err := d.client.Call("disk.query", params)
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query disks: %s", err.Error()))
 		return
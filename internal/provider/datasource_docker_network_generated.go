@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &DockerNetworkDataSource{}
+
+func NewDockerNetworkDataSource() datasource.DataSource {
+	return &DockerNetworkDataSource{}
+}
+
+type DockerNetworkDataSource struct {
+	client *client.Client
+}
+
+type DockerNetworkDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	ShortId types.String `tfsdk:"short_id"`
+	Created types.String `tfsdk:"created"`
+	Driver  types.String `tfsdk:"driver"`
+	Scope   types.String `tfsdk:"scope"`
+	Ipam    types.String `tfsdk:"ipam"`
+	Labels  types.String `tfsdk:"labels"`
+}
+
+func (d *DockerNetworkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_docker_network"
+}
+
+func (d *DockerNetworkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS docker_network data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the network",
+			},
+			"short_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Short ID of the network",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation time of the network",
+			},
+			"driver": schema.StringAttribute{
+				Computed:    true,
+				Description: "Network driver",
+			},
+			"scope": schema.StringAttribute{
+				Computed:    true,
+				Description: "Network scope",
+			},
+			"ipam": schema.StringAttribute{
+				Computed:    true,
+				Description: "IP address management configuration",
+			},
+			"labels": schema.StringAttribute{
+				Computed:    true,
+				Description: "Labels attached to the network",
+			},
+		},
+	}
+}
+
+func (d *DockerNetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *DockerNetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DockerNetworkDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("docker.network.get_instance", data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read docker_network: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["short_id"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.ShortId = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.ShortId = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.ShortId = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["created"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Created = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Created = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Created = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["driver"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Driver = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Driver = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Driver = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["scope"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Scope = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Scope = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Scope = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["ipam"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Ipam = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Ipam = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Ipam = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["labels"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Labels = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Labels = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Labels = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &FcFcHostDataSource{}
+
+func NewFcFcHostDataSource() datasource.DataSource {
+	return &FcFcHostDataSource{}
+}
+
+type FcFcHostDataSource struct {
+	client *client.Client
+}
+
+type FcFcHostDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Alias types.String `tfsdk:"alias"`
+	Wwpn  types.String `tfsdk:"wwpn"`
+	WwpnB types.String `tfsdk:"wwpn_b"`
+	Npiv  types.Int64  `tfsdk:"npiv"`
+}
+
+func (d *FcFcHostDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fc_fc_host"
+}
+
+func (d *FcFcHostDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS fc_fc_host data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"alias": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human-readable alias for the Fibre Channel host.",
+			},
+			"wwpn": schema.StringAttribute{
+				Computed:    true,
+				Description: "World Wide Port Name for port A or `null` if not configured.",
+			},
+			"wwpn_b": schema.StringAttribute{
+				Computed:    true,
+				Description: "World Wide Port Name for port B or `null` if not configured.",
+			},
+			"npiv": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of N_Port ID Virtualization (NPIV) virtual ports to create.",
+			},
+		},
+	}
+}
+
+func (d *FcFcHostDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *FcFcHostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FcFcHostDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("fc.fc_host.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read fc_fc_host: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["alias"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Alias = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Alias = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Alias = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["wwpn"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Wwpn = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Wwpn = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Wwpn = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["wwpn_b"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.WwpnB = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.WwpnB = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.WwpnB = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["npiv"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Npiv = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Npiv = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
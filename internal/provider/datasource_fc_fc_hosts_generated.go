@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &FcFcHostsDataSource{}
+
+func NewFcFcHostsDataSource() datasource.DataSource {
+	return &FcFcHostsDataSource{}
+}
+
+type FcFcHostsDataSource struct {
+	client *client.Client
+}
+
+type FcFcHostsDataSourceModel struct {
+	QueryArgsModel
+	Items types.List `tfsdk:"items"`
+}
+
+type FcFcHostsItemModel struct {
+	ID    types.String `tfsdk:"id"`
+	Alias types.String `tfsdk:"alias"`
+	Wwpn  types.String `tfsdk:"wwpn"`
+	WwpnB types.String `tfsdk:"wwpn_b"`
+	Npiv  types.Int64  `tfsdk:"npiv"`
+}
+
+func (d *FcFcHostsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fc_fc_hosts"
+}
+
+func (d *FcFcHostsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query fc_fc_hosts",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of fc_fc_hosts resources",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+						"alias": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human-readable alias for the Fibre Channel host.",
+						},
+						"wwpn": schema.StringAttribute{
+							Computed:    true,
+							Description: "World Wide Port Name for port A or `null` if not configured.",
+						},
+						"wwpn_b": schema.StringAttribute{
+							Computed:    true,
+							Description: "World Wide Port Name for port B or `null` if not configured.",
+						},
+						"npiv": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of N_Port ID Virtualization (NPIV) virtual ports to create.",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (d *FcFcHostsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *FcFcHostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FcFcHostsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("fc.fc_host.query", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query fc_fc_hosts: %s", err.Error()))
+		return
+	}
+
+	resultList, ok := result.([]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response as list")
+		return
+	}
+
+	// Convert results to items
+	items := make([]FcFcHostsItemModel, 0, len(resultList))
+	for _, item := range resultList {
+		resultMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemModel := FcFcHostsItemModel{}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			itemModel.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["alias"]; ok && v != nil {
+			itemModel.Alias = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["wwpn"]; ok && v != nil {
+			itemModel.Wwpn = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["wwpn_b"]; ok && v != nil {
+			itemModel.WwpnB = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["npiv"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.Npiv = types.Int64Value(int64(fv))
+			}
+		}
+		items = append(items, itemModel)
+	}
+
+	// Convert to types.List
+	itemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"alias":  types.StringType,
+			"id":     types.StringType,
+			"npiv":   types.Int64Type,
+			"wwpn":   types.StringType,
+			"wwpn_b": types.StringType,
+		},
+	}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = itemsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
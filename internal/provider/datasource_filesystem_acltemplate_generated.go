@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &FilesystemAcltemplateDataSource{}
+
+func NewFilesystemAcltemplateDataSource() datasource.DataSource {
+	return &FilesystemAcltemplateDataSource{}
+}
+
+type FilesystemAcltemplateDataSource struct {
+	client *client.Client
+}
+
+type FilesystemAcltemplateDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Acltype types.String `tfsdk:"acltype"`
+	Acl     types.List   `tfsdk:"acl"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func (d *FilesystemAcltemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_filesystem_acltemplate"
+}
+
+func (d *FilesystemAcltemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS filesystem_acltemplate data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human-readable name for the ACL template.",
+			},
+			"acltype": schema.StringAttribute{
+				Computed:    true,
+				Description: "ACL type this template provides.",
+			},
+			"acl": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of Access Control Entries defined by this template.",
+			},
+			"comment": schema.StringAttribute{
+				Computed:    true,
+				Description: "Optional descriptive comment about the template's purpose.",
+			},
+		},
+	}
+}
+
+func (d *FilesystemAcltemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *FilesystemAcltemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FilesystemAcltemplateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("filesystem.acltemplate.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read filesystem_acltemplate: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["acltype"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Acltype = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Acltype = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Acltype = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["acl"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Acl, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["comment"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Comment = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
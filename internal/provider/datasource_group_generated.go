@@ -3,10 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 
@@ -48,12 +50,20 @@ func (d *GroupDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 	resp.Schema = schema.Schema{
 		Description: "Returns instance matching `id`. If `id` is not found, Validation error is raised.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Resource ID. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
+			},
 			"gid": schema.Int64Attribute{
 				Computed:    true,
 				Description: "A non-negative integer used to identify a group. TrueNAS uses this value for permission checks and m",
 			},
 			"name": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "A string used to identify a group.",
 			},
@@ -128,7 +138,13 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	result, err := d.client.Call("group.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	var result interface{}
+	var err error
+	if !data.Name.IsNull() {
+		result, err = queryOne(d.client, "group.query", "name", data.Name.ValueString())
+	} else {
+		result, err = d.client.Call("group.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read group: %s", err.Error()))
 		return
@@ -140,6 +156,9 @@ func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	if v, ok := resultMap["id"]; ok && v != nil {
+		data.ID = types.StringValue(fmt.Sprintf("%v", v))
+	}
 	if v, ok := resultMap["gid"]; ok && v != nil {
 		switch val := v.(type) {
 		case float64:
@@ -23,6 +23,7 @@ type GroupsDataSource struct {
 }
 
 type GroupsDataSourceModel struct {
+	QueryArgsModel
 	Items types.List `tfsdk:"items"`
 }
 
@@ -94,6 +95,9 @@ func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			},
 		},
 	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (d *GroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -110,9 +114,18 @@ func (d *GroupsDataSource) Configure(ctx context.Context, req datasource.Configu
 
 func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data GroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Call query method with empty filters to get all items
-	result, err := d.client.Call("group.query", []interface{}{})
+	result, err := d.client.Call("group.query", params)
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query groups: %s", err.Error()))
 		return
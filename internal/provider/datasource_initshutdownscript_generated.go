@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &InitshutdownscriptDataSource{}
+
+func NewInitshutdownscriptDataSource() datasource.DataSource {
+	return &InitshutdownscriptDataSource{}
+}
+
+type InitshutdownscriptDataSource struct {
+	client *client.Client
+}
+
+type InitshutdownscriptDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Type    types.String `tfsdk:"type"`
+	Command types.String `tfsdk:"command"`
+	Script  types.String `tfsdk:"script"`
+	When    types.String `tfsdk:"when"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func (d *InitshutdownscriptDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_initshutdownscript"
+}
+
+func (d *InitshutdownscriptDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS initshutdownscript data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of init/shutdown script to execute.  * `COMMAND`: Execute a single command * `SCRIPT`: Execute ",
+			},
+			"command": schema.StringAttribute{
+				Computed:    true,
+				Description: "Must be given if `type=\"COMMAND\"`.",
+			},
+			"script": schema.StringAttribute{
+				Computed:    true,
+				Description: "Must be given if `type=\"SCRIPT\"`.",
+			},
+			"when": schema.StringAttribute{
+				Computed:    true,
+				Description: "* \"PREINIT\": Early in the boot process before all services have started. * \"POSTINIT\": Late in the b",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the init/shutdown script is enabled to execute.",
+			},
+			"timeout": schema.Int64Attribute{
+				Computed:    true,
+				Description: "An integer time in seconds that the system should wait for the execution of the script/command.  A h",
+			},
+			"comment": schema.StringAttribute{
+				Computed:    true,
+				Description: "Optional comment describing the purpose of this script.",
+			},
+		},
+	}
+}
+
+func (d *InitshutdownscriptDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *InitshutdownscriptDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InitshutdownscriptDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("initshutdownscript.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read initshutdownscript: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["type"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Type = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Type = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Type = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["command"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Command = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Command = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Command = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["script"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Script = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Script = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Script = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["when"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.When = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.When = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.When = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["timeout"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Timeout = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Timeout = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["comment"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Comment = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"encoding/json"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -198,7 +199,8 @@ func (d *InterfaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		if arr, ok := v.([]interface{}); ok {
 			strVals := make([]attr.Value, len(arr))
 			for i, item := range arr {
-				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				b, _ := json.Marshal(item)
+				strVals[i] = types.StringValue(string(b))
 			}
 			data.Aliases, _ = types.ListValue(types.StringType, strVals)
 		}
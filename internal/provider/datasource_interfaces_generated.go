@@ -23,6 +23,7 @@ type InterfacesDataSource struct {
 }
 
 type InterfacesDataSourceModel struct {
+	QueryArgsModel
 	Items types.List `tfsdk:"items"`
 }
 
@@ -114,6 +115,9 @@ func (d *InterfacesDataSource) Schema(ctx context.Context, req datasource.Schema
 			},
 		},
 	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (d *InterfacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -130,9 +134,18 @@ func (d *InterfacesDataSource) Configure(ctx context.Context, req datasource.Con
 
 func (d *InterfacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data InterfacesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Call query method with empty filters to get all items
-	result, err := d.client.Call("interface.query", []interface{}{})
+	result, err := d.client.Call("interface.query", params)
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query interfaces: %s", err.Error()))
 		return
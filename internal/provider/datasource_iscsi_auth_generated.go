@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &IscsiAuthDataSource{}
+
+func NewIscsiAuthDataSource() datasource.DataSource {
+	return &IscsiAuthDataSource{}
+}
+
+type IscsiAuthDataSource struct {
+	client *client.Client
+}
+
+type IscsiAuthDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Tag           types.Int64  `tfsdk:"tag"`
+	User          types.String `tfsdk:"user"`
+	Secret        types.String `tfsdk:"secret"`
+	Peeruser      types.String `tfsdk:"peeruser"`
+	Peersecret    types.String `tfsdk:"peersecret"`
+	DiscoveryAuth types.String `tfsdk:"discovery_auth"`
+}
+
+func (d *IscsiAuthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iscsi_auth"
+}
+
+func (d *IscsiAuthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS iscsi_auth data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"tag": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Numeric tag used to associate this credential with iSCSI targets.",
+			},
+			"user": schema.StringAttribute{
+				Computed:    true,
+				Description: "Username for iSCSI CHAP authentication.",
+			},
+			"secret": schema.StringAttribute{
+				Computed:    true,
+				Description: "Password/secret for iSCSI CHAP authentication.",
+			},
+			"peeruser": schema.StringAttribute{
+				Computed:    true,
+				Description: "Username for mutual CHAP authentication or empty string if not configured.",
+			},
+			"peersecret": schema.StringAttribute{
+				Computed:    true,
+				Description: "Password/secret for mutual CHAP authentication or empty string if not configured.",
+			},
+			"discovery_auth": schema.StringAttribute{
+				Computed:    true,
+				Description: "Authentication method for target discovery. If \"CHAP_MUTUAL\" is selected for target discovery, it is",
+			},
+		},
+	}
+}
+
+func (d *IscsiAuthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *IscsiAuthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IscsiAuthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("iscsi.auth.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read iscsi_auth: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["tag"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Tag = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Tag = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["user"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.User = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.User = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.User = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["secret"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Secret = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Secret = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Secret = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["peeruser"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Peeruser = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Peeruser = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Peeruser = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["peersecret"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Peersecret = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Peersecret = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Peersecret = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["discovery_auth"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.DiscoveryAuth = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.DiscoveryAuth = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.DiscoveryAuth = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
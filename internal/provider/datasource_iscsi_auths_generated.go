@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &IscsiAuthsDataSource{}
+
+func NewIscsiAuthsDataSource() datasource.DataSource {
+	return &IscsiAuthsDataSource{}
+}
+
+type IscsiAuthsDataSource struct {
+	client *client.Client
+}
+
+type IscsiAuthsDataSourceModel struct {
+	QueryArgsModel
+	Items types.List `tfsdk:"items"`
+}
+
+type IscsiAuthsItemModel struct {
+	ID            types.String `tfsdk:"id"`
+	Tag           types.Int64  `tfsdk:"tag"`
+	User          types.String `tfsdk:"user"`
+	Secret        types.String `tfsdk:"secret"`
+	Peeruser      types.String `tfsdk:"peeruser"`
+	Peersecret    types.String `tfsdk:"peersecret"`
+	DiscoveryAuth types.String `tfsdk:"discovery_auth"`
+}
+
+func (d *IscsiAuthsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iscsi_auths"
+}
+
+func (d *IscsiAuthsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query iscsi_auths",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of iscsi_auths resources",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+						"tag": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Numeric tag used to associate this credential with iSCSI targets.",
+						},
+						"user": schema.StringAttribute{
+							Computed:    true,
+							Description: "Username for iSCSI CHAP authentication.",
+						},
+						"secret": schema.StringAttribute{
+							Computed:    true,
+							Description: "Password/secret for iSCSI CHAP authentication.",
+						},
+						"peeruser": schema.StringAttribute{
+							Computed:    true,
+							Description: "Username for mutual CHAP authentication or empty string if not configured.",
+						},
+						"peersecret": schema.StringAttribute{
+							Computed:    true,
+							Description: "Password/secret for mutual CHAP authentication or empty string if not configured.",
+						},
+						"discovery_auth": schema.StringAttribute{
+							Computed:    true,
+							Description: "Authentication method for target discovery. If \"CHAP_MUTUAL\" is selected for target discovery, it is",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (d *IscsiAuthsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *IscsiAuthsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IscsiAuthsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("iscsi.auth.query", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query iscsi_auths: %s", err.Error()))
+		return
+	}
+
+	resultList, ok := result.([]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response as list")
+		return
+	}
+
+	// Convert results to items
+	items := make([]IscsiAuthsItemModel, 0, len(resultList))
+	for _, item := range resultList {
+		resultMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemModel := IscsiAuthsItemModel{}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			itemModel.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["tag"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.Tag = types.Int64Value(int64(fv))
+			}
+		}
+		if v, ok := resultMap["user"]; ok && v != nil {
+			itemModel.User = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["secret"]; ok && v != nil {
+			itemModel.Secret = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["peeruser"]; ok && v != nil {
+			itemModel.Peeruser = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["peersecret"]; ok && v != nil {
+			itemModel.Peersecret = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["discovery_auth"]; ok && v != nil {
+			itemModel.DiscoveryAuth = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		items = append(items, itemModel)
+	}
+
+	// Convert to types.List
+	itemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"discovery_auth": types.StringType,
+			"id":             types.StringType,
+			"peersecret":     types.StringType,
+			"peeruser":       types.StringType,
+			"secret":         types.StringType,
+			"tag":            types.Int64Type,
+			"user":           types.StringType,
+		},
+	}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = itemsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
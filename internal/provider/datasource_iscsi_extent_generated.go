@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &IscsiExtentDataSource{}
+
+func NewIscsiExtentDataSource() datasource.DataSource {
+	return &IscsiExtentDataSource{}
+}
+
+type IscsiExtentDataSource struct {
+	client *client.Client
+}
+
+type IscsiExtentDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Disk           types.String `tfsdk:"disk"`
+	Serial         types.String `tfsdk:"serial"`
+	Path           types.String `tfsdk:"path"`
+	Filesize       types.Int64  `tfsdk:"filesize"`
+	Blocksize      types.Int64  `tfsdk:"blocksize"`
+	Pblocksize     types.Bool   `tfsdk:"pblocksize"`
+	AvailThreshold types.Int64  `tfsdk:"avail_threshold"`
+	Comment        types.String `tfsdk:"comment"`
+	InsecureTpc    types.Bool   `tfsdk:"insecure_tpc"`
+	Xen            types.Bool   `tfsdk:"xen"`
+	Rpm            types.String `tfsdk:"rpm"`
+	Ro             types.Bool   `tfsdk:"ro"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	ProductId      types.String `tfsdk:"product_id"`
+}
+
+func (d *IscsiExtentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iscsi_extent"
+}
+
+func (d *IscsiExtentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS iscsi_extent data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the iSCSI extent.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the extent storage backend.",
+			},
+			"disk": schema.StringAttribute{
+				Computed:    true,
+				Description: "Disk device to use for the extent or `null` if using a file.",
+			},
+			"serial": schema.StringAttribute{
+				Computed:    true,
+				Description: "Serial number for the extent or `null` to auto-generate.",
+			},
+			"path": schema.StringAttribute{
+				Computed:    true,
+				Description: "File path for file-based extents or `null` if using a disk.",
+			},
+			"filesize": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Size of the file-based extent in bytes.",
+			},
+			"blocksize": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Block size for the extent in bytes.",
+			},
+			"pblocksize": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to use physical block size reporting.",
+			},
+			"avail_threshold": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Available space threshold percentage or `null` to disable.",
+			},
+			"comment": schema.StringAttribute{
+				Computed:    true,
+				Description: "Optional comment describing the extent.",
+			},
+			"insecure_tpc": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to enable insecure Third Party Copy (TPC) operations.",
+			},
+			"xen": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to enable Xen compatibility mode.",
+			},
+			"rpm": schema.StringAttribute{
+				Computed:    true,
+				Description: "Reported RPM type for the extent.",
+			},
+			"ro": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the extent is read-only.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the extent is enabled and available for use.",
+			},
+			"product_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Product ID string for the extent or `null` for default.",
+			},
+		},
+	}
+}
+
+func (d *IscsiExtentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *IscsiExtentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IscsiExtentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("iscsi.extent.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read iscsi_extent: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["type"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Type = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Type = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Type = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["disk"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Disk = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Disk = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Disk = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["serial"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Serial = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Serial = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Serial = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["path"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Path = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Path = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Path = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["filesize"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Filesize = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Filesize = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["blocksize"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Blocksize = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Blocksize = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["pblocksize"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Pblocksize = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["avail_threshold"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.AvailThreshold = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.AvailThreshold = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["comment"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Comment = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["insecure_tpc"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.InsecureTpc = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["xen"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Xen = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["rpm"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Rpm = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Rpm = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Rpm = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["ro"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Ro = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["product_id"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.ProductId = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.ProductId = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.ProductId = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
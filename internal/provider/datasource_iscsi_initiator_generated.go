@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &IscsiInitiatorDataSource{}
+
+func NewIscsiInitiatorDataSource() datasource.DataSource {
+	return &IscsiInitiatorDataSource{}
+}
+
+type IscsiInitiatorDataSource struct {
+	client *client.Client
+}
+
+type IscsiInitiatorDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Initiators types.List   `tfsdk:"initiators"`
+	Comment    types.String `tfsdk:"comment"`
+}
+
+func (d *IscsiInitiatorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iscsi_initiator"
+}
+
+func (d *IscsiInitiatorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS iscsi_initiator data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"initiators": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of iSCSI Qualified Names (IQNs) or IP addresses of authorized initiators.",
+			},
+			"comment": schema.StringAttribute{
+				Computed:    true,
+				Description: "Optional comment describing the authorized initiator group.",
+			},
+		},
+	}
+}
+
+func (d *IscsiInitiatorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *IscsiInitiatorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IscsiInitiatorDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("iscsi.initiator.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read iscsi_initiator: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["initiators"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Initiators, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["comment"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Comment = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &IscsiPortalDataSource{}
+
+func NewIscsiPortalDataSource() datasource.DataSource {
+	return &IscsiPortalDataSource{}
+}
+
+type IscsiPortalDataSource struct {
+	client *client.Client
+}
+
+type IscsiPortalDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Listen  types.List   `tfsdk:"listen"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func (d *IscsiPortalDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iscsi_portal"
+}
+
+func (d *IscsiPortalDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS iscsi_portal data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"listen": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of IP addresses for the portal to listen on.",
+			},
+			"comment": schema.StringAttribute{
+				Computed:    true,
+				Description: "Optional comment describing the portal.",
+			},
+		},
+	}
+}
+
+func (d *IscsiPortalDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *IscsiPortalDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IscsiPortalDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("iscsi.portal.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read iscsi_portal: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["listen"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				b, _ := json.Marshal(item)
+				strVals[i] = types.StringValue(string(b))
+			}
+			data.Listen, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["comment"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Comment = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &IscsiTargetDataSource{}
+
+func NewIscsiTargetDataSource() datasource.DataSource {
+	return &IscsiTargetDataSource{}
+}
+
+type IscsiTargetDataSource struct {
+	client *client.Client
+}
+
+type IscsiTargetDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Alias           types.String `tfsdk:"alias"`
+	Mode            types.String `tfsdk:"mode"`
+	Groups          types.List   `tfsdk:"groups"`
+	AuthNetworks    types.List   `tfsdk:"auth_networks"`
+	IscsiParameters types.String `tfsdk:"iscsi_parameters"`
+}
+
+func (d *IscsiTargetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iscsi_target"
+}
+
+func (d *IscsiTargetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS iscsi_target data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the iSCSI target (maximum 120 characters).",
+			},
+			"alias": schema.StringAttribute{
+				Computed:    true,
+				Description: "Optional alias name for the iSCSI target.",
+			},
+			"mode": schema.StringAttribute{
+				Computed:    true,
+				Description: "Protocol mode for the target.  * `ISCSI`: iSCSI protocol only * `FC`: Fibre Channel protocol only * ",
+			},
+			"groups": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of portal-initiator group associations for this target.",
+			},
+			"auth_networks": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of network addresses allowed to access this target.",
+			},
+			"iscsi_parameters": schema.StringAttribute{
+				Computed:    true,
+				Description: "Optional iSCSI-specific parameters for this target.",
+			},
+		},
+	}
+}
+
+func (d *IscsiTargetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *IscsiTargetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IscsiTargetDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("iscsi.target.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read iscsi_target: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["alias"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Alias = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Alias = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Alias = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["mode"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Mode = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Mode = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Mode = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["groups"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				b, _ := json.Marshal(item)
+				strVals[i] = types.StringValue(string(b))
+			}
+			data.Groups, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["auth_networks"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.AuthNetworks, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["iscsi_parameters"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.IscsiParameters = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.IscsiParameters = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.IscsiParameters = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
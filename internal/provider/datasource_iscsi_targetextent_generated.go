@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &IscsiTargetextentDataSource{}
+
+func NewIscsiTargetextentDataSource() datasource.DataSource {
+	return &IscsiTargetextentDataSource{}
+}
+
+type IscsiTargetextentDataSource struct {
+	client *client.Client
+}
+
+type IscsiTargetextentDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Target types.Int64  `tfsdk:"target"`
+	Lunid  types.Int64  `tfsdk:"lunid"`
+	Extent types.Int64  `tfsdk:"extent"`
+}
+
+func (d *IscsiTargetextentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iscsi_targetextent"
+}
+
+func (d *IscsiTargetextentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS iscsi_targetextent data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"target": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the iSCSI target to associate with the extent.",
+			},
+			"lunid": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Logical Unit Number (LUN) ID for presenting the extent to the target.",
+			},
+			"extent": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the iSCSI extent to associate with the target.",
+			},
+		},
+	}
+}
+
+func (d *IscsiTargetextentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *IscsiTargetextentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IscsiTargetextentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("iscsi.targetextent.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read iscsi_targetextent: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["target"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Target = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Target = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["lunid"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Lunid = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Lunid = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["extent"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Extent = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Extent = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
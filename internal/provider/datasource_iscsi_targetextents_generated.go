@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &IscsiTargetextentsDataSource{}
+
+func NewIscsiTargetextentsDataSource() datasource.DataSource {
+	return &IscsiTargetextentsDataSource{}
+}
+
+type IscsiTargetextentsDataSource struct {
+	client *client.Client
+}
+
+type IscsiTargetextentsDataSourceModel struct {
+	QueryArgsModel
+	Items types.List `tfsdk:"items"`
+}
+
+type IscsiTargetextentsItemModel struct {
+	ID     types.String `tfsdk:"id"`
+	Target types.Int64  `tfsdk:"target"`
+	Lunid  types.Int64  `tfsdk:"lunid"`
+	Extent types.Int64  `tfsdk:"extent"`
+}
+
+func (d *IscsiTargetextentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iscsi_targetextents"
+}
+
+func (d *IscsiTargetextentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query iscsi_targetextents",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of iscsi_targetextents resources",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+						"target": schema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the iSCSI target to associate with the extent.",
+						},
+						"lunid": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Logical Unit Number (LUN) ID for presenting the extent to the target.",
+						},
+						"extent": schema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the iSCSI extent to associate with the target.",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (d *IscsiTargetextentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *IscsiTargetextentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IscsiTargetextentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("iscsi.targetextent.query", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query iscsi_targetextents: %s", err.Error()))
+		return
+	}
+
+	resultList, ok := result.([]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response as list")
+		return
+	}
+
+	// Convert results to items
+	items := make([]IscsiTargetextentsItemModel, 0, len(resultList))
+	for _, item := range resultList {
+		resultMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemModel := IscsiTargetextentsItemModel{}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			itemModel.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["target"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.Target = types.Int64Value(int64(fv))
+			}
+		}
+		if v, ok := resultMap["lunid"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.Lunid = types.Int64Value(int64(fv))
+			}
+		}
+		if v, ok := resultMap["extent"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.Extent = types.Int64Value(int64(fv))
+			}
+		}
+		items = append(items, itemModel)
+	}
+
+	// Convert to types.List
+	itemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"extent": types.Int64Type,
+			"id":     types.StringType,
+			"lunid":  types.Int64Type,
+			"target": types.Int64Type,
+		},
+	}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = itemsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
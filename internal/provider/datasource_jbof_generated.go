@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &JbofDataSource{}
+
+func NewJbofDataSource() datasource.DataSource {
+	return &JbofDataSource{}
+}
+
+type JbofDataSource struct {
+	client *client.Client
+}
+
+type JbofDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Description  types.String `tfsdk:"description"`
+	MgmtIp1      types.String `tfsdk:"mgmt_ip1"`
+	MgmtIp2      types.String `tfsdk:"mgmt_ip2"`
+	MgmtUsername types.String `tfsdk:"mgmt_username"`
+	MgmtPassword types.String `tfsdk:"mgmt_password"`
+}
+
+func (d *JbofDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jbof"
+}
+
+func (d *JbofDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS jbof data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Optional description of the JBOF.",
+			},
+			"mgmt_ip1": schema.StringAttribute{
+				Computed:    true,
+				Description: "IP of first Redfish management interface.",
+			},
+			"mgmt_ip2": schema.StringAttribute{
+				Computed:    true,
+				Description: "Optional IP of second Redfish management interface.",
+			},
+			"mgmt_username": schema.StringAttribute{
+				Computed:    true,
+				Description: "Redfish administrative username.",
+			},
+			"mgmt_password": schema.StringAttribute{
+				Computed:    true,
+				Description: "Redfish administrative password.",
+			},
+		},
+	}
+}
+
+func (d *JbofDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *JbofDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JbofDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("jbof.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read jbof: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["description"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Description = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Description = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["mgmt_ip1"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.MgmtIp1 = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.MgmtIp1 = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.MgmtIp1 = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["mgmt_ip2"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.MgmtIp2 = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.MgmtIp2 = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.MgmtIp2 = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["mgmt_username"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.MgmtUsername = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.MgmtUsername = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.MgmtUsername = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["mgmt_password"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.MgmtPassword = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.MgmtPassword = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.MgmtPassword = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &KerberosRealmDataSource{}
+
+func NewKerberosRealmDataSource() datasource.DataSource {
+	return &KerberosRealmDataSource{}
+}
+
+type KerberosRealmDataSource struct {
+	client *client.Client
+}
+
+type KerberosRealmDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Realm         types.String `tfsdk:"realm"`
+	PrimaryKdc    types.String `tfsdk:"primary_kdc"`
+	Kdc           types.List   `tfsdk:"kdc"`
+	AdminServer   types.List   `tfsdk:"admin_server"`
+	KpasswdServer types.List   `tfsdk:"kpasswd_server"`
+}
+
+func (d *KerberosRealmDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kerberos_realm"
+}
+
+func (d *KerberosRealmDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS kerberos_realm data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"realm": schema.StringAttribute{
+				Computed:    true,
+				Description: "Kerberos realm name. This is external to TrueNAS and is case-sensitive.     The general convention f",
+			},
+			"primary_kdc": schema.StringAttribute{
+				Computed:    true,
+				Description: "The master Kerberos domain controller for this realm. TrueNAS uses this as a fallback if it cannot g",
+			},
+			"kdc": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of kerberos domain controllers. If the list is empty then the kerberos     libraries will use D",
+			},
+			"admin_server": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of kerberos admin servers. If the list is empty then the kerberos     libraries will use DNS to",
+			},
+			"kpasswd_server": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of kerberos kpasswd servers. If the list is empty then DNS will be used     to look them up if ",
+			},
+		},
+	}
+}
+
+func (d *KerberosRealmDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *KerberosRealmDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KerberosRealmDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("kerberos.realm.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read kerberos_realm: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["realm"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Realm = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Realm = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Realm = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["primary_kdc"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.PrimaryKdc = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.PrimaryKdc = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.PrimaryKdc = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["kdc"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Kdc, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["admin_server"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.AdminServer, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["kpasswd_server"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.KpasswdServer, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
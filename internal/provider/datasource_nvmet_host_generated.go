@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &NvmetHostDataSource{}
+
+func NewNvmetHostDataSource() datasource.DataSource {
+	return &NvmetHostDataSource{}
+}
+
+type NvmetHostDataSource struct {
+	client *client.Client
+}
+
+type NvmetHostDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Hostnqn       types.String `tfsdk:"hostnqn"`
+	DhchapKey     types.String `tfsdk:"dhchap_key"`
+	DhchapCtrlKey types.String `tfsdk:"dhchap_ctrl_key"`
+	DhchapDhgroup types.String `tfsdk:"dhchap_dhgroup"`
+	DhchapHash    types.String `tfsdk:"dhchap_hash"`
+}
+
+func (d *NvmetHostDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nvmet_host"
+}
+
+func (d *NvmetHostDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS nvmet_host data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"hostnqn": schema.StringAttribute{
+				Computed:    true,
+				Description: "NQN of the host that will connect to this TrueNAS. ",
+			},
+			"dhchap_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "If set, the secret that the host must present when connecting.  A suitable secret can be generated u",
+			},
+			"dhchap_ctrl_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "If set, the secret that this TrueNAS will present to the host when the host is connecting (Bi-Direct",
+			},
+			"dhchap_dhgroup": schema.StringAttribute{
+				Computed:    true,
+				Description: "If selected, the DH (Diffie-Hellman) key exchange built on top of CHAP to be used for authentication",
+			},
+			"dhchap_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "HMAC (Hashed Message Authentication Code) to be used in conjunction if a `dhchap_dhgroup` is selecte",
+			},
+		},
+	}
+}
+
+func (d *NvmetHostDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *NvmetHostDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NvmetHostDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("nvmet.host.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read nvmet_host: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["hostnqn"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Hostnqn = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Hostnqn = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Hostnqn = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["dhchap_key"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.DhchapKey = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.DhchapKey = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.DhchapKey = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["dhchap_ctrl_key"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.DhchapCtrlKey = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.DhchapCtrlKey = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.DhchapCtrlKey = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["dhchap_dhgroup"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.DhchapDhgroup = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.DhchapDhgroup = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.DhchapDhgroup = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["dhchap_hash"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.DhchapHash = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.DhchapHash = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.DhchapHash = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &NvmetHostSubsysDataSource{}
+
+func NewNvmetHostSubsysDataSource() datasource.DataSource {
+	return &NvmetHostSubsysDataSource{}
+}
+
+type NvmetHostSubsysDataSource struct {
+	client *client.Client
+}
+
+type NvmetHostSubsysDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	HostId   types.Int64  `tfsdk:"host_id"`
+	SubsysId types.Int64  `tfsdk:"subsys_id"`
+}
+
+func (d *NvmetHostSubsysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nvmet_host_subsys"
+}
+
+func (d *NvmetHostSubsysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS nvmet_host_subsys data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"host_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the NVMe-oF host to authorize.",
+			},
+			"subsys_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the NVMe-oF subsystem to grant access to.",
+			},
+		},
+	}
+}
+
+func (d *NvmetHostSubsysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *NvmetHostSubsysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NvmetHostSubsysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("nvmet.host_subsys.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read nvmet_host_subsys: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["host_id"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.HostId = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.HostId = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["subsys_id"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.SubsysId = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.SubsysId = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
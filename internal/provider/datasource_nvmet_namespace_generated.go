@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &NvmetNamespaceDataSource{}
+
+func NewNvmetNamespaceDataSource() datasource.DataSource {
+	return &NvmetNamespaceDataSource{}
+}
+
+type NvmetNamespaceDataSource struct {
+	client *client.Client
+}
+
+type NvmetNamespaceDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Nsid       types.Int64  `tfsdk:"nsid"`
+	DeviceType types.String `tfsdk:"device_type"`
+	DevicePath types.String `tfsdk:"device_path"`
+	Filesize   types.Int64  `tfsdk:"filesize"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	SubsysId   types.Int64  `tfsdk:"subsys_id"`
+}
+
+func (d *NvmetNamespaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nvmet_namespace"
+}
+
+func (d *NvmetNamespaceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS nvmet_namespace data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"nsid": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Namespace ID (NSID).  Each namespace within a subsystem has an associated NSID, unique within that s",
+			},
+			"device_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of device (or file) used to implement the namespace. ",
+			},
+			"device_path": schema.StringAttribute{
+				Computed:    true,
+				Description: "Normalized path to the device or file for the namespace.",
+			},
+			"filesize": schema.Int64Attribute{
+				Computed:    true,
+				Description: "When `device_type` is \"FILE\" then this will be the size of the file in bytes.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "If `enabled` is `False` then the namespace will not be accessible.  Some namespace configuration cha",
+			},
+			"subsys_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the NVMe-oF subsystem to contain this namespace.",
+			},
+		},
+	}
+}
+
+func (d *NvmetNamespaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *NvmetNamespaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NvmetNamespaceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("nvmet.namespace.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read nvmet_namespace: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["nsid"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Nsid = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Nsid = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["device_type"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.DeviceType = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.DeviceType = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.DeviceType = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["device_path"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.DevicePath = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.DevicePath = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.DevicePath = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["filesize"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Filesize = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Filesize = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["subsys_id"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.SubsysId = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.SubsysId = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
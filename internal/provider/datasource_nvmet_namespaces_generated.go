@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &NvmetNamespacesDataSource{}
+
+func NewNvmetNamespacesDataSource() datasource.DataSource {
+	return &NvmetNamespacesDataSource{}
+}
+
+type NvmetNamespacesDataSource struct {
+	client *client.Client
+}
+
+type NvmetNamespacesDataSourceModel struct {
+	QueryArgsModel
+	Items types.List `tfsdk:"items"`
+}
+
+type NvmetNamespacesItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	Nsid       types.Int64  `tfsdk:"nsid"`
+	DeviceType types.String `tfsdk:"device_type"`
+	DevicePath types.String `tfsdk:"device_path"`
+	Filesize   types.Int64  `tfsdk:"filesize"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	SubsysId   types.Int64  `tfsdk:"subsys_id"`
+}
+
+func (d *NvmetNamespacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nvmet_namespaces"
+}
+
+func (d *NvmetNamespacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query nvmet_namespaces",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of nvmet_namespaces resources",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+						"nsid": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Namespace ID (NSID).  Each namespace within a subsystem has an associated NSID, unique within that s",
+						},
+						"device_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of device (or file) used to implement the namespace. ",
+						},
+						"device_path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Normalized path to the device or file for the namespace.",
+						},
+						"filesize": schema.Int64Attribute{
+							Computed:    true,
+							Description: "When `device_type` is \"FILE\" then this will be the size of the file in bytes.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "If `enabled` is `False` then the namespace will not be accessible.  Some namespace configuration cha",
+						},
+						"subsys_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the NVMe-oF subsystem to contain this namespace.",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (d *NvmetNamespacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *NvmetNamespacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NvmetNamespacesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("nvmet.namespace.query", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query nvmet_namespaces: %s", err.Error()))
+		return
+	}
+
+	resultList, ok := result.([]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response as list")
+		return
+	}
+
+	// Convert results to items
+	items := make([]NvmetNamespacesItemModel, 0, len(resultList))
+	for _, item := range resultList {
+		resultMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemModel := NvmetNamespacesItemModel{}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			itemModel.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["nsid"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.Nsid = types.Int64Value(int64(fv))
+			}
+		}
+		if v, ok := resultMap["device_type"]; ok && v != nil {
+			itemModel.DeviceType = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["device_path"]; ok && v != nil {
+			itemModel.DevicePath = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["filesize"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.Filesize = types.Int64Value(int64(fv))
+			}
+		}
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.Enabled = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["subsys_id"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.SubsysId = types.Int64Value(int64(fv))
+			}
+		}
+		items = append(items, itemModel)
+	}
+
+	// Convert to types.List
+	itemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"device_path": types.StringType,
+			"device_type": types.StringType,
+			"enabled":     types.BoolType,
+			"filesize":    types.Int64Type,
+			"id":          types.StringType,
+			"nsid":        types.Int64Type,
+			"subsys_id":   types.Int64Type,
+		},
+	}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = itemsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &NvmetPortDataSource{}
+
+func NewNvmetPortDataSource() datasource.DataSource {
+	return &NvmetPortDataSource{}
+}
+
+type NvmetPortDataSource struct {
+	client *client.Client
+}
+
+type NvmetPortDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Index          types.Int64  `tfsdk:"index"`
+	AddrTrtype     types.String `tfsdk:"addr_trtype"`
+	AddrAdrfam     types.String `tfsdk:"addr_adrfam"`
+	AddrTraddr     types.String `tfsdk:"addr_traddr"`
+	AddrTrsvcid    types.String `tfsdk:"addr_trsvcid"`
+	InlineDataSize types.Int64  `tfsdk:"inline_data_size"`
+	MaxQueueSize   types.Int64  `tfsdk:"max_queue_size"`
+	PiEnable       types.Bool   `tfsdk:"pi_enable"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *NvmetPortDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nvmet_port"
+}
+
+func (d *NvmetPortDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS nvmet_port data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"index": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Index of the port",
+			},
+			"addr_trtype": schema.StringAttribute{
+				Computed:    true,
+				Description: "Transport type: TCP, RDMA or FC",
+			},
+			"addr_adrfam": schema.StringAttribute{
+				Computed:    true,
+				Description: "Address family",
+			},
+			"addr_traddr": schema.StringAttribute{
+				Computed:    true,
+				Description: "Transport address",
+			},
+			"addr_trsvcid": schema.StringAttribute{
+				Computed:    true,
+				Description: "Transport service ID (port number for TCP and RDMA)",
+			},
+			"inline_data_size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Maximum size of inline data",
+			},
+			"max_queue_size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Maximum queue size",
+			},
+			"pi_enable": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Enable protection information",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Port is enabled",
+			},
+		},
+	}
+}
+
+func (d *NvmetPortDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *NvmetPortDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NvmetPortDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("nvmet.port.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read nvmet_port: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["index"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Index = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Index = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["addr_trtype"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.AddrTrtype = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.AddrTrtype = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.AddrTrtype = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["addr_adrfam"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.AddrAdrfam = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.AddrAdrfam = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.AddrAdrfam = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["addr_traddr"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.AddrTraddr = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.AddrTraddr = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.AddrTraddr = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["addr_trsvcid"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.AddrTrsvcid = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.AddrTrsvcid = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.AddrTrsvcid = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["inline_data_size"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.InlineDataSize = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.InlineDataSize = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["max_queue_size"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.MaxQueueSize = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.MaxQueueSize = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["pi_enable"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.PiEnable = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
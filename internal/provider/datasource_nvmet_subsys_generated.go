@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &NvmetSubsysDataSource{}
+
+func NewNvmetSubsysDataSource() datasource.DataSource {
+	return &NvmetSubsysDataSource{}
+}
+
+type NvmetSubsysDataSource struct {
+	client *client.Client
+}
+
+type NvmetSubsysDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Subnqn       types.String `tfsdk:"subnqn"`
+	AllowAnyHost types.Bool   `tfsdk:"allow_any_host"`
+	PiEnable     types.Bool   `tfsdk:"pi_enable"`
+	QidMax       types.Int64  `tfsdk:"qid_max"`
+	IeeeOui      types.String `tfsdk:"ieee_oui"`
+	Ana          types.Bool   `tfsdk:"ana"`
+}
+
+func (d *NvmetSubsysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nvmet_subsys"
+}
+
+func (d *NvmetSubsysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS nvmet_subsys data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Human readable name for the subsystem.  If `subnqn` is not provided on creation, then this name will",
+			},
+			"subnqn": schema.StringAttribute{
+				Computed:    true,
+				Description: "NVMe Qualified Name (NQN) for the subsystem.  Must be a valid NQN format if provided.",
+			},
+			"allow_any_host": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Any host can access the storage associated with this subsystem (i.e. no access control).",
+			},
+			"pi_enable": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Enable Protection Information (PI) for data integrity checking.",
+			},
+			"qid_max": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Maximum number of queue IDs allowed for this subsystem.",
+			},
+			"ieee_oui": schema.StringAttribute{
+				Computed:    true,
+				Description: "IEEE Organizationally Unique Identifier for the subsystem.",
+			},
+			"ana": schema.BoolAttribute{
+				Computed:    true,
+				Description: "If set to either `True` or `False`, then *override* the global `ana` setting from `nvmet.global.conf",
+			},
+		},
+	}
+}
+
+func (d *NvmetSubsysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *NvmetSubsysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NvmetSubsysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("nvmet.subsys.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read nvmet_subsys: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["subnqn"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Subnqn = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Subnqn = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Subnqn = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["allow_any_host"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.AllowAnyHost = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["pi_enable"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.PiEnable = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["qid_max"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.QidMax = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.QidMax = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["ieee_oui"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.IeeeOui = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.IeeeOui = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.IeeeOui = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["ana"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Ana = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
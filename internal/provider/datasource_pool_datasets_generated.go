@@ -23,6 +23,7 @@ type PoolDatasetsDataSource struct {
 }
 
 type PoolDatasetsDataSourceModel struct {
+	QueryArgsModel
 	Items types.List `tfsdk:"items"`
 }
 
@@ -299,6 +300,9 @@ func (d *PoolDatasetsDataSource) Schema(ctx context.Context, req datasource.Sche
 			},
 		},
 	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (d *PoolDatasetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -315,9 +319,18 @@ func (d *PoolDatasetsDataSource) Configure(ctx context.Context, req datasource.C
 
 func (d *PoolDatasetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data PoolDatasetsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Call query method with empty filters to get all items
-	result, err := d.client.Call("pool.dataset.query", []interface{}{})
+	result, err := d.client.Call("pool.dataset.query", params)
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query pool_datasets: %s", err.Error()))
 		return
@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
-
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 
@@ -58,8 +60,16 @@ func (d *PoolDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Returns instance matching `id`. If `id` is not found, Validation error is raised.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Resource ID. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
+			},
 			"name": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "Name of the storage pool.",
 			},
@@ -178,7 +188,13 @@ func (d *PoolDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	result, err := d.client.Call("pool.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	var result interface{}
+	var err error
+	if !data.Name.IsNull() {
+		result, err = queryOne(d.client, "pool.query", "name", data.Name.ValueString())
+	} else {
+		result, err = d.client.Call("pool.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read pool: %s", err.Error()))
 		return
@@ -190,6 +206,9 @@ func (d *PoolDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	if v, ok := resultMap["id"]; ok && v != nil {
+		data.ID = types.StringValue(fmt.Sprintf("%v", v))
+	}
 	if v, ok := resultMap["name"]; ok && v != nil {
 		switch val := v.(type) {
 		case string:
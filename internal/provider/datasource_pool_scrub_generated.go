@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &PoolScrubDataSource{}
+
+func NewPoolScrubDataSource() datasource.DataSource {
+	return &PoolScrubDataSource{}
+}
+
+type PoolScrubDataSource struct {
+	client *client.Client
+}
+
+type PoolScrubDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Pool        types.Int64  `tfsdk:"pool"`
+	Threshold   types.Int64  `tfsdk:"threshold"`
+	Description types.String `tfsdk:"description"`
+	Schedule    types.String `tfsdk:"schedule"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *PoolScrubDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pool_scrub"
+}
+
+func (d *PoolScrubDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS pool_scrub data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"pool": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the pool to scrub.",
+			},
+			"threshold": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Days before a scrub is due when a scrub should automatically start.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description or notes for this scrub schedule.",
+			},
+			"schedule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Cron schedule for when scrubs should run.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether this scrub schedule is enabled.",
+			},
+		},
+	}
+}
+
+func (d *PoolScrubDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *PoolScrubDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PoolScrubDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("pool.scrub.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read pool_scrub: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["pool"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Pool = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Pool = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["threshold"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Threshold = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Threshold = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["description"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Description = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Description = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["schedule"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Schedule = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Schedule = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Schedule = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
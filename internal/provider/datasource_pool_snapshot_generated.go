@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &PoolSnapshotDataSource{}
+
+func NewPoolSnapshotDataSource() datasource.DataSource {
+	return &PoolSnapshotDataSource{}
+}
+
+type PoolSnapshotDataSource struct {
+	client *client.Client
+}
+
+type PoolSnapshotDataSourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Dataset              types.String `tfsdk:"dataset"`
+	Recursive            types.Bool   `tfsdk:"recursive"`
+	Exclude              types.List   `tfsdk:"exclude"`
+	VmwareSync           types.Bool   `tfsdk:"vmware_sync"`
+	Properties           types.String `tfsdk:"properties"`
+	Name                 types.String `tfsdk:"name"`
+	NamingSchema         types.String `tfsdk:"naming_schema"`
+	UserPropertiesUpdate types.List   `tfsdk:"user_properties_update"`
+	UserPropertiesRemove types.List   `tfsdk:"user_properties_remove"`
+}
+
+func (d *PoolSnapshotDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pool_snapshot"
+}
+
+func (d *PoolSnapshotDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS pool_snapshot data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"dataset": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the dataset to create a snapshot of.",
+			},
+			"recursive": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to recursively snapshot child datasets.",
+			},
+			"exclude": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of dataset patterns to exclude from recursive snapshots.",
+			},
+			"vmware_sync": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to sync VMware VMs before taking the snapshot.",
+			},
+			"properties": schema.StringAttribute{
+				Computed:    true,
+				Description: "Object mapping ZFS property names to values to set on the snapshot.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Explicit name for the snapshot.",
+			},
+			"naming_schema": schema.StringAttribute{
+				Computed:    true,
+				Description: "Naming schema pattern to generate the snapshot name automatically.",
+			},
+			"user_properties_update": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Properties to update.",
+			},
+			"user_properties_remove": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Properties to remove.",
+			},
+		},
+	}
+}
+
+func (d *PoolSnapshotDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *PoolSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PoolSnapshotDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("pool.snapshot.get_instance", data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read pool_snapshot: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["dataset"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Dataset = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Dataset = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Dataset = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["recursive"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Recursive = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["exclude"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Exclude, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["vmware_sync"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.VmwareSync = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["properties"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Properties = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Properties = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Properties = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["naming_schema"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.NamingSchema = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.NamingSchema = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.NamingSchema = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["user_properties_update"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				b, _ := json.Marshal(item)
+				strVals[i] = types.StringValue(string(b))
+			}
+			data.UserPropertiesUpdate, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["user_properties_remove"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.UserPropertiesRemove, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &PoolSnapshotsDataSource{}
+
+func NewPoolSnapshotsDataSource() datasource.DataSource {
+	return &PoolSnapshotsDataSource{}
+}
+
+type PoolSnapshotsDataSource struct {
+	client *client.Client
+}
+
+type PoolSnapshotsDataSourceModel struct {
+	QueryArgsModel
+	Items types.List `tfsdk:"items"`
+}
+
+type PoolSnapshotsItemModel struct {
+	ID           types.String `tfsdk:"id"`
+	Dataset      types.String `tfsdk:"dataset"`
+	Recursive    types.Bool   `tfsdk:"recursive"`
+	VmwareSync   types.Bool   `tfsdk:"vmware_sync"`
+	Properties   types.String `tfsdk:"properties"`
+	Name         types.String `tfsdk:"name"`
+	NamingSchema types.String `tfsdk:"naming_schema"`
+}
+
+func (d *PoolSnapshotsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pool_snapshots"
+}
+
+func (d *PoolSnapshotsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query pool_snapshots",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of pool_snapshots resources",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+						"dataset": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the dataset to create a snapshot of.",
+						},
+						"recursive": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether to recursively snapshot child datasets.",
+						},
+						"vmware_sync": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether to sync VMware VMs before taking the snapshot.",
+						},
+						"properties": schema.StringAttribute{
+							Computed:    true,
+							Description: "Object mapping ZFS property names to values to set on the snapshot.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Explicit name for the snapshot.",
+						},
+						"naming_schema": schema.StringAttribute{
+							Computed:    true,
+							Description: "Naming schema pattern to generate the snapshot name automatically.",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (d *PoolSnapshotsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *PoolSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PoolSnapshotsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("pool.snapshot.query", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query pool_snapshots: %s", err.Error()))
+		return
+	}
+
+	resultList, ok := result.([]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response as list")
+		return
+	}
+
+	// Convert results to items
+	items := make([]PoolSnapshotsItemModel, 0, len(resultList))
+	for _, item := range resultList {
+		resultMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemModel := PoolSnapshotsItemModel{}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			itemModel.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["dataset"]; ok && v != nil {
+			itemModel.Dataset = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["recursive"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.Recursive = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["vmware_sync"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.VmwareSync = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["properties"]; ok && v != nil {
+			itemModel.Properties = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["name"]; ok && v != nil {
+			itemModel.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["naming_schema"]; ok && v != nil {
+			itemModel.NamingSchema = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		items = append(items, itemModel)
+	}
+
+	// Convert to types.List
+	itemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"dataset":       types.StringType,
+			"id":            types.StringType,
+			"name":          types.StringType,
+			"naming_schema": types.StringType,
+			"properties":    types.StringType,
+			"recursive":     types.BoolType,
+			"vmware_sync":   types.BoolType,
+		},
+	}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = itemsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
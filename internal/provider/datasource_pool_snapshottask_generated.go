@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &PoolSnapshottaskDataSource{}
+
+func NewPoolSnapshottaskDataSource() datasource.DataSource {
+	return &PoolSnapshottaskDataSource{}
+}
+
+type PoolSnapshottaskDataSource struct {
+	client *client.Client
+}
+
+type PoolSnapshottaskDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Dataset           types.String `tfsdk:"dataset"`
+	Recursive         types.Bool   `tfsdk:"recursive"`
+	LifetimeValue     types.Int64  `tfsdk:"lifetime_value"`
+	LifetimeUnit      types.String `tfsdk:"lifetime_unit"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	Exclude           types.List   `tfsdk:"exclude"`
+	NamingSchema      types.String `tfsdk:"naming_schema"`
+	AllowEmpty        types.Bool   `tfsdk:"allow_empty"`
+	Schedule          types.String `tfsdk:"schedule"`
+	FixateRemovalDate types.Bool   `tfsdk:"fixate_removal_date"`
+}
+
+func (d *PoolSnapshottaskDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pool_snapshottask"
+}
+
+func (d *PoolSnapshottaskDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS pool_snapshottask data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"dataset": schema.StringAttribute{
+				Computed:    true,
+				Description: "The dataset to take snapshots of.",
+			},
+			"recursive": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to recursively snapshot child datasets.",
+			},
+			"lifetime_value": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of time units to retain snapshots. `lifetime_unit` gives the time unit.",
+			},
+			"lifetime_unit": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unit of time for snapshot retention.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether this periodic snapshot task is enabled.",
+			},
+			"exclude": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of dataset patterns to exclude from recursive snapshots.",
+			},
+			"naming_schema": schema.StringAttribute{
+				Computed:    true,
+				Description: "Naming pattern for generated snapshots using strftime format.",
+			},
+			"allow_empty": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to take snapshots even if no data has changed.",
+			},
+			"schedule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Cron schedule for when snapshots should be taken.",
+			},
+			"fixate_removal_date": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to fix the removal date of existing snapshots when retention settings change.",
+			},
+		},
+	}
+}
+
+func (d *PoolSnapshottaskDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *PoolSnapshottaskDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PoolSnapshottaskDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("pool.snapshottask.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read pool_snapshottask: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["dataset"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Dataset = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Dataset = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Dataset = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["recursive"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Recursive = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["lifetime_value"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.LifetimeValue = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.LifetimeValue = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["lifetime_unit"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.LifetimeUnit = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.LifetimeUnit = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.LifetimeUnit = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["exclude"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Exclude, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["naming_schema"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.NamingSchema = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.NamingSchema = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.NamingSchema = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["allow_empty"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.AllowEmpty = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["schedule"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Schedule = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Schedule = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Schedule = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["fixate_removal_date"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.FixateRemovalDate = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
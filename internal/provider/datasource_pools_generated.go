@@ -23,6 +23,7 @@ type PoolsDataSource struct {
 }
 
 type PoolsDataSourceModel struct {
+	QueryArgsModel
 	Items types.List `tfsdk:"items"`
 }
 
@@ -169,6 +170,9 @@ func (d *PoolsDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 			},
 		},
 	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
 }
 
 func (d *PoolsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -185,9 +189,18 @@ func (d *PoolsDataSource) Configure(ctx context.Context, req datasource.Configur
 
 func (d *PoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data PoolsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Call query method with empty filters to get all items
-	result, err := d.client.Call("pool.query", []interface{}{})
+	result, err := d.client.Call("pool.query", params)
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query pools: %s", err.Error()))
 		return
@@ -0,0 +1,748 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &ReplicationDataSource{}
+
+func NewReplicationDataSource() datasource.DataSource {
+	return &ReplicationDataSource{}
+}
+
+type ReplicationDataSource struct {
+	client *client.Client
+}
+
+type ReplicationDataSourceModel struct {
+	ID                              types.String `tfsdk:"id"`
+	Name                            types.String `tfsdk:"name"`
+	Direction                       types.String `tfsdk:"direction"`
+	Transport                       types.String `tfsdk:"transport"`
+	SshCredentials                  types.Int64  `tfsdk:"ssh_credentials"`
+	NetcatActiveSide                types.String `tfsdk:"netcat_active_side"`
+	NetcatActiveSideListenAddress   types.String `tfsdk:"netcat_active_side_listen_address"`
+	NetcatActiveSidePortMin         types.Int64  `tfsdk:"netcat_active_side_port_min"`
+	NetcatActiveSidePortMax         types.Int64  `tfsdk:"netcat_active_side_port_max"`
+	NetcatPassiveSideConnectAddress types.String `tfsdk:"netcat_passive_side_connect_address"`
+	Sudo                            types.Bool   `tfsdk:"sudo"`
+	SourceDatasets                  types.List   `tfsdk:"source_datasets"`
+	TargetDataset                   types.String `tfsdk:"target_dataset"`
+	Recursive                       types.Bool   `tfsdk:"recursive"`
+	Exclude                         types.List   `tfsdk:"exclude"`
+	Properties                      types.Bool   `tfsdk:"properties"`
+	PropertiesExclude               types.List   `tfsdk:"properties_exclude"`
+	PropertiesOverride              types.String `tfsdk:"properties_override"`
+	Replicate                       types.Bool   `tfsdk:"replicate"`
+	Encryption                      types.Bool   `tfsdk:"encryption"`
+	EncryptionInherit               types.Bool   `tfsdk:"encryption_inherit"`
+	EncryptionKey                   types.String `tfsdk:"encryption_key"`
+	EncryptionKeyFormat             types.String `tfsdk:"encryption_key_format"`
+	EncryptionKeyLocation           types.String `tfsdk:"encryption_key_location"`
+	PeriodicSnapshotTasks           types.List   `tfsdk:"periodic_snapshot_tasks"`
+	NamingSchema                    types.List   `tfsdk:"naming_schema"`
+	AlsoIncludeNamingSchema         types.List   `tfsdk:"also_include_naming_schema"`
+	NameRegex                       types.String `tfsdk:"name_regex"`
+	Auto                            types.Bool   `tfsdk:"auto"`
+	Schedule                        types.String `tfsdk:"schedule"`
+	RestrictSchedule                types.String `tfsdk:"restrict_schedule"`
+	OnlyMatchingSchedule            types.Bool   `tfsdk:"only_matching_schedule"`
+	AllowFromScratch                types.Bool   `tfsdk:"allow_from_scratch"`
+	Readonly                        types.String `tfsdk:"readonly"`
+	HoldPendingSnapshots            types.Bool   `tfsdk:"hold_pending_snapshots"`
+	RetentionPolicy                 types.String `tfsdk:"retention_policy"`
+	LifetimeValue                   types.Int64  `tfsdk:"lifetime_value"`
+	LifetimeUnit                    types.String `tfsdk:"lifetime_unit"`
+	Lifetimes                       types.List   `tfsdk:"lifetimes"`
+	Compression                     types.String `tfsdk:"compression"`
+	SpeedLimit                      types.Int64  `tfsdk:"speed_limit"`
+	LargeBlock                      types.Bool   `tfsdk:"large_block"`
+	Embed                           types.Bool   `tfsdk:"embed"`
+	Compressed                      types.Bool   `tfsdk:"compressed"`
+	Retries                         types.Int64  `tfsdk:"retries"`
+	LoggingLevel                    types.String `tfsdk:"logging_level"`
+	Enabled                         types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *ReplicationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication"
+}
+
+func (d *ReplicationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS replication data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name for replication task.",
+			},
+			"direction": schema.StringAttribute{
+				Computed:    true,
+				Description: "Whether task will `PUSH` or `PULL` snapshots.",
+			},
+			"transport": schema.StringAttribute{
+				Computed:    true,
+				Description: "Method of snapshots transfer.  * `SSH` transfers snapshots via SSH connection. This method is suppor",
+			},
+			"ssh_credentials": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Keychain Credential ID of type `SSH_CREDENTIALS`.",
+			},
+			"netcat_active_side": schema.StringAttribute{
+				Computed:    true,
+				Description: "Which side actively establishes the netcat connection for `SSH+NETCAT` transport.  * `LOCAL`: Local ",
+			},
+			"netcat_active_side_listen_address": schema.StringAttribute{
+				Computed:    true,
+				Description: "IP address for the active side to listen on for `SSH+NETCAT` transport. `null` if not applicable.",
+			},
+			"netcat_active_side_port_min": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Minimum port number in the range for netcat connections. `null` if not applicable.",
+			},
+			"netcat_active_side_port_max": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Maximum port number in the range for netcat connections. `null` if not applicable.",
+			},
+			"netcat_passive_side_connect_address": schema.StringAttribute{
+				Computed:    true,
+				Description: "IP address for the passive side to connect to for `SSH+NETCAT` transport. `null` if not applicable.",
+			},
+			"sudo": schema.BoolAttribute{
+				Computed:    true,
+				Description: "`SSH` and `SSH+NETCAT` transports should use sudo (which is expected to be passwordless) to run `zfs",
+			},
+			"source_datasets": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of datasets to replicate snapshots from.",
+			},
+			"target_dataset": schema.StringAttribute{
+				Computed:    true,
+				Description: "Dataset to put snapshots into.",
+			},
+			"recursive": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to recursively replicate child datasets.",
+			},
+			"exclude": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of dataset patterns to exclude from replication.",
+			},
+			"properties": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Send dataset properties along with snapshots.",
+			},
+			"properties_exclude": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of dataset property names to exclude from replication.",
+			},
+			"properties_override": schema.StringAttribute{
+				Computed:    true,
+				Description: "Object mapping dataset property names to override values during replication.",
+			},
+			"replicate": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to use full ZFS replication.",
+			},
+			"encryption": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to enable encryption for the replicated datasets.",
+			},
+			"encryption_inherit": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether replicated datasets should inherit encryption from parent. `null` if encryption is disabled.",
+			},
+			"encryption_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Encryption key for replicated datasets. `null` if not specified.",
+			},
+			"encryption_key_format": schema.StringAttribute{
+				Computed:    true,
+				Description: "Format of the encryption key.  * `HEX`: Hexadecimal-encoded key * `PASSPHRASE`: Text passphrase * `n",
+			},
+			"encryption_key_location": schema.StringAttribute{
+				Computed:    true,
+				Description: "Filesystem path where encryption key is stored. `null` if not using key file.",
+			},
+			"periodic_snapshot_tasks": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of periodic snapshot task IDs that are sources of snapshots for this replication task. Only pus",
+			},
+			"naming_schema": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of naming schemas for pull replication.",
+			},
+			"also_include_naming_schema": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of naming schemas for push replication.",
+			},
+			"name_regex": schema.StringAttribute{
+				Computed:    true,
+				Description: "Replicate all snapshots which names match specified regular expression.",
+			},
+			"auto": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Allow replication to run automatically on schedule or after bound periodic snapshot task.",
+			},
+			"schedule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Schedule to run replication task. Only `auto` replication tasks without bound periodic snapshot task",
+			},
+			"restrict_schedule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Restricts when replication task with bound periodic snapshot tasks runs. For example, you can have p",
+			},
+			"only_matching_schedule": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Will only replicate snapshots that match `schedule` or `restrict_schedule`.",
+			},
+			"allow_from_scratch": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Will destroy all snapshots on target side and replicate everything from scratch if none of the snaps",
+			},
+			"readonly": schema.StringAttribute{
+				Computed:    true,
+				Description: "Controls destination datasets readonly property.  * `SET`: Set all destination datasets to readonly=",
+			},
+			"hold_pending_snapshots": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Prevent source snapshots from being deleted by retention of replication fails for some reason.",
+			},
+			"retention_policy": schema.StringAttribute{
+				Computed:    true,
+				Description: "How to delete old snapshots on target side:  * `SOURCE`: Delete snapshots that are absent on source ",
+			},
+			"lifetime_value": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of time units to retain snapshots for custom retention policy. Only applies when `retention_p",
+			},
+			"lifetime_unit": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time unit for snapshot retention for custom retention policy. Only applies when `retention_policy` i",
+			},
+			"lifetimes": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of different retention schedules with their own cron schedules and lifetime settings.",
+			},
+			"compression": schema.StringAttribute{
+				Computed:    true,
+				Description: "Compresses SSH stream. Available only for SSH transport.",
+			},
+			"speed_limit": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Limits speed of SSH stream. Available only for SSH transport.",
+			},
+			"large_block": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Enable large block support for ZFS send streams.",
+			},
+			"embed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Enable embedded block support for ZFS send streams.",
+			},
+			"compressed": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Enable compressed ZFS send streams.",
+			},
+			"retries": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of retries before considering replication failed.",
+			},
+			"logging_level": schema.StringAttribute{
+				Computed:    true,
+				Description: "Log level for replication task execution. Controls verbosity of replication logs.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether this replication task is enabled.",
+			},
+		},
+	}
+}
+
+func (d *ReplicationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *ReplicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReplicationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("replication.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read replication: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["direction"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Direction = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Direction = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Direction = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["transport"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Transport = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Transport = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Transport = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["ssh_credentials"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.SshCredentials = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.SshCredentials = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["netcat_active_side"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.NetcatActiveSide = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.NetcatActiveSide = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.NetcatActiveSide = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["netcat_active_side_listen_address"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.NetcatActiveSideListenAddress = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.NetcatActiveSideListenAddress = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.NetcatActiveSideListenAddress = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["netcat_active_side_port_min"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.NetcatActiveSidePortMin = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.NetcatActiveSidePortMin = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["netcat_active_side_port_max"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.NetcatActiveSidePortMax = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.NetcatActiveSidePortMax = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["netcat_passive_side_connect_address"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.NetcatPassiveSideConnectAddress = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.NetcatPassiveSideConnectAddress = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.NetcatPassiveSideConnectAddress = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["sudo"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Sudo = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["source_datasets"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.SourceDatasets, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["target_dataset"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.TargetDataset = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.TargetDataset = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.TargetDataset = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["recursive"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Recursive = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["exclude"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Exclude, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["properties"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Properties = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["properties_exclude"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.PropertiesExclude, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["properties_override"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.PropertiesOverride = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.PropertiesOverride = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.PropertiesOverride = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["replicate"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Replicate = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["encryption"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Encryption = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["encryption_inherit"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.EncryptionInherit = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["encryption_key"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.EncryptionKey = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.EncryptionKey = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.EncryptionKey = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["encryption_key_format"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.EncryptionKeyFormat = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.EncryptionKeyFormat = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.EncryptionKeyFormat = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["encryption_key_location"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.EncryptionKeyLocation = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.EncryptionKeyLocation = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.EncryptionKeyLocation = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["periodic_snapshot_tasks"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.PeriodicSnapshotTasks, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["naming_schema"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.NamingSchema, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["also_include_naming_schema"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.AlsoIncludeNamingSchema, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["name_regex"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.NameRegex = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.NameRegex = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.NameRegex = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["auto"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Auto = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["schedule"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Schedule = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Schedule = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Schedule = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["restrict_schedule"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.RestrictSchedule = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.RestrictSchedule = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.RestrictSchedule = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["only_matching_schedule"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.OnlyMatchingSchedule = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["allow_from_scratch"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.AllowFromScratch = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["readonly"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Readonly = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Readonly = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Readonly = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["hold_pending_snapshots"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.HoldPendingSnapshots = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["retention_policy"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.RetentionPolicy = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.RetentionPolicy = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.RetentionPolicy = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["lifetime_value"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.LifetimeValue = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.LifetimeValue = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["lifetime_unit"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.LifetimeUnit = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.LifetimeUnit = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.LifetimeUnit = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["lifetimes"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				b, _ := json.Marshal(item)
+				strVals[i] = types.StringValue(string(b))
+			}
+			data.Lifetimes, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["compression"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Compression = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Compression = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Compression = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["speed_limit"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.SpeedLimit = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.SpeedLimit = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["large_block"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.LargeBlock = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["embed"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Embed = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["compressed"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Compressed = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["retries"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Retries = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Retries = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["logging_level"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.LoggingLevel = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.LoggingLevel = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.LoggingLevel = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RPCDataSource{}
+
+func NewRPCDataSource() datasource.DataSource {
+	return &RPCDataSource{}
+}
+
+type RPCDataSource struct {
+	client *client.Client
+}
+
+type RPCDataSourceModel struct {
+	Method types.String  `tfsdk:"method"`
+	Params types.Dynamic `tfsdk:"params"`
+	Job    types.Bool    `tfsdk:"job"`
+	Result types.Dynamic `tfsdk:"result"`
+}
+
+func (d *RPCDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rpc"
+}
+
+func (d *RPCDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Calls a read-only TrueNAS middleware method and returns the decoded result. Only `*.query`, `*.get_*`, `*.config`, `*.info` and a fixed set of informational methods such as `system.version` are allowed.",
+		Attributes: map[string]schema.Attribute{
+			"method": schema.StringAttribute{
+				Required:    true,
+				Description: "Middleware method to call, e.g. `system.info` or `pool.dataset.details`.",
+			},
+			"params": schema.DynamicAttribute{
+				Optional:    true,
+				Description: "Positional parameters as a list, e.g. `[\"tank\"]`. Any other value is sent as the only parameter.",
+			},
+			"job": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Wait for the job the method starts and return its result. Default: `false`",
+			},
+			"result": schema.DynamicAttribute{
+				Computed:    true,
+				Description: "Decoded result of the call.",
+			},
+		},
+	}
+}
+
+func (d *RPCDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *RPCDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RPCDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	method := data.Method.ValueString()
+	if !client.IsReadOnlyMethod(method) {
+		resp.Diagnostics.AddAttributeError(path.Root("method"), "Method Not Allowed",
+			fmt.Sprintf("%s is not a read-only method. truenas_rpc only calls *.query, *.get_*, *.config, *.info and informational methods such as system.version.", method))
+		return
+	}
+
+	params, err := rpcParams(ctx, data.Params)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("params"), "Invalid Params", err.Error())
+		return
+	}
+
+	var result interface{}
+	if data.Job.ValueBool() {
+		result, err = d.client.CallWithJob(method, params)
+	} else {
+		result, err = d.client.Call(method, params)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to call %s: %s", method, err.Error()))
+		return
+	}
+
+	data.Result, err = interfaceToDynamic(result)
+	if err != nil {
+		resp.Diagnostics.AddError("Result Error", fmt.Sprintf("Unable to decode %s result: %s", method, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// rpcParams turns the params attribute into the positional parameter list
+func rpcParams(ctx context.Context, v types.Dynamic) ([]interface{}, error) {
+	params, err := dynamicToInterface(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	switch p := params.(type) {
+	case nil:
+		return []interface{}{}, nil
+	case []interface{}:
+		return p, nil
+	default:
+		return []interface{}{p}, nil
+	}
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRPCParams(t *testing.T) {
+	ctx := context.Background()
+	list := types.DynamicValue(types.TupleValueMust(
+		[]attr.Type{types.StringType, types.NumberType},
+		[]attr.Value{types.StringValue("tank"), types.NumberValue(big.NewFloat(3))},
+	))
+	tests := map[string]struct {
+		in   types.Dynamic
+		want []interface{}
+	}{
+		"null":   {types.DynamicNull(), []interface{}{}},
+		"list":   {list, []interface{}{"tank", int64(3)}},
+		"scalar": {types.DynamicValue(types.StringValue("tank")), []interface{}{"tank"}},
+	}
+	for name, tt := range tests {
+		got, err := rpcParams(ctx, tt.in)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %#v, got %#v", name, tt.want, got)
+		}
+	}
+
+	if _, err := rpcParams(ctx, types.DynamicUnknown()); err == nil {
+		t.Error("Expected an error for unknown params")
+	}
+}
+
+func TestInterfaceToDynamic_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	in := map[string]interface{}{
+		"hostname": "nas",
+		"cores":    float64(8),
+		"ecc":      true,
+		"loadavg":  []interface{}{0.5, "x", nil},
+		"license":  nil,
+	}
+	v, err := interfaceToDynamic(in)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	out, err := dynamicToInterface(ctx, v)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	want := map[string]interface{}{
+		"hostname": "nas",
+		"cores":    int64(8),
+		"ecc":      true,
+		"loadavg":  []interface{}{0.5, "x", nil},
+		"license":  nil,
+	}
+	if !reflect.DeepEqual(out, want) {
+		t.Errorf("Expected %#v, got %#v", want, out)
+	}
+}
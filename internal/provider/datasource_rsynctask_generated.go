@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &RsynctaskDataSource{}
+
+func NewRsynctaskDataSource() datasource.DataSource {
+	return &RsynctaskDataSource{}
+}
+
+type RsynctaskDataSource struct {
+	client *client.Client
+}
+
+type RsynctaskDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Path           types.String `tfsdk:"path"`
+	User           types.String `tfsdk:"user"`
+	Mode           types.String `tfsdk:"mode"`
+	Remotehost     types.String `tfsdk:"remotehost"`
+	Remoteport     types.Int64  `tfsdk:"remoteport"`
+	Remotemodule   types.String `tfsdk:"remotemodule"`
+	SshCredentials types.Int64  `tfsdk:"ssh_credentials"`
+	Remotepath     types.String `tfsdk:"remotepath"`
+	Direction      types.String `tfsdk:"direction"`
+	Desc           types.String `tfsdk:"desc"`
+	Schedule       types.String `tfsdk:"schedule"`
+	Recursive      types.Bool   `tfsdk:"recursive"`
+	Times          types.Bool   `tfsdk:"times"`
+	Compress       types.Bool   `tfsdk:"compress"`
+	Archive        types.Bool   `tfsdk:"archive"`
+	Delete         types.Bool   `tfsdk:"delete"`
+	Quiet          types.Bool   `tfsdk:"quiet"`
+	Preserveperm   types.Bool   `tfsdk:"preserveperm"`
+	Preserveattr   types.Bool   `tfsdk:"preserveattr"`
+	Delayupdates   types.Bool   `tfsdk:"delayupdates"`
+	Extra          types.List   `tfsdk:"extra"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	ValidateRpath  types.Bool   `tfsdk:"validate_rpath"`
+	SshKeyscan     types.Bool   `tfsdk:"ssh_keyscan"`
+}
+
+func (d *RsynctaskDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rsynctask"
+}
+
+func (d *RsynctaskDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS rsynctask data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"path": schema.StringAttribute{
+				Computed:    true,
+				Description: "Local filesystem path to synchronize.",
+			},
+			"user": schema.StringAttribute{
+				Computed:    true,
+				Description: "Username to run the rsync task as.",
+			},
+			"mode": schema.StringAttribute{
+				Computed:    true,
+				Description: "Operating mechanism for Rsync, i.e. Rsync Module mode or Rsync SSH mode.",
+			},
+			"remotehost": schema.StringAttribute{
+				Computed:    true,
+				Description: "IP address or hostname of the remote system. If username differs on the remote host, \"username@remot",
+			},
+			"remoteport": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Port number for SSH connection. Only applies when `mode` is SSH.",
+			},
+			"remotemodule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of remote module, this attribute should be specified when `mode` is set to MODULE.",
+			},
+			"ssh_credentials": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Keychain credential ID for SSH authentication. `null` to use user's SSH keys.",
+			},
+			"remotepath": schema.StringAttribute{
+				Computed:    true,
+				Description: "Path on the remote system to synchronize with.",
+			},
+			"direction": schema.StringAttribute{
+				Computed:    true,
+				Description: "Specify if data should be PULLED or PUSHED from the remote system.",
+			},
+			"desc": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the rsync task.",
+			},
+			"schedule": schema.StringAttribute{
+				Computed:    true,
+				Description: "Cron schedule for when the rsync task should run.",
+			},
+			"recursive": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Recursively transfer subdirectories.",
+			},
+			"times": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Preserve modification times of files.",
+			},
+			"compress": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Reduce the size of the data to be transmitted.",
+			},
+			"archive": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Make rsync run recursively, preserving symlinks, permissions, modification times, group, and special",
+			},
+			"delete": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Delete files in the destination directory that do not exist in the source directory.",
+			},
+			"quiet": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Suppress informational messages from rsync.",
+			},
+			"preserveperm": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Preserve original file permissions.",
+			},
+			"preserveattr": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Preserve extended attributes of files.",
+			},
+			"delayupdates": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Delay updating destination files until all transfers are complete.",
+			},
+			"extra": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of additional rsync command-line options.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether this rsync task is enabled.",
+			},
+			"validate_rpath": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Validate the existence of the remote path.",
+			},
+			"ssh_keyscan": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Automatically add remote host key to user's known_hosts file.",
+			},
+		},
+	}
+}
+
+func (d *RsynctaskDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *RsynctaskDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RsynctaskDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("rsynctask.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read rsynctask: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["path"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Path = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Path = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Path = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["user"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.User = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.User = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.User = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["mode"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Mode = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Mode = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Mode = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["remotehost"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Remotehost = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Remotehost = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Remotehost = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["remoteport"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Remoteport = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Remoteport = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["remotemodule"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Remotemodule = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Remotemodule = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Remotemodule = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["ssh_credentials"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.SshCredentials = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.SshCredentials = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["remotepath"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Remotepath = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Remotepath = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Remotepath = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["direction"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Direction = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Direction = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Direction = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["desc"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Desc = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Desc = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Desc = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["schedule"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Schedule = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Schedule = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Schedule = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["recursive"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Recursive = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["times"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Times = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["compress"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Compress = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["archive"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Archive = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["delete"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Delete = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["quiet"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Quiet = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["preserveperm"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Preserveperm = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["preserveattr"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Preserveattr = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["delayupdates"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Delayupdates = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["extra"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Extra, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["validate_rpath"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.ValidateRpath = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["ssh_keyscan"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.SshKeyscan = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
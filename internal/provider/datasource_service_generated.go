@@ -3,10 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 
@@ -39,8 +41,16 @@ func (d *ServiceDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 	resp.Schema = schema.Schema{
 		Description: "Returns instance matching `id`. If `id` is not found, Validation error is raised.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Resource ID. Exactly one of `id` or `service` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("service")),
+				},
+			},
 			"service": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "Name of the system service.",
 			},
@@ -80,7 +90,13 @@ func (d *ServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	result, err := d.client.Call("service.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	var result interface{}
+	var err error
+	if !data.Service.IsNull() {
+		result, err = queryOne(d.client, "service.query", "service", data.Service.ValueString())
+	} else {
+		result, err = d.client.Call("service.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read service: %s", err.Error()))
 		return
@@ -92,6 +108,9 @@ func (d *ServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	if v, ok := resultMap["id"]; ok && v != nil {
+		data.ID = types.StringValue(fmt.Sprintf("%v", v))
+	}
 	if v, ok := resultMap["service"]; ok && v != nil {
 		switch val := v.(type) {
 		case string:
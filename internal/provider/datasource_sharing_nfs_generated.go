@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &SharingNfsDataSource{}
+
+func NewSharingNfsDataSource() datasource.DataSource {
+	return &SharingNfsDataSource{}
+}
+
+type SharingNfsDataSource struct {
+	client *client.Client
+}
+
+type SharingNfsDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Path            types.String `tfsdk:"path"`
+	Aliases         types.List   `tfsdk:"aliases"`
+	Comment         types.String `tfsdk:"comment"`
+	Networks        types.List   `tfsdk:"networks"`
+	Hosts           types.List   `tfsdk:"hosts"`
+	Ro              types.Bool   `tfsdk:"ro"`
+	MaprootUser     types.String `tfsdk:"maproot_user"`
+	MaprootGroup    types.String `tfsdk:"maproot_group"`
+	MapallUser      types.String `tfsdk:"mapall_user"`
+	MapallGroup     types.String `tfsdk:"mapall_group"`
+	Security        types.List   `tfsdk:"security"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	ExposeSnapshots types.Bool   `tfsdk:"expose_snapshots"`
+}
+
+func (d *SharingNfsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sharing_nfs"
+}
+
+func (d *SharingNfsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS sharing_nfs data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"path": schema.StringAttribute{
+				Computed:    true,
+				Description: "Local path to be exported. ",
+			},
+			"aliases": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IGNORED for now. ",
+			},
+			"comment": schema.StringAttribute{
+				Computed:    true,
+				Description: "User comment associated with share. ",
+			},
+			"networks": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of authorized networks that are allowed to access the share having format     \"network/mask\" CI",
+			},
+			"hosts": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "List of IP's/hostnames which are allowed to access the share. No quotes or spaces are allowed. Each ",
+			},
+			"ro": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Export the share as read only. ",
+			},
+			"maproot_user": schema.StringAttribute{
+				Computed:    true,
+				Description: "Map root user client to a specified user. ",
+			},
+			"maproot_group": schema.StringAttribute{
+				Computed:    true,
+				Description: "Map root group client to a specified group. ",
+			},
+			"mapall_user": schema.StringAttribute{
+				Computed:    true,
+				Description: "Map all client users to a specified user. ",
+			},
+			"mapall_group": schema.StringAttribute{
+				Computed:    true,
+				Description: "Map all client groups to a specified group. ",
+			},
+			"security": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Specify the security schema. ",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Enable or disable the share. ",
+			},
+			"expose_snapshots": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Enterprise feature to enable access to the ZFS snapshot directory for the export. Export path must b",
+			},
+		},
+	}
+}
+
+func (d *SharingNfsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *SharingNfsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SharingNfsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("sharing.nfs.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read sharing_nfs: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["path"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Path = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Path = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Path = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["aliases"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Aliases, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["comment"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Comment = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["networks"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Networks, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["hosts"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Hosts, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["ro"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Ro = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["maproot_user"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.MaprootUser = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.MaprootUser = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.MaprootUser = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["maproot_group"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.MaprootGroup = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.MaprootGroup = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.MaprootGroup = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["mapall_user"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.MapallUser = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.MapallUser = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.MapallUser = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["mapall_group"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.MapallGroup = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.MapallGroup = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.MapallGroup = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["security"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Security, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["expose_snapshots"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.ExposeSnapshots = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &SharingSmbDataSource{}
+
+func NewSharingSmbDataSource() datasource.DataSource {
+	return &SharingSmbDataSource{}
+}
+
+type SharingSmbDataSource struct {
+	client *client.Client
+}
+
+type SharingSmbDataSourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	Purpose                     types.String `tfsdk:"purpose"`
+	Name                        types.String `tfsdk:"name"`
+	Path                        types.String `tfsdk:"path"`
+	Enabled                     types.Bool   `tfsdk:"enabled"`
+	Comment                     types.String `tfsdk:"comment"`
+	Readonly                    types.Bool   `tfsdk:"readonly"`
+	Browsable                   types.Bool   `tfsdk:"browsable"`
+	AccessBasedShareEnumeration types.Bool   `tfsdk:"access_based_share_enumeration"`
+	Audit                       types.String `tfsdk:"audit"`
+	Options                     types.String `tfsdk:"options"`
+}
+
+func (d *SharingSmbDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sharing_smb"
+}
+
+func (d *SharingSmbDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS sharing_smb data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"purpose": schema.StringAttribute{
+				Computed:    true,
+				Description: "This parameter sets the purpose of the SMB share. It controls how the SMB share behaves and what fea",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "SMB share name. SMB share names are case-insensitive and must be unique, and are subject     to the ",
+			},
+			"path": schema.StringAttribute{
+				Computed:    true,
+				Description: "Local server path to share by using the SMB protocol. The path must start with `/mnt/` and must be i",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "If unset, the SMB share is not available over the SMB protocol. ",
+			},
+			"comment": schema.StringAttribute{
+				Computed:    true,
+				Description: "Text field that is seen next to a share when an SMB client requests a list of SMB shares on the True",
+			},
+			"readonly": schema.BoolAttribute{
+				Computed:    true,
+				Description: "If set, SMB clients cannot create or change files and directories in the SMB share.  NOTE: If set, t",
+			},
+			"browsable": schema.BoolAttribute{
+				Computed:    true,
+				Description: "If set, the share is included when an SMB client requests a list of SMB shares on the TrueNAS server",
+			},
+			"access_based_share_enumeration": schema.BoolAttribute{
+				Computed:    true,
+				Description: "If set, the share is only included when an SMB client requests a list of shares on the SMB server if",
+			},
+			"audit": schema.StringAttribute{
+				Computed:    true,
+				Description: "Audit configuration for monitoring SMB share access and operations.",
+			},
+			"options": schema.StringAttribute{
+				Computed:    true,
+				Description: "Additional configuration related to the configured SMB share purpose. If null, then the default     ",
+			},
+		},
+	}
+}
+
+func (d *SharingSmbDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *SharingSmbDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SharingSmbDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("sharing.smb.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read sharing_smb: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["purpose"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Purpose = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Purpose = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Purpose = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["path"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Path = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Path = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Path = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["comment"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Comment = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["readonly"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Readonly = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["browsable"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Browsable = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["access_based_share_enumeration"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.AccessBasedShareEnumeration = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["audit"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Audit = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Audit = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Audit = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["options"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Options = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Options = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Options = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSourceWithConfigure = &SystemInfoDataSource{}
+
+func NewSystemInfoDataSource() datasource.DataSource {
+	return &SystemInfoDataSource{}
+}
+
+// SystemInfoDataSource reads system.info and system.state
+type SystemInfoDataSource struct {
+	client *client.Client
+}
+
+type SystemInfoDataSourceModel struct {
+	State                types.String  `tfsdk:"state"`
+	Version              types.String  `tfsdk:"version"`
+	Hostname             types.String  `tfsdk:"hostname"`
+	Uptime               types.String  `tfsdk:"uptime"`
+	UptimeSeconds        types.Float64 `tfsdk:"uptime_seconds"`
+	BootTime             types.String  `tfsdk:"boot_time"`
+	BuildTime            types.String  `tfsdk:"build_time"`
+	Timezone             types.String  `tfsdk:"timezone"`
+	Model                types.String  `tfsdk:"model"`
+	Cores                types.Int64   `tfsdk:"cores"`
+	PhysicalCores        types.Int64   `tfsdk:"physical_cores"`
+	Physmem              types.Int64   `tfsdk:"physmem"`
+	Loadavg              types.List    `tfsdk:"loadavg"`
+	EccMemory            types.Bool    `tfsdk:"ecc_memory"`
+	SystemManufacturer   types.String  `tfsdk:"system_manufacturer"`
+	SystemProduct        types.String  `tfsdk:"system_product"`
+	SystemProductVersion types.String  `tfsdk:"system_product_version"`
+	SystemSerial         types.String  `tfsdk:"system_serial"`
+}
+
+func (d *SystemInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_info"
+}
+
+func (d *SystemInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	computedString := func(desc string) schema.StringAttribute {
+		return schema.StringAttribute{Computed: true, Description: desc}
+	}
+	resp.Schema = schema.Schema{
+		Description: "Version, host and hardware details of the TrueNAS system, from `system.info` and `system.state`.",
+		Attributes: map[string]schema.Attribute{
+			"state":    computedString("Middleware state: `BOOTING`, `READY` or `SHUTTING_DOWN`."),
+			"version":  computedString("TrueNAS version, e.g. `25.10.1`."),
+			"hostname": computedString("Hostname."),
+			"uptime":   computedString("Time since boot as reported by the system, e.g. `3 days, 2:01:15.53`."),
+			"uptime_seconds": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Time since boot in seconds.",
+			},
+			"boot_time":  computedString("When the system booted, in RFC 3339 format."),
+			"build_time": computedString("When the running TrueNAS version was built, in RFC 3339 format."),
+			"timezone":   computedString("Configured time zone, e.g. `Europe/Belgrade`."),
+			"model":      computedString("CPU model."),
+			"cores": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of logical CPU cores.",
+			},
+			"physical_cores": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of physical CPU cores.",
+			},
+			"physmem": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Physical memory in bytes.",
+			},
+			"loadavg": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.Float64Type,
+				Description: "Load average over 1, 5 and 15 minutes.",
+			},
+			"ecc_memory": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the memory is ECC.",
+			},
+			"system_manufacturer":    computedString("System manufacturer."),
+			"system_product":         computedString("System product name."),
+			"system_product_version": computedString("System product version."),
+			"system_serial":          computedString("System serial number."),
+		},
+	}
+}
+
+func (d *SystemInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *SystemInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	state, err := d.client.Call("system.state", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read system state: %s", err))
+		return
+	}
+	info, err := d.client.Call("system.info", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read system info: %s", err))
+		return
+	}
+	infoMap, ok := info.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unexpected system.info response: %T", info))
+		return
+	}
+
+	var data SystemInfoDataSourceModel
+	data.State = types.StringValue(fmt.Sprintf("%v", state))
+	resp.Diagnostics.Append(setSystemInfo(infoMap, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setSystemInfo copies the fields of a system.info result into data
+func setSystemInfo(info map[string]interface{}, data *SystemInfoDataSourceModel) diag.Diagnostics {
+	str := func(key string) types.String {
+		if v, ok := info[key]; ok && v != nil {
+			return types.StringValue(fmt.Sprintf("%v", v))
+		}
+		return types.StringNull()
+	}
+	num := func(key string) types.Int64 {
+		if v, ok := info[key].(float64); ok {
+			return types.Int64Value(int64(v))
+		}
+		return types.Int64Null()
+	}
+	timestamp := func(key string) types.String {
+		if t, ok := middlewareTime(info[key]); ok {
+			return types.StringValue(t.UTC().Format(time.RFC3339))
+		}
+		return types.StringNull()
+	}
+
+	data.Version = str("version")
+	data.Hostname = str("hostname")
+	data.Uptime = str("uptime")
+	data.UptimeSeconds = types.Float64Null()
+	if v, ok := info["uptime_seconds"].(float64); ok {
+		data.UptimeSeconds = types.Float64Value(v)
+	}
+	data.BootTime = timestamp("boottime")
+	data.BuildTime = timestamp("buildtime")
+	data.Timezone = str("timezone")
+	data.Model = str("model")
+	data.Cores = num("cores")
+	data.PhysicalCores = num("physical_cores")
+	data.Physmem = num("physmem")
+	data.EccMemory = types.BoolNull()
+	if v, ok := info["ecc_memory"].(bool); ok {
+		data.EccMemory = types.BoolValue(v)
+	}
+	data.SystemManufacturer = str("system_manufacturer")
+	data.SystemProduct = str("system_product")
+	data.SystemProductVersion = str("system_product_version")
+	data.SystemSerial = str("system_serial")
+
+	var loads []attr.Value
+	if l, ok := info["loadavg"].([]interface{}); ok {
+		for _, v := range l {
+			if f, ok := v.(float64); ok {
+				loads = append(loads, types.Float64Value(f))
+			}
+		}
+	}
+	var diags diag.Diagnostics
+	data.Loadavg, diags = types.ListValue(types.Float64Type, loads)
+	return diags
+}
+
+// middlewareTime decodes a datetime from the API, sent as {"$date": <ms since
+// the epoch>} or as an RFC 3339 string
+func middlewareTime(v interface{}) (time.Time, bool) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if ms, ok := t["$date"].(float64); ok {
+			return time.UnixMilli(int64(ms)), true
+		}
+	case string:
+		if parsed, err := time.Parse(time.RFC3339, t); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestSetSystemInfo(t *testing.T) {
+	info := map[string]interface{}{
+		"version":             "25.10.1",
+		"hostname":            "nas",
+		"uptime":              "1:00:00.50",
+		"uptime_seconds":      3600.5,
+		"boottime":            map[string]interface{}{"$date": float64(1735689600000)},
+		"buildtime":           map[string]interface{}{"$date": float64(1733011200000)},
+		"timezone":            "Europe/Belgrade",
+		"model":               "AMD Ryzen 5 5600G",
+		"cores":               float64(12),
+		"physical_cores":      float64(6),
+		"physmem":             float64(33554432000),
+		"loadavg":             []interface{}{0.5, 0.25, 0.1},
+		"ecc_memory":          true,
+		"system_manufacturer": "ASRock",
+		"system_serial":       nil,
+	}
+
+	var data SystemInfoDataSourceModel
+	if diags := setSystemInfo(info, &data); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if data.Version.ValueString() != "25.10.1" || data.Hostname.ValueString() != "nas" {
+		t.Errorf("Expected version and hostname, got %s and %s", data.Version, data.Hostname)
+	}
+	if data.BootTime.ValueString() != "2025-01-01T00:00:00Z" {
+		t.Errorf("Expected boot time 2025-01-01T00:00:00Z, got %s", data.BootTime)
+	}
+	if data.UptimeSeconds.ValueFloat64() != 3600.5 || data.Cores.ValueInt64() != 12 || data.Physmem.ValueInt64() != 33554432000 {
+		t.Errorf("Unexpected numbers: %s, %s, %s", data.UptimeSeconds, data.Cores, data.Physmem)
+	}
+	if len(data.Loadavg.Elements()) != 3 || !data.EccMemory.ValueBool() {
+		t.Errorf("Expected 3 load averages and ECC memory, got %s and %s", data.Loadavg, data.EccMemory)
+	}
+	if !data.SystemSerial.IsNull() || !data.SystemProduct.IsNull() {
+		t.Error("Expected missing and null fields to be null")
+	}
+}
+
+func TestMiddlewareTime(t *testing.T) {
+	want := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, v := range []interface{}{
+		map[string]interface{}{"$date": float64(1735689600000)},
+		"2025-01-01T00:00:00Z",
+	} {
+		got, ok := middlewareTime(v)
+		if !ok || !got.Equal(want) {
+			t.Errorf("middlewareTime(%v) = %v, %v", v, got, ok)
+		}
+	}
+	if _, ok := middlewareTime("yesterday"); ok {
+		t.Error("Expected an invalid time to be rejected")
+	}
+}
+
+func TestSystemInfoDataSource_Configure(t *testing.T) {
+	d := NewSystemInfoDataSource().(datasource.DataSourceWithConfigure)
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: "not a client"}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected unexpected provider data to be rejected")
+	}
+}
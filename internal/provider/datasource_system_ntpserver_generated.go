@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &SystemNtpserverDataSource{}
+
+func NewSystemNtpserverDataSource() datasource.DataSource {
+	return &SystemNtpserverDataSource{}
+}
+
+type SystemNtpserverDataSource struct {
+	client *client.Client
+}
+
+type SystemNtpserverDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Address types.String `tfsdk:"address"`
+	Burst   types.Bool   `tfsdk:"burst"`
+	Iburst  types.Bool   `tfsdk:"iburst"`
+	Prefer  types.Bool   `tfsdk:"prefer"`
+	Minpoll types.Int64  `tfsdk:"minpoll"`
+	Maxpoll types.Int64  `tfsdk:"maxpoll"`
+	Force   types.Bool   `tfsdk:"force"`
+}
+
+func (d *SystemNtpserverDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_ntpserver"
+}
+
+func (d *SystemNtpserverDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS system_ntpserver data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"address": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hostname or IP address of the NTP server.",
+			},
+			"burst": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Send a burst of packets when the server is reachable.",
+			},
+			"iburst": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Send a burst of packets when the server is unreachable.",
+			},
+			"prefer": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Mark this server as preferred for time synchronization.",
+			},
+			"minpoll": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Minimum polling interval (log2 seconds).",
+			},
+			"maxpoll": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Maximum polling interval (log2 seconds).",
+			},
+			"force": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Force creation even if the server is unreachable.",
+			},
+		},
+	}
+}
+
+func (d *SystemNtpserverDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *SystemNtpserverDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SystemNtpserverDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("system.ntpserver.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read system_ntpserver: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["address"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Address = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Address = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Address = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["burst"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Burst = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["iburst"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Iburst = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["prefer"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Prefer = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["minpoll"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Minpoll = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Minpoll = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["maxpoll"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Maxpoll = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Maxpoll = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["force"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Force = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
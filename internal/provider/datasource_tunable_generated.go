@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &TunableDataSource{}
+
+func NewTunableDataSource() datasource.DataSource {
+	return &TunableDataSource{}
+}
+
+type TunableDataSource struct {
+	client *client.Client
+}
+
+type TunableDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Type            types.String `tfsdk:"type"`
+	Var             types.String `tfsdk:"var"`
+	Value           types.String `tfsdk:"value"`
+	Comment         types.String `tfsdk:"comment"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	UpdateInitramfs types.Bool   `tfsdk:"update_initramfs"`
+}
+
+func (d *TunableDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tunable"
+}
+
+func (d *TunableDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS tunable data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "* `SYSCTL`: `var` is a sysctl name (e.g. `kernel.watchdog`) and `value` is its corresponding value (",
+			},
+			"var": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name or identifier of the system parameter to tune.",
+			},
+			"value": schema.StringAttribute{
+				Computed:    true,
+				Description: "Value to assign to the tunable parameter.",
+			},
+			"comment": schema.StringAttribute{
+				Computed:    true,
+				Description: "Optional descriptive comment explaining the purpose of this tunable.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether this tunable is active and should be applied.",
+			},
+			"update_initramfs": schema.BoolAttribute{
+				Computed:    true,
+				Description: "If `false`, then initramfs will not be updated after creating a ZFS tunable and you will need to run",
+			},
+		},
+	}
+}
+
+func (d *TunableDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *TunableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TunableDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("tunable.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read tunable: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["type"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Type = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Type = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Type = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["var"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Var = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Var = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Var = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["value"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Value = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Value = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Value = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["comment"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Comment = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Enabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["update_initramfs"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.UpdateInitramfs = types.BoolValue(bv)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
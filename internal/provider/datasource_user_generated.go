@@ -3,10 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 
@@ -64,12 +66,20 @@ func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Returns instance matching `id`. If `id` is not found, Validation error is raised.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Resource ID. Exactly one of `id` or `username` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("username")),
+				},
+			},
 			"uid": schema.Int64Attribute{
 				Computed:    true,
 				Description: "A non-negative integer used to identify a system user. TrueNAS uses this value for permission     ch",
 			},
 			"username": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "A string used to identify a user. Local accounts must use characters from the POSIX portable filenam",
 			},
@@ -210,7 +220,13 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	result, err := d.client.Call("user.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	var result interface{}
+	var err error
+	if !data.Username.IsNull() {
+		result, err = queryOne(d.client, "user.query", "username", data.Username.ValueString())
+	} else {
+		result, err = d.client.Call("user.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read user: %s", err.Error()))
 		return
@@ -222,6 +238,9 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	if v, ok := resultMap["id"]; ok && v != nil {
+		data.ID = types.StringValue(fmt.Sprintf("%v", v))
+	}
 	if v, ok := resultMap["uid"]; ok && v != nil {
 		switch val := v.(type) {
 		case float64:
@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &VirtInstanceDataSource{}
+
+func NewVirtInstanceDataSource() datasource.DataSource {
+	return &VirtInstanceDataSource{}
+}
+
+type VirtInstanceDataSource struct {
+	client *client.Client
+}
+
+type VirtInstanceDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	SourceType     types.String `tfsdk:"source_type"`
+	StoragePool    types.String `tfsdk:"storage_pool"`
+	Image          types.String `tfsdk:"image"`
+	RootDiskSize   types.Int64  `tfsdk:"root_disk_size"`
+	RootDiskIoBus  types.String `tfsdk:"root_disk_io_bus"`
+	Remote         types.String `tfsdk:"remote"`
+	InstanceType   types.String `tfsdk:"instance_type"`
+	Environment    types.String `tfsdk:"environment"`
+	Autostart      types.Bool   `tfsdk:"autostart"`
+	Cpu            types.String `tfsdk:"cpu"`
+	Devices        types.List   `tfsdk:"devices"`
+	Memory         types.Int64  `tfsdk:"memory"`
+	PrivilegedMode types.Bool   `tfsdk:"privileged_mode"`
+	VncPort        types.Int64  `tfsdk:"vnc_port"`
+	EnableVnc      types.Bool   `tfsdk:"enable_vnc"`
+	VncPassword    types.String `tfsdk:"vnc_password"`
+	SecureBoot     types.Bool   `tfsdk:"secure_boot"`
+	ImageOs        types.String `tfsdk:"image_os"`
+}
+
+func (d *VirtInstanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virt_instance"
+}
+
+func (d *VirtInstanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS virt_instance data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name for the new virtual instance.",
+			},
+			"source_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Source type for instance creation.",
+			},
+			"storage_pool": schema.StringAttribute{
+				Computed:    true,
+				Description: "Storage pool under which to allocate root filesystem. Must be one of the pools     listed in virt.gl",
+			},
+			"image": schema.StringAttribute{
+				Computed:    true,
+				Description: "Image identifier to use for creating the instance.",
+			},
+			"root_disk_size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Size of the root disk in GB (minimum 5GB) or `null` to keep current size.",
+			},
+			"root_disk_io_bus": schema.StringAttribute{
+				Computed:    true,
+				Description: "I/O bus type for the root disk or `null` to keep current setting.",
+			},
+			"remote": schema.StringAttribute{
+				Computed:    true,
+				Description: "Remote image source to use.",
+			},
+			"instance_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of instance to create.",
+			},
+			"environment": schema.StringAttribute{
+				Computed:    true,
+				Description: "Environment variables to set inside the instance.",
+			},
+			"autostart": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the instance should automatically start when the host boots.",
+			},
+			"cpu": schema.StringAttribute{
+				Computed:    true,
+				Description: "CPU allocation specification or `null` for automatic allocation.",
+			},
+			"devices": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Array of devices to attach to the instance.",
+			},
+			"memory": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Memory allocation in bytes or `null` for automatic allocation.",
+			},
+			"privileged_mode": schema.BoolAttribute{
+				Computed:    true,
+				Description: "This is only valid for containers and should only be set when container instance which is to be depl",
+			},
+			"vnc_port": schema.Int64Attribute{
+				Computed:    true,
+				Description: "TCP port number for VNC access (5900-65535) or `null` to disable VNC.",
+			},
+			"enable_vnc": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to enable VNC remote access for the instance.",
+			},
+			"vnc_password": schema.StringAttribute{
+				Computed:    true,
+				Description: "Setting vnc_password to null will unset VNC password.",
+			},
+			"secure_boot": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to enable UEFI Secure Boot (VMs only).",
+			},
+			"image_os": schema.StringAttribute{
+				Computed:    true,
+				Description: "Operating system type for the instance or `null` for auto-detection.",
+			},
+		},
+	}
+}
+
+func (d *VirtInstanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *VirtInstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtInstanceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("virt.instance.get_instance", data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read virt_instance: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["source_type"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.SourceType = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.SourceType = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.SourceType = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["storage_pool"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.StoragePool = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.StoragePool = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.StoragePool = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["image"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Image = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Image = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Image = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["root_disk_size"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.RootDiskSize = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.RootDiskSize = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["root_disk_io_bus"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.RootDiskIoBus = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.RootDiskIoBus = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.RootDiskIoBus = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["remote"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Remote = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Remote = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Remote = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["instance_type"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.InstanceType = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.InstanceType = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.InstanceType = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["environment"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Environment = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Environment = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Environment = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["autostart"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Autostart = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["cpu"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Cpu = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Cpu = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Cpu = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["devices"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Devices, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["memory"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Memory = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Memory = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["privileged_mode"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.PrivilegedMode = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["vnc_port"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.VncPort = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.VncPort = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["enable_vnc"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.EnableVnc = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["vnc_password"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.VncPassword = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.VncPassword = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.VncPassword = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["secure_boot"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.SecureBoot = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["image_os"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.ImageOs = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.ImageOs = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.ImageOs = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
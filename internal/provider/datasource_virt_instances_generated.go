@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &VirtInstancesDataSource{}
+
+func NewVirtInstancesDataSource() datasource.DataSource {
+	return &VirtInstancesDataSource{}
+}
+
+type VirtInstancesDataSource struct {
+	client *client.Client
+}
+
+type VirtInstancesDataSourceModel struct {
+	QueryArgsModel
+	Items types.List `tfsdk:"items"`
+}
+
+type VirtInstancesItemModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	SourceType     types.String `tfsdk:"source_type"`
+	StoragePool    types.String `tfsdk:"storage_pool"`
+	Image          types.String `tfsdk:"image"`
+	RootDiskSize   types.Int64  `tfsdk:"root_disk_size"`
+	RootDiskIoBus  types.String `tfsdk:"root_disk_io_bus"`
+	Remote         types.String `tfsdk:"remote"`
+	InstanceType   types.String `tfsdk:"instance_type"`
+	Environment    types.String `tfsdk:"environment"`
+	Autostart      types.Bool   `tfsdk:"autostart"`
+	Cpu            types.String `tfsdk:"cpu"`
+	Memory         types.Int64  `tfsdk:"memory"`
+	PrivilegedMode types.Bool   `tfsdk:"privileged_mode"`
+	VncPort        types.Int64  `tfsdk:"vnc_port"`
+	EnableVnc      types.Bool   `tfsdk:"enable_vnc"`
+	VncPassword    types.String `tfsdk:"vnc_password"`
+	SecureBoot     types.Bool   `tfsdk:"secure_boot"`
+	ImageOs        types.String `tfsdk:"image_os"`
+}
+
+func (d *VirtInstancesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virt_instances"
+}
+
+func (d *VirtInstancesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Query virt_instances",
+		Attributes: map[string]schema.Attribute{
+			"items": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of virt_instances resources",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name for the new virtual instance.",
+						},
+						"source_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Source type for instance creation.",
+						},
+						"storage_pool": schema.StringAttribute{
+							Computed:    true,
+							Description: "Storage pool under which to allocate root filesystem. Must be one of the pools     listed in virt.gl",
+						},
+						"image": schema.StringAttribute{
+							Computed:    true,
+							Description: "Image identifier to use for creating the instance.",
+						},
+						"root_disk_size": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Size of the root disk in GB (minimum 5GB) or `null` to keep current size.",
+						},
+						"root_disk_io_bus": schema.StringAttribute{
+							Computed:    true,
+							Description: "I/O bus type for the root disk or `null` to keep current setting.",
+						},
+						"remote": schema.StringAttribute{
+							Computed:    true,
+							Description: "Remote image source to use.",
+						},
+						"instance_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of instance to create.",
+						},
+						"environment": schema.StringAttribute{
+							Computed:    true,
+							Description: "Environment variables to set inside the instance.",
+						},
+						"autostart": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the instance should automatically start when the host boots.",
+						},
+						"cpu": schema.StringAttribute{
+							Computed:    true,
+							Description: "CPU allocation specification or `null` for automatic allocation.",
+						},
+						"memory": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Memory allocation in bytes or `null` for automatic allocation.",
+						},
+						"privileged_mode": schema.BoolAttribute{
+							Computed:    true,
+							Description: "This is only valid for containers and should only be set when container instance which is to be depl",
+						},
+						"vnc_port": schema.Int64Attribute{
+							Computed:    true,
+							Description: "TCP port number for VNC access (5900-65535) or `null` to disable VNC.",
+						},
+						"enable_vnc": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether to enable VNC remote access for the instance.",
+						},
+						"vnc_password": schema.StringAttribute{
+							Computed:    true,
+							Description: "Setting vnc_password to null will unset VNC password.",
+						},
+						"secure_boot": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether to enable UEFI Secure Boot (VMs only).",
+						},
+						"image_os": schema.StringAttribute{
+							Computed:    true,
+							Description: "Operating system type for the instance or `null` for auto-detection.",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, attribute := range queryArgsAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (d *VirtInstancesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *VirtInstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtInstancesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.QueryParams(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("virt.instance.query", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query virt_instances: %s", err.Error()))
+		return
+	}
+
+	resultList, ok := result.([]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response as list")
+		return
+	}
+
+	// Convert results to items
+	items := make([]VirtInstancesItemModel, 0, len(resultList))
+	for _, item := range resultList {
+		resultMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		itemModel := VirtInstancesItemModel{}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			itemModel.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["name"]; ok && v != nil {
+			itemModel.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["source_type"]; ok && v != nil {
+			itemModel.SourceType = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["storage_pool"]; ok && v != nil {
+			itemModel.StoragePool = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["image"]; ok && v != nil {
+			itemModel.Image = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["root_disk_size"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.RootDiskSize = types.Int64Value(int64(fv))
+			}
+		}
+		if v, ok := resultMap["root_disk_io_bus"]; ok && v != nil {
+			itemModel.RootDiskIoBus = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["remote"]; ok && v != nil {
+			itemModel.Remote = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["instance_type"]; ok && v != nil {
+			itemModel.InstanceType = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["environment"]; ok && v != nil {
+			itemModel.Environment = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["autostart"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.Autostart = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["cpu"]; ok && v != nil {
+			itemModel.Cpu = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["memory"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.Memory = types.Int64Value(int64(fv))
+			}
+		}
+		if v, ok := resultMap["privileged_mode"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.PrivilegedMode = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["vnc_port"]; ok && v != nil {
+			if fv, ok := v.(float64); ok {
+				itemModel.VncPort = types.Int64Value(int64(fv))
+			}
+		}
+		if v, ok := resultMap["enable_vnc"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.EnableVnc = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["vnc_password"]; ok && v != nil {
+			itemModel.VncPassword = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["secure_boot"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				itemModel.SecureBoot = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["image_os"]; ok && v != nil {
+			itemModel.ImageOs = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		items = append(items, itemModel)
+	}
+
+	// Convert to types.List
+	itemsValue, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"autostart":        types.BoolType,
+			"cpu":              types.StringType,
+			"enable_vnc":       types.BoolType,
+			"environment":      types.StringType,
+			"id":               types.StringType,
+			"image":            types.StringType,
+			"image_os":         types.StringType,
+			"instance_type":    types.StringType,
+			"memory":           types.Int64Type,
+			"name":             types.StringType,
+			"privileged_mode":  types.BoolType,
+			"remote":           types.StringType,
+			"root_disk_io_bus": types.StringType,
+			"root_disk_size":   types.Int64Type,
+			"secure_boot":      types.BoolType,
+			"source_type":      types.StringType,
+			"storage_pool":     types.StringType,
+			"vnc_password":     types.StringType,
+			"vnc_port":         types.Int64Type,
+		},
+	}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = itemsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
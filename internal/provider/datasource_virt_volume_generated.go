@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &VirtVolumeDataSource{}
+
+func NewVirtVolumeDataSource() datasource.DataSource {
+	return &VirtVolumeDataSource{}
+}
+
+type VirtVolumeDataSource struct {
+	client *client.Client
+}
+
+type VirtVolumeDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	ContentType types.String `tfsdk:"content_type"`
+	Size        types.Int64  `tfsdk:"size"`
+	StoragePool types.String `tfsdk:"storage_pool"`
+}
+
+func (d *VirtVolumeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virt_volume"
+}
+
+func (d *VirtVolumeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS virt_volume data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name for the new virtualization volume (alphanumeric, dashes, dots, underscores).",
+			},
+			"content_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "",
+			},
+			"size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "New size for the volume in MB (minimum 512MB).",
+			},
+			"storage_pool": schema.StringAttribute{
+				Computed:    true,
+				Description: "Storage pool in which to create the volume. This must be one of pools listed     in virt.global.conf",
+			},
+		},
+	}
+}
+
+func (d *VirtVolumeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *VirtVolumeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtVolumeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("virt.volume.get_instance", data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read virt_volume: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["name"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Name = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Name = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["content_type"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.ContentType = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.ContentType = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.ContentType = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["size"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Size = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Size = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["storage_pool"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.StoragePool = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.StoragePool = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.StoragePool = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -3,10 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 
@@ -66,7 +68,14 @@ func (d *VmDataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Description: "Returns instance matching `id`. If `id` is not found, Validation error is raised.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Resource ID. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+				},
+			},
 			"command_line_args": schema.StringAttribute{
 				Computed:    true,
 				Description: "Additional command line arguments passed to the VM hypervisor.",
@@ -80,6 +89,7 @@ func (d *VmDataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
 				Description: "Specific CPU model to emulate. `null` to use hypervisor default.",
 			},
 			"name": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
 				Description: "Display name of the virtual machine.",
 			},
@@ -215,7 +225,13 @@ func (d *VmDataSource) Read(ctx context.Context, req datasource.ReadRequest, res
 		return
 	}
 
-	result, err := d.client.Call("vm.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	var result interface{}
+	var err error
+	if !data.Name.IsNull() {
+		result, err = queryOne(d.client, "vm.query", "name", data.Name.ValueString())
+	} else {
+		result, err = d.client.Call("vm.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read vm: %s", err.Error()))
 		return
@@ -227,6 +243,9 @@ func (d *VmDataSource) Read(ctx context.Context, req datasource.ReadRequest, res
 		return
 	}
 
+	if v, ok := resultMap["id"]; ok && v != nil {
+		data.ID = types.StringValue(fmt.Sprintf("%v", v))
+	}
 	if v, ok := resultMap["command_line_args"]; ok && v != nil {
 		switch val := v.(type) {
 		case string:
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+var _ datasource.DataSource = &VmwareDataSource{}
+
+func NewVmwareDataSource() datasource.DataSource {
+	return &VmwareDataSource{}
+}
+
+type VmwareDataSource struct {
+	client *client.Client
+}
+
+type VmwareDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Datastore  types.String `tfsdk:"datastore"`
+	Filesystem types.String `tfsdk:"filesystem"`
+	Hostname   types.String `tfsdk:"hostname"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+}
+
+func (d *VmwareDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vmware"
+}
+
+func (d *VmwareDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves TrueNAS vmware data",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
+			"datastore": schema.StringAttribute{
+				Computed:    true,
+				Description: "Valid datastore name which exists on the VMWare host.",
+			},
+			"filesystem": schema.StringAttribute{
+				Computed:    true,
+				Description: "ZFS filesystem or dataset to use for VMware storage.",
+			},
+			"hostname": schema.StringAttribute{
+				Computed:    true,
+				Description: "Valid IP address / hostname of a VMWare host. When clustering, this is the vCenter server for the cl",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "Credentials used to authorize access to the VMWare host.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Description: "Password for VMware host authentication.",
+			},
+		},
+	}
+}
+
+func (d *VmwareDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *VmwareDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VmwareDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.Call("vmware.get_instance", func() int { id, _ := strconv.Atoi(data.ID.ValueString()); return id }())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read vmware: %s", err.Error()))
+		return
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["datastore"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Datastore = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Datastore = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Datastore = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["filesystem"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Filesystem = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Filesystem = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Filesystem = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["hostname"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Hostname = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Hostname = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Hostname = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["username"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Username = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Username = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Username = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["password"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Password = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Password = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Password = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
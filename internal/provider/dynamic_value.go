@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// dynamicToInterface converts a dynamic attribute into the plain Go value sent
+// to the middleware. Null becomes nil; unknown values are rejected.
+func dynamicToInterface(ctx context.Context, v types.Dynamic) (interface{}, error) {
+	if v.IsNull() || v.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() || v.IsUnderlyingValueUnknown() {
+		return nil, fmt.Errorf("value is not known until apply")
+	}
+	tfv, err := v.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tftypesToInterface(tfv)
+}
+
+func tftypesToInterface(v tftypes.Value) (interface{}, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+	if !v.IsKnown() {
+		return nil, fmt.Errorf("value is not known until apply")
+	}
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return s, err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return b, err
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := v.As(&n); err != nil {
+			return nil, err
+		}
+		if n.IsInt() {
+			if i, acc := n.Int64(); acc == big.Exact {
+				return i, nil
+			}
+		}
+		f, _ := n.Float64()
+		return f, nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+		out := make([]interface{}, 0, len(elems))
+		for _, e := range elems {
+			ev, err := tftypesToInterface(e)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, ev)
+		}
+		return out, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var attrs map[string]tftypes.Value
+		if err := v.As(&attrs); err != nil {
+			return nil, err
+		}
+		out := make(map[string]interface{}, len(attrs))
+		for k, e := range attrs {
+			ev, err := tftypesToInterface(e)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = ev
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unsupported value type %s", typ)
+}
+
+// interfaceToDynamic converts a decoded middleware response into a dynamic
+// value. Arrays become tuples and objects become objects, so elements may
+// differ in type; JSON null is stored as a null string.
+func interfaceToDynamic(v interface{}) (types.Dynamic, error) {
+	av, err := interfaceToAttr(v)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+	return types.DynamicValue(av), nil
+}
+
+func interfaceToAttr(v interface{}) (attr.Value, error) {
+	switch val := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(val), nil
+	case bool:
+		return types.BoolValue(val), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(val)), nil
+	case int:
+		return types.NumberValue(new(big.Float).SetInt64(int64(val))), nil
+	case int64:
+		return types.NumberValue(new(big.Float).SetInt64(val)), nil
+	case []interface{}:
+		elemTypes := make([]attr.Type, 0, len(val))
+		elems := make([]attr.Value, 0, len(val))
+		for _, e := range val {
+			ev, err := interfaceToAttr(e)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes = append(elemTypes, ev.Type(context.Background()))
+			elems = append(elems, ev)
+		}
+		tv, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, fmt.Errorf("building tuple: %v", diags)
+		}
+		return tv, nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		attrTypes := make(map[string]attr.Type, len(val))
+		attrs := make(map[string]attr.Value, len(val))
+		for _, k := range keys {
+			ev, err := interfaceToAttr(val[k])
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[k] = ev.Type(context.Background())
+			attrs[k] = ev
+		}
+		ov, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("building object: %v", diags)
+		}
+		return ov, nil
+	}
+	return nil, fmt.Errorf("unsupported value type %T", v)
+}
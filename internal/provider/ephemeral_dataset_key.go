@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &DatasetKeyEphemeralResource{}
+
+// DatasetKeyEphemeralResource exports the encryption key of a dataset without
+// writing it to state
+type DatasetKeyEphemeralResource struct {
+	client *client.Client
+}
+
+type DatasetKeyEphemeralResourceModel struct {
+	Dataset types.String `tfsdk:"dataset"`
+	Key     types.String `tfsdk:"key"`
+}
+
+func NewDatasetKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &DatasetKeyEphemeralResource{}
+}
+
+func (e *DatasetKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dataset_key"
+}
+
+func (e *DatasetKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports the encryption key of a dataset with `pool.dataset.export_key`. The key is never stored in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"dataset": schema.StringAttribute{
+				Required:    true,
+				Description: "Encrypted dataset, e.g. `tank/secure`.",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Hex-encoded encryption key.",
+			},
+		},
+	}
+}
+
+func (e *DatasetKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	e.client = client
+}
+
+func (e *DatasetKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data DatasetKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := e.client.CallWithJob("pool.dataset.export_key", []interface{}{data.Dataset.ValueString(), false})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to export key of %s: %s", data.Dataset.ValueString(), err))
+		return
+	}
+	key, ok := result.(string)
+	if !ok || key == "" {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("pool.dataset.export_key returned no key for %s; is it encrypted with a key rather than a passphrase?", data.Dataset.ValueString()))
+		return
+	}
+	data.Key = types.StringValue(key)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sessionTokenDefaultTTL matches the default of auth.generate_token
+const sessionTokenDefaultTTL = 600
+
+var _ ephemeral.EphemeralResourceWithConfigure = &SessionTokenEphemeralResource{}
+
+// SessionTokenEphemeralResource issues a short-lived authentication token for
+// the provider's user, e.g. to hand to another provider or a script
+type SessionTokenEphemeralResource struct {
+	client *client.Client
+}
+
+type SessionTokenEphemeralResourceModel struct {
+	TTL         types.Int64  `tfsdk:"ttl"`
+	MatchOrigin types.Bool   `tfsdk:"match_origin"`
+	SingleUse   types.Bool   `tfsdk:"single_use"`
+	Token       types.String `tfsdk:"token"`
+}
+
+func NewSessionTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &SessionTokenEphemeralResource{}
+}
+
+func (e *SessionTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_token"
+}
+
+func (e *SessionTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Issues a short-lived authentication token with `auth.generate_token`. The token is never stored in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"ttl": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Seconds the token stays valid. Default: `600`",
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"match_origin": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only accept the token from the address that requested it, i.e. where Terraform runs. Default: the server's default",
+			},
+			"single_use": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Invalidate the token after its first use. Default: `false`",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Authentication token.",
+			},
+		},
+	}
+}
+
+func (e *SessionTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	e.client = client
+}
+
+func (e *SessionTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data SessionTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ttl := int64(sessionTokenDefaultTTL)
+	if !data.TTL.IsNull() {
+		ttl = data.TTL.ValueInt64()
+	}
+	// auth.generate_token(ttl, attrs, match_origin, single_use); arguments
+	// left unset are not sent, so the server's defaults apply
+	params := []interface{}{ttl, map[string]interface{}{}}
+	if !data.MatchOrigin.IsNull() {
+		params = append(params, data.MatchOrigin.ValueBool())
+	} else if !data.SingleUse.IsNull() {
+		// single_use comes after match_origin, which then takes its default
+		matchOrigin, ok, err := e.client.ArgDefault("auth.generate_token", 2)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read the default of match_origin: %s", err))
+			return
+		}
+		if !ok {
+			resp.Diagnostics.AddError("Missing match_origin", "The server lists no default for match_origin; set it together with single_use.")
+			return
+		}
+		params = append(params, matchOrigin)
+	}
+	if !data.SingleUse.IsNull() {
+		params = append(params, data.SingleUse.ValueBool())
+	}
+	result, err := e.client.Call("auth.generate_token", params)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to generate token: %s", err))
+		return
+	}
+	token, ok := result.(string)
+	if !ok || token == "" {
+		resp.Diagnostics.AddError("API Error", "auth.generate_token returned no token")
+		return
+	}
+	data.TTL = types.Int64Value(ttl)
+	data.SingleUse = types.BoolValue(data.SingleUse.ValueBool())
+	data.Token = types.StringValue(token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
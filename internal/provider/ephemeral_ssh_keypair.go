@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &SSHKeypairEphemeralResource{}
+
+// SSHKeypairEphemeralResource generates an SSH key pair on the server without
+// writing the private key to state
+type SSHKeypairEphemeralResource struct {
+	client *client.Client
+}
+
+type SSHKeypairEphemeralResourceModel struct {
+	PrivateKey types.String `tfsdk:"private_key"`
+	PublicKey  types.String `tfsdk:"public_key"`
+}
+
+func NewSSHKeypairEphemeralResource() ephemeral.EphemeralResource {
+	return &SSHKeypairEphemeralResource{}
+}
+
+func (e *SSHKeypairEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_keypair"
+}
+
+func (e *SSHKeypairEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates an SSH key pair with `keychaincredential.generate_ssh_key_pair`. A new pair is generated on every plan and apply, and neither key is stored in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"private_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Private key in OpenSSH format.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key in `authorized_keys` format.",
+			},
+		},
+	}
+}
+
+func (e *SSHKeypairEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type", fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData))
+		return
+	}
+	e.client = client
+}
+
+func (e *SSHKeypairEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	result, err := e.client.Call("keychaincredential.generate_ssh_key_pair", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to generate SSH key pair: %s", err))
+		return
+	}
+	var data SSHKeypairEphemeralResourceModel
+	pair, _ := result.(map[string]interface{})
+	data.PrivateKey = stringOrNull(pair["private_key"])
+	data.PublicKey = stringOrNull(pair["public_key"])
+	if data.PrivateKey.IsNull() || data.PublicKey.IsNull() {
+		resp.Diagnostics.AddError("API Error", "keychaincredential.generate_ssh_key_pair returned no key pair")
+		return
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
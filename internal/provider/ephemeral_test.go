@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProvider_EphemeralResources(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("GetProviderSchema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	// Secrets must be marked sensitive so they are redacted in output
+	secrets := map[string]string{
+		"truenas_dataset_key":   "key",
+		"truenas_session_token": "token",
+		"truenas_ssh_keypair":   "private_key",
+	}
+	for name, secret := range secrets {
+		s, ok := resp.EphemeralResourceSchemas[name]
+		if !ok {
+			t.Errorf("Expected ephemeral resource %s", name)
+			continue
+		}
+		found := false
+		for _, a := range s.Block.Attributes {
+			if a.Name == secret {
+				found = true
+				if !a.Sensitive {
+					t.Errorf("Expected %s.%s to be sensitive", name, secret)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("Expected %s to have attribute %s", name, secret)
+		}
+	}
+}
+
+func TestEphemeralResources_Configure(t *testing.T) {
+	for _, f := range New("test")().(*TrueNASProvider).EphemeralResources(context.Background()) {
+		e := f().(ephemeral.EphemeralResourceWithConfigure)
+		resp := &ephemeral.ConfigureResponse{}
+		e.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: "not a client"}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("Expected %T to reject unexpected provider data", e)
+		}
+	}
+}
+
+func TestSessionTokenEphemeralResource_MatchOrigin(t *testing.T) {
+	ctx := context.Background()
+	c, fs := newFakeClient(t, func(method string, params []interface{}) (interface{}, error) {
+		if method == "core.get_methods" {
+			return map[string]interface{}{"auth.generate_token": map[string]interface{}{
+				"accepts": []interface{}{
+					map[string]interface{}{"default": float64(600)},
+					map[string]interface{}{"default": map[string]interface{}{}},
+					map[string]interface{}{"default": true},
+					map[string]interface{}{"default": false},
+				},
+			}}, nil
+		}
+		return "token", nil
+	})
+	e := &SessionTokenEphemeralResource{client: c}
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+
+	tests := map[string]struct {
+		matchOrigin, singleUse interface{}
+		want                   []interface{}
+	}{
+		// Unset arguments are left to the server
+		"unset":        {nil, nil, []interface{}{float64(600), map[string]interface{}{}}},
+		"match_origin": {false, nil, []interface{}{float64(600), map[string]interface{}{}, false}},
+		"both":         {false, true, []interface{}{float64(600), map[string]interface{}{}, false, true}},
+		// match_origin must be sent before single_use, with the server's default
+		"single_use": {nil, true, []interface{}{float64(600), map[string]interface{}{}, true, true}},
+	}
+	for name, tt := range tests {
+		typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		vals := map[string]tftypes.Value{}
+		for attr, attrType := range typ.AttributeTypes {
+			vals[attr] = tftypes.NewValue(attrType, nil)
+		}
+		vals["match_origin"] = tftypes.NewValue(tftypes.Bool, tt.matchOrigin)
+		vals["single_use"] = tftypes.NewValue(tftypes.Bool, tt.singleUse)
+		resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema}}
+		e.Open(ctx, ephemeral.OpenRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, vals)},
+		}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: %v", name, resp.Diagnostics)
+		}
+		calls := fs.Calls()
+		last := calls[len(calls)-1]
+		if last.Method != "auth.generate_token" || !reflect.DeepEqual(last.Params, tt.want) {
+			t.Errorf("%s: expected auth.generate_token %v, got %s %v", name, tt.want, last.Method, last.Params)
+		}
+	}
+}
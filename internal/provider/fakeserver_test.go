@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/gorilla/websocket"
+)
+
+// fakeCall is one method call received by a fakeServer
+type fakeCall struct {
+	Method string
+	Params []interface{}
+}
+
+// fakeServer is a minimal middleware websocket: it accepts any API key and
+// answers every method call through handle
+type fakeServer struct {
+	mu     sync.Mutex
+	calls  []fakeCall
+	handle func(method string, params []interface{}) (interface{}, error)
+}
+
+// newFakeClient starts a fakeServer and returns a client connected to it
+func newFakeClient(t *testing.T, handle func(method string, params []interface{}) (interface{}, error)) (*client.Client, *fakeServer) {
+	t.Helper()
+	fs := &fakeServer{handle: handle}
+	srv := httptest.NewTLSServer(http.HandlerFunc(fs.serve))
+	t.Cleanup(srv.Close)
+
+	c, err := client.NewClient(strings.TrimPrefix(srv.URL, "https://"), "token")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	return c, fs
+}
+
+// Calls returns the methods called so far, leaving out the login
+func (fs *fakeServer) Calls() []fakeCall {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return append([]fakeCall(nil), fs.calls...)
+}
+
+func (fs *fakeServer) serve(w http.ResponseWriter, r *http.Request) {
+	conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	for {
+		var msg struct {
+			Msg    string        `json:"msg"`
+			ID     string        `json:"id"`
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		if err := conn.ReadJSON(&msg); err != nil {
+			return
+		}
+		if msg.Msg != "method" {
+			continue
+		}
+		reply := map[string]interface{}{"msg": "result", "id": msg.ID}
+		if msg.Method == "auth.login_with_api_key" {
+			reply["result"] = true
+		} else {
+			fs.mu.Lock()
+			fs.calls = append(fs.calls, fakeCall{Method: msg.Method, Params: msg.Params})
+			fs.mu.Unlock()
+			result, err := fs.handle(msg.Method, msg.Params)
+			if err != nil {
+				reply["error"] = map[string]interface{}{"reason": err.Error()}
+			} else {
+				reply["result"] = result
+			}
+		}
+		if err := conn.WriteJSON(reply); err != nil {
+			return
+		}
+	}
+}
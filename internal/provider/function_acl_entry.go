@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ACLEntryFunction{}
+
+var (
+	nfs4ACLTags  = map[string]bool{"owner@": true, "group@": true, "everyone@": true, "USER": true, "GROUP": true}
+	posixACLTags = map[string]bool{"USER_OBJ": true, "GROUP_OBJ": true, "OTHER": true, "MASK": true, "USER": true, "GROUP": true}
+	nfs4ACLPerms = map[string]bool{"FULL_CONTROL": true, "MODIFY": true, "READ": true, "TRAVERSE": true}
+)
+
+var posixPermsPattern = regexp.MustCompile(`^[r-][w-][x-]$`)
+
+type ACLEntryFunction struct{}
+
+func NewACLEntryFunction() function.Function {
+	return &ACLEntryFunction{}
+}
+
+func (f *ACLEntryFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "acl_entry"
+}
+
+func (f *ACLEntryFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds an ACL entry",
+		MarkdownDescription: "Builds one entry of a `dacl` list for `filesystem.setacl` and ACL templates. " +
+			"NFSv4 basic permissions (`FULL_CONTROL`, `MODIFY`, `READ`, `TRAVERSE`) give an inheriting `ALLOW` entry; " +
+			"POSIX permissions written as `rwx`, `r-x` etc. give an access (non-default) POSIX1E entry.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "tag",
+				Description: "Who the entry applies to: `owner@`, `group@`, `everyone@`, `USER_OBJ`, `GROUP_OBJ`, `OTHER`, `MASK`, `USER` or `GROUP`.",
+			},
+			function.Int64Parameter{
+				Name:           "id",
+				AllowNullValue: true,
+				Description:    "UID or GID for `USER` and `GROUP`; `null` for every other tag.",
+			},
+			function.StringParameter{
+				Name:        "perms",
+				Description: "NFSv4 basic permission or POSIX `rwx` string.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *ACLEntryFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tag, perms string
+	var id types.Int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tag, &id, &perms))
+	if resp.Error != nil {
+		return
+	}
+	var idValue *int64
+	if !id.IsNull() {
+		v := id.ValueInt64()
+		idValue = &v
+	}
+	entry, argument, err := buildACLEntry(tag, idValue, perms)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(argument, err.Error())
+		return
+	}
+	value, err := interfaceToDynamic(entry)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, value))
+}
+
+// buildACLEntry validates the arguments the way filesystem.setacl does and
+// returns the entry, or the index of the offending argument
+func buildACLEntry(tag string, id *int64, perms string) (map[string]interface{}, int64, error) {
+	nfs4 := nfs4ACLPerms[perms]
+	posix := posixPermsPattern.MatchString(perms)
+	switch {
+	case !nfs4 && !posix:
+		return nil, 2, fmt.Errorf("invalid perms %q: expected FULL_CONTROL, MODIFY, READ or TRAVERSE for NFSv4, or an rwx string such as \"r-x\" for POSIX", perms)
+	case nfs4 && !nfs4ACLTags[tag]:
+		return nil, 0, fmt.Errorf("invalid NFSv4 tag %q: expected owner@, group@, everyone@, USER or GROUP", tag)
+	case posix && !posixACLTags[tag]:
+		return nil, 0, fmt.Errorf("invalid POSIX tag %q: expected USER_OBJ, GROUP_OBJ, OTHER, MASK, USER or GROUP", tag)
+	}
+
+	named := tag == "USER" || tag == "GROUP"
+	var idValue interface{}
+	switch {
+	case named && id == nil:
+		return nil, 1, fmt.Errorf("%s entries need a numeric id", tag)
+	case named && *id < 0:
+		return nil, 1, fmt.Errorf("id must not be negative, got %d", *id)
+	case !named && id != nil:
+		return nil, 1, fmt.Errorf("%s entries take no id; pass null", tag)
+	case named:
+		idValue = *id
+	}
+
+	if nfs4 {
+		return map[string]interface{}{
+			"tag":   tag,
+			"id":    idValue,
+			"type":  "ALLOW",
+			"perms": map[string]interface{}{"BASIC": perms},
+			"flags": map[string]interface{}{"BASIC": "INHERIT"},
+		}, 0, nil
+	}
+	return map[string]interface{}{
+		"tag": tag,
+		"id":  idValue,
+		"perms": map[string]interface{}{
+			"READ":    perms[0] == 'r',
+			"WRITE":   perms[1] == 'w',
+			"EXECUTE": perms[2] == 'x',
+		},
+		"default": false,
+	}, 0, nil
+}
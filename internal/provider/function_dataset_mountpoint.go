@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &DatasetMountpointFunction{}
+
+// datasetComponentPattern matches the characters ZFS allows in a dataset
+// name component
+var datasetComponentPattern = regexp.MustCompile(`^[A-Za-z0-9_.: -]+$`)
+
+// maxDatasetNameLen is ZFS_MAX_DATASET_NAME_LEN less the terminating NUL
+const maxDatasetNameLen = 255
+
+type DatasetMountpointFunction struct{}
+
+func NewDatasetMountpointFunction() function.Function {
+	return &DatasetMountpointFunction{}
+}
+
+func (f *DatasetMountpointFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dataset_mountpoint"
+}
+
+func (f *DatasetMountpointFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Returns the mount path of a dataset",
+		MarkdownDescription: "Maps a dataset name such as `tank/shares/media` to its mount path `/mnt/tank/shares/media`, e.g. for a share's `path`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "dataset",
+				Description: "Dataset name, e.g. `tank/shares/media`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DatasetMountpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var dataset string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &dataset))
+	if resp.Error != nil {
+		return
+	}
+	if err := validateDatasetName(dataset); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, "/mnt/"+dataset))
+}
+
+// validateDatasetName applies the ZFS naming rules to a filesystem name
+func validateDatasetName(name string) error {
+	if name == "" {
+		return fmt.Errorf("dataset name must not be empty")
+	}
+	if strings.HasPrefix(name, "/") {
+		return fmt.Errorf("invalid dataset %q: expected a dataset name such as \"tank/data\", not a path", name)
+	}
+	if strings.ContainsAny(name, "@#") {
+		return fmt.Errorf("invalid dataset %q: snapshots and bookmarks have no mount path", name)
+	}
+	if len(name) > maxDatasetNameLen {
+		return fmt.Errorf("invalid dataset %q: longer than %d characters", name, maxDatasetNameLen)
+	}
+	for i, component := range strings.Split(name, "/") {
+		switch {
+		case component == "":
+			return fmt.Errorf("invalid dataset %q: empty component", name)
+		case component == "." || component == "..":
+			return fmt.Errorf("invalid dataset %q: %q is not a valid component", name, component)
+		case !datasetComponentPattern.MatchString(component):
+			return fmt.Errorf("invalid dataset %q: %q may only contain letters, digits, '_', '-', ':', '.' and spaces", name, component)
+		case i == 0 && !(component[0] >= 'A' && component[0] <= 'Z' || component[0] >= 'a' && component[0] <= 'z'):
+			return fmt.Errorf("invalid dataset %q: pool name must start with a letter", name)
+		}
+	}
+	return nil
+}
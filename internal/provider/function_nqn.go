@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &NQNFunction{}
+
+// nqnBasePattern matches nqn.yyyy-mm.<reverse domain>, optionally followed
+// by :-separated parts
+var nqnBasePattern = regexp.MustCompile(`^nqn\.[0-9]{4}-(0[1-9]|1[0-2])\.[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*(:[^\s:]+)*$`)
+
+// maxNQNLen is the NVMe limit on qualified names, in bytes
+const maxNQNLen = 223
+
+type NQNFunction struct{}
+
+func NewNQNFunction() function.Function {
+	return &NQNFunction{}
+}
+
+func (f *NQNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "nqn"
+}
+
+func (f *NQNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Builds an NVMe qualified name",
+		MarkdownDescription: "Joins a base NQN such as `nqn.2011-06.com.truenas` and a subsystem name into `nqn.2011-06.com.truenas:name`, checking the NVMe naming rules and the 223-byte limit.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "base",
+				Description: "Base NQN: `nqn.`, the year and month the domain was registered, and the reverse domain name.",
+			},
+			function.StringParameter{
+				Name:        "name",
+				Description: "Subsystem name appended after `:`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NQNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var base, name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &base, &name))
+	if resp.Error != nil {
+		return
+	}
+	nqn, argument, err := buildNQN(base, name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(argument, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, nqn))
+}
+
+// buildNQN validates and joins the parts, returning the index of the
+// offending argument on error
+func buildNQN(base, name string) (string, int64, error) {
+	if !nqnBasePattern.MatchString(base) {
+		return "", 0, fmt.Errorf("invalid base NQN %q: expected nqn.yyyy-mm.reverse.domain, e.g. \"nqn.2011-06.com.truenas\"", base)
+	}
+	if name == "" || strings.ContainsAny(name, " \t\n:") {
+		return "", 1, fmt.Errorf("invalid name %q: must be non-empty without whitespace or ':'", name)
+	}
+	nqn := base + ":" + name
+	if len(nqn) > maxNQNLen {
+		return "", 1, fmt.Errorf("NQN %q is %d bytes, longer than the %d-byte limit", nqn, len(nqn), maxNQNLen)
+	}
+	return nqn, 0, nil
+}
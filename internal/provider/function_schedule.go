@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ScheduleFunction{}
+
+// scheduleFields are the fields of a TrueNAS schedule object, in cron order
+var scheduleFields = []struct {
+	name     string
+	min, max int
+	names    []string
+}{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "dom", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "dow", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+var scheduleShortcuts = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * sun",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+}
+
+type ScheduleFunction struct{}
+
+func NewScheduleFunction() function.Function {
+	return &ScheduleFunction{}
+}
+
+func (f *ScheduleFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule"
+}
+
+func (f *ScheduleFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	attrTypes := map[string]attr.Type{}
+	for _, field := range scheduleFields {
+		attrTypes[field.name] = types.StringType
+	}
+	resp.Definition = function.Definition{
+		Summary:             "Builds a schedule object from a cron expression",
+		MarkdownDescription: "Converts a five-field cron expression such as `0 3 * * sun`, or a shortcut such as `@daily`, into the `minute`, `hour`, `dom`, `month` and `dow` object used by snapshot tasks, replication, rsync tasks and cron jobs.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cron",
+				Description: "Cron expression: minute, hour, day of month, month and day of week.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: attrTypes},
+	}
+}
+
+func (f *ScheduleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cron string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cron))
+	if resp.Error != nil {
+		return
+	}
+	schedule, err := parseSchedule(cron)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	attrTypes := map[string]attr.Type{}
+	values := map[string]attr.Value{}
+	for k, v := range schedule {
+		attrTypes[k] = types.StringType
+		values[k] = types.StringValue(v)
+	}
+	obj, diags := types.ObjectValue(attrTypes, values)
+	resp.Error = function.ConcatFuncErrors(function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, obj))
+}
+
+// parseSchedule splits and validates a cron expression
+func parseSchedule(cron string) (map[string]string, error) {
+	expr := strings.TrimSpace(cron)
+	if shortcut, ok := scheduleShortcuts[strings.ToLower(expr)]; ok {
+		expr = shortcut
+	}
+	parts := strings.Fields(expr)
+	if len(parts) != len(scheduleFields) {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields (minute hour dom month dow), got %d", cron, len(parts))
+	}
+	schedule := make(map[string]string, len(parts))
+	for i, field := range scheduleFields {
+		value := strings.ToLower(parts[i])
+		for _, item := range strings.Split(value, ",") {
+			if err := validateCronItem(item, field.min, field.max, field.names); err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %s", field.name, parts[i], err)
+			}
+		}
+		schedule[field.name] = value
+	}
+	return schedule, nil
+}
+
+// validateCronItem checks one comma-separated item: *, a, a-b, optionally
+// followed by /step
+func validateCronItem(item string, min, max int, names []string) error {
+	rng, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n < 1 {
+			return fmt.Errorf("step %q must be a positive number", step)
+		}
+	}
+	if rng == "*" {
+		return nil
+	}
+	lo, hi, isRange := strings.Cut(rng, "-")
+	start, err := cronValue(lo, min, max, names)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		if hasStep {
+			return fmt.Errorf("a step needs * or a range, got %q", item)
+		}
+		return nil
+	}
+	end, err := cronValue(hi, min, max, names)
+	if err != nil {
+		return err
+	}
+	if end < start {
+		return fmt.Errorf("range %q ends before it starts", rng)
+	}
+	return nil
+}
+
+func cronValue(s string, min, max int, names []string) (int, error) {
+	for i, name := range names {
+		if s == name {
+			return i + min, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if n < min || n > max {
+		return 0, fmt.Errorf("%d is outside %d-%d", n, min, max)
+	}
+	return n, nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &SizeToBytesFunction{}
+
+var sizePattern = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([KMGTPE]?)(?:I?B?)$`)
+
+// sizeUnits are binary multiples, as the TrueNAS UI interprets "500G"
+var sizeUnits = map[string]int64{
+	"":  1,
+	"K": 1 << 10,
+	"M": 1 << 20,
+	"G": 1 << 30,
+	"T": 1 << 40,
+	"P": 1 << 50,
+	"E": 1 << 60,
+}
+
+type SizeToBytesFunction struct{}
+
+func NewSizeToBytesFunction() function.Function {
+	return &SizeToBytesFunction{}
+}
+
+func (f *SizeToBytesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "size_to_bytes"
+}
+
+func (f *SizeToBytesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Converts a human-readable size to bytes",
+		MarkdownDescription: "Converts a size such as `500G`, `1.5T` or `512MiB` to bytes for `quota`, `refquota`, `volsize` and `reservation`. Units are binary (`1K` = 1024). A plain number is taken as bytes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "size",
+				Description: "Size with an optional K, M, G, T, P or E suffix.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *SizeToBytesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var size string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &size))
+	if resp.Error != nil {
+		return
+	}
+	n, err := sizeToBytes(size)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, n))
+}
+
+// sizeToBytes parses size into a whole number of bytes
+func sizeToBytes(size string) (int64, error) {
+	m := sizePattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(size)))
+	if m == nil {
+		return 0, fmt.Errorf("invalid size %q: expected a number with an optional K, M, G, T, P or E suffix, e.g. \"500G\"", size)
+	}
+	value, ok := new(big.Float).SetPrec(128).SetString(m[1])
+	if !ok {
+		return 0, fmt.Errorf("invalid size %q", size)
+	}
+	value.Mul(value, new(big.Float).SetInt64(sizeUnits[m[2]]))
+	if !value.IsInt() {
+		return 0, fmt.Errorf("size %q is not a whole number of bytes", size)
+	}
+	n, accuracy := value.Int64()
+	if accuracy != big.Exact || n == math.MaxInt64 {
+		return 0, fmt.Errorf("size %q is too large", size)
+	}
+	return n, nil
+}
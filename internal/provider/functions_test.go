@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSizeToBytes(t *testing.T) {
+	cases := map[string]int64{
+		"1024":   1024,
+		"500G":   500 << 30,
+		"1.5T":   3 << 39,
+		"512MiB": 512 << 20,
+		"10 gb":  10 << 30,
+		"1k":     1024,
+		"0":      0,
+	}
+	for in, want := range cases {
+		got, err := sizeToBytes(in)
+		if err != nil {
+			t.Errorf("sizeToBytes(%q): %s", in, err)
+		} else if got != want {
+			t.Errorf("sizeToBytes(%q) = %d, want %d", in, got, want)
+		}
+	}
+	for _, in := range []string{"", "G", "-1G", "1.1", "0.1K", "10X", "16E"} {
+		if _, err := sizeToBytes(in); err == nil {
+			t.Errorf("Expected sizeToBytes(%q) to fail", in)
+		}
+	}
+}
+
+func TestSizeToBytesFunction_Run(t *testing.T) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.Int64Unknown())}
+	NewSizeToBytesFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("2G")}),
+	}, resp)
+	if resp.Error != nil {
+		t.Fatal(resp.Error)
+	}
+	if got := resp.Result.Value().(types.Int64).ValueInt64(); got != 2<<30 {
+		t.Errorf("Expected %d, got %d", 2<<30, got)
+	}
+
+	resp = &function.RunResponse{Result: function.NewResultData(types.Int64Unknown())}
+	NewSizeToBytesFunction().Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("lots")}),
+	}, resp)
+	if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("Expected an error on argument 0, got %v", resp.Error)
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	got, err := parseSchedule("0 3 * * SUN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"minute": "0", "hour": "3", "dom": "*", "month": "*", "dow": "sun"}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %q, want %q", k, got[k], v)
+		}
+	}
+
+	got, err = parseSchedule("@daily")
+	if err != nil || got["minute"] != "0" || got["hour"] != "0" {
+		t.Errorf("Unexpected @daily schedule %v (%v)", got, err)
+	}
+
+	for _, in := range []string{"*/15 8-18 1,15 jan-jun mon-fri", "0 0 * * 7", "5-55/10 * * * *"} {
+		if _, err := parseSchedule(in); err != nil {
+			t.Errorf("parseSchedule(%q): %s", in, err)
+		}
+	}
+	for _, in := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5/10 * * * *", "10-5 * * * *", "* * * * funday"} {
+		if _, err := parseSchedule(in); err == nil {
+			t.Errorf("Expected parseSchedule(%q) to fail", in)
+		}
+	}
+}
+
+func TestValidateDatasetName(t *testing.T) {
+	for _, in := range []string{"tank", "tank/shares/media", "tank/my data", "Pool1/a.b:c-d_e"} {
+		if err := validateDatasetName(in); err != nil {
+			t.Errorf("validateDatasetName(%q): %s", in, err)
+		}
+	}
+	for _, in := range []string{"", "/mnt/tank", "tank/", "tank//a", "tank/a@snap", "tank/..", "1tank/a", "tank/a*b", "tank/" + strings.Repeat("a", 255)} {
+		if err := validateDatasetName(in); err == nil {
+			t.Errorf("Expected validateDatasetName(%q) to fail", in)
+		}
+	}
+}
+
+func TestBuildACLEntry(t *testing.T) {
+	uid := int64(1000)
+	entry, _, err := buildACLEntry("USER", &uid, "MODIFY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry["type"] != "ALLOW" || entry["id"] != uid || entry["perms"].(map[string]interface{})["BASIC"] != "MODIFY" {
+		t.Errorf("Unexpected NFSv4 entry %v", entry)
+	}
+
+	entry, _, err = buildACLEntry("GROUP_OBJ", nil, "r-x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	perms := entry["perms"].(map[string]interface{})
+	if perms["READ"] != true || perms["WRITE"] != false || perms["EXECUTE"] != true || entry["id"] != nil {
+		t.Errorf("Unexpected POSIX entry %v", entry)
+	}
+
+	negative := int64(-1)
+	failures := []struct {
+		tag      string
+		id       *int64
+		perms    string
+		argument int64
+	}{
+		{"USER", &uid, "rw", 2},
+		{"MASK", nil, "MODIFY", 0},
+		{"everyone@", nil, "rwx", 0},
+		{"USER", nil, "READ", 1},
+		{"GROUP", &negative, "READ", 1},
+		{"owner@", &uid, "FULL_CONTROL", 1},
+	}
+	for _, f := range failures {
+		_, argument, err := buildACLEntry(f.tag, f.id, f.perms)
+		if err == nil {
+			t.Errorf("Expected buildACLEntry(%s, %v, %s) to fail", f.tag, f.id, f.perms)
+		} else if argument != f.argument {
+			t.Errorf("buildACLEntry(%s, %v, %s) blamed argument %d, want %d", f.tag, f.id, f.perms, argument, f.argument)
+		}
+	}
+}
+
+func TestBuildNQN(t *testing.T) {
+	got, _, err := buildNQN("nqn.2011-06.com.truenas", "vm-disks")
+	if err != nil || got != "nqn.2011-06.com.truenas:vm-disks" {
+		t.Errorf("Unexpected NQN %q (%v)", got, err)
+	}
+	if _, _, err := buildNQN("nqn.2014-08.org.nvmexpress:uuid", "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"); err != nil {
+		t.Errorf("Expected a UUID NQN to be accepted: %s", err)
+	}
+	failures := []struct {
+		base, name string
+		argument   int64
+	}{
+		{"iqn.2011-06.com.truenas", "a", 0},
+		{"nqn.2011-13.com.truenas", "a", 0},
+		{"nqn.2011-06.-truenas", "a", 0},
+		{"nqn.2011-06.com.truenas", "", 1},
+		{"nqn.2011-06.com.truenas", "a b", 1},
+		{"nqn.2011-06.com.truenas", strings.Repeat("a", 200), 1},
+	}
+	for _, f := range failures {
+		_, argument, err := buildNQN(f.base, f.name)
+		if err == nil {
+			t.Errorf("Expected buildNQN(%q, %q) to fail", f.base, f.name)
+		} else if argument != f.argument {
+			t.Errorf("buildNQN(%q, %q) blamed argument %d, want %d", f.base, f.name, argument, f.argument)
+		}
+	}
+}
+
+func TestFunctions_Run(t *testing.T) {
+	ctx := context.Background()
+
+	resp := &function.RunResponse{Result: function.NewResultData(types.DynamicUnknown())}
+	NewACLEntryFunction().Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("everyone@"), types.Int64Null(), types.StringValue("READ")}),
+	}, resp)
+	if resp.Error != nil {
+		t.Fatalf("acl_entry: %s", resp.Error)
+	}
+	if _, ok := resp.Result.Value().(types.Dynamic).UnderlyingValue().(types.Object); !ok {
+		t.Errorf("Expected acl_entry to return an object, got %s", resp.Result.Value())
+	}
+
+	var def function.DefinitionResponse
+	NewScheduleFunction().Definition(ctx, function.DefinitionRequest{}, &def)
+	resp = &function.RunResponse{Result: function.NewResultData(def.Definition.Return.GetType().ValueType(ctx).(attr.Value))}
+	NewScheduleFunction().Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("30 2 * * *")}),
+	}, resp)
+	if resp.Error != nil {
+		t.Fatalf("schedule: %s", resp.Error)
+	}
+	hour := resp.Result.Value().(types.Object).Attributes()["hour"].(types.String)
+	if hour.ValueString() != "2" {
+		t.Errorf("Expected hour 2, got %s", hour)
+	}
+}
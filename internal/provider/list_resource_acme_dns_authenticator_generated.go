@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &AcmeDnsAuthenticatorListResource{}
+
+type AcmeDnsAuthenticatorListResource struct {
+	client *client.Client
+}
+
+func NewAcmeDnsAuthenticatorListResource() list.ListResource {
+	return &AcmeDnsAuthenticatorListResource{}
+}
+
+func (l *AcmeDnsAuthenticatorListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acme_dns_authenticator"
+}
+
+func (l *AcmeDnsAuthenticatorListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listQuerySchema("Lists acme_dns_authenticator instances through `acme.dns.authenticator.query`, for `terraform query` to generate their configuration and import blocks.")
+}
+
+func (l *AcmeDnsAuthenticatorListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected List Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	l.client = client
+}
+
+func (l *AcmeDnsAuthenticatorListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = queryList(ctx, l.client, "acme.dns.authenticator.query", req, func(resultMap map[string]interface{}, result *list.ListResult) {
+		result.DisplayName = listDisplayName(resultMap, "name")
+		result.Diagnostics.Append(setIdentity(ctx, result.Identity, acmeDnsAuthenticatorIdentity, resultMap)...)
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return
+		}
+
+		// Same attributes as the resource's Read
+		var data AcmeDnsAuthenticatorResourceModel
+		result.Diagnostics.Append(nullAttributes(ctx, result.Resource)...)
+		result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			data.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["attributes"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Attributes = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Attributes = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Attributes = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+		if v, ok := resultMap["name"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Name = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Name = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &AppListResource{}
+
+type AppListResource struct {
+	client *client.Client
+}
+
+func NewAppListResource() list.ListResource {
+	return &AppListResource{}
+}
+
+func (l *AppListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app"
+}
+
+func (l *AppListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listQuerySchema("Lists app instances through `app.query`, for `terraform query` to generate their configuration and import blocks.")
+}
+
+func (l *AppListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected List Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	l.client = client
+}
+
+func (l *AppListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = queryList(ctx, l.client, "app.query", req, func(resultMap map[string]interface{}, result *list.ListResult) {
+		result.DisplayName = listDisplayName(resultMap, "name")
+		result.Diagnostics.Append(setIdentity(ctx, result.Identity, appIdentity, resultMap)...)
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return
+		}
+
+		// Same attributes as the resource's Read
+		var data AppResourceModel
+		result.Diagnostics.Append(nullAttributes(ctx, result.Resource)...)
+		result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			data.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &AppRegistryListResource{}
+
+type AppRegistryListResource struct {
+	client *client.Client
+}
+
+func NewAppRegistryListResource() list.ListResource {
+	return &AppRegistryListResource{}
+}
+
+func (l *AppRegistryListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_registry"
+}
+
+func (l *AppRegistryListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listQuerySchema("Lists app_registry instances through `app.registry.query`, for `terraform query` to generate their configuration and import blocks.")
+}
+
+func (l *AppRegistryListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected List Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	l.client = client
+}
+
+func (l *AppRegistryListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = queryList(ctx, l.client, "app.registry.query", req, func(resultMap map[string]interface{}, result *list.ListResult) {
+		result.DisplayName = listDisplayName(resultMap, "name")
+		result.Diagnostics.Append(setIdentity(ctx, result.Identity, appRegistryIdentity, resultMap)...)
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return
+		}
+
+		// Same attributes as the resource's Read
+		var data AppRegistryResourceModel
+		result.Diagnostics.Append(nullAttributes(ctx, result.Resource)...)
+		result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			data.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["name"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Name = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Name = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+		if v, ok := resultMap["username"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Username = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Username = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Username = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+		if v, ok := resultMap["password"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Password = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Password = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Password = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
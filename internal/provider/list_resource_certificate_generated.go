@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &CertificateListResource{}
+
+type CertificateListResource struct {
+	client *client.Client
+}
+
+func NewCertificateListResource() list.ListResource {
+	return &CertificateListResource{}
+}
+
+func (l *CertificateListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (l *CertificateListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listQuerySchema("Lists certificate instances through `certificate.query`, for `terraform query` to generate their configuration and import blocks.")
+}
+
+func (l *CertificateListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected List Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	l.client = client
+}
+
+func (l *CertificateListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = queryList(ctx, l.client, "certificate.query", req, func(resultMap map[string]interface{}, result *list.ListResult) {
+		result.DisplayName = listDisplayName(resultMap, "name")
+		result.Diagnostics.Append(setIdentity(ctx, result.Identity, certificateIdentity, resultMap)...)
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return
+		}
+
+		// Same attributes as the resource's Read
+		var data CertificateResourceModel
+		result.Diagnostics.Append(nullAttributes(ctx, result.Resource)...)
+		result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			data.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["name"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Name = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Name = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = &ReplicationListResource{}
+
+type ReplicationListResource struct {
+	client *client.Client
+}
+
+func NewReplicationListResource() list.ListResource {
+	return &ReplicationListResource{}
+}
+
+func (l *ReplicationListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication"
+}
+
+func (l *ReplicationListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listQuerySchema("Lists replication instances through `replication.query`, for `terraform query` to generate their configuration and import blocks.")
+}
+
+func (l *ReplicationListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected List Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	l.client = client
+}
+
+func (l *ReplicationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = queryList(ctx, l.client, "replication.query", req, func(resultMap map[string]interface{}, result *list.ListResult) {
+		result.DisplayName = listDisplayName(resultMap, "name")
+		result.Diagnostics.Append(setIdentity(ctx, result.Identity, replicationIdentity, resultMap)...)
+		if !req.IncludeResource || result.Diagnostics.HasError() {
+			return
+		}
+
+		// Same attributes as the resource's Read
+		var data ReplicationResourceModel
+		result.Diagnostics.Append(nullAttributes(ctx, result.Resource)...)
+		result.Diagnostics.Append(result.Resource.Get(ctx, &data)...)
+		if result.Diagnostics.HasError() {
+			return
+		}
+		if v, ok := resultMap["id"]; ok && v != nil {
+			data.ID = types.StringValue(fmt.Sprintf("%v", v))
+		}
+		if v, ok := resultMap["name"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Name = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Name = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+		if v, ok := resultMap["direction"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Direction = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Direction = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Direction = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+		if v, ok := resultMap["transport"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Transport = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Transport = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Transport = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+		if v, ok := resultMap["source_datasets"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.SourceDatasets, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+		if v, ok := resultMap["target_dataset"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.TargetDataset = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.TargetDataset = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.TargetDataset = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+		if v, ok := resultMap["recursive"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Recursive = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["auto"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Auto = types.BoolValue(bv)
+			}
+		}
+		if v, ok := resultMap["retention_policy"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.RetentionPolicy = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.RetentionPolicy = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.RetentionPolicy = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
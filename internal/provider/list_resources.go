@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ListQueryModel is the config of every list resource: the filter and
+// order_by of the query data sources. The list block's own limit caps results.
+type ListQueryModel struct {
+	Filter  []QueryFilterModel `tfsdk:"filter"`
+	OrderBy types.List         `tfsdk:"order_by"`
+}
+
+// listQuerySchema returns the config schema backing ListQueryModel
+func listQuerySchema(description string) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Server-side filters, combined with AND. Translated into TrueNAS query-filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Required:    true,
+							Description: "Field to compare. Nested fields use dot notation, e.g. `status.state`.",
+						},
+						"operator": schema.StringAttribute{
+							Optional:    true,
+							Description: "Comparison operator. Default: `=`",
+							Validators:  []validator.String{stringvalidator.OneOf(queryOperators...)},
+						},
+						"value": schema.StringAttribute{
+							Optional:    true,
+							Description: "Value to compare against, sent as a string.",
+						},
+						"value_json": schema.StringAttribute{
+							Optional:    true,
+							Description: "Value to compare against as JSON, for numbers, booleans, null or the lists used by `in`/`nin`, e.g. `jsonencode([1, 2])`.",
+						},
+					},
+					Validators: []validator.Object{
+						objectvalidator.ExactlyOneOf(
+							path.MatchRelative().AtName("value"),
+							path.MatchRelative().AtName("value_json"),
+						),
+					},
+				},
+			},
+			"order_by": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Fields to sort by. Prefix a field with `-` for descending order.",
+			},
+		},
+	}
+}
+
+// queryList runs method with the list config and returns one result per
+// record. fill sets the identity, display name and, when requested, the
+// resource of each result.
+func queryList(ctx context.Context, c *client.Client, method string, req list.ListRequest, fill func(obj map[string]interface{}, result *list.ListResult)) iter.Seq[list.ListResult] {
+	var config ListQueryModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		return list.ListResultsStreamDiagnostics(diags)
+	}
+
+	args := QueryArgsModel{Filter: config.Filter, OrderBy: config.OrderBy}
+	if req.Limit > 0 {
+		args.Limit = types.Int64Value(req.Limit)
+	}
+	params, diags := args.QueryParams(ctx)
+	if diags.HasError() {
+		return list.ListResultsStreamDiagnostics(diags)
+	}
+
+	result, err := c.Call(method, params)
+	if err != nil {
+		diags.AddError("List Error", fmt.Sprintf("Unable to query %s: %s", method, err))
+		return list.ListResultsStreamDiagnostics(diags)
+	}
+	items, ok := result.([]interface{})
+	if !ok {
+		diags.AddError("List Error", fmt.Sprintf("Unexpected %s response: %T", method, result))
+		return list.ListResultsStreamDiagnostics(diags)
+	}
+
+	return func(push func(list.ListResult) bool) {
+		for _, item := range items {
+			obj, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			res := req.NewListResult(ctx)
+			fill(obj, &res)
+			if !push(res) {
+				return
+			}
+		}
+	}
+}
+
+// listDisplayName is the field value a list result is shown by, falling back
+// to the ID
+func listDisplayName(obj map[string]interface{}, field string) string {
+	if v, ok := obj[field]; ok && v != nil && fmt.Sprintf("%v", v) != "" {
+		return fmt.Sprintf("%v", v)
+	}
+	return fmt.Sprintf("%v", obj["id"])
+}
+
+// nullAttributes replaces a null resource with an object whose attributes are
+// all null, so it can be read into a resource model and filled in
+func nullAttributes(ctx context.Context, res *tfsdk.Resource) diag.Diagnostics {
+	var diags diag.Diagnostics
+	typ, ok := res.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		diags.AddError("List Error", "Resource schema is not an object")
+		return diags
+	}
+	vals := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, t := range typ.AttributeTypes {
+		vals[name] = tftypes.NewValue(t, nil)
+	}
+	res.Raw = tftypes.NewValue(typ, vals)
+	return diags
+}
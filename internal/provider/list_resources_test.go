@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProvider_ListResources(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("GetProviderSchema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+	idResp, err := server.GetResourceIdentitySchemas(context.Background(), &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{
+		"truenas_pool_dataset", "truenas_sharing_nfs", "truenas_sharing_smb",
+		"truenas_user", "truenas_group", "truenas_pool_snapshottask",
+		"truenas_replication", "truenas_vm", "truenas_app",
+		"truenas_iscsi_target", "truenas_iscsi_extent", "truenas_iscsi_portal",
+		"truenas_iscsi_initiator", "truenas_iscsi_auth", "truenas_iscsi_targetextent",
+		"truenas_cronjob",
+	} {
+		s, ok := resp.ListResourceSchemas[name]
+		if !ok {
+			t.Errorf("Expected list resource %s", name)
+			continue
+		}
+		attrs := map[string]bool{}
+		for _, a := range s.Block.Attributes {
+			attrs[a.Name] = true
+		}
+		if !attrs["filter"] || !attrs["order_by"] {
+			t.Errorf("Expected %s to accept filter and order_by", name)
+		}
+		if _, ok := idResp.IdentitySchemas[name]; !ok {
+			t.Errorf("Expected resource %s to have an identity", name)
+		}
+	}
+}
+
+func TestListResources_Configure(t *testing.T) {
+	for _, f := range New("test")().(*TrueNASProvider).ListResources(context.Background()) {
+		l := f().(list.ListResourceWithConfigure)
+		resp := &resource.ConfigureResponse{}
+		l.Configure(context.Background(), resource.ConfigureRequest{ProviderData: "not a client"}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("Expected %T to reject unexpected provider data", l)
+		}
+	}
+}
+
+func TestSetIdentity(t *testing.T) {
+	ctx := context.Background()
+	keys := []identityKey{{Name: "name"}, {Name: "target", Int: true}}
+	s := identitySchema(keys)
+	identity := &tfsdk.ResourceIdentity{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+	}
+
+	diags := setIdentity(ctx, identity, keys, map[string]interface{}{"id": float64(3), "name": "lun0", "target": float64(7)})
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	var name types.String
+	var target types.Int64
+	identity.GetAttribute(ctx, path.Root("name"), &name)
+	identity.GetAttribute(ctx, path.Root("target"), &target)
+	if name.ValueString() != "lun0" || target.ValueInt64() != 7 {
+		t.Errorf("Expected name lun0 and target 7, got %s and %d", name, target.ValueInt64())
+	}
+
+	if !setIdentity(ctx, identity, keys, map[string]interface{}{"name": "lun0"}).HasError() {
+		t.Error("Expected an error when a key is missing from the response")
+	}
+	if !setIdentity(ctx, identity, keys, map[string]interface{}{"name": "lun0", "target": "7"}).HasError() {
+		t.Error("Expected an error when an Int key is not a number")
+	}
+}
+
+func TestDescribeFilters(t *testing.T) {
+	got := describeFilters([]interface{}{
+		[]interface{}{"pool", "=", "tank"},
+		[]interface{}{"target", "=", int64(7)},
+	})
+	want := `pool = "tank" and target = 7`
+	if got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
+
+func TestNullAttributes(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	NewUserResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	res := &tfsdk.Resource{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	if diags := nullAttributes(ctx, res); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	var data UserResourceModel
+	if diags := res.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("Expected a null resource to read into the model: %v", diags)
+	}
+	data.Username = types.StringValue("alice")
+	if diags := res.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &AlertRestoreAction{}
+
+type AlertRestoreAction struct {
+	client *client.Client
+}
+
+type AlertRestoreActionModel struct {
+	Uuid types.String `tfsdk:"uuid"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewAlertRestoreAction() action.Action {
+	return &AlertRestoreAction{}
+}
+
+func (a *AlertRestoreAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_restore"
+}
+
+func (a *AlertRestoreAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restore `id` alert which had been dismissed.",
+		Attributes: map[string]schema.Attribute{
+			"uuid": schema.StringAttribute{Required: true, MarkdownDescription: "UUID of the dismissed alert to restore."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *AlertRestoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *AlertRestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data AlertRestoreActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.Uuid.ValueString())
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.CallWithTimeout("alert.restore", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute alert.restore: %s", err.Error()))
+		return
+	}
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &AppConvert_To_CustomAction{}
+
+type AppConvert_To_CustomAction struct {
+	client *client.Client
+}
+
+type AppConvert_To_CustomActionModel struct {
+	AppName types.String `tfsdk:"app_name"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewAppConvert_To_CustomAction() action.Action {
+	return &AppConvert_To_CustomAction{}
+}
+
+func (a *AppConvert_To_CustomAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_convert_to_custom"
+}
+
+func (a *AppConvert_To_CustomAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Convert `app_name` to a custom app.",
+		Attributes: map[string]schema.Attribute{
+			"app_name": schema.StringAttribute{Required: true, MarkdownDescription: "Name of the catalog application to convert to a custom application."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *AppConvert_To_CustomAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *AppConvert_To_CustomAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data AppConvert_To_CustomActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.AppName.ValueString())
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := a.client.CallWithTimeout("app.convert_to_custom", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute app.convert_to_custom: %s", err.Error()))
+		return
+	}
+
+	// Background job - wait for completion, forwarding progress to Terraform
+	if jobID, ok := result.(float64); ok {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("app.convert_to_custom: started job %d", int(jobID))})
+		_, err := a.client.WaitForJobWithProgress(int(jobID), invokeTimeout, func(percent float64, description string) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("app.convert_to_custom: %.0f%% %s", percent, description)})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Job Failed", fmt.Sprintf("app.convert_to_custom job %d: %s", int(jobID), err.Error()))
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &AppImagePullAction{}
+
+type AppImagePullAction struct {
+	client *client.Client
+}
+
+type AppImagePullActionModel struct {
+	ImagePull types.String `tfsdk:"image_pull"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewAppImagePullAction() action.Action {
+	return &AppImagePullAction{}
+}
+
+func (a *AppImagePullAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_image_pull"
+}
+
+func (a *AppImagePullAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "`image` is the name of the image to pull. Format for the name is \"registry/repo/image:v1.2.3\" where registry may be omitted and it will default to docker registry in this case. It can or cannot cont",
+		Attributes: map[string]schema.Attribute{
+			"image_pull": schema.StringAttribute{Required: true, MarkdownDescription: "AppImagePullArgs parameters."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *AppImagePullAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *AppImagePullAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data AppImagePullActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.ImagePull.ValueString())
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := a.client.CallWithTimeout("app.image.pull", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute app.image.pull: %s", err.Error()))
+		return
+	}
+
+	// Background job - wait for completion, forwarding progress to Terraform
+	if jobID, ok := result.(float64); ok {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("app.image.pull: started job %d", int(jobID))})
+		_, err := a.client.WaitForJobWithProgress(int(jobID), invokeTimeout, func(percent float64, description string) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("app.image.pull: %.0f%% %s", percent, description)})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Job Failed", fmt.Sprintf("app.image.pull job %d: %s", int(jobID), err.Error()))
+		}
+	}
+}
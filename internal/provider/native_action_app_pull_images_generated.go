@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &AppPull_ImagesAction{}
+
+type AppPull_ImagesAction struct {
+	client *client.Client
+}
+
+type AppPull_ImagesActionModel struct {
+	AppName types.String `tfsdk:"app_name"`
+	Options types.String `tfsdk:"options"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewAppPull_ImagesAction() action.Action {
+	return &AppPull_ImagesAction{}
+}
+
+func (a *AppPull_ImagesAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_pull_images"
+}
+
+func (a *AppPull_ImagesAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pulls docker images for the specified app `name`.",
+		Attributes: map[string]schema.Attribute{
+			"app_name": schema.StringAttribute{Required: true, MarkdownDescription: "Name of the application to pull images for."},
+			"options":  schema.StringAttribute{Optional: true, MarkdownDescription: "Options for pulling images including whether to redeploy."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *AppPull_ImagesAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *AppPull_ImagesAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data AppPull_ImagesActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.AppName.ValueString())
+	if !data.Options.IsNull() {
+		params = append(params, data.Options.ValueString())
+	}
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := a.client.CallWithTimeout("app.pull_images", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute app.pull_images: %s", err.Error()))
+		return
+	}
+
+	// Background job - wait for completion, forwarding progress to Terraform
+	if jobID, ok := result.(float64); ok {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("app.pull_images: started job %d", int(jobID))})
+		_, err := a.client.WaitForJobWithProgress(int(jobID), invokeTimeout, func(percent float64, description string) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("app.pull_images: %.0f%% %s", percent, description)})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Job Failed", fmt.Sprintf("app.pull_images job %d: %s", int(jobID), err.Error()))
+		}
+	}
+}
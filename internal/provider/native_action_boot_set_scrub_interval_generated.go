@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &BootSet_Scrub_IntervalAction{}
+
+type BootSet_Scrub_IntervalAction struct {
+	client *client.Client
+}
+
+type BootSet_Scrub_IntervalActionModel struct {
+	Interval types.Int64 `tfsdk:"interval"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewBootSet_Scrub_IntervalAction() action.Action {
+	return &BootSet_Scrub_IntervalAction{}
+}
+
+func (a *BootSet_Scrub_IntervalAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_boot_set_scrub_interval"
+}
+
+func (a *BootSet_Scrub_IntervalAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Set Automatic Scrub Interval value in days.",
+		Attributes: map[string]schema.Attribute{
+			"interval": schema.Int64Attribute{Required: true, MarkdownDescription: "Scrub interval in days (must be a positive integer)."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *BootSet_Scrub_IntervalAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *BootSet_Scrub_IntervalAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data BootSet_Scrub_IntervalActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.Interval.ValueInt64())
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.CallWithTimeout("boot.set_scrub_interval", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute boot.set_scrub_interval: %s", err.Error()))
+		return
+	}
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &Cloud_BackupDelete_SnapshotAction{}
+
+type Cloud_BackupDelete_SnapshotAction struct {
+	client *client.Client
+}
+
+type Cloud_BackupDelete_SnapshotActionModel struct {
+	Id         types.Int64  `tfsdk:"id"`
+	SnapshotId types.String `tfsdk:"snapshot_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewCloud_BackupDelete_SnapshotAction() action.Action {
+	return &Cloud_BackupDelete_SnapshotAction{}
+}
+
+func (a *Cloud_BackupDelete_SnapshotAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_backup_delete_snapshot"
+}
+
+func (a *Cloud_BackupDelete_SnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Delete snapshot `snapshot_id` created by the cloud backup job `id`.",
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.Int64Attribute{Required: true, MarkdownDescription: "The cloud backup task ID."},
+			"snapshot_id": schema.StringAttribute{Required: true, MarkdownDescription: "ID of the snapshot to delete."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *Cloud_BackupDelete_SnapshotAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *Cloud_BackupDelete_SnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data Cloud_BackupDelete_SnapshotActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.Id.ValueInt64())
+	params = append(params, data.SnapshotId.ValueString())
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := a.client.CallWithTimeout("cloud_backup.delete_snapshot", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute cloud_backup.delete_snapshot: %s", err.Error()))
+		return
+	}
+
+	// Background job - wait for completion, forwarding progress to Terraform
+	if jobID, ok := result.(float64); ok {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("cloud_backup.delete_snapshot: started job %d", int(jobID))})
+		_, err := a.client.WaitForJobWithProgress(int(jobID), invokeTimeout, func(percent float64, description string) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("cloud_backup.delete_snapshot: %.0f%% %s", percent, description)})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Job Failed", fmt.Sprintf("cloud_backup.delete_snapshot job %d: %s", int(jobID), err.Error()))
+		}
+	}
+}
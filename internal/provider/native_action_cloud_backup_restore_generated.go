@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &Cloud_BackupRestoreAction{}
+
+type Cloud_BackupRestoreAction struct {
+	client *client.Client
+}
+
+type Cloud_BackupRestoreActionModel struct {
+	Id              types.Int64  `tfsdk:"id"`
+	SnapshotId      types.String `tfsdk:"snapshot_id"`
+	Subfolder       types.String `tfsdk:"subfolder"`
+	DestinationPath types.String `tfsdk:"destination_path"`
+	Options         types.String `tfsdk:"options"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewCloud_BackupRestoreAction() action.Action {
+	return &Cloud_BackupRestoreAction{}
+}
+
+func (a *Cloud_BackupRestoreAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_backup_restore"
+}
+
+func (a *Cloud_BackupRestoreAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restore files to the directory `destination_path` from the `snapshot_id` subfolder `subfolder` created by the cloud backup job `id`.",
+		Attributes: map[string]schema.Attribute{
+			"id":               schema.Int64Attribute{Required: true, MarkdownDescription: "ID of the cloud backup task."},
+			"snapshot_id":      schema.StringAttribute{Required: true, MarkdownDescription: "ID of the snapshot to restore."},
+			"subfolder":        schema.StringAttribute{Required: true, MarkdownDescription: "Path within the snapshot to restore."},
+			"destination_path": schema.StringAttribute{Required: true, MarkdownDescription: "Local path to restore to."},
+			"options":          schema.StringAttribute{Optional: true, MarkdownDescription: "Additional restore options."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *Cloud_BackupRestoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *Cloud_BackupRestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data Cloud_BackupRestoreActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.Id.ValueInt64())
+	params = append(params, data.SnapshotId.ValueString())
+	params = append(params, data.Subfolder.ValueString())
+	params = append(params, data.DestinationPath.ValueString())
+	if !data.Options.IsNull() {
+		params = append(params, data.Options.ValueString())
+	}
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := a.client.CallWithTimeout("cloud_backup.restore", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute cloud_backup.restore: %s", err.Error()))
+		return
+	}
+
+	// Background job - wait for completion, forwarding progress to Terraform
+	if jobID, ok := result.(float64); ok {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("cloud_backup.restore: started job %d", int(jobID))})
+		_, err := a.client.WaitForJobWithProgress(int(jobID), invokeTimeout, func(percent float64, description string) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("cloud_backup.restore: %.0f%% %s", percent, description)})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Job Failed", fmt.Sprintf("cloud_backup.restore job %d: %s", int(jobID), err.Error()))
+		}
+	}
+}
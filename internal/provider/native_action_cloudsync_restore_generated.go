@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &CloudsyncRestoreAction{}
+
+type CloudsyncRestoreAction struct {
+	client *client.Client
+}
+
+type CloudsyncRestoreActionModel struct {
+	Id   types.Int64  `tfsdk:"id"`
+	Opts types.String `tfsdk:"opts"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewCloudsyncRestoreAction() action.Action {
+	return &CloudsyncRestoreAction{}
+}
+
+func (a *CloudsyncRestoreAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudsync_restore"
+}
+
+func (a *CloudsyncRestoreAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Create the opposite of cloud sync task `id` (PULL if it was PUSH and vice versa).",
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.Int64Attribute{Required: true, MarkdownDescription: "ID of the cloud sync task to restore from."},
+			"opts": schema.StringAttribute{Required: true, MarkdownDescription: "Restore operation configuration options."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *CloudsyncRestoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *CloudsyncRestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data CloudsyncRestoreActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.Id.ValueInt64())
+	params = append(params, data.Opts.ValueString())
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.CallWithTimeout("cloudsync.restore", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute cloudsync.restore: %s", err.Error()))
+		return
+	}
+}
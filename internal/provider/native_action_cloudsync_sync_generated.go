@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &CloudsyncSyncAction{}
+
+type CloudsyncSyncAction struct {
+	client *client.Client
+}
+
+type CloudsyncSyncActionModel struct {
+	Id                   types.Int64  `tfsdk:"id"`
+	CloudSyncSyncOptions types.String `tfsdk:"cloud_sync_sync_options"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewCloudsyncSyncAction() action.Action {
+	return &CloudsyncSyncAction{}
+}
+
+func (a *CloudsyncSyncAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudsync_sync"
+}
+
+func (a *CloudsyncSyncAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Run the cloud_sync job `id`, syncing the local data to remote.",
+		Attributes: map[string]schema.Attribute{
+			"id":                      schema.Int64Attribute{Required: true, MarkdownDescription: "ID of the cloud sync task to run."},
+			"cloud_sync_sync_options": schema.StringAttribute{Optional: true, MarkdownDescription: "Options for the sync operation."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *CloudsyncSyncAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *CloudsyncSyncAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data CloudsyncSyncActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.Id.ValueInt64())
+	if !data.CloudSyncSyncOptions.IsNull() {
+		params = append(params, data.CloudSyncSyncOptions.ValueString())
+	}
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := a.client.CallWithTimeout("cloudsync.sync", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute cloudsync.sync: %s", err.Error()))
+		return
+	}
+
+	// Background job - wait for completion, forwarding progress to Terraform
+	if jobID, ok := result.(float64); ok {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("cloudsync.sync: started job %d", int(jobID))})
+		_, err := a.client.WaitForJobWithProgress(int(jobID), invokeTimeout, func(percent float64, description string) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("cloudsync.sync: %.0f%% %s", percent, description)})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Job Failed", fmt.Sprintf("cloudsync.sync job %d: %s", int(jobID), err.Error()))
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &PoolDatasetDestroy_SnapshotsAction{}
+
+type PoolDatasetDestroy_SnapshotsAction struct {
+	client *client.Client
+}
+
+type PoolDatasetDestroy_SnapshotsActionModel struct {
+	Name      types.String `tfsdk:"name"`
+	Snapshots types.String `tfsdk:"snapshots"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewPoolDatasetDestroy_SnapshotsAction() action.Action {
+	return &PoolDatasetDestroy_SnapshotsAction{}
+}
+
+func (a *PoolDatasetDestroy_SnapshotsAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pool_dataset_destroy_snapshots"
+}
+
+func (a *PoolDatasetDestroy_SnapshotsAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Destroy specified snapshots of a given dataset.",
+		Attributes: map[string]schema.Attribute{
+			"name":      schema.StringAttribute{Required: true, MarkdownDescription: "The dataset name to destroy snapshots for."},
+			"snapshots": schema.StringAttribute{Required: true, MarkdownDescription: "Specification of which snapshots to destroy (all, specific ones, or ranges)."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *PoolDatasetDestroy_SnapshotsAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *PoolDatasetDestroy_SnapshotsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data PoolDatasetDestroy_SnapshotsActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.Name.ValueString())
+	params = append(params, data.Snapshots.ValueString())
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := a.client.CallWithTimeout("pool.dataset.destroy_snapshots", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute pool.dataset.destroy_snapshots: %s", err.Error()))
+		return
+	}
+
+	// Background job - wait for completion, forwarding progress to Terraform
+	if jobID, ok := result.(float64); ok {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("pool.dataset.destroy_snapshots: started job %d", int(jobID))})
+		_, err := a.client.WaitForJobWithProgress(int(jobID), invokeTimeout, func(percent float64, description string) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("pool.dataset.destroy_snapshots: %.0f%% %s", percent, description)})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Job Failed", fmt.Sprintf("pool.dataset.destroy_snapshots job %d: %s", int(jobID), err.Error()))
+		}
+	}
+}
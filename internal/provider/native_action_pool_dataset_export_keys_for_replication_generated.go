@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &PoolDatasetExport_Keys_For_ReplicationAction{}
+
+type PoolDatasetExport_Keys_For_ReplicationAction struct {
+	client *client.Client
+}
+
+type PoolDatasetExport_Keys_For_ReplicationActionModel struct {
+	Id types.Int64 `tfsdk:"id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewPoolDatasetExport_Keys_For_ReplicationAction() action.Action {
+	return &PoolDatasetExport_Keys_For_ReplicationAction{}
+}
+
+func (a *PoolDatasetExport_Keys_For_ReplicationAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pool_dataset_export_keys_for_replication"
+}
+
+func (a *PoolDatasetExport_Keys_For_ReplicationAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Export keys for replication task `id` for source dataset(s) which are stored in the system. The exported file is a JSON file which has a dictionary containing dataset names as keys and their keys as t",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{Required: true, MarkdownDescription: "The pool ID to export dataset keys for replication purposes."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *PoolDatasetExport_Keys_For_ReplicationAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *PoolDatasetExport_Keys_For_ReplicationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data PoolDatasetExport_Keys_For_ReplicationActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.Id.ValueInt64())
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := a.client.CallWithTimeout("pool.dataset.export_keys_for_replication", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute pool.dataset.export_keys_for_replication: %s", err.Error()))
+		return
+	}
+
+	// Background job - wait for completion, forwarding progress to Terraform
+	if jobID, ok := result.(float64); ok {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("pool.dataset.export_keys_for_replication: started job %d", int(jobID))})
+		_, err := a.client.WaitForJobWithProgress(int(jobID), invokeTimeout, func(percent float64, description string) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("pool.dataset.export_keys_for_replication: %.0f%% %s", percent, description)})
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Job Failed", fmt.Sprintf("pool.dataset.export_keys_for_replication job %d: %s", int(jobID), err.Error()))
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &ServiceStarted_Or_EnabledAction{}
+
+type ServiceStarted_Or_EnabledAction struct {
+	client *client.Client
+}
+
+type ServiceStarted_Or_EnabledActionModel struct {
+	Service types.String `tfsdk:"service"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewServiceStarted_Or_EnabledAction() action.Action {
+	return &ServiceStarted_Or_EnabledAction{}
+}
+
+func (a *ServiceStarted_Or_EnabledAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_started_or_enabled"
+}
+
+func (a *ServiceStarted_Or_EnabledAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Test if service specified by `service` is started or enabled to start automatically.",
+		Attributes: map[string]schema.Attribute{
+			"service": schema.StringAttribute{Required: true, MarkdownDescription: "Name of the service to check if running or enabled."},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *ServiceStarted_Or_EnabledAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Action Configure Type", "Expected *client.Client")
+		return
+	}
+	a.client = client
+}
+
+func (a *ServiceStarted_Or_EnabledAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data ServiceStarted_Or_EnabledActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Build parameters
+	params := []interface{}{}
+	params = append(params, data.Service.ValueString())
+
+	invokeTimeout, diags := data.Timeouts.Invoke(ctx, a.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := a.client.CallWithTimeout("service.started_or_enabled", params, invokeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Action Failed", fmt.Sprintf("Failed to execute service.started_or_enabled: %s", err.Error()))
+		return
+	}
+}
@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// poolCategories are the vdev classes of a pool, in the order changes to them
+// are applied. Cache and spare devices are single disks.
+var poolCategories = []string{"data", "special", "dedup", "log", "cache", "spares"}
+
+// poolVdev is one vdev of a pool: its type and member disks. Vdevs read from
+// the API also carry the ZFS guids that pool.attach, pool.replace and
+// pool.remove take.
+type poolVdev struct {
+	Type      string
+	Disks     []string
+	GUID      string
+	DiskGUIDs map[string]string
+}
+
+// poolLayout maps each vdev class to its vdevs
+type poolLayout map[string][]poolVdev
+
+// poolOp is a job that changes a vdev already in the pool
+type poolOp struct {
+	Method  string
+	Options map[string]interface{}
+	Summary string
+}
+
+// poolTopologyChange turns one layout into another: jobs on existing vdevs,
+// then new vdevs added through pool.update
+type poolTopologyChange struct {
+	Ops    []poolOp
+	Extend poolLayout
+}
+
+// params returns the layout as the topology of pool.create or pool.update
+func (l poolLayout) params() map[string]interface{} {
+	topology := map[string]interface{}{}
+	for _, category := range poolCategories {
+		vdevs := l[category]
+		if len(vdevs) == 0 {
+			continue
+		}
+		var disks []string
+		var params []interface{}
+		for _, v := range vdevs {
+			disks = append(disks, v.Disks...)
+			params = append(params, map[string]interface{}{"type": v.Type, "disks": v.Disks})
+		}
+		switch category {
+		case "spares":
+			topology[category] = disks
+		case "cache":
+			// A STRIPE of several disks becomes one cache device per disk
+			topology[category] = []interface{}{map[string]interface{}{"type": "STRIPE", "disks": disks}}
+		default:
+			topology[category] = params
+		}
+	}
+	return topology
+}
+
+// validate rejects layouts pool.create would read differently than written
+func (l poolLayout) validate() error {
+	if len(l["data"]) == 0 {
+		return fmt.Errorf("the topology needs at least one data vdev")
+	}
+	seen := map[string]bool{}
+	for _, category := range poolCategories {
+		for _, v := range l[category] {
+			switch {
+			case v.Type == "STRIPE" && len(v.Disks) != 1:
+				return fmt.Errorf("a STRIPE %s vdev has exactly one disk, got %s; declare one %s block per disk", category, strings.Join(v.Disks, ", "), category)
+			case v.Type == "MIRROR" && len(v.Disks) < 2:
+				return fmt.Errorf("a MIRROR %s vdev needs at least two disks, got %s", category, strings.Join(v.Disks, ", "))
+			}
+			for _, disk := range v.Disks {
+				if seen[disk] {
+					return fmt.Errorf("disk %s is used more than once", disk)
+				}
+				seen[disk] = true
+			}
+		}
+	}
+	return nil
+}
+
+// poolLayoutFromAPI converts the topology reported by pool.query
+func poolLayoutFromAPI(topology map[string]interface{}) poolLayout {
+	layout := poolLayout{}
+	for _, category := range poolCategories {
+		key := category
+		if category == "spares" {
+			// pool.create takes "spares" but pool.query reports "spare"
+			key = "spare"
+		}
+		vdevs, _ := topology[key].([]interface{})
+		for _, v := range vdevs {
+			if vdev, ok := v.(map[string]interface{}); ok {
+				layout[category] = append(layout[category], apiPoolVdev(vdev))
+			}
+		}
+	}
+	return layout
+}
+
+// apiPoolVdev converts one vdev from pool.query. A single disk is reported as
+// a DISK without children, which pool.create calls a STRIPE; so is a single
+// disk that is being replaced or covered by a hot spare.
+func apiPoolVdev(vdev map[string]interface{}) poolVdev {
+	v := poolVdev{Type: fmt.Sprint(vdev["type"]), GUID: fmt.Sprint(vdev["guid"]), DiskGUIDs: map[string]string{}}
+	children, _ := vdev["children"].([]interface{})
+	switch {
+	case v.Type == "REPLACING" || v.Type == "SPARE":
+		v.Type = "STRIPE"
+		v.GUID = fmt.Sprint(poolMemberDisk(vdev)["guid"])
+		children = []interface{}{vdev}
+	case v.Type == "DISK" || len(children) == 0:
+		v.Type = "STRIPE"
+		children = []interface{}{vdev}
+	}
+	for _, c := range children {
+		child, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		disk := poolMemberDisk(child)
+		name := poolDiskName(disk)
+		v.Disks = append(v.Disks, name)
+		v.DiskGUIDs[name] = fmt.Sprint(disk["guid"])
+	}
+	sort.Strings(v.Disks)
+	return v
+}
+
+// poolMemberDisk follows a vdev member to the disk it stands for. While a
+// disk is resilvered onto a new one, ZFS reports a REPLACING member holding
+// both, and the new disk is the member; a hot spare in use is reported as a
+// SPARE member holding the original disk and the spare, and the original is
+// still the member until it is replaced.
+func poolMemberDisk(dev map[string]interface{}) map[string]interface{} {
+	children, _ := dev["children"].([]interface{})
+	if len(children) == 0 {
+		return dev
+	}
+	var member interface{}
+	switch dev["type"] {
+	case "REPLACING":
+		member = children[len(children)-1]
+	case "SPARE":
+		member = children[0]
+	default:
+		return dev
+	}
+	if m, ok := member.(map[string]interface{}); ok {
+		return poolMemberDisk(m)
+	}
+	return dev
+}
+
+// poolDiskName is the disk a vdev member is on, or its guid when the disk is
+// missing, e.g. after it failed
+func poolDiskName(dev map[string]interface{}) string {
+	if disk, ok := dev["disk"].(string); ok && disk != "" {
+		return disk
+	}
+	return fmt.Sprint(dev["guid"])
+}
+
+// diffPoolLayout works out the jobs that turn the current layout into the
+// planned one. Vdevs are matched by the disks they share; a vdev whose disks
+// were all swapped at once is matched with a new vdev of the same type and
+// width. Changes ZFS can't make, such as shrinking a RAIDZ vdev, are errors.
+func diffPoolLayout(current, planned poolLayout) (poolTopologyChange, error) {
+	change := poolTopologyChange{Extend: poolLayout{}}
+
+	inPool := map[string]bool{}
+	for _, vdevs := range current {
+		for _, v := range vdevs {
+			for _, disk := range v.Disks {
+				inPool[disk] = true
+			}
+		}
+	}
+	newDisk := func(disk string) error {
+		if inPool[disk] {
+			return fmt.Errorf("disk %s is already in another vdev of the pool; remove it there in a separate apply first", disk)
+		}
+		return nil
+	}
+
+	// ZFS removes top-level vdevs by copying their data to the rest of the
+	// pool, which it can't do while a data vdev is RAIDZ
+	var raidz *poolVdev
+	for i, v := range current["data"] {
+		if strings.HasPrefix(v.Type, "RAIDZ") {
+			raidz = &current["data"][i]
+			break
+		}
+	}
+
+	for _, category := range poolCategories {
+		single := category == "cache" || category == "spares"
+		pairs, removed, added := matchPoolVdevs(current[category], planned[category], !single)
+		for _, p := range pairs {
+			ops, err := poolVdevChanges(category, p[0], p[1], newDisk)
+			if err != nil {
+				return change, err
+			}
+			change.Ops = append(change.Ops, ops...)
+		}
+		for _, v := range removed {
+			if strings.HasPrefix(v.Type, "RAIDZ") {
+				return change, fmt.Errorf("cannot remove %s: ZFS can't remove RAIDZ vdevs", describePoolVdev(category, v))
+			}
+			if raidz != nil && (category == "data" || category == "special" || category == "dedup") {
+				return change, fmt.Errorf("cannot remove %s: ZFS can't remove data, special or dedup vdevs from a pool with a RAIDZ data vdev (%s)",
+					describePoolVdev(category, v), describePoolVdev("data", *raidz))
+			}
+			change.Ops = append(change.Ops, poolOp{
+				Method:  "pool.remove",
+				Options: map[string]interface{}{"label": v.GUID},
+				Summary: "remove " + describePoolVdev(category, v),
+			})
+		}
+		for _, v := range added {
+			for _, disk := range v.Disks {
+				if err := newDisk(disk); err != nil {
+					return change, err
+				}
+			}
+			change.Extend[category] = append(change.Extend[category], v)
+		}
+	}
+	return change, nil
+}
+
+// poolVdevChanges returns the jobs that turn vdev cur into want: disks that
+// were swapped are replaced, extra disks attached and missing ones detached
+func poolVdevChanges(category string, cur, want poolVdev, newDisk func(string) error) ([]poolOp, error) {
+	where := describePoolVdev(category, cur)
+	switch {
+	case cur.Type == want.Type:
+	case cur.Type == "STRIPE" && want.Type == "MIRROR", cur.Type == "MIRROR" && want.Type == "STRIPE":
+	default:
+		return nil, fmt.Errorf("cannot change %s to %s; only STRIPE and MIRROR vdevs turn into each other, by attaching or detaching disks", where, want.Type)
+	}
+
+	var added, removed []string
+	for _, disk := range want.Disks {
+		if !slices.Contains(cur.Disks, disk) {
+			added = append(added, disk)
+		}
+	}
+	for _, disk := range cur.Disks {
+		if !slices.Contains(want.Disks, disk) {
+			removed = append(removed, disk)
+		}
+	}
+	if strings.HasPrefix(cur.Type, "RAIDZ") && len(removed) > len(added) {
+		return nil, fmt.Errorf("cannot shrink %s: disks of a RAIDZ vdev can be replaced or added, not removed", where)
+	}
+
+	var ops []poolOp
+	for i, disk := range added {
+		if err := newDisk(disk); err != nil {
+			return nil, err
+		}
+		if i < len(removed) {
+			ops = append(ops, poolOp{
+				Method:  "pool.replace",
+				Options: map[string]interface{}{"label": cur.DiskGUIDs[removed[i]], "disk": disk},
+				Summary: fmt.Sprintf("replace %s with %s in %s", removed[i], disk, where),
+			})
+			continue
+		}
+		ops = append(ops, poolOp{
+			Method:  "pool.attach",
+			Options: map[string]interface{}{"target_vdev": cur.GUID, "new_disk": disk},
+			Summary: fmt.Sprintf("attach %s to %s", disk, where),
+		})
+	}
+	for _, disk := range removed[min(len(added), len(removed)):] {
+		ops = append(ops, poolOp{
+			Method:  "pool.detach",
+			Options: map[string]interface{}{"label": cur.DiskGUIDs[disk]},
+			Summary: fmt.Sprintf("detach %s from %s", disk, where),
+		})
+	}
+	return ops, nil
+}
+
+// matchPoolVdevs pairs current vdevs with planned ones, most shared disks
+// first. With bySize, vdevs left over are paired by type and width.
+func matchPoolVdevs(current, planned []poolVdev, bySize bool) (pairs [][2]poolVdev, removed, added []poolVdev) {
+	usedCur := make([]bool, len(current))
+	usedPlan := make([]bool, len(planned))
+	for {
+		best, bi, bj := 0, -1, -1
+		for i := range current {
+			for j := range planned {
+				if usedCur[i] || usedPlan[j] {
+					continue
+				}
+				if n := sharedDisks(current[i], planned[j]); n > best {
+					best, bi, bj = n, i, j
+				}
+			}
+		}
+		if bi < 0 {
+			break
+		}
+		usedCur[bi], usedPlan[bj] = true, true
+		pairs = append(pairs, [2]poolVdev{current[bi], planned[bj]})
+	}
+	if bySize {
+		for i := range current {
+			for j := range planned {
+				if usedCur[i] || usedPlan[j] || current[i].Type != planned[j].Type || len(current[i].Disks) != len(planned[j].Disks) {
+					continue
+				}
+				usedCur[i], usedPlan[j] = true, true
+				pairs = append(pairs, [2]poolVdev{current[i], planned[j]})
+			}
+		}
+	}
+	for i, v := range current {
+		if !usedCur[i] {
+			removed = append(removed, v)
+		}
+	}
+	for j, v := range planned {
+		if !usedPlan[j] {
+			added = append(added, v)
+		}
+	}
+	return pairs, removed, added
+}
+
+func sharedDisks(a, b poolVdev) int {
+	n := 0
+	for _, disk := range a.Disks {
+		if slices.Contains(b.Disks, disk) {
+			n++
+		}
+	}
+	return n
+}
+
+// describePoolVdev names a vdev in messages, e.g. "data MIRROR sda, sdb"
+func describePoolVdev(category string, v poolVdev) string {
+	return fmt.Sprintf("%s %s %s", category, v.Type, strings.Join(v.Disks, ", "))
+}
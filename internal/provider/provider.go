@@ -2,26 +2,51 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
 )
 
-var _ provider.Provider = &TrueNASProvider{}
+var (
+	_ provider.Provider                       = &TrueNASProvider{}
+	_ provider.ProviderWithEphemeralResources = &TrueNASProvider{}
+	_ provider.ProviderWithFunctions          = &TrueNASProvider{}
+	_ provider.ProviderWithActions            = &TrueNASProvider{}
+	_ provider.ProviderWithListResources      = &TrueNASProvider{}
+)
 
 type TrueNASProvider struct {
 	version string
 }
 
 type TrueNASProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	Host          types.String `tfsdk:"host"`
+	Token         types.String `tfsdk:"token"`
+	TokenFile     types.String `tfsdk:"token_file"`
+	TokenCommand  types.List   `tfsdk:"token_command"`
+	Profile       types.String `tfsdk:"profile"`
+	RPCTimeout    types.String `tfsdk:"rpc_timeout"`
+	JobTimeout    types.String `tfsdk:"job_timeout"`
+	UploadTimeout types.String `tfsdk:"upload_timeout"`
+	ReadOnly      types.Bool   `tfsdk:"read_only"`
+	WaitForReady  types.String `tfsdk:"wait_for_ready"`
 }
 
 func (p *TrueNASProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -41,6 +66,46 @@ func (p *TrueNASProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "File holding the API token, e.g. one written by Vault agent. It is read again on every reconnect, so the token can be rotated without restarting Terraform.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token"), path.MatchRoot("token_command")),
+				},
+			},
+			"token_command": schema.ListAttribute{
+				MarkdownDescription: "Credential helper to run for the API token, as a program and its arguments, e.g. `[\"pass\", \"show\", \"truenas\"]`. It prints the bare token or JSON such as `{\"token\": \"...\", \"expiry\": \"2025-01-02T15:04:05Z\"}`; the token is cached until that expiry.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("token"), path.MatchRoot("token_file")),
+				},
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Profile to read `host` and the token from in the credentials file, `~/.config/truenas/credentials` or `TRUENAS_CREDENTIALS_FILE`. Can also be set with `TRUENAS_PROFILE`. Default: `default`, if the file has it",
+				Optional:            true,
+			},
+			"rpc_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for a single API call to respond, as a Go duration (e.g. `30s`). Default: `30s`",
+				Optional:            true,
+			},
+			"job_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for background jobs when a resource has no `timeouts` block, as a Go duration (e.g. `20m`). Default: `5m`",
+				Optional:            true,
+			},
+			"upload_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for file uploads, as a Go duration (e.g. `10m`). Default: `30s`",
+				Optional:            true,
+			},
+			"wait_for_ready": schema.StringAttribute{
+				MarkdownDescription: "Before any other call, wait up to this long for middleware to report `READY` through `system.state`, as a Go duration (e.g. `10m`). Use it when the system may still be booting, e.g. right after a reboot or update. Default: don't wait",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Only call methods that read (`query`, `get_*`, `config` and `info`) and refuse all others, so plans cannot change the system. Can also be enabled with the `TRUENAS_READ_ONLY` environment variable; either one turns it on. Default: `false`",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -54,38 +119,54 @@ func (p *TrueNASProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	host := os.Getenv("TRUENAS_HOST")
-	token := os.Getenv("TRUENAS_TOKEN")
-
-	if !data.Host.IsNull() {
-		host = data.Host.ValueString()
-	}
-
-	if !data.Token.IsNull() {
-		token = data.Token.ValueString()
+	host, tokenSource, diags := resolveCredentials(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if host == "" {
 		resp.Diagnostics.AddError(
 			"Missing TrueNAS Host",
 			"The provider cannot create the TrueNAS client as there is a missing or empty value for the TrueNAS host. "+
-				"Set the host value in the configuration or use the TRUENAS_HOST environment variable.",
+				"Set the host value in the configuration or a profile, or use the TRUENAS_HOST environment variable.",
 		)
 	}
 
-	if token == "" {
+	if tokenSource == nil {
 		resp.Diagnostics.AddError(
 			"Missing TrueNAS Token",
 			"The provider cannot create the TrueNAS client as there is a missing or empty value for the TrueNAS API token. "+
-				"Set the token value in the configuration or use the TRUENAS_TOKEN environment variable.",
+				"Set token, token_file or token_command in the configuration or a profile, or use the TRUENAS_TOKEN or TRUENAS_TOKEN_FILE environment variable. "+
+				"A profile's token is only used for the profile's own host.",
 		)
+	} else if _, err := tokenSource.Token(); err != nil {
+		resp.Diagnostics.AddError("Unable to Get TrueNAS Token", err.Error())
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readOnly := data.ReadOnly.ValueBool()
+	if v := os.Getenv("TRUENAS_READ_ONLY"); v != "" {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid TRUENAS_READ_ONLY", fmt.Sprintf("Expected true or false, got %q", v))
+			return
+		}
+		readOnly = readOnly || enabled
 	}
 
+	rpcTimeout := parseDuration(data.RPCTimeout, path.Root("rpc_timeout"), resp)
+	jobTimeout := parseDuration(data.JobTimeout, path.Root("job_timeout"), resp)
+	uploadTimeout := parseDuration(data.UploadTimeout, path.Root("upload_timeout"), resp)
+	waitForReady := parseDuration(data.WaitForReady, path.Root("wait_for_ready"), resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	c, err := client.NewClient(host, token)
+	c, err := client.NewClientWithTokenSource(host, tokenSource)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create TrueNAS Client",
@@ -95,9 +176,21 @@ func (p *TrueNASProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 		return
 	}
+	c.SetTimeouts(rpcTimeout, jobTimeout, uploadTimeout)
+	c.SetReadOnly(readOnly)
+
+	if waitForReady > 0 {
+		if err := c.WaitForReady(ctx, waitForReady); err != nil {
+			resp.Diagnostics.AddError("TrueNAS Not Ready", fmt.Sprintf("Middleware did not become ready: %s", err))
+			return
+		}
+	}
 
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+	resp.ActionData = c
+	resp.ListResourceData = c
 }
 
 func (p *TrueNASProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -153,6 +246,19 @@ func (p *TrueNASProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewVmResource,
 		NewVmDeviceResource,
 		NewVmwareResource,
+		NewFtpConfigResource,
+		NewIscsiGlobalConfigResource,
+		NewMailConfigResource,
+		NewNetworkConfigResource,
+		NewNfsConfigResource,
+		NewNvmetGlobalConfigResource,
+		NewSmbConfigResource,
+		NewSnmpConfigResource,
+		NewSshConfigResource,
+		NewSystemAdvancedConfigResource,
+		NewSystemGeneralConfigResource,
+		NewSystemdatasetConfigResource,
+		NewUpsConfigResource,
 		NewConfigUploadResource,
 		NewFilesystemPutResource,
 		NewPoolDatasetChange_KeyResource,
@@ -248,28 +354,294 @@ func (p *TrueNASProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewActionVmStartResource,
 		NewActionVmStopResource,
 		NewActionVmDeviceConvertResource,
+		NewGenericResource,
+		NewServiceResource,
+		NewDirectoryservicesResource,
 	}
 }
 
 func (p *TrueNASProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		NewVmDataSource,
-		NewPoolDataSource,
-		NewPoolDatasetDataSource,
+		NewAcmeDnsAuthenticatorDataSource,
+		NewAlertserviceDataSource,
+		NewApiKeyDataSource,
+		NewAppDataSource,
+		NewAppImageDataSource,
+		NewAppRegistryDataSource,
+		NewCertificateDataSource,
+		NewCloudBackupDataSource,
+		NewCloudsyncDataSource,
+		NewCloudsyncCredentialsDataSource,
+		NewCronjobDataSource,
 		NewDiskDataSource,
-		NewUserDataSource,
+		NewDockerNetworkDataSource,
+		NewFcFcHostDataSource,
+		NewFcportDataSource,
+		NewFilesystemAcltemplateDataSource,
 		NewGroupDataSource,
+		NewInitshutdownscriptDataSource,
 		NewInterfaceDataSource,
+		NewIscsiAuthDataSource,
+		NewIscsiExtentDataSource,
+		NewIscsiInitiatorDataSource,
+		NewIscsiPortalDataSource,
+		NewIscsiTargetDataSource,
+		NewIscsiTargetextentDataSource,
+		NewJbofDataSource,
+		NewKerberosKeytabDataSource,
+		NewKerberosRealmDataSource,
+		NewKeychaincredentialDataSource,
+		NewNvmetHostDataSource,
+		NewNvmetHostSubsysDataSource,
+		NewNvmetNamespaceDataSource,
+		NewNvmetPortDataSource,
+		NewNvmetPortSubsysDataSource,
+		NewNvmetSubsysDataSource,
+		NewPoolDataSource,
+		NewPoolDatasetDataSource,
+		NewPoolScrubDataSource,
+		NewPoolSnapshotDataSource,
+		NewPoolSnapshottaskDataSource,
+		NewPrivilegeDataSource,
+		NewReplicationDataSource,
+		NewReportingExportersDataSource,
+		NewRsynctaskDataSource,
 		NewServiceDataSource,
-		NewVmsDataSource,
-		NewPoolsDataSource,
-		NewPoolDatasetsDataSource,
+		NewSharingNfsDataSource,
+		NewSharingSmbDataSource,
+		NewStaticrouteDataSource,
+		NewSystemNtpserverDataSource,
+		NewTunableDataSource,
+		NewUserDataSource,
+		NewVirtInstanceDataSource,
+		NewVirtVolumeDataSource,
+		NewVmDataSource,
+		NewVmDeviceDataSource,
+		NewVmwareDataSource,
+		NewAcmeDnsAuthenticatorsDataSource,
+		NewAlertservicesDataSource,
+		NewApiKeysDataSource,
+		NewAppsDataSource,
+		NewAppImagesDataSource,
+		NewAppRegistriesDataSource,
+		NewCertificatesDataSource,
+		NewCloudBackupsDataSource,
+		NewCloudsyncsDataSource,
+		NewCloudsyncCredentialsListDataSource,
+		NewCronjobsDataSource,
 		NewDisksDataSource,
-		NewUsersDataSource,
+		NewDockerNetworksDataSource,
+		NewFcFcHostsDataSource,
+		NewFcportsDataSource,
+		NewFilesystemAcltemplatesDataSource,
 		NewGroupsDataSource,
+		NewInitshutdownscriptsDataSource,
 		NewInterfacesDataSource,
+		NewIscsiAuthsDataSource,
+		NewIscsiExtentsDataSource,
+		NewIscsiInitiatorsDataSource,
+		NewIscsiPortalsDataSource,
+		NewIscsiTargetsDataSource,
+		NewIscsiTargetextentsDataSource,
+		NewJbofsDataSource,
+		NewKerberosKeytabsDataSource,
+		NewKerberosRealmsDataSource,
+		NewKeychaincredentialsDataSource,
+		NewNvmetHostsDataSource,
+		NewNvmetHostSubsysListDataSource,
+		NewNvmetNamespacesDataSource,
+		NewNvmetPortsDataSource,
+		NewNvmetPortSubsysListDataSource,
+		NewNvmetSubsysListDataSource,
+		NewPoolsDataSource,
+		NewPoolDatasetsDataSource,
+		NewPoolScrubsDataSource,
+		NewPoolSnapshotsDataSource,
+		NewPoolSnapshottasksDataSource,
+		NewPrivilegesDataSource,
+		NewReplicationsDataSource,
+		NewReportingExportersListDataSource,
+		NewRsynctasksDataSource,
 		NewServicesDataSource,
+		NewSharingNfsListDataSource,
+		NewSharingSmbsDataSource,
+		NewStaticroutesDataSource,
+		NewSystemNtpserversDataSource,
+		NewTunablesDataSource,
+		NewUsersDataSource,
+		NewVirtInstancesDataSource,
+		NewVirtVolumesDataSource,
+		NewVmsDataSource,
+		NewVmDevicesDataSource,
+		NewVmwaresDataSource,
+		NewRPCDataSource,
+		NewSystemInfoDataSource,
+	}
+}
+
+func (p *TrueNASProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDatasetKeyEphemeralResource,
+		NewSessionTokenEphemeralResource,
+		NewSSHKeypairEphemeralResource,
+	}
+}
+
+func (p *TrueNASProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewAlertRestoreAction,
+		NewAppConvert_To_CustomAction,
+		NewAppImagePullAction,
+		NewAppPull_ImagesAction,
+		NewAppRedeployAction,
+		NewAppRollbackAction,
+		NewAppRollback_VersionsAction,
+		NewAppStartAction,
+		NewAppStopAction,
+		NewAppUpgradeAction,
+		NewAuditDownload_ReportAction,
+		NewAuditExportAction,
+		NewBootAttachAction,
+		NewBootReplaceAction,
+		NewBootSet_Scrub_IntervalAction,
+		NewCloud_BackupDelete_SnapshotAction,
+		NewCloud_BackupRestoreAction,
+		NewCloud_BackupSyncAction,
+		NewCloudsyncRestoreAction,
+		NewCloudsyncSyncAction,
+		NewCloudsyncSync_OnetimeAction,
+		NewConfigResetAction,
+		NewConfigSaveAction,
+		NewCoreBulkAction,
+		NewCoreJob_WaitAction,
+		NewCronjobRunAction,
+		NewDirectoryservicesLeaveAction,
+		NewDiskWipeAction,
+		NewDockerBackupAction,
+		NewDockerBackup_To_PoolAction,
+		NewDockerDelete_BackupAction,
+		NewDockerRestore_BackupAction,
+		NewFailoverRebootOther_NodeAction,
+		NewFilesystemChownAction,
+		NewFilesystemGetAction,
+		NewFilesystemSetaclAction,
+		NewFilesystemSetpermAction,
+		NewIpmiSelElistAction,
+		NewPoolAttachAction,
+		NewPoolDatasetDestroy_SnapshotsAction,
+		NewPoolDatasetExport_KeyAction,
+		NewPoolDatasetExport_KeysAction,
+		NewPoolDatasetExport_Keys_For_ReplicationAction,
+		NewPoolDatasetLockAction,
+		NewPoolDdt_PrefetchAction,
+		NewPoolDdt_PruneAction,
+		NewPoolExpandAction,
+		NewPoolExportAction,
+		NewPoolImport_PoolAction,
+		NewPoolRemoveAction,
+		NewPoolReplaceAction,
+		NewPoolScrubAction,
+		NewPoolScrubRunAction,
+		NewPoolScrubScrubAction,
+		NewPoolSnapshotRollbackAction,
+		NewPoolSnapshottaskRunAction,
+		NewReplicationRestoreAction,
+		NewReplicationRunAction,
+		NewReplicationRun_OnetimeAction,
+		NewRsynctaskRunAction,
+		NewServiceControlAction,
+		NewServiceRestartAction,
+		NewServiceStartAction,
+		NewServiceStartedAction,
+		NewServiceStarted_Or_EnabledAction,
+		NewServiceStopAction,
+		NewSupportNew_TicketAction,
+		NewSystemGeneralUi_RestartAction,
+		NewSystemRebootAction,
+		NewSystemShutdownAction,
+		NewTruenasSet_ProductionAction,
+		NewUpdateDownloadAction,
+		NewUpdateFileAction,
+		NewUpdateManualAction,
+		NewUpdateRunAction,
+		NewVirtDeviceExport_Disk_ImageAction,
+		NewVirtDeviceImport_Disk_ImageAction,
+		NewVirtInstanceRestartAction,
+		NewVirtInstanceStartAction,
+		NewVirtInstanceStopAction,
+		NewVirtVolumeImport_ZvolAction,
+		NewVmDeviceConvertAction,
+		NewVmExport_Disk_ImageAction,
+		NewVmImport_Disk_ImageAction,
+		NewVmLog_File_DownloadAction,
+		NewVmRestartAction,
+		NewVmStartAction,
+		NewVmStopAction,
+	}
+}
+
+func (p *TrueNASProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewAcmeDnsAuthenticatorListResource,
+		NewApiKeyListResource,
+		NewAppListResource,
+		NewAppRegistryListResource,
+		NewCertificateListResource,
+		NewCloudsyncCredentialsListResource,
+		NewCronjobListResource,
+		NewFilesystemAcltemplateListResource,
+		NewGroupListResource,
+		NewInterfaceListResource,
+		NewIscsiAuthListResource,
+		NewIscsiExtentListResource,
+		NewIscsiInitiatorListResource,
+		NewIscsiPortalListResource,
+		NewIscsiTargetListResource,
+		NewIscsiTargetextentListResource,
+		NewKerberosKeytabListResource,
+		NewKerberosRealmListResource,
+		NewKeychaincredentialListResource,
+		NewNvmetHostListResource,
+		NewNvmetSubsysListResource,
+		NewPoolListResource,
+		NewPoolDatasetListResource,
+		NewPoolSnapshotListResource,
+		NewPoolSnapshottaskListResource,
+		NewPrivilegeListResource,
+		NewReplicationListResource,
+		NewReportingExportersListResource,
+		NewSharingNfsListResource,
+		NewSharingSmbListResource,
+		NewTunableListResource,
+		NewUserListResource,
+		NewVirtInstanceListResource,
+		NewVirtVolumeListResource,
+		NewVmListResource,
+	}
+}
+
+func (p *TrueNASProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewSizeToBytesFunction,
+		NewScheduleFunction,
+		NewDatasetMountpointFunction,
+		NewACLEntryFunction,
+		NewNQNFunction,
+	}
+}
+
+// parseDuration returns the configured duration, or zero when unset
+func parseDuration(value types.String, p path.Path, resp *provider.ConfigureResponse) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return 0
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(p, "Invalid Timeout",
+			fmt.Sprintf("Expected a positive duration such as \"30s\" or \"10m\", got %q", value.ValueString()))
+		return 0
 	}
+	return d
 }
 
 func New(version string) func() provider.Provider {
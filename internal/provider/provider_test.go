@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -36,3 +42,56 @@ provider "truenas" {
 }
 `
 }
+
+func TestProviderConfigure_ReadOnly(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	configure := func(readOnly interface{}) (*client.Client, diag.Diagnostics) {
+		typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		vals := map[string]tftypes.Value{}
+		for name, attrType := range typ.AttributeTypes {
+			vals[name] = tftypes.NewValue(attrType, nil)
+		}
+		vals["host"] = tftypes.NewValue(tftypes.String, "127.0.0.1")
+		vals["token"] = tftypes.NewValue(tftypes.String, "token")
+		vals["read_only"] = tftypes.NewValue(tftypes.Bool, readOnly)
+		resp := &provider.ConfigureResponse{}
+		p.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, vals)},
+		}, resp)
+		c, _ := resp.ResourceData.(*client.Client)
+		return c, resp.Diagnostics
+	}
+
+	tests := []struct {
+		env      string
+		readOnly interface{}
+		want     bool
+	}{
+		{"", nil, false},
+		{"", true, true},
+		{"true", nil, true},
+		// Configuration can't switch off read-only mode set in the environment
+		{"1", false, true},
+		{"false", true, true},
+		{"false", nil, false},
+	}
+	for _, tt := range tests {
+		t.Setenv("TRUENAS_READ_ONLY", tt.env)
+		c, diags := configure(tt.readOnly)
+		if diags.HasError() {
+			t.Fatalf("env %q, read_only %v: unexpected error: %v", tt.env, tt.readOnly, diags)
+		}
+		if c.ReadOnly() != tt.want {
+			t.Errorf("env %q, read_only %v: expected read-only %v", tt.env, tt.readOnly, tt.want)
+		}
+	}
+
+	t.Setenv("TRUENAS_READ_ONLY", "yes please")
+	if _, diags := configure(nil); !diags.HasError() {
+		t.Error("Expected an invalid TRUENAS_READ_ONLY to be rejected")
+	}
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// queryOperators are the comparison operators accepted in TrueNAS query-filters
+var queryOperators = []string{
+	"=", "!=", ">", ">=", "<", "<=",
+	"~", "^", "!^", "$", "!$",
+	"in", "nin", "rin", "rnin",
+}
+
+// QueryArgsModel holds the filter and option attributes shared by every query
+// data source. It is embedded in the generated data source models.
+type QueryArgsModel struct {
+	Filter  []QueryFilterModel `tfsdk:"filter"`
+	OrderBy types.List         `tfsdk:"order_by"`
+	Limit   types.Int64        `tfsdk:"limit"`
+	Offset  types.Int64        `tfsdk:"offset"`
+	Select  types.List         `tfsdk:"select"`
+}
+
+// QueryFilterModel is a single query-filters entry: [field, operator, value]
+type QueryFilterModel struct {
+	Field     types.String `tfsdk:"field"`
+	Operator  types.String `tfsdk:"operator"`
+	Value     types.String `tfsdk:"value"`
+	ValueJSON types.String `tfsdk:"value_json"`
+}
+
+// queryArgsAttributes returns the schema attributes backing QueryArgsModel
+func queryArgsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"filter": schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "Server-side filters, combined with AND. Translated into TrueNAS query-filters.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"field": schema.StringAttribute{
+						Required:    true,
+						Description: "Field to compare. Nested fields use dot notation, e.g. `status.state`.",
+					},
+					"operator": schema.StringAttribute{
+						Optional:    true,
+						Description: "Comparison operator. Default: `=`",
+						Validators:  []validator.String{stringvalidator.OneOf(queryOperators...)},
+					},
+					"value": schema.StringAttribute{
+						Optional:    true,
+						Description: "Value to compare against, sent as a string.",
+					},
+					"value_json": schema.StringAttribute{
+						Optional:    true,
+						Description: "Value to compare against as JSON, for numbers, booleans, null or the lists used by `in`/`nin`, e.g. `jsonencode([1, 2])`.",
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(
+						path.MatchRelative().AtName("value"),
+						path.MatchRelative().AtName("value_json"),
+					),
+				},
+			},
+		},
+		"order_by": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Fields to sort by. Prefix a field with `-` for descending order.",
+		},
+		"limit": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Maximum number of items to return.",
+			Validators:  []validator.Int64{int64validator.AtLeast(1)},
+		},
+		"offset": schema.Int64Attribute{
+			Optional:    true,
+			Description: "Number of matching items to skip.",
+			Validators:  []validator.Int64{int64validator.AtLeast(0)},
+		},
+		"select": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Only return these fields. Attributes for other fields are null.",
+		},
+	}
+}
+
+// QueryParams builds the [query-filters, query-options] parameters for a
+// *.query call.
+func (m QueryArgsModel) QueryParams(ctx context.Context) ([]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filters := make([]interface{}, 0, len(m.Filter))
+	for i, f := range m.Filter {
+		op := "="
+		if !f.Operator.IsNull() && !f.Operator.IsUnknown() {
+			op = f.Operator.ValueString()
+		}
+		var value interface{}
+		if !f.ValueJSON.IsNull() {
+			if err := json.Unmarshal([]byte(f.ValueJSON.ValueString()), &value); err != nil {
+				diags.AddAttributeError(path.Root("filter").AtListIndex(i).AtName("value_json"),
+					"Invalid Filter Value", fmt.Sprintf("value_json is not valid JSON: %s", err))
+				continue
+			}
+		} else {
+			value = f.Value.ValueString()
+		}
+		filters = append(filters, []interface{}{f.Field.ValueString(), op, value})
+	}
+
+	options := map[string]interface{}{}
+	if !m.OrderBy.IsNull() && !m.OrderBy.IsUnknown() {
+		var orderBy []string
+		diags.Append(m.OrderBy.ElementsAs(ctx, &orderBy, false)...)
+		options["order_by"] = orderBy
+	}
+	if !m.Select.IsNull() && !m.Select.IsUnknown() {
+		var sel []string
+		diags.Append(m.Select.ElementsAs(ctx, &sel, false)...)
+		options["select"] = sel
+	}
+	if !m.Limit.IsNull() && !m.Limit.IsUnknown() {
+		options["limit"] = m.Limit.ValueInt64()
+	}
+	if !m.Offset.IsNull() && !m.Offset.IsUnknown() {
+		options["offset"] = m.Offset.ValueInt64()
+	}
+
+	return []interface{}{filters, options}, diags
+}
+
+// queryOne looks up the single record whose field equals value. It is used by
+// data sources that accept a natural key (username, pool name, ...) instead of
+// the numeric ID, and fails unless exactly one record matches.
+func queryOne(c *client.Client, method, field, value string) (interface{}, error) {
+	return queryMatch(c, method, []interface{}{[]interface{}{field, "=", value}})
+}
+
+// queryMatch is queryOne for any query-filters, e.g. the keys of a resource
+// identity
+func queryMatch(c *client.Client, method string, filters []interface{}) (interface{}, error) {
+	result, err := c.Call(method, []interface{}{filters, map[string]interface{}{}})
+	if err != nil {
+		return nil, err
+	}
+	items, ok := result.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s response: %T", method, result)
+	}
+	switch len(items) {
+	case 0:
+		return nil, fmt.Errorf("no match for %s", describeFilters(filters))
+	case 1:
+		return items[0], nil
+	default:
+		return nil, fmt.Errorf("%d matches for %s, expected exactly one", len(items), describeFilters(filters))
+	}
+}
+
+// describeFilters renders query-filters for error messages: name = "tank"
+func describeFilters(filters []interface{}) string {
+	parts := make([]string, 0, len(filters))
+	for _, f := range filters {
+		if cond, ok := f.([]interface{}); ok && len(cond) == 3 {
+			value := fmt.Sprintf("%v", cond[2])
+			if str, ok := cond[2].(string); ok {
+				value = fmt.Sprintf("%q", str)
+			}
+			parts = append(parts, fmt.Sprintf("%v %v %s", cond[0], cond[1], value))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%v", f))
+	}
+	return strings.Join(parts, " and ")
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestQueryArgs_QueryParams(t *testing.T) {
+	ctx := context.Background()
+	m := QueryArgsModel{
+		Filter: []QueryFilterModel{
+			{Field: types.StringValue("pool"), Operator: types.StringNull(), Value: types.StringValue("tank"), ValueJSON: types.StringNull()},
+			{Field: types.StringValue("id"), Operator: types.StringValue("in"), Value: types.StringNull(), ValueJSON: types.StringValue(`[1, 2]`)},
+		},
+		OrderBy: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("-name")}),
+		Limit:   types.Int64Value(10),
+		Offset:  types.Int64Null(),
+		Select:  types.ListNull(types.StringType),
+	}
+
+	params, diags := m.QueryParams(ctx)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	want := []interface{}{
+		[]interface{}{
+			[]interface{}{"pool", "=", "tank"},
+			[]interface{}{"id", "in", []interface{}{float64(1), float64(2)}},
+		},
+		map[string]interface{}{
+			"order_by": []string{"-name"},
+			"limit":    int64(10),
+		},
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("Expected %#v, got %#v", want, params)
+	}
+}
+
+func TestQueryArgs_Empty(t *testing.T) {
+	m := QueryArgsModel{
+		OrderBy: types.ListNull(types.StringType),
+		Limit:   types.Int64Null(),
+		Offset:  types.Int64Null(),
+		Select:  types.ListNull(types.StringType),
+	}
+	params, diags := m.QueryParams(context.Background())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	want := []interface{}{[]interface{}{}, map[string]interface{}{}}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("Expected empty filters and options, got %#v", params)
+	}
+}
+
+func TestQueryArgs_InvalidJSON(t *testing.T) {
+	m := QueryArgsModel{
+		Filter: []QueryFilterModel{
+			{Field: types.StringValue("id"), Operator: types.StringNull(), Value: types.StringNull(), ValueJSON: types.StringValue("[1,")},
+		},
+		OrderBy: types.ListNull(types.StringType),
+		Limit:   types.Int64Null(),
+		Offset:  types.Int64Null(),
+		Select:  types.ListNull(types.StringType),
+	}
+	if _, diags := m.QueryParams(context.Background()); !diags.HasError() {
+		t.Error("Expected an error for malformed value_json")
+	}
+}
+
+func TestQueryDataSource_EmbeddedArgs(t *testing.T) {
+	ctx := context.Background()
+	d := NewPoolDatasetsDataSource()
+	var resp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &resp)
+
+	for _, name := range []string{"filter", "order_by", "limit", "offset", "select", "items"} {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("Expected attribute %q on pool_datasets", name)
+		}
+	}
+
+	// The embedded QueryArgsModel must round-trip through the schema
+	state := tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+	}
+	in := PoolDatasetsDataSourceModel{
+		QueryArgsModel: QueryArgsModel{
+			Filter: []QueryFilterModel{
+				{Field: types.StringValue("pool"), Operator: types.StringValue("="), Value: types.StringValue("tank"), ValueJSON: types.StringNull()},
+			},
+			OrderBy: types.ListNull(types.StringType),
+			Limit:   types.Int64Value(5),
+			Offset:  types.Int64Null(),
+			Select:  types.ListNull(types.StringType),
+		},
+		Items: types.ListNull(resp.Schema.Attributes["items"].GetType().(types.ListType).ElemType),
+	}
+	diags := state.Set(ctx, &in)
+	if diags.HasError() {
+		t.Fatalf("Failed to set state: %v", diags)
+	}
+	var out PoolDatasetsDataSourceModel
+	diags = state.Get(ctx, &out)
+	if diags.HasError() {
+		t.Fatalf("Failed to get state: %v", diags)
+	}
+	if len(out.Filter) != 1 || out.Filter[0].Value.ValueString() != "tank" || out.Limit.ValueInt64() != 5 {
+		t.Errorf("Query arguments did not round-trip: %+v", out.QueryArgsModel)
+	}
+}
+
+func TestSingularDataSource_NaturalKey(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		ds  datasource.DataSource
+		key string
+	}{
+		"user":    {NewUserDataSource(), "username"},
+		"group":   {NewGroupDataSource(), "name"},
+		"pool":    {NewPoolDataSource(), "name"},
+		"vm":      {NewVmDataSource(), "name"},
+		"service": {NewServiceDataSource(), "service"},
+	}
+	for name, tt := range tests {
+		var resp datasource.SchemaResponse
+		tt.ds.Schema(ctx, datasource.SchemaRequest{}, &resp)
+
+		id, ok := resp.Schema.Attributes["id"].(schema.StringAttribute)
+		if !ok || id.Required || !id.Optional || len(id.Validators) == 0 {
+			t.Errorf("%s: expected optional id with an exactly-one-of validator", name)
+		}
+		key, ok := resp.Schema.Attributes[tt.key].(schema.StringAttribute)
+		if !ok || !key.Optional || !key.Computed {
+			t.Errorf("%s: expected %s to be optional and computed", name, tt.key)
+		}
+	}
+}
+
+func TestProvider_DataSourcePairs(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+	names := map[string]bool{}
+	for _, f := range p.DataSources(ctx) {
+		var resp datasource.MetadataResponse
+		f().Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "truenas"}, &resp)
+		if names[resp.TypeName] {
+			t.Errorf("Duplicate data source %s", resp.TypeName)
+		}
+		names[resp.TypeName] = true
+	}
+	for _, name := range []string{
+		"truenas_certificate", "truenas_certificates",
+		"truenas_sharing_nfs", "truenas_sharing_nfs_list",
+		"truenas_app_registry", "truenas_app_registries",
+	} {
+		if !names[name] {
+			t.Errorf("Expected data source %s to be registered", name)
+		}
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -29,14 +28,30 @@ func NewAcmeDnsAuthenticatorResource() resource.Resource {
 
 func (r *AcmeDnsAuthenticatorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_acme_dns_authenticator"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *AcmeDnsAuthenticatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "acme.dns.authenticator.query", acmeDnsAuthenticatorIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &AcmeDnsAuthenticatorResource{}
+
+// acmeDnsAuthenticatorIdentity lists the API fields that identify a acme_dns_authenticator
+var acmeDnsAuthenticatorIdentity = []identityKey{{Name: "name"}}
+
+func (r *AcmeDnsAuthenticatorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(acmeDnsAuthenticatorIdentity)
+}
+
+func (r *AcmeDnsAuthenticatorResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *AcmeDnsAuthenticatorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a DNS Authenticator",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -74,7 +89,7 @@ func (r *AcmeDnsAuthenticatorResource) Create(ctx context.Context, req resource.
 	}
 
 	params := map[string]interface{}{}
-	if !data.Attributes.IsNull() {
+	if !data.Attributes.IsNull() && !data.Attributes.IsUnknown() {
 		var attributesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Attributes.ValueString()), &attributesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse attributes: %s", err))
@@ -82,7 +97,7 @@ func (r *AcmeDnsAuthenticatorResource) Create(ctx context.Context, req resource.
 		}
 		params["attributes"] = attributesObj
 	}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
 
@@ -104,6 +119,8 @@ func (r *AcmeDnsAuthenticatorResource) Create(ctx context.Context, req resource.
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, acmeDnsAuthenticatorIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -168,6 +185,7 @@ func (r *AcmeDnsAuthenticatorResource) Read(ctx context.Context, req resource.Re
 			data.Name = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, acmeDnsAuthenticatorIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -194,7 +212,7 @@ func (r *AcmeDnsAuthenticatorResource) Update(ctx context.Context, req resource.
 	}
 
 	params := map[string]interface{}{}
-	if !data.Attributes.IsNull() {
+	if !data.Attributes.IsNull() && !data.Attributes.IsUnknown() {
 		var attributesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Attributes.ValueString()), &attributesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse attributes: %s", err))
@@ -202,17 +220,19 @@ func (r *AcmeDnsAuthenticatorResource) Update(ctx context.Context, req resource.
 		}
 		params["attributes"] = attributesObj
 	}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
 
-	_, err = r.client.Call("acme.dns.authenticator.update", []interface{}{id, params})
+	result, err := r.client.Call("acme.dns.authenticator.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update acme_dns_authenticator: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, acmeDnsAuthenticatorIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -237,3 +257,9 @@ func (r *AcmeDnsAuthenticatorResource) Delete(ctx context.Context, req resource.
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *AcmeDnsAuthenticatorResource) setComputed(result interface{}, data *AcmeDnsAuthenticatorResourceModel) {
+	_ = result // No computed fields
+}
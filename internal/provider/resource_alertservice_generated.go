@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -37,8 +40,13 @@ func (r *AlertserviceResource) ImportState(ctx context.Context, req resource.Imp
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *AlertserviceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *AlertserviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an Alert Service of specified `type`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -56,11 +64,14 @@ func (r *AlertserviceResource) Schema(ctx context.Context, req resource.SchemaRe
 				Required:    true,
 				Optional:    false,
 				Description: "Minimum alert severity level that triggers notifications through this service.",
+				Validators:  []validator.String{stringvalidator.OneOf("INFO", "NOTICE", "WARNING", "ERROR", "CRITICAL", "ALERT", "EMERGENCY")},
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether the alert service is active and will send notifications.",
+				Default:     booldefault.StaticBool(true),
 			},
 		},
 	}
@@ -86,10 +97,10 @@ func (r *AlertserviceResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Attributes.IsNull() {
+	if !data.Attributes.IsNull() && !data.Attributes.IsUnknown() {
 		var attributesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Attributes.ValueString()), &attributesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse attributes: %s", err))
@@ -97,10 +108,10 @@ func (r *AlertserviceResource) Create(ctx context.Context, req resource.CreateRe
 		}
 		params["attributes"] = attributesObj
 	}
-	if !data.Level.IsNull() {
+	if !data.Level.IsNull() && !data.Level.IsUnknown() {
 		params["level"] = data.Level.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
 
@@ -122,6 +133,7 @@ func (r *AlertserviceResource) Create(ctx context.Context, req resource.CreateRe
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -224,10 +236,10 @@ func (r *AlertserviceResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Attributes.IsNull() {
+	if !data.Attributes.IsNull() && !data.Attributes.IsUnknown() {
 		var attributesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Attributes.ValueString()), &attributesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse attributes: %s", err))
@@ -235,20 +247,21 @@ func (r *AlertserviceResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		params["attributes"] = attributesObj
 	}
-	if !data.Level.IsNull() {
+	if !data.Level.IsNull() && !data.Level.IsUnknown() {
 		params["level"] = data.Level.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
 
-	_, err = r.client.Call("alertservice.update", []interface{}{id, params})
+	result, err := r.client.Call("alertservice.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update alertservice: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -273,3 +286,17 @@ func (r *AlertserviceResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *AlertserviceResource) setComputed(result interface{}, data *AlertserviceResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+}
@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
@@ -32,37 +33,58 @@ func NewApiKeyResource() resource.Resource {
 
 func (r *ApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_api_key"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *ApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "api_key.query", apiKeyIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &ApiKeyResource{}
+
+// apiKeyIdentity lists the API fields that identify a api_key
+var apiKeyIdentity = []identityKey{{Name: "name"}}
+
+func (r *ApiKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(apiKeyIdentity)
+}
+
+func (r *ApiKeyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Creates API Key.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"name": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Human-readable name for the API key.",
+				Default:     stringdefault.StaticString("nobody"),
 			},
 			"username": schema.StringAttribute{
 				Required:      true,
 				Optional:      false,
 				Description:   "",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"expires_at": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Expiration timestamp for the API key or `null` for no expiration.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Expiration timestamp for the API key or `null` for no expiration.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"reset": schema.BoolAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "Whether to regenerate a new API key value for this entry.",
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether to regenerate a new API key value for this entry.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
 		},
 	}
@@ -88,16 +110,16 @@ func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Username.IsNull() {
+	if !data.Username.IsNull() && !data.Username.IsUnknown() {
 		params["username"] = data.Username.ValueString()
 	}
-	if !data.ExpiresAt.IsNull() {
+	if !data.ExpiresAt.IsNull() && !data.ExpiresAt.IsUnknown() {
 		params["expires_at"] = data.ExpiresAt.ValueString()
 	}
-	if !data.Reset.IsNull() {
+	if !data.Reset.IsNull() && !data.Reset.IsUnknown() {
 		params["reset"] = data.Reset.ValueBool()
 	}
 
@@ -119,6 +141,8 @@ func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, apiKeyIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -171,6 +195,7 @@ func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 			data.Name = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, apiKeyIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -197,23 +222,25 @@ func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.ExpiresAt.IsNull() {
+	if !data.ExpiresAt.IsNull() && !data.ExpiresAt.IsUnknown() {
 		params["expires_at"] = data.ExpiresAt.ValueString()
 	}
-	if !data.Reset.IsNull() {
+	if !data.Reset.IsNull() && !data.Reset.IsUnknown() {
 		params["reset"] = data.Reset.ValueBool()
 	}
 
-	_, err = r.client.Call("api_key.update", []interface{}{id, params})
+	result, err := r.client.Call("api_key.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update api_key: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, apiKeyIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -238,3 +265,62 @@ func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *ApiKeyResource) setComputed(result interface{}, data *ApiKeyResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Name.IsUnknown() {
+		data.Name = types.StringNull()
+		if v, ok := resultMap["name"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Name = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Name = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Username.IsUnknown() {
+		data.Username = types.StringNull()
+		if v, ok := resultMap["username"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Username = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Username = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Username = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.ExpiresAt.IsUnknown() {
+		data.ExpiresAt = types.StringNull()
+		if v, ok := resultMap["expires_at"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.ExpiresAt = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.ExpiresAt = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.ExpiresAt = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Reset.IsUnknown() {
+		data.Reset = types.BoolNull()
+		if v, ok := resultMap["reset"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Reset = types.BoolValue(bv)
+			}
+		}
+	}
+}
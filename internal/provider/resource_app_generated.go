@@ -5,13 +5,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -21,15 +26,16 @@ type AppResource struct {
 }
 
 type AppResourceModel struct {
-	ID                        types.String `tfsdk:"id"`
-	CustomApp                 types.Bool   `tfsdk:"custom_app"`
-	Values                    types.String `tfsdk:"values"`
-	CustomComposeConfig       types.String `tfsdk:"custom_compose_config"`
-	CustomComposeConfigString types.String `tfsdk:"custom_compose_config_string"`
-	CatalogApp                types.String `tfsdk:"catalog_app"`
-	AppName                   types.String `tfsdk:"app_name"`
-	Train                     types.String `tfsdk:"train"`
-	Version                   types.String `tfsdk:"version"`
+	ID                        types.String   `tfsdk:"id"`
+	CustomApp                 types.Bool     `tfsdk:"custom_app"`
+	Values                    types.String   `tfsdk:"values"`
+	CustomComposeConfig       types.String   `tfsdk:"custom_compose_config"`
+	CustomComposeConfigString types.String   `tfsdk:"custom_compose_config_string"`
+	CatalogApp                types.String   `tfsdk:"catalog_app"`
+	AppName                   types.String   `tfsdk:"app_name"`
+	Train                     types.String   `tfsdk:"train"`
+	Version                   types.String   `tfsdk:"version"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewAppResource() resource.Resource {
@@ -41,60 +47,91 @@ func (r *AppResource) Metadata(ctx context.Context, req resource.MetadataRequest
 }
 
 func (r *AppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "app.query", appIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &AppResource{}
+
+// appIdentity lists the API fields that identify a app
+var appIdentity = []identityKey{{Name: "name"}}
+
+func (r *AppResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(appIdentity)
+}
+
+func (r *AppResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *AppResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an app with `app_name` using `catalog_app` with `train` and `version`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"custom_app": schema.BoolAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Whether to create a custom application (`true`) or install from catalog (`false`).",
+				Default:       booldefault.StaticBool(false),
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 			},
 			"values": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Updated configuration values for the application.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Updated configuration values for the application.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"custom_compose_config": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Updated Docker Compose configuration as a structured object.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Updated Docker Compose configuration as a structured object.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"custom_compose_config_string": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Updated Docker Compose configuration as a YAML string.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"catalog_app": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Name of the catalog application to install. Required when `custom_app` is `false`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"app_name": schema.StringAttribute{
 				Required:      true,
 				Optional:      false,
 				Description:   "Application name must have the following:  * Lowercase alphanumeric characters can be specified. * N",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.LengthBetween(1, 40), stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`), "must match ^[a-z]([-a-z0-9]*[a-z0-9])?$")},
 			},
 			"train": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "The catalog train to install from.",
+				Default:       stringdefault.StaticString("stable"),
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"version": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "The version of the application to install.",
+				Default:       stringdefault.StaticString("latest"),
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -118,10 +155,10 @@ func (r *AppResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	params := map[string]interface{}{}
-	if !data.CustomApp.IsNull() {
+	if !data.CustomApp.IsNull() && !data.CustomApp.IsUnknown() {
 		params["custom_app"] = data.CustomApp.ValueBool()
 	}
-	if !data.Values.IsNull() {
+	if !data.Values.IsNull() && !data.Values.IsUnknown() {
 		var valuesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Values.ValueString()), &valuesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse values: %s", err))
@@ -129,7 +166,7 @@ func (r *AppResource) Create(ctx context.Context, req resource.CreateRequest, re
 		}
 		params["values"] = valuesObj
 	}
-	if !data.CustomComposeConfig.IsNull() {
+	if !data.CustomComposeConfig.IsNull() && !data.CustomComposeConfig.IsUnknown() {
 		var custom_compose_configObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.CustomComposeConfig.ValueString()), &custom_compose_configObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse custom_compose_config: %s", err))
@@ -137,23 +174,29 @@ func (r *AppResource) Create(ctx context.Context, req resource.CreateRequest, re
 		}
 		params["custom_compose_config"] = custom_compose_configObj
 	}
-	if !data.CustomComposeConfigString.IsNull() {
+	if !data.CustomComposeConfigString.IsNull() && !data.CustomComposeConfigString.IsUnknown() {
 		params["custom_compose_config_string"] = data.CustomComposeConfigString.ValueString()
 	}
-	if !data.CatalogApp.IsNull() {
+	if !data.CatalogApp.IsNull() && !data.CatalogApp.IsUnknown() {
 		params["catalog_app"] = data.CatalogApp.ValueString()
 	}
-	if !data.AppName.IsNull() {
+	if !data.AppName.IsNull() && !data.AppName.IsUnknown() {
 		params["app_name"] = data.AppName.ValueString()
 	}
-	if !data.Train.IsNull() {
+	if !data.Train.IsNull() && !data.Train.IsUnknown() {
 		params["train"] = data.Train.ValueString()
 	}
-	if !data.Version.IsNull() {
+	if !data.Version.IsNull() && !data.Version.IsUnknown() {
 		params["version"] = data.Version.ValueString()
 	}
 
-	result, err := r.client.CallWithJob("app.create", params)
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithJobTimeout("app.create", params, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to create app: %s", err))
 		return
@@ -171,6 +214,8 @@ func (r *AppResource) Create(ctx context.Context, req resource.CreateRequest, re
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, appIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -186,7 +231,13 @@ func (r *AppResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	var err error
 	id = data.ID.ValueString()
 
-	result, err := r.client.Call("app.get_instance", id)
+	readTimeout, diags := data.Timeouts.Read(ctx, r.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithTimeout("app.get_instance", id, readTimeout)
 	if err != nil {
 		// Check if resource was deleted outside Terraform (ENOENT = entity not found)
 		if strings.Contains(err.Error(), "[ENOENT]") {
@@ -207,6 +258,7 @@ func (r *AppResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	if v, ok := resultMap["id"]; ok && v != nil {
 		data.ID = types.StringValue(fmt.Sprintf("%v", v))
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, appIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -229,7 +281,7 @@ func (r *AppResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	id = state.ID.ValueString()
 
 	params := map[string]interface{}{}
-	if !data.Values.IsNull() {
+	if !data.Values.IsNull() && !data.Values.IsUnknown() {
 		var valuesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Values.ValueString()), &valuesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse values: %s", err))
@@ -237,7 +289,7 @@ func (r *AppResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		}
 		params["values"] = valuesObj
 	}
-	if !data.CustomComposeConfig.IsNull() {
+	if !data.CustomComposeConfig.IsNull() && !data.CustomComposeConfig.IsUnknown() {
 		var custom_compose_configObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.CustomComposeConfig.ValueString()), &custom_compose_configObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse custom_compose_config: %s", err))
@@ -245,17 +297,25 @@ func (r *AppResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		}
 		params["custom_compose_config"] = custom_compose_configObj
 	}
-	if !data.CustomComposeConfigString.IsNull() {
+	if !data.CustomComposeConfigString.IsNull() && !data.CustomComposeConfigString.IsUnknown() {
 		params["custom_compose_config_string"] = data.CustomComposeConfigString.ValueString()
 	}
 
-	_, err = r.client.CallWithJob("app.update", []interface{}{id, params})
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithJobTimeout("app.update", []interface{}{id, params}, updateTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update app: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, appIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -273,9 +333,120 @@ func (r *AppResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	_, _ = r.client.Call("app.stop", data.ID.ValueString())
 	time.Sleep(2 * time.Second)
 
-	_, err = r.client.CallWithJob("app.delete", id)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = r.client.CallWithJobTimeout("app.delete", id, deleteTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to delete app: %s", err))
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *AppResource) setComputed(result interface{}, data *AppResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.CustomApp.IsUnknown() {
+		data.CustomApp = types.BoolNull()
+		if v, ok := resultMap["custom_app"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.CustomApp = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Values.IsUnknown() {
+		data.Values = types.StringNull()
+		if v, ok := resultMap["values"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Values = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.CustomComposeConfig.IsUnknown() {
+		data.CustomComposeConfig = types.StringNull()
+		if v, ok := resultMap["custom_compose_config"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.CustomComposeConfig = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.CustomComposeConfigString.IsUnknown() {
+		data.CustomComposeConfigString = types.StringNull()
+		if v, ok := resultMap["custom_compose_config_string"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.CustomComposeConfigString = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.CustomComposeConfigString = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.CustomComposeConfigString = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.CatalogApp.IsUnknown() {
+		data.CatalogApp = types.StringNull()
+		if v, ok := resultMap["catalog_app"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.CatalogApp = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.CatalogApp = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.CatalogApp = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.AppName.IsUnknown() {
+		data.AppName = types.StringNull()
+		if v, ok := resultMap["app_name"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.AppName = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.AppName = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.AppName = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Train.IsUnknown() {
+		data.Train = types.StringNull()
+		if v, ok := resultMap["train"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Train = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Train = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Train = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Version.IsUnknown() {
+		data.Version = types.StringNull()
+		if v, ok := resultMap["version"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Version = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Version = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Version = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
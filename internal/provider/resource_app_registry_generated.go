@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -31,14 +33,30 @@ func NewAppRegistryResource() resource.Resource {
 
 func (r *AppRegistryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_app_registry"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *AppRegistryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "app.registry.query", appRegistryIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &AppRegistryResource{}
+
+// appRegistryIdentity lists the API fields that identify a app_registry
+var appRegistryIdentity = []identityKey{{Name: "name"}}
+
+func (r *AppRegistryResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(appRegistryIdentity)
+}
+
+func (r *AppRegistryResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *AppRegistryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an app registry entry.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -48,9 +66,11 @@ func (r *AppRegistryResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "Human-readable name for the container registry.",
 			},
 			"description": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Optional description of the container registry or `null`.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Optional description of the container registry or `null`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"username": schema.StringAttribute{
 				Required:    true,
@@ -65,7 +85,9 @@ func (r *AppRegistryResource) Schema(ctx context.Context, req resource.SchemaReq
 			"uri": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Container registry URI endpoint (defaults to Docker Hub).",
+				Default:     stringdefault.StaticString("https://index.docker.io/v1/"),
 			},
 		},
 	}
@@ -91,19 +113,19 @@ func (r *AppRegistryResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Username.IsNull() {
+	if !data.Username.IsNull() && !data.Username.IsUnknown() {
 		params["username"] = data.Username.ValueString()
 	}
-	if !data.Password.IsNull() {
+	if !data.Password.IsNull() && !data.Password.IsUnknown() {
 		params["password"] = data.Password.ValueString()
 	}
-	if !data.Uri.IsNull() {
+	if !data.Uri.IsNull() && !data.Uri.IsUnknown() {
 		params["uri"] = data.Uri.ValueString()
 	}
 
@@ -125,6 +147,8 @@ func (r *AppRegistryResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, appRegistryIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -201,6 +225,7 @@ func (r *AppRegistryResource) Read(ctx context.Context, req resource.ReadRequest
 			data.Password = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, appRegistryIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -227,29 +252,31 @@ func (r *AppRegistryResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Username.IsNull() {
+	if !data.Username.IsNull() && !data.Username.IsUnknown() {
 		params["username"] = data.Username.ValueString()
 	}
-	if !data.Password.IsNull() {
+	if !data.Password.IsNull() && !data.Password.IsUnknown() {
 		params["password"] = data.Password.ValueString()
 	}
-	if !data.Uri.IsNull() {
+	if !data.Uri.IsNull() && !data.Uri.IsUnknown() {
 		params["uri"] = data.Uri.ValueString()
 	}
 
-	_, err = r.client.Call("app.registry.update", []interface{}{id, params})
+	result, err := r.client.Call("app.registry.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update app_registry: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, appRegistryIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -274,3 +301,39 @@ func (r *AppRegistryResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *AppRegistryResource) setComputed(result interface{}, data *AppRegistryResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Description.IsUnknown() {
+		data.Description = types.StringNull()
+		if v, ok := resultMap["description"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Description = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Description = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Uri.IsUnknown() {
+		data.Uri = types.StringNull()
+		if v, ok := resultMap["uri"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Uri = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Uri = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Uri = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
@@ -5,13 +5,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -22,32 +29,33 @@ type CertificateResource struct {
 }
 
 type CertificateResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	CreateType         types.String `tfsdk:"create_type"`
-	AddToTrustedStore  types.Bool   `tfsdk:"add_to_trusted_store"`
-	Certificate        types.String `tfsdk:"certificate"`
-	Privatekey         types.String `tfsdk:"privatekey"`
-	Csr                types.String `tfsdk:"csr"`
-	KeyLength          types.Int64  `tfsdk:"key_length"`
-	KeyType            types.String `tfsdk:"key_type"`
-	EcCurve            types.String `tfsdk:"ec_curve"`
-	Passphrase         types.String `tfsdk:"passphrase"`
-	City               types.String `tfsdk:"city"`
-	Common             types.String `tfsdk:"common"`
-	Country            types.String `tfsdk:"country"`
-	Email              types.String `tfsdk:"email"`
-	Organization       types.String `tfsdk:"organization"`
-	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
-	State              types.String `tfsdk:"state"`
-	DigestAlgorithm    types.String `tfsdk:"digest_algorithm"`
-	San                types.List   `tfsdk:"san"`
-	CertExtensions     types.String `tfsdk:"cert_extensions"`
-	AcmeDirectoryUri   types.String `tfsdk:"acme_directory_uri"`
-	CsrId              types.Int64  `tfsdk:"csr_id"`
-	Tos                types.Bool   `tfsdk:"tos"`
-	DnsMapping         types.String `tfsdk:"dns_mapping"`
-	RenewDays          types.Int64  `tfsdk:"renew_days"`
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	CreateType         types.String   `tfsdk:"create_type"`
+	AddToTrustedStore  types.Bool     `tfsdk:"add_to_trusted_store"`
+	Certificate        types.String   `tfsdk:"certificate"`
+	Privatekey         types.String   `tfsdk:"privatekey"`
+	Csr                types.String   `tfsdk:"csr"`
+	KeyLength          types.Int64    `tfsdk:"key_length"`
+	KeyType            types.String   `tfsdk:"key_type"`
+	EcCurve            types.String   `tfsdk:"ec_curve"`
+	Passphrase         types.String   `tfsdk:"passphrase"`
+	City               types.String   `tfsdk:"city"`
+	Common             types.String   `tfsdk:"common"`
+	Country            types.String   `tfsdk:"country"`
+	Email              types.String   `tfsdk:"email"`
+	Organization       types.String   `tfsdk:"organization"`
+	OrganizationalUnit types.String   `tfsdk:"organizational_unit"`
+	State              types.String   `tfsdk:"state"`
+	DigestAlgorithm    types.String   `tfsdk:"digest_algorithm"`
+	San                types.List     `tfsdk:"san"`
+	CertExtensions     types.String   `tfsdk:"cert_extensions"`
+	AcmeDirectoryUri   types.String   `tfsdk:"acme_directory_uri"`
+	CsrId              types.Int64    `tfsdk:"csr_id"`
+	Tos                types.Bool     `tfsdk:"tos"`
+	DnsMapping         types.String   `tfsdk:"dns_mapping"`
+	RenewDays          types.Int64    `tfsdk:"renew_days"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewCertificateResource() resource.Resource {
@@ -56,14 +64,30 @@ func NewCertificateResource() resource.Resource {
 
 func (r *CertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_certificate"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *CertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "certificate.query", certificateIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &CertificateResource{}
+
+// certificateIdentity lists the API fields that identify a certificate
+var certificateIdentity = []identityKey{{Name: "name"}}
+
+func (r *CertificateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(certificateIdentity)
+}
+
+func (r *CertificateResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a new Certificate",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -76,145 +100,180 @@ func (r *CertificateResource) Schema(ctx context.Context, req resource.SchemaReq
 				Required:      true,
 				Optional:      false,
 				Description:   "Type of certificate creation operation.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("CERTIFICATE_CREATE_IMPORTED", "CERTIFICATE_CREATE_CSR", "CERTIFICATE_CREATE_IMPORTED_CSR", "CERTIFICATE_CREATE_ACME")},
 			},
 			"add_to_trusted_store": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to add this certificate to the trusted certificate store.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"certificate": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "PEM-encoded certificate to import or `null`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"privatekey": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "PEM-encoded private key to import or `null`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"csr": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "PEM-encoded certificate signing request to import or `null`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"key_length": schema.Int64Attribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "RSA key length in bits or `null`.",
-				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown(), int64planmodifier.RequiresReplace()},
 			},
 			"key_type": schema.StringAttribute{
 				Optional:      true,
 				Computed:      true,
 				Description:   "Type of cryptographic key to generate.",
+				Default:       stringdefault.StaticString("RSA"),
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("RSA", "EC")},
 			},
 			"ec_curve": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Elliptic curve to use for EC keys.",
+				Default:       stringdefault.StaticString("SECP384R1"),
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("SECP256R1", "SECP384R1", "SECP521R1", "ed25519")},
 			},
 			"passphrase": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Passphrase to protect the private key or `null`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"city": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "City or locality name for certificate subject or `null`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"common": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Common name for certificate subject or `null`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"country": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Country name for certificate subject or `null`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"email": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Email address for certificate subject or `null`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"organization": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Organization name for certificate subject or `null`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"organizational_unit": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Organizational unit for certificate subject or `null`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"state": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "State or province name for certificate subject or `null`.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"digest_algorithm": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Hash algorithm for certificate signing.",
+				Default:       stringdefault.StaticString("SHA256"),
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("SHA224", "SHA256", "SHA384", "SHA512")},
 			},
 			"san": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Subject alternative names for the certificate.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Subject alternative names for the certificate.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"cert_extensions": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Certificate extensions configuration.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"acme_directory_uri": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "ACME directory URI to be used for ACME certificate creation.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"csr_id": schema.Int64Attribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "CSR to be used for ACME certificate creation.",
-				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown(), int64planmodifier.RequiresReplace()},
 			},
 			"tos": schema.BoolAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Set this when creating an ACME certificate to accept terms of service of the ACME service.",
-				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown(), boolplanmodifier.RequiresReplace()},
 			},
 			"dns_mapping": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "A mapping of domain to ACME DNS Authenticator ID for each domain listed in SAN or common name of the",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"renew_days": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Days before expiration to attempt renewal.",
+				Default:     int64default.StaticInt64(10),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -238,66 +297,66 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.CreateType.IsNull() {
+	if !data.CreateType.IsNull() && !data.CreateType.IsUnknown() {
 		params["create_type"] = data.CreateType.ValueString()
 	}
-	if !data.AddToTrustedStore.IsNull() {
+	if !data.AddToTrustedStore.IsNull() && !data.AddToTrustedStore.IsUnknown() {
 		params["add_to_trusted_store"] = data.AddToTrustedStore.ValueBool()
 	}
-	if !data.Certificate.IsNull() {
+	if !data.Certificate.IsNull() && !data.Certificate.IsUnknown() {
 		params["certificate"] = data.Certificate.ValueString()
 	}
-	if !data.Privatekey.IsNull() {
+	if !data.Privatekey.IsNull() && !data.Privatekey.IsUnknown() {
 		params["privatekey"] = data.Privatekey.ValueString()
 	}
-	if !data.Csr.IsNull() {
+	if !data.Csr.IsNull() && !data.Csr.IsUnknown() {
 		params["CSR"] = data.Csr.ValueString()
 	}
-	if !data.KeyLength.IsNull() {
+	if !data.KeyLength.IsNull() && !data.KeyLength.IsUnknown() {
 		params["key_length"] = data.KeyLength.ValueInt64()
 	}
-	if !data.KeyType.IsNull() {
+	if !data.KeyType.IsNull() && !data.KeyType.IsUnknown() {
 		params["key_type"] = data.KeyType.ValueString()
 	}
-	if !data.EcCurve.IsNull() {
+	if !data.EcCurve.IsNull() && !data.EcCurve.IsUnknown() {
 		params["ec_curve"] = data.EcCurve.ValueString()
 	}
-	if !data.Passphrase.IsNull() {
+	if !data.Passphrase.IsNull() && !data.Passphrase.IsUnknown() {
 		params["passphrase"] = data.Passphrase.ValueString()
 	}
-	if !data.City.IsNull() {
+	if !data.City.IsNull() && !data.City.IsUnknown() {
 		params["city"] = data.City.ValueString()
 	}
-	if !data.Common.IsNull() {
+	if !data.Common.IsNull() && !data.Common.IsUnknown() {
 		params["common"] = data.Common.ValueString()
 	}
-	if !data.Country.IsNull() {
+	if !data.Country.IsNull() && !data.Country.IsUnknown() {
 		params["country"] = data.Country.ValueString()
 	}
-	if !data.Email.IsNull() {
+	if !data.Email.IsNull() && !data.Email.IsUnknown() {
 		params["email"] = data.Email.ValueString()
 	}
-	if !data.Organization.IsNull() {
+	if !data.Organization.IsNull() && !data.Organization.IsUnknown() {
 		params["organization"] = data.Organization.ValueString()
 	}
-	if !data.OrganizationalUnit.IsNull() {
+	if !data.OrganizationalUnit.IsNull() && !data.OrganizationalUnit.IsUnknown() {
 		params["organizational_unit"] = data.OrganizationalUnit.ValueString()
 	}
-	if !data.State.IsNull() {
+	if !data.State.IsNull() && !data.State.IsUnknown() {
 		params["state"] = data.State.ValueString()
 	}
-	if !data.DigestAlgorithm.IsNull() {
+	if !data.DigestAlgorithm.IsNull() && !data.DigestAlgorithm.IsUnknown() {
 		params["digest_algorithm"] = data.DigestAlgorithm.ValueString()
 	}
-	if !data.San.IsNull() {
+	if !data.San.IsNull() && !data.San.IsUnknown() {
 		var sanList []string
 		data.San.ElementsAs(ctx, &sanList, false)
 		params["san"] = sanList
 	}
-	if !data.CertExtensions.IsNull() {
+	if !data.CertExtensions.IsNull() && !data.CertExtensions.IsUnknown() {
 		var cert_extensionsObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.CertExtensions.ValueString()), &cert_extensionsObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse cert_extensions: %s", err))
@@ -305,16 +364,16 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["cert_extensions"] = cert_extensionsObj
 	}
-	if !data.AcmeDirectoryUri.IsNull() {
+	if !data.AcmeDirectoryUri.IsNull() && !data.AcmeDirectoryUri.IsUnknown() {
 		params["acme_directory_uri"] = data.AcmeDirectoryUri.ValueString()
 	}
-	if !data.CsrId.IsNull() {
+	if !data.CsrId.IsNull() && !data.CsrId.IsUnknown() {
 		params["csr_id"] = data.CsrId.ValueInt64()
 	}
-	if !data.Tos.IsNull() {
+	if !data.Tos.IsNull() && !data.Tos.IsUnknown() {
 		params["tos"] = data.Tos.ValueBool()
 	}
-	if !data.DnsMapping.IsNull() {
+	if !data.DnsMapping.IsNull() && !data.DnsMapping.IsUnknown() {
 		var dns_mappingObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.DnsMapping.ValueString()), &dns_mappingObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse dns_mapping: %s", err))
@@ -322,11 +381,17 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["dns_mapping"] = dns_mappingObj
 	}
-	if !data.RenewDays.IsNull() {
+	if !data.RenewDays.IsNull() && !data.RenewDays.IsUnknown() {
 		params["renew_days"] = data.RenewDays.ValueInt64()
 	}
 
-	result, err := r.client.CallWithJob("certificate.create", params)
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithJobTimeout("certificate.create", params, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to create certificate: %s", err))
 		return
@@ -344,6 +409,8 @@ func (r *CertificateResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, certificateIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -363,7 +430,13 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	result, err := r.client.Call("certificate.get_instance", id)
+	readTimeout, diags := data.Timeouts.Read(ctx, r.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithTimeout("certificate.get_instance", id, readTimeout)
 	if err != nil {
 		// Check if resource was deleted outside Terraform (ENOENT = entity not found)
 		if strings.Contains(err.Error(), "[ENOENT]") {
@@ -396,6 +469,7 @@ func (r *CertificateResource) Read(ctx context.Context, req resource.ReadRequest
 			data.Name = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, certificateIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -422,23 +496,31 @@ func (r *CertificateResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.RenewDays.IsNull() {
+	if !data.RenewDays.IsNull() && !data.RenewDays.IsUnknown() {
 		params["renew_days"] = data.RenewDays.ValueInt64()
 	}
-	if !data.AddToTrustedStore.IsNull() {
+	if !data.AddToTrustedStore.IsNull() && !data.AddToTrustedStore.IsUnknown() {
 		params["add_to_trusted_store"] = data.AddToTrustedStore.ValueBool()
 	}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
 
-	_, err = r.client.CallWithJob("certificate.update", []interface{}{id, params})
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithJobTimeout("certificate.update", []interface{}{id, params}, updateTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update certificate: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, certificateIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -458,9 +540,350 @@ func (r *CertificateResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 	id = []interface{}{id, map[string]interface{}{}}
 
-	_, err = r.client.CallWithJob("certificate.delete", id)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = r.client.CallWithJobTimeout("certificate.delete", id, deleteTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to delete certificate: %s", err))
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *CertificateResource) setComputed(result interface{}, data *CertificateResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.CreateType.IsUnknown() {
+		data.CreateType = types.StringNull()
+		if v, ok := resultMap["create_type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.CreateType = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.CreateType = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.CreateType = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.AddToTrustedStore.IsUnknown() {
+		data.AddToTrustedStore = types.BoolNull()
+		if v, ok := resultMap["add_to_trusted_store"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.AddToTrustedStore = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Certificate.IsUnknown() {
+		data.Certificate = types.StringNull()
+		if v, ok := resultMap["certificate"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Certificate = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Certificate = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Certificate = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Privatekey.IsUnknown() {
+		data.Privatekey = types.StringNull()
+		if v, ok := resultMap["privatekey"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Privatekey = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Privatekey = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Privatekey = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Csr.IsUnknown() {
+		data.Csr = types.StringNull()
+		if v, ok := resultMap["csr"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Csr = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Csr = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Csr = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.KeyLength.IsUnknown() {
+		data.KeyLength = types.Int64Null()
+		if v, ok := resultMap["key_length"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.KeyLength = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.KeyLength = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.KeyType.IsUnknown() {
+		data.KeyType = types.StringNull()
+		if v, ok := resultMap["key_type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.KeyType = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.KeyType = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.KeyType = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.EcCurve.IsUnknown() {
+		data.EcCurve = types.StringNull()
+		if v, ok := resultMap["ec_curve"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.EcCurve = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.EcCurve = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.EcCurve = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Passphrase.IsUnknown() {
+		data.Passphrase = types.StringNull()
+		if v, ok := resultMap["passphrase"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Passphrase = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Passphrase = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Passphrase = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.City.IsUnknown() {
+		data.City = types.StringNull()
+		if v, ok := resultMap["city"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.City = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.City = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.City = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Common.IsUnknown() {
+		data.Common = types.StringNull()
+		if v, ok := resultMap["common"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Common = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Common = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Common = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Country.IsUnknown() {
+		data.Country = types.StringNull()
+		if v, ok := resultMap["country"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Country = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Country = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Country = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Email.IsUnknown() {
+		data.Email = types.StringNull()
+		if v, ok := resultMap["email"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Email = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Email = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Email = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Organization.IsUnknown() {
+		data.Organization = types.StringNull()
+		if v, ok := resultMap["organization"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Organization = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Organization = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Organization = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.OrganizationalUnit.IsUnknown() {
+		data.OrganizationalUnit = types.StringNull()
+		if v, ok := resultMap["organizational_unit"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.OrganizationalUnit = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.OrganizationalUnit = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.OrganizationalUnit = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.State.IsUnknown() {
+		data.State = types.StringNull()
+		if v, ok := resultMap["state"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.State = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.State = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.State = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.DigestAlgorithm.IsUnknown() {
+		data.DigestAlgorithm = types.StringNull()
+		if v, ok := resultMap["digest_algorithm"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.DigestAlgorithm = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.DigestAlgorithm = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.DigestAlgorithm = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.San.IsUnknown() {
+		data.San = types.ListNull(types.StringType)
+		if v, ok := resultMap["san"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.San, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.CertExtensions.IsUnknown() {
+		data.CertExtensions = types.StringNull()
+		if v, ok := resultMap["cert_extensions"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.CertExtensions = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.AcmeDirectoryUri.IsUnknown() {
+		data.AcmeDirectoryUri = types.StringNull()
+		if v, ok := resultMap["acme_directory_uri"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.AcmeDirectoryUri = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.AcmeDirectoryUri = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.AcmeDirectoryUri = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.CsrId.IsUnknown() {
+		data.CsrId = types.Int64Null()
+		if v, ok := resultMap["csr_id"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.CsrId = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.CsrId = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Tos.IsUnknown() {
+		data.Tos = types.BoolNull()
+		if v, ok := resultMap["tos"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Tos = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.DnsMapping.IsUnknown() {
+		data.DnsMapping = types.StringNull()
+		if v, ok := resultMap["dns_mapping"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.DnsMapping = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.RenewDays.IsUnknown() {
+		data.RenewDays = types.Int64Null()
+		if v, ok := resultMap["renew_days"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.RenewDays = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.RenewDays = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+}
@@ -5,11 +5,19 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -53,15 +61,22 @@ func (r *CloudBackupResource) ImportState(ctx context.Context, req resource.Impo
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *CloudBackupResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *CloudBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a new cloud backup task",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"description": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "The name of the task to display in the UI.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"path": schema.StringAttribute{
 				Required:    true,
@@ -79,46 +94,62 @@ func (r *CloudBackupResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "Additional information for each backup, e.g. bucket name.",
 			},
 			"schedule": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Cron schedule dictating when the task should run.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Cron schedule dictating when the task should run.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"pre_script": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "A Bash script to run immediately before every backup.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"post_script": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "A Bash script to run immediately after every backup if it succeeds.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"snapshot": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to create a temporary snapshot of the dataset before every backup.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"include": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Paths to pass to `restic backup --include`.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Paths to pass to `restic backup --include`.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"exclude": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Paths to pass to `restic backup --exclude`.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Paths to pass to `restic backup --exclude`.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"args": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "(Slated for removal).",
+				Default:     stringdefault.StaticString(""),
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Can enable/disable the task.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"password": schema.StringAttribute{
 				Required:    true,
@@ -133,23 +164,32 @@ func (r *CloudBackupResource) Schema(ctx context.Context, req resource.SchemaReq
 			"transfer_setting": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "* DEFAULT:     * pack size given by `$RESTIC_PACK_SIZE` (default 16 MiB)     * read concurrency give",
+				Default:     stringdefault.StaticString("DEFAULT"),
+				Validators:  []validator.String{stringvalidator.OneOf("DEFAULT", "PERFORMANCE", "FAST_STORAGE")},
 			},
 			"absolute_paths": schema.BoolAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Preserve absolute paths in each backup (cannot be set when `snapshot=True`).",
+				Default:       booldefault.StaticBool(false),
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 			},
 			"cache_path": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Cache path. If not set, performance may degrade.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Cache path. If not set, performance may degrade.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"rate_limit": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Maximum upload/download rate in KiB/s. Passed to `restic --limit-upload` on `cloud_backup.sync` and ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum upload/download rate in KiB/s. Passed to `restic --limit-upload` on `cloud_backup.sync` and ",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 		},
 	}
@@ -175,16 +215,16 @@ func (r *CloudBackupResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.Credentials.IsNull() {
+	if !data.Credentials.IsNull() && !data.Credentials.IsUnknown() {
 		params["credentials"] = data.Credentials.ValueInt64()
 	}
-	if !data.Attributes.IsNull() {
+	if !data.Attributes.IsNull() && !data.Attributes.IsUnknown() {
 		var attributesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Attributes.ValueString()), &attributesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse attributes: %s", err))
@@ -192,7 +232,7 @@ func (r *CloudBackupResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["attributes"] = attributesObj
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -200,47 +240,47 @@ func (r *CloudBackupResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.PreScript.IsNull() {
+	if !data.PreScript.IsNull() && !data.PreScript.IsUnknown() {
 		params["pre_script"] = data.PreScript.ValueString()
 	}
-	if !data.PostScript.IsNull() {
+	if !data.PostScript.IsNull() && !data.PostScript.IsUnknown() {
 		params["post_script"] = data.PostScript.ValueString()
 	}
-	if !data.Snapshot.IsNull() {
+	if !data.Snapshot.IsNull() && !data.Snapshot.IsUnknown() {
 		params["snapshot"] = data.Snapshot.ValueBool()
 	}
-	if !data.Include.IsNull() {
+	if !data.Include.IsNull() && !data.Include.IsUnknown() {
 		var includeList []string
 		data.Include.ElementsAs(ctx, &includeList, false)
 		params["include"] = includeList
 	}
-	if !data.Exclude.IsNull() {
+	if !data.Exclude.IsNull() && !data.Exclude.IsUnknown() {
 		var excludeList []string
 		data.Exclude.ElementsAs(ctx, &excludeList, false)
 		params["exclude"] = excludeList
 	}
-	if !data.Args.IsNull() {
+	if !data.Args.IsNull() && !data.Args.IsUnknown() {
 		params["args"] = data.Args.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Password.IsNull() {
+	if !data.Password.IsNull() && !data.Password.IsUnknown() {
 		params["password"] = data.Password.ValueString()
 	}
-	if !data.KeepLast.IsNull() {
+	if !data.KeepLast.IsNull() && !data.KeepLast.IsUnknown() {
 		params["keep_last"] = data.KeepLast.ValueInt64()
 	}
-	if !data.TransferSetting.IsNull() {
+	if !data.TransferSetting.IsNull() && !data.TransferSetting.IsUnknown() {
 		params["transfer_setting"] = data.TransferSetting.ValueString()
 	}
-	if !data.AbsolutePaths.IsNull() {
+	if !data.AbsolutePaths.IsNull() && !data.AbsolutePaths.IsUnknown() {
 		params["absolute_paths"] = data.AbsolutePaths.ValueBool()
 	}
-	if !data.CachePath.IsNull() {
+	if !data.CachePath.IsNull() && !data.CachePath.IsUnknown() {
 		params["cache_path"] = data.CachePath.ValueString()
 	}
-	if !data.RateLimit.IsNull() {
+	if !data.RateLimit.IsNull() && !data.RateLimit.IsUnknown() {
 		params["rate_limit"] = data.RateLimit.ValueInt64()
 	}
 
@@ -262,6 +302,7 @@ func (r *CloudBackupResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -388,16 +429,16 @@ func (r *CloudBackupResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.Credentials.IsNull() {
+	if !data.Credentials.IsNull() && !data.Credentials.IsUnknown() {
 		params["credentials"] = data.Credentials.ValueInt64()
 	}
-	if !data.Attributes.IsNull() {
+	if !data.Attributes.IsNull() && !data.Attributes.IsUnknown() {
 		var attributesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Attributes.ValueString()), &attributesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse attributes: %s", err))
@@ -405,7 +446,7 @@ func (r *CloudBackupResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		params["attributes"] = attributesObj
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -413,54 +454,55 @@ func (r *CloudBackupResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.PreScript.IsNull() {
+	if !data.PreScript.IsNull() && !data.PreScript.IsUnknown() {
 		params["pre_script"] = data.PreScript.ValueString()
 	}
-	if !data.PostScript.IsNull() {
+	if !data.PostScript.IsNull() && !data.PostScript.IsUnknown() {
 		params["post_script"] = data.PostScript.ValueString()
 	}
-	if !data.Snapshot.IsNull() {
+	if !data.Snapshot.IsNull() && !data.Snapshot.IsUnknown() {
 		params["snapshot"] = data.Snapshot.ValueBool()
 	}
-	if !data.Include.IsNull() {
+	if !data.Include.IsNull() && !data.Include.IsUnknown() {
 		var includeList []string
 		data.Include.ElementsAs(ctx, &includeList, false)
 		params["include"] = includeList
 	}
-	if !data.Exclude.IsNull() {
+	if !data.Exclude.IsNull() && !data.Exclude.IsUnknown() {
 		var excludeList []string
 		data.Exclude.ElementsAs(ctx, &excludeList, false)
 		params["exclude"] = excludeList
 	}
-	if !data.Args.IsNull() {
+	if !data.Args.IsNull() && !data.Args.IsUnknown() {
 		params["args"] = data.Args.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Password.IsNull() {
+	if !data.Password.IsNull() && !data.Password.IsUnknown() {
 		params["password"] = data.Password.ValueString()
 	}
-	if !data.KeepLast.IsNull() {
+	if !data.KeepLast.IsNull() && !data.KeepLast.IsUnknown() {
 		params["keep_last"] = data.KeepLast.ValueInt64()
 	}
-	if !data.TransferSetting.IsNull() {
+	if !data.TransferSetting.IsNull() && !data.TransferSetting.IsUnknown() {
 		params["transfer_setting"] = data.TransferSetting.ValueString()
 	}
-	if !data.CachePath.IsNull() {
+	if !data.CachePath.IsNull() && !data.CachePath.IsUnknown() {
 		params["cache_path"] = data.CachePath.ValueString()
 	}
-	if !data.RateLimit.IsNull() {
+	if !data.RateLimit.IsNull() && !data.RateLimit.IsUnknown() {
 		params["rate_limit"] = data.RateLimit.ValueInt64()
 	}
 
-	_, err = r.client.Call("cloud_backup.update", []interface{}{id, params})
+	result, err := r.client.Call("cloud_backup.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update cloud_backup: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -485,3 +527,170 @@ func (r *CloudBackupResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *CloudBackupResource) setComputed(result interface{}, data *CloudBackupResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Description.IsUnknown() {
+		data.Description = types.StringNull()
+		if v, ok := resultMap["description"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Description = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Description = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Schedule.IsUnknown() {
+		data.Schedule = types.StringNull()
+		if v, ok := resultMap["schedule"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Schedule = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.PreScript.IsUnknown() {
+		data.PreScript = types.StringNull()
+		if v, ok := resultMap["pre_script"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.PreScript = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.PreScript = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.PreScript = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.PostScript.IsUnknown() {
+		data.PostScript = types.StringNull()
+		if v, ok := resultMap["post_script"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.PostScript = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.PostScript = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.PostScript = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Snapshot.IsUnknown() {
+		data.Snapshot = types.BoolNull()
+		if v, ok := resultMap["snapshot"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Snapshot = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Include.IsUnknown() {
+		data.Include = types.ListNull(types.StringType)
+		if v, ok := resultMap["include"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Include, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Exclude.IsUnknown() {
+		data.Exclude = types.ListNull(types.StringType)
+		if v, ok := resultMap["exclude"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Exclude, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Args.IsUnknown() {
+		data.Args = types.StringNull()
+		if v, ok := resultMap["args"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Args = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Args = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Args = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TransferSetting.IsUnknown() {
+		data.TransferSetting = types.StringNull()
+		if v, ok := resultMap["transfer_setting"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.TransferSetting = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.TransferSetting = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.TransferSetting = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.AbsolutePaths.IsUnknown() {
+		data.AbsolutePaths = types.BoolNull()
+		if v, ok := resultMap["absolute_paths"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.AbsolutePaths = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.CachePath.IsUnknown() {
+		data.CachePath = types.StringNull()
+		if v, ok := resultMap["cache_path"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.CachePath = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.CachePath = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.CachePath = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.RateLimit.IsUnknown() {
+		data.RateLimit = types.Int64Null()
+		if v, ok := resultMap["rate_limit"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.RateLimit = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.RateLimit = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,14 +26,30 @@ func NewCloudsyncCredentialsResource() resource.Resource {
 
 func (r *CloudsyncCredentialsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cloudsync_credentials"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *CloudsyncCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "cloudsync.credentials.query", cloudsyncCredentialsIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &CloudsyncCredentialsResource{}
+
+// cloudsyncCredentialsIdentity lists the API fields that identify a cloudsync_credentials
+var cloudsyncCredentialsIdentity = []identityKey{{Name: "name"}}
+
+func (r *CloudsyncCredentialsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(cloudsyncCredentialsIdentity)
+}
+
+func (r *CloudsyncCredentialsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *CloudsyncCredentialsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create Cloud Sync Credentials.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -67,7 +82,7 @@ func (r *CloudsyncCredentialsResource) Create(ctx context.Context, req resource.
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
 
@@ -89,6 +104,8 @@ func (r *CloudsyncCredentialsResource) Create(ctx context.Context, req resource.
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, cloudsyncCredentialsIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -141,6 +158,7 @@ func (r *CloudsyncCredentialsResource) Read(ctx context.Context, req resource.Re
 			data.Name = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, cloudsyncCredentialsIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -167,17 +185,19 @@ func (r *CloudsyncCredentialsResource) Update(ctx context.Context, req resource.
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
 
-	_, err = r.client.Call("cloudsync.credentials.update", []interface{}{id, params})
+	result, err := r.client.Call("cloudsync.credentials.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update cloudsync_credentials: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, cloudsyncCredentialsIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -202,3 +222,9 @@ func (r *CloudsyncCredentialsResource) Delete(ctx context.Context, req resource.
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *CloudsyncCredentialsResource) setComputed(result interface{}, data *CloudsyncCredentialsResourceModel) {
+	_ = result // No computed fields
+}
@@ -5,9 +5,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -55,15 +64,22 @@ func (r *CloudsyncResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *CloudsyncResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *CloudsyncResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Creates a new cloud_sync entry.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"description": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "The name of the task to display in the UI.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"path": schema.StringAttribute{
 				Required:    true,
@@ -81,97 +97,131 @@ func (r *CloudsyncResource) Schema(ctx context.Context, req resource.SchemaReque
 				Description: "Additional information for each backup, e.g. bucket name.",
 			},
 			"schedule": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Cron schedule dictating when the task should run.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Cron schedule dictating when the task should run.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"pre_script": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "A Bash script to run immediately before every backup.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"post_script": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "A Bash script to run immediately after every backup if it succeeds.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"snapshot": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to create a temporary snapshot of the dataset before every backup.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"include": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Paths to pass to `restic backup --include`.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Paths to pass to `restic backup --include`.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"exclude": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Paths to pass to `restic backup --exclude`.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Paths to pass to `restic backup --exclude`.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"args": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "(Slated for removal).",
+				Default:     stringdefault.StaticString(""),
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Can enable/disable the task.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"bwlimit": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Schedule of bandwidth limits.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Schedule of bandwidth limits.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"transfers": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Maximum number of parallel file transfers. `null` for default.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum number of parallel file transfers. `null` for default.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"direction": schema.StringAttribute{
 				Required:    true,
 				Optional:    false,
 				Description: "Direction of the cloud sync operation.  * `PUSH`: Upload local files to cloud storage * `PULL`: Down",
+				Validators:  []validator.String{stringvalidator.OneOf("PUSH", "PULL")},
 			},
 			"transfer_mode": schema.StringAttribute{
 				Required:    true,
 				Optional:    false,
 				Description: "How files are transferred between local and cloud storage.  * `SYNC`: Synchronize directories (add n",
+				Validators:  []validator.String{stringvalidator.OneOf("SYNC", "COPY", "MOVE")},
 			},
 			"encryption": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to encrypt files before uploading to cloud storage.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"filename_encryption": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to encrypt filenames in addition to file contents.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"encryption_password": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Password for client-side encryption. Empty string if encryption is disabled.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"encryption_salt": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Salt value for encryption key derivation. Empty string if encryption is disabled.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"create_empty_src_dirs": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to create empty directories in the destination that exist in the source.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"follow_symlinks": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to follow symbolic links and sync the files they point to.",
+				Default:     booldefault.StaticBool(false),
 			},
 		},
 	}
@@ -197,16 +247,16 @@ func (r *CloudsyncResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.Credentials.IsNull() {
+	if !data.Credentials.IsNull() && !data.Credentials.IsUnknown() {
 		params["credentials"] = data.Credentials.ValueInt64()
 	}
-	if !data.Attributes.IsNull() {
+	if !data.Attributes.IsNull() && !data.Attributes.IsUnknown() {
 		var attributesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Attributes.ValueString()), &attributesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse attributes: %s", err))
@@ -214,7 +264,7 @@ func (r *CloudsyncResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		params["attributes"] = attributesObj
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -222,32 +272,32 @@ func (r *CloudsyncResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.PreScript.IsNull() {
+	if !data.PreScript.IsNull() && !data.PreScript.IsUnknown() {
 		params["pre_script"] = data.PreScript.ValueString()
 	}
-	if !data.PostScript.IsNull() {
+	if !data.PostScript.IsNull() && !data.PostScript.IsUnknown() {
 		params["post_script"] = data.PostScript.ValueString()
 	}
-	if !data.Snapshot.IsNull() {
+	if !data.Snapshot.IsNull() && !data.Snapshot.IsUnknown() {
 		params["snapshot"] = data.Snapshot.ValueBool()
 	}
-	if !data.Include.IsNull() {
+	if !data.Include.IsNull() && !data.Include.IsUnknown() {
 		var includeList []string
 		data.Include.ElementsAs(ctx, &includeList, false)
 		params["include"] = includeList
 	}
-	if !data.Exclude.IsNull() {
+	if !data.Exclude.IsNull() && !data.Exclude.IsUnknown() {
 		var excludeList []string
 		data.Exclude.ElementsAs(ctx, &excludeList, false)
 		params["exclude"] = excludeList
 	}
-	if !data.Args.IsNull() {
+	if !data.Args.IsNull() && !data.Args.IsUnknown() {
 		params["args"] = data.Args.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Bwlimit.IsNull() {
+	if !data.Bwlimit.IsNull() && !data.Bwlimit.IsUnknown() {
 		var bwlimitList []string
 		data.Bwlimit.ElementsAs(ctx, &bwlimitList, false)
 		var bwlimitObjs []map[string]interface{}
@@ -261,31 +311,31 @@ func (r *CloudsyncResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		params["bwlimit"] = bwlimitObjs
 	}
-	if !data.Transfers.IsNull() {
+	if !data.Transfers.IsNull() && !data.Transfers.IsUnknown() {
 		params["transfers"] = data.Transfers.ValueInt64()
 	}
-	if !data.Direction.IsNull() {
+	if !data.Direction.IsNull() && !data.Direction.IsUnknown() {
 		params["direction"] = data.Direction.ValueString()
 	}
-	if !data.TransferMode.IsNull() {
+	if !data.TransferMode.IsNull() && !data.TransferMode.IsUnknown() {
 		params["transfer_mode"] = data.TransferMode.ValueString()
 	}
-	if !data.Encryption.IsNull() {
+	if !data.Encryption.IsNull() && !data.Encryption.IsUnknown() {
 		params["encryption"] = data.Encryption.ValueBool()
 	}
-	if !data.FilenameEncryption.IsNull() {
+	if !data.FilenameEncryption.IsNull() && !data.FilenameEncryption.IsUnknown() {
 		params["filename_encryption"] = data.FilenameEncryption.ValueBool()
 	}
-	if !data.EncryptionPassword.IsNull() {
+	if !data.EncryptionPassword.IsNull() && !data.EncryptionPassword.IsUnknown() {
 		params["encryption_password"] = data.EncryptionPassword.ValueString()
 	}
-	if !data.EncryptionSalt.IsNull() {
+	if !data.EncryptionSalt.IsNull() && !data.EncryptionSalt.IsUnknown() {
 		params["encryption_salt"] = data.EncryptionSalt.ValueString()
 	}
-	if !data.CreateEmptySrcDirs.IsNull() {
+	if !data.CreateEmptySrcDirs.IsNull() && !data.CreateEmptySrcDirs.IsUnknown() {
 		params["create_empty_src_dirs"] = data.CreateEmptySrcDirs.ValueBool()
 	}
-	if !data.FollowSymlinks.IsNull() {
+	if !data.FollowSymlinks.IsNull() && !data.FollowSymlinks.IsUnknown() {
 		params["follow_symlinks"] = data.FollowSymlinks.ValueBool()
 	}
 
@@ -307,6 +357,7 @@ func (r *CloudsyncResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -433,16 +484,16 @@ func (r *CloudsyncResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.Credentials.IsNull() {
+	if !data.Credentials.IsNull() && !data.Credentials.IsUnknown() {
 		params["credentials"] = data.Credentials.ValueInt64()
 	}
-	if !data.Attributes.IsNull() {
+	if !data.Attributes.IsNull() && !data.Attributes.IsUnknown() {
 		var attributesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Attributes.ValueString()), &attributesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse attributes: %s", err))
@@ -450,7 +501,7 @@ func (r *CloudsyncResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		params["attributes"] = attributesObj
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -458,32 +509,32 @@ func (r *CloudsyncResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.PreScript.IsNull() {
+	if !data.PreScript.IsNull() && !data.PreScript.IsUnknown() {
 		params["pre_script"] = data.PreScript.ValueString()
 	}
-	if !data.PostScript.IsNull() {
+	if !data.PostScript.IsNull() && !data.PostScript.IsUnknown() {
 		params["post_script"] = data.PostScript.ValueString()
 	}
-	if !data.Snapshot.IsNull() {
+	if !data.Snapshot.IsNull() && !data.Snapshot.IsUnknown() {
 		params["snapshot"] = data.Snapshot.ValueBool()
 	}
-	if !data.Include.IsNull() {
+	if !data.Include.IsNull() && !data.Include.IsUnknown() {
 		var includeList []string
 		data.Include.ElementsAs(ctx, &includeList, false)
 		params["include"] = includeList
 	}
-	if !data.Exclude.IsNull() {
+	if !data.Exclude.IsNull() && !data.Exclude.IsUnknown() {
 		var excludeList []string
 		data.Exclude.ElementsAs(ctx, &excludeList, false)
 		params["exclude"] = excludeList
 	}
-	if !data.Args.IsNull() {
+	if !data.Args.IsNull() && !data.Args.IsUnknown() {
 		params["args"] = data.Args.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Bwlimit.IsNull() {
+	if !data.Bwlimit.IsNull() && !data.Bwlimit.IsUnknown() {
 		var bwlimitList []string
 		data.Bwlimit.ElementsAs(ctx, &bwlimitList, false)
 		var bwlimitObjs []map[string]interface{}
@@ -497,41 +548,42 @@ func (r *CloudsyncResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		params["bwlimit"] = bwlimitObjs
 	}
-	if !data.Transfers.IsNull() {
+	if !data.Transfers.IsNull() && !data.Transfers.IsUnknown() {
 		params["transfers"] = data.Transfers.ValueInt64()
 	}
-	if !data.Direction.IsNull() {
+	if !data.Direction.IsNull() && !data.Direction.IsUnknown() {
 		params["direction"] = data.Direction.ValueString()
 	}
-	if !data.TransferMode.IsNull() {
+	if !data.TransferMode.IsNull() && !data.TransferMode.IsUnknown() {
 		params["transfer_mode"] = data.TransferMode.ValueString()
 	}
-	if !data.Encryption.IsNull() {
+	if !data.Encryption.IsNull() && !data.Encryption.IsUnknown() {
 		params["encryption"] = data.Encryption.ValueBool()
 	}
-	if !data.FilenameEncryption.IsNull() {
+	if !data.FilenameEncryption.IsNull() && !data.FilenameEncryption.IsUnknown() {
 		params["filename_encryption"] = data.FilenameEncryption.ValueBool()
 	}
-	if !data.EncryptionPassword.IsNull() {
+	if !data.EncryptionPassword.IsNull() && !data.EncryptionPassword.IsUnknown() {
 		params["encryption_password"] = data.EncryptionPassword.ValueString()
 	}
-	if !data.EncryptionSalt.IsNull() {
+	if !data.EncryptionSalt.IsNull() && !data.EncryptionSalt.IsUnknown() {
 		params["encryption_salt"] = data.EncryptionSalt.ValueString()
 	}
-	if !data.CreateEmptySrcDirs.IsNull() {
+	if !data.CreateEmptySrcDirs.IsNull() && !data.CreateEmptySrcDirs.IsUnknown() {
 		params["create_empty_src_dirs"] = data.CreateEmptySrcDirs.ValueBool()
 	}
-	if !data.FollowSymlinks.IsNull() {
+	if !data.FollowSymlinks.IsNull() && !data.FollowSymlinks.IsUnknown() {
 		params["follow_symlinks"] = data.FollowSymlinks.ValueBool()
 	}
 
-	_, err = r.client.Call("cloudsync.update", []interface{}{id, params})
+	result, err := r.client.Call("cloudsync.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update cloudsync: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -556,3 +608,207 @@ func (r *CloudsyncResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *CloudsyncResource) setComputed(result interface{}, data *CloudsyncResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Description.IsUnknown() {
+		data.Description = types.StringNull()
+		if v, ok := resultMap["description"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Description = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Description = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Schedule.IsUnknown() {
+		data.Schedule = types.StringNull()
+		if v, ok := resultMap["schedule"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Schedule = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.PreScript.IsUnknown() {
+		data.PreScript = types.StringNull()
+		if v, ok := resultMap["pre_script"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.PreScript = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.PreScript = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.PreScript = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.PostScript.IsUnknown() {
+		data.PostScript = types.StringNull()
+		if v, ok := resultMap["post_script"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.PostScript = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.PostScript = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.PostScript = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Snapshot.IsUnknown() {
+		data.Snapshot = types.BoolNull()
+		if v, ok := resultMap["snapshot"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Snapshot = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Include.IsUnknown() {
+		data.Include = types.ListNull(types.StringType)
+		if v, ok := resultMap["include"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Include, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Exclude.IsUnknown() {
+		data.Exclude = types.ListNull(types.StringType)
+		if v, ok := resultMap["exclude"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Exclude, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Args.IsUnknown() {
+		data.Args = types.StringNull()
+		if v, ok := resultMap["args"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Args = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Args = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Args = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Bwlimit.IsUnknown() {
+		data.Bwlimit = types.ListNull(types.StringType)
+		if v, ok := resultMap["bwlimit"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					b, _ := json.Marshal(item)
+					strVals[i] = types.StringValue(string(b))
+				}
+				data.Bwlimit, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Transfers.IsUnknown() {
+		data.Transfers = types.Int64Null()
+		if v, ok := resultMap["transfers"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Transfers = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Transfers = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Encryption.IsUnknown() {
+		data.Encryption = types.BoolNull()
+		if v, ok := resultMap["encryption"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Encryption = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.FilenameEncryption.IsUnknown() {
+		data.FilenameEncryption = types.BoolNull()
+		if v, ok := resultMap["filename_encryption"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.FilenameEncryption = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.EncryptionPassword.IsUnknown() {
+		data.EncryptionPassword = types.StringNull()
+		if v, ok := resultMap["encryption_password"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.EncryptionPassword = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.EncryptionPassword = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.EncryptionPassword = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.EncryptionSalt.IsUnknown() {
+		data.EncryptionSalt = types.StringNull()
+		if v, ok := resultMap["encryption_salt"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.EncryptionSalt = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.EncryptionSalt = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.EncryptionSalt = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.CreateEmptySrcDirs.IsUnknown() {
+		data.CreateEmptySrcDirs = types.BoolNull()
+		if v, ok := resultMap["create_empty_src_dirs"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.CreateEmptySrcDirs = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.FollowSymlinks.IsUnknown() {
+		data.FollowSymlinks = types.BoolNull()
+		if v, ok := resultMap["follow_symlinks"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.FollowSymlinks = types.BoolValue(bv)
+			}
+		}
+	}
+}
@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -37,33 +40,55 @@ func (r *CronjobResource) Metadata(ctx context.Context, req resource.MetadataReq
 }
 
 func (r *CronjobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "cronjob.query", cronjobIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &CronjobResource{}
+
+// cronjobIdentity lists the API fields that identify a cronjob
+var cronjobIdentity = []identityKey{{Name: "id"}}
+
+func (r *CronjobResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(cronjobIdentity)
+}
+
+func (r *CronjobResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *CronjobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a new cron job.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether the cron job is active and will be executed.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"stderr": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to IGNORE standard error (if `false`, it will be added to email).",
+				Default:     booldefault.StaticBool(false),
 			},
 			"stdout": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to IGNORE standard output (if `false`, it will be added to email).",
+				Default:     booldefault.StaticBool(true),
 			},
 			"schedule": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Cron schedule configuration for when the job runs.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Cron schedule configuration for when the job runs.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"command": schema.StringAttribute{
 				Required:    true,
@@ -73,7 +98,9 @@ func (r *CronjobResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"description": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Human-readable description of what this cron job does.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"user": schema.StringAttribute{
 				Required:    true,
@@ -104,16 +131,16 @@ func (r *CronjobResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	params := map[string]interface{}{}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Stderr.IsNull() {
+	if !data.Stderr.IsNull() && !data.Stderr.IsUnknown() {
 		params["stderr"] = data.Stderr.ValueBool()
 	}
-	if !data.Stdout.IsNull() {
+	if !data.Stdout.IsNull() && !data.Stdout.IsUnknown() {
 		params["stdout"] = data.Stdout.ValueBool()
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -121,13 +148,13 @@ func (r *CronjobResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.Command.IsNull() {
+	if !data.Command.IsNull() && !data.Command.IsUnknown() {
 		params["command"] = data.Command.ValueString()
 	}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.User.IsNull() {
+	if !data.User.IsNull() && !data.User.IsUnknown() {
 		params["user"] = data.User.ValueString()
 	}
 
@@ -149,6 +176,8 @@ func (r *CronjobResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, cronjobIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -213,6 +242,7 @@ func (r *CronjobResource) Read(ctx context.Context, req resource.ReadRequest, re
 			data.User = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, cronjobIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -239,16 +269,16 @@ func (r *CronjobResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	params := map[string]interface{}{}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Stderr.IsNull() {
+	if !data.Stderr.IsNull() && !data.Stderr.IsUnknown() {
 		params["stderr"] = data.Stderr.ValueBool()
 	}
-	if !data.Stdout.IsNull() {
+	if !data.Stdout.IsNull() && !data.Stdout.IsUnknown() {
 		params["stdout"] = data.Stdout.ValueBool()
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -256,23 +286,25 @@ func (r *CronjobResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.Command.IsNull() {
+	if !data.Command.IsNull() && !data.Command.IsUnknown() {
 		params["command"] = data.Command.ValueString()
 	}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.User.IsNull() {
+	if !data.User.IsNull() && !data.User.IsUnknown() {
 		params["user"] = data.User.ValueString()
 	}
 
-	_, err = r.client.Call("cronjob.update", []interface{}{id, params})
+	result, err := r.client.Call("cronjob.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update cronjob: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, cronjobIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -297,3 +329,56 @@ func (r *CronjobResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *CronjobResource) setComputed(result interface{}, data *CronjobResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Stderr.IsUnknown() {
+		data.Stderr = types.BoolNull()
+		if v, ok := resultMap["stderr"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Stderr = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Stdout.IsUnknown() {
+		data.Stdout = types.BoolNull()
+		if v, ok := resultMap["stdout"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Stdout = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Schedule.IsUnknown() {
+		data.Schedule = types.StringNull()
+		if v, ok := resultMap["schedule"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Schedule = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.Description.IsUnknown() {
+		data.Description = types.StringNull()
+		if v, ok := resultMap["description"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Description = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Description = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
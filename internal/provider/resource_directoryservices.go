@@ -0,0 +1,817 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// directoryServicePollInterval is how often directoryservices.status is
+// polled while waiting for a join to become healthy
+var directoryServicePollInterval = 5 * time.Second
+
+// DirectoryservicesResource joins TrueNAS to Active Directory, FreeIPA or an
+// LDAP directory. There is one directory service configuration per system.
+type DirectoryservicesResource struct {
+	client *client.Client
+}
+
+var (
+	_ resource.ResourceWithModifyPlan       = &DirectoryservicesResource{}
+	_ resource.ResourceWithConfigValidators = &DirectoryservicesResource{}
+	_ resource.ResourceWithImportState      = &DirectoryservicesResource{}
+)
+
+type DirectoryservicesResourceModel struct {
+	ID                 types.String                `tfsdk:"id"`
+	Enable             types.Bool                  `tfsdk:"enable"`
+	EnableAccountCache types.Bool                  `tfsdk:"enable_account_cache"`
+	EnableDNSUpdates   types.Bool                  `tfsdk:"enable_dns_updates"`
+	Timeout            types.Int64                 `tfsdk:"timeout"`
+	KerberosRealm      types.String                `tfsdk:"kerberos_realm"`
+	Credential         *DirectoryCredentialModel   `tfsdk:"credential"`
+	ActiveDirectory    *ActiveDirectoryConfigModel `tfsdk:"active_directory"`
+	IPA                *IPAConfigModel             `tfsdk:"ipa"`
+	LDAP               *LDAPConfigModel            `tfsdk:"ldap"`
+	Status             types.String                `tfsdk:"status"`
+	StatusMsg          types.String                `tfsdk:"status_msg"`
+	Timeouts           timeouts.Value              `tfsdk:"timeouts"`
+}
+
+type DirectoryCredentialModel struct {
+	CredentialType    types.String `tfsdk:"credential_type"`
+	Username          types.String `tfsdk:"username"`
+	Principal         types.String `tfsdk:"principal"`
+	BindDN            types.String `tfsdk:"binddn"`
+	ClientCertificate types.String `tfsdk:"client_certificate"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+type ActiveDirectoryConfigModel struct {
+	Hostname             types.String `tfsdk:"hostname"`
+	Domain               types.String `tfsdk:"domain"`
+	Site                 types.String `tfsdk:"site"`
+	ComputerAccountOU    types.String `tfsdk:"computer_account_ou"`
+	UseDefaultDomain     types.Bool   `tfsdk:"use_default_domain"`
+	EnableTrustedDomains types.Bool   `tfsdk:"enable_trusted_domains"`
+	Idmap                types.String `tfsdk:"idmap"`
+	TrustedDomains       types.String `tfsdk:"trusted_domains"`
+}
+
+type IPAConfigModel struct {
+	TargetServer         types.String `tfsdk:"target_server"`
+	Hostname             types.String `tfsdk:"hostname"`
+	Domain               types.String `tfsdk:"domain"`
+	BaseDN               types.String `tfsdk:"basedn"`
+	SMBDomain            types.String `tfsdk:"smb_domain"`
+	ValidateCertificates types.Bool   `tfsdk:"validate_certificates"`
+}
+
+type LDAPConfigModel struct {
+	ServerURLs           types.List   `tfsdk:"server_urls"`
+	BaseDN               types.String `tfsdk:"basedn"`
+	StartTLS             types.Bool   `tfsdk:"starttls"`
+	ValidateCertificates types.Bool   `tfsdk:"validate_certificates"`
+	Schema               types.String `tfsdk:"schema"`
+	SearchBases          types.String `tfsdk:"search_bases"`
+	AttributeMaps        types.String `tfsdk:"attribute_maps"`
+	AuxiliaryParameters  types.String `tfsdk:"auxiliary_parameters"`
+}
+
+func NewDirectoryservicesResource() resource.Resource {
+	return &DirectoryservicesResource{}
+}
+
+func (r *DirectoryservicesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directoryservices"
+}
+
+func (r *DirectoryservicesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *DirectoryservicesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	optionalString := func(desc string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:      true,
+			Computed:      true,
+			Description:   desc,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		}
+	}
+	optionalBool := func(desc string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional:      true,
+			Computed:      true,
+			Description:   desc,
+			PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+		}
+	}
+	jsonString := func(desc string) schema.StringAttribute {
+		return optionalString(desc + " **Note:** This is a JSON object. Use `jsonencode()` to pass structured data.")
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Joins TrueNAS to Active Directory, FreeIPA or an LDAP directory through `directoryservices.update`, and leaves it on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Resource ID",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"enable": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Enable the directory service. Default: `true`",
+			},
+			"enable_account_cache": optionalBool("Cache directory users and groups for the UI and permission editors."),
+			"enable_dns_updates":   optionalBool("Register this host's addresses in DNS (Active Directory and FreeIPA)."),
+			"timeout": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Seconds to wait for directory server responses.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"kerberos_realm": optionalString("Kerberos realm. Detected from the domain when unset."),
+			"credential": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "Credentials used to join and bind. The secret is write-only and never stored in state.",
+				Attributes: map[string]schema.Attribute{
+					"credential_type": schema.StringAttribute{
+						Required:    true,
+						Description: "Credential kind: `KERBEROS_USER`, `KERBEROS_PRINCIPAL`, `LDAP_PLAIN`, `LDAP_ANONYMOUS` or `LDAP_MTLS`.",
+						Validators: []validator.String{stringvalidator.OneOf(
+							"KERBEROS_USER", "KERBEROS_PRINCIPAL", "LDAP_PLAIN", "LDAP_ANONYMOUS", "LDAP_MTLS",
+						)},
+					},
+					"username": schema.StringAttribute{
+						Optional:    true,
+						Description: "User name for `KERBEROS_USER`.",
+					},
+					"principal": schema.StringAttribute{
+						Optional:    true,
+						Description: "Keytab principal for `KERBEROS_PRINCIPAL`.",
+					},
+					"binddn": schema.StringAttribute{
+						Optional:    true,
+						Description: "Bind DN for `LDAP_PLAIN`.",
+					},
+					"client_certificate": schema.StringAttribute{
+						Optional:    true,
+						Description: "Client certificate name for `LDAP_MTLS`.",
+					},
+					"password_wo": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						Description: "Password for `KERBEROS_USER` or bind password for `LDAP_PLAIN`. Write-only: sent on create and whenever `password_wo_version` changes. On destroy the password is read from `TRUENAS_DIRECTORY_PASSWORD` instead.",
+					},
+					"password_wo_version": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Change this to send `password_wo` again, e.g. after rotating the password.",
+					},
+				},
+			},
+			"active_directory": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Active Directory settings.",
+				Attributes: map[string]schema.Attribute{
+					"hostname": schema.StringAttribute{
+						Required:    true,
+						Description: "NetBIOS name of the computer account.",
+					},
+					"domain": schema.StringAttribute{
+						Required:    true,
+						Description: "DNS name of the domain, e.g. `ad.example.com`.",
+					},
+					"site":                   optionalString("Active Directory site. Detected when unset."),
+					"computer_account_ou":    optionalString("Organizational unit for the computer account, e.g. `Servers/NAS`."),
+					"use_default_domain":     optionalBool("Leave the domain prefix off user and group names."),
+					"enable_trusted_domains": optionalBool("Allow users from trusted domains."),
+					"idmap":                  jsonString("ID mapping for the primary domain."),
+					"trusted_domains":        jsonString("ID mapping for trusted domains."),
+				},
+			},
+			"ipa": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "FreeIPA settings.",
+				Attributes: map[string]schema.Attribute{
+					"target_server": schema.StringAttribute{
+						Required:    true,
+						Description: "FreeIPA server to join, e.g. `ipa.example.com`.",
+					},
+					"hostname": schema.StringAttribute{
+						Required:    true,
+						Description: "Host name to register for this system.",
+					},
+					"domain": schema.StringAttribute{
+						Required:    true,
+						Description: "FreeIPA domain, e.g. `example.com`.",
+					},
+					"basedn": schema.StringAttribute{
+						Required:    true,
+						Description: "Base DN, e.g. `dc=example,dc=com`.",
+					},
+					"smb_domain":            jsonString("SMB domain settings for FreeIPA-managed SMB."),
+					"validate_certificates": optionalBool("Verify the server certificate."),
+				},
+			},
+			"ldap": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "LDAP settings.",
+				Attributes: map[string]schema.Attribute{
+					"server_urls": schema.ListAttribute{
+						Required:    true,
+						ElementType: types.StringType,
+						Description: "LDAP server URLs, e.g. `ldaps://ldap.example.com`.",
+					},
+					"basedn": schema.StringAttribute{
+						Required:    true,
+						Description: "Base DN, e.g. `dc=example,dc=com`.",
+					},
+					"starttls":              optionalBool("Upgrade `ldap://` connections with StartTLS."),
+					"validate_certificates": optionalBool("Verify the server certificate."),
+					"schema": schema.StringAttribute{
+						Optional:      true,
+						Computed:      true,
+						Description:   "Directory schema: `RFC2307` or `RFC2307BIS`.",
+						PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+						Validators:    []validator.String{stringvalidator.OneOf("RFC2307", "RFC2307BIS")},
+					},
+					"search_bases":         jsonString("Search bases for users, groups and netgroups."),
+					"attribute_maps":       jsonString("Attribute name overrides."),
+					"auxiliary_parameters": optionalString("Extra sssd configuration lines."),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Health reported by `directoryservices.status`: `HEALTHY` once joined, `DISABLED` when `enable` is false. Any other value shows up as a diff; apply sends the join credential again to repair it, which needs `password_wo` in configuration for `KERBEROS_USER` and `LDAP_PLAIN`.",
+			},
+			"status_msg": schema.StringAttribute{
+				Computed:    true,
+				Description: "Detail message for the current status.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *DirectoryservicesResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("active_directory"),
+			path.MatchRoot("ipa"),
+			path.MatchRoot("ldap"),
+		),
+	}
+}
+
+func (r *DirectoryservicesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan plans the status the service should reach, so a directory that
+// went unhealthy since the last apply shows up as a diff.
+func (r *DirectoryservicesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var enable types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("enable"), &enable)...)
+	if resp.Diagnostics.HasError() || enable.IsUnknown() {
+		return
+	}
+	target := types.StringValue(directoryServiceTarget(enable.ValueBool()))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), target)...)
+
+	// Keep the message when nothing changes; it's refreshed on any repair
+	var prior types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("status"), &prior)...)
+	}
+	if prior.Equal(target) {
+		var msg types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("status_msg"), &msg)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status_msg"), msg)...)
+	} else {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status_msg"), types.StringUnknown())...)
+	}
+}
+
+func (r *DirectoryservicesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DirectoryservicesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var secret types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credential").AtName("password_wo"), &secret)...)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &data, secret, true, createTimeout); err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to join directory service: %s", err))
+		return
+	}
+	data.ID = types.StringValue("directoryservices")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryservicesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DirectoryservicesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.Call("directoryservices.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read directoryservices: %s", err))
+		return
+	}
+	configMap, _ := config.(map[string]interface{})
+	if configMap["service_type"] == nil {
+		// Left or reset outside Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	setDirectoryServiceConfig(configMap, &data, false)
+
+	if err := r.readStatus(&data); err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read directoryservices status: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryservicesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DirectoryservicesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state DirectoryservicesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	var secret types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credential").AtName("password_wo"), &secret)...)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sendCredential := directoryResendCredential(&data, &state, secret)
+	if err := r.apply(ctx, &data, secret, sendCredential, updateTimeout); err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update directory service: %s", err))
+		return
+	}
+	data.ID = types.StringValue("directoryservices")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DirectoryservicesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DirectoryservicesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only values aren't available on destroy, so the password to leave
+	// with comes from the environment
+	secret := types.StringNull()
+	if pw, ok := os.LookupEnv(directoryPasswordEnv); ok {
+		secret = types.StringValue(pw)
+	}
+	var err error
+	if directoryCredentialNeedsSecret(data.Credential) && secret.IsNull() {
+		err = fmt.Errorf("the %s credential needs its password to leave, and %s is not set", data.Credential.CredentialType.ValueString(), directoryPasswordEnv)
+	} else {
+		_, err = r.client.CallWithJobTimeout("directoryservices.leave", []interface{}{directoryCredentialParams(data.Credential, secret)}, deleteTimeout)
+		if err == nil {
+			return
+		}
+	}
+
+	// Fall back to disabling and clearing the configuration locally
+	reset := map[string]interface{}{"service_type": nil, "enable": false}
+	if _, resetErr := r.client.CallWithJobTimeout("directoryservices.update", []interface{}{reset}, deleteTimeout); resetErr != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to leave directory service: %s; resetting the configuration also failed: %s", err, resetErr))
+		return
+	}
+	resp.Diagnostics.AddWarning("Directory Left Locally",
+		fmt.Sprintf("Unable to leave the directory (%s), so the configuration was cleared locally instead. The computer account may remain in the directory; "+
+			"set %s to the join password when destroying to remove it.", err, directoryPasswordEnv))
+}
+
+// directoryPasswordEnv holds the password directoryservices.leave
+// authenticates with, as password_wo isn't available on destroy
+const directoryPasswordEnv = "TRUENAS_DIRECTORY_PASSWORD"
+
+// directoryCredentialNeedsSecret reports whether a credential authenticates
+// with a password. Kerberos principals use their keytab, and anonymous and
+// mTLS binds need no password.
+func directoryCredentialNeedsSecret(c *DirectoryCredentialModel) bool {
+	if c == nil {
+		return false
+	}
+	switch c.CredentialType.ValueString() {
+	case "KERBEROS_USER", "LDAP_PLAIN":
+		return true
+	}
+	return false
+}
+
+// apply sends the planned configuration, waits for the join job and then for
+// the service to report the target status
+func (r *DirectoryservicesResource) apply(ctx context.Context, data *DirectoryservicesResourceModel, secret types.String, sendCredential bool, timeout time.Duration) error {
+	params, err := directoryServiceParams(ctx, data, secret)
+	if err != nil {
+		return err
+	}
+	if !sendCredential {
+		delete(params, "credential")
+	}
+	deadline := time.Now().Add(timeout)
+
+	result, err := r.client.CallWithJobTimeout("directoryservices.update", []interface{}{params}, timeout)
+	if err != nil {
+		return err
+	}
+	if m, ok := result.(map[string]interface{}); ok {
+		setDirectoryServiceConfig(m, data, true)
+	}
+
+	target := directoryServiceTarget(data.Enable.ValueBool())
+	for {
+		if err := r.readStatus(data); err != nil {
+			return err
+		}
+		switch data.Status.ValueString() {
+		case target:
+			return r.refresh(data)
+		case "FAULTED":
+			return fmt.Errorf("directory service is FAULTED: %s. To join again, set credential.password_wo and change "+
+				"password_wo_version, or fix the directory and apply again", data.StatusMsg.ValueString())
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("directory service is %s after %v, expected %s: %s",
+				data.Status.ValueString(), timeout, target, data.StatusMsg.ValueString())
+		}
+		time.Sleep(directoryServicePollInterval)
+	}
+}
+
+// refresh fills attributes left unknown in the plan from directoryservices.config
+func (r *DirectoryservicesResource) refresh(data *DirectoryservicesResourceModel) error {
+	config, err := r.client.Call("directoryservices.config", []interface{}{})
+	if err != nil {
+		return err
+	}
+	configMap, _ := config.(map[string]interface{})
+	setDirectoryServiceConfig(configMap, data, true)
+	return nil
+}
+
+func (r *DirectoryservicesResource) readStatus(data *DirectoryservicesResourceModel) error {
+	status, err := r.client.Call("directoryservices.status", []interface{}{})
+	if err != nil {
+		return err
+	}
+	statusMap, _ := status.(map[string]interface{})
+	data.Status = types.StringValue(directoryServiceTarget(false))
+	if s, ok := statusMap["status"].(string); ok && s != "" {
+		data.Status = types.StringValue(s)
+	}
+	data.StatusMsg = types.StringValue("")
+	if msg, ok := statusMap["status_msg"].(string); ok {
+		data.StatusMsg = types.StringValue(msg)
+	}
+	return nil
+}
+
+// directoryServiceTarget is the status a healthy service reports
+func directoryServiceTarget(enable bool) string {
+	if enable {
+		return "HEALTHY"
+	}
+	return "DISABLED"
+}
+
+// directoryServiceParams builds the directoryservices.update payload
+func directoryServiceParams(ctx context.Context, data *DirectoryservicesResourceModel, secret types.String) (map[string]interface{}, error) {
+	params := map[string]interface{}{
+		"enable":     data.Enable.ValueBool(),
+		"credential": directoryCredentialParams(data.Credential, secret),
+	}
+	setBool(params, "enable_account_cache", data.EnableAccountCache)
+	setBool(params, "enable_dns_updates", data.EnableDNSUpdates)
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() {
+		params["timeout"] = data.Timeout.ValueInt64()
+	}
+	setString(params, "kerberos_realm", data.KerberosRealm)
+
+	config := map[string]interface{}{}
+	var err error
+	switch {
+	case data.ActiveDirectory != nil:
+		params["service_type"] = "ACTIVEDIRECTORY"
+		ad := data.ActiveDirectory
+		setString(config, "hostname", ad.Hostname)
+		setString(config, "domain", ad.Domain)
+		setString(config, "site", ad.Site)
+		setString(config, "computer_account_ou", ad.ComputerAccountOU)
+		setBool(config, "use_default_domain", ad.UseDefaultDomain)
+		setBool(config, "enable_trusted_domains", ad.EnableTrustedDomains)
+		if err = setJSON(config, "idmap", ad.Idmap); err == nil {
+			err = setJSON(config, "trusted_domains", ad.TrustedDomains)
+		}
+	case data.IPA != nil:
+		params["service_type"] = "IPA"
+		ipa := data.IPA
+		setString(config, "target_server", ipa.TargetServer)
+		setString(config, "hostname", ipa.Hostname)
+		setString(config, "domain", ipa.Domain)
+		setString(config, "basedn", ipa.BaseDN)
+		setBool(config, "validate_certificates", ipa.ValidateCertificates)
+		err = setJSON(config, "smb_domain", ipa.SMBDomain)
+	case data.LDAP != nil:
+		params["service_type"] = "LDAP"
+		ldap := data.LDAP
+		var urls []string
+		ldap.ServerURLs.ElementsAs(ctx, &urls, false)
+		config["server_urls"] = urls
+		setString(config, "basedn", ldap.BaseDN)
+		setBool(config, "starttls", ldap.StartTLS)
+		setBool(config, "validate_certificates", ldap.ValidateCertificates)
+		setString(config, "schema", ldap.Schema)
+		setString(config, "auxiliary_parameters", ldap.AuxiliaryParameters)
+		if err = setJSON(config, "search_bases", ldap.SearchBases); err == nil {
+			err = setJSON(config, "attribute_maps", ldap.AttributeMaps)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	params["configuration"] = config
+	return params, nil
+}
+
+// directoryResendCredential reports whether Update sends the join credential.
+// Once joined the server holds its own machine credential, so it is only sent
+// again when it or password_wo_version changes, or to rejoin a service that
+// isn't healthy, e.g. after a broken trust.
+func directoryResendCredential(plan, state *DirectoryservicesResourceModel, secret types.String) bool {
+	if directoryCredentialChanged(plan.Credential, state.Credential) {
+		return true
+	}
+	if !plan.Enable.ValueBool() || state.Status.ValueString() == directoryServiceTarget(true) {
+		return false
+	}
+	// Without its password the credential can't rejoin
+	return !directoryCredentialNeedsSecret(plan.Credential) || (!secret.IsNull() && !secret.IsUnknown())
+}
+
+// directoryCredentialChanged reports whether the non-secret credential fields
+// or password_wo_version differ between plan and state
+func directoryCredentialChanged(plan, state *DirectoryCredentialModel) bool {
+	if plan == nil || state == nil {
+		return plan != state
+	}
+	return !plan.CredentialType.Equal(state.CredentialType) ||
+		!plan.Username.Equal(state.Username) ||
+		!plan.Principal.Equal(state.Principal) ||
+		!plan.BindDN.Equal(state.BindDN) ||
+		!plan.ClientCertificate.Equal(state.ClientCertificate) ||
+		!plan.PasswordWOVersion.Equal(state.PasswordWOVersion)
+}
+
+// directoryCredentialParams builds the credential object. The secret goes in
+// the field the credential type expects.
+func directoryCredentialParams(c *DirectoryCredentialModel, secret types.String) map[string]interface{} {
+	params := map[string]interface{}{}
+	if c == nil {
+		return params
+	}
+	params["credential_type"] = c.CredentialType.ValueString()
+	setString(params, "username", c.Username)
+	setString(params, "principal", c.Principal)
+	setString(params, "binddn", c.BindDN)
+	setString(params, "client_certificate", c.ClientCertificate)
+	if !secret.IsNull() && !secret.IsUnknown() {
+		if c.CredentialType.ValueString() == "LDAP_PLAIN" {
+			params["bindpw"] = secret.ValueString()
+		} else {
+			params["password"] = secret.ValueString()
+		}
+	}
+	return params
+}
+
+// setDirectoryServiceConfig copies server values into the model. With
+// keepKnown, as after an apply, only values left null or unknown in the plan
+// are filled: the server may normalise what it was sent, e.g. upper-case a
+// hostname, and the planned value must still be stored. Otherwise, as on
+// refresh, values equivalent to the current ones are kept as written. The
+// credential is left alone: the server swaps the join credential for the
+// machine account once joined, which isn't a change to the configuration.
+func setDirectoryServiceConfig(m map[string]interface{}, data *DirectoryservicesResourceModel, keepKnown bool) {
+	if v, ok := m["enable"].(bool); ok && !keepValue(data.Enable, keepKnown) {
+		data.Enable = types.BoolValue(v)
+	}
+	if v, ok := m["enable_account_cache"].(bool); ok && !keepValue(data.EnableAccountCache, keepKnown) {
+		data.EnableAccountCache = types.BoolValue(v)
+	}
+	if v, ok := m["enable_dns_updates"].(bool); ok && !keepValue(data.EnableDNSUpdates, keepKnown) {
+		data.EnableDNSUpdates = types.BoolValue(v)
+	}
+	if v, ok := m["timeout"].(float64); ok && !keepValue(data.Timeout, keepKnown) {
+		data.Timeout = types.Int64Value(int64(v))
+	}
+	data.KerberosRealm = mergeName(data.KerberosRealm, m["kerberos_realm"], keepKnown)
+
+	config, _ := m["configuration"].(map[string]interface{})
+	switch m["service_type"] {
+	case "ACTIVEDIRECTORY":
+		if data.ActiveDirectory == nil {
+			data.ActiveDirectory = &ActiveDirectoryConfigModel{}
+		}
+		ad := data.ActiveDirectory
+		ad.Hostname = mergeName(ad.Hostname, config["hostname"], keepKnown)
+		ad.Domain = mergeName(ad.Domain, config["domain"], keepKnown)
+		ad.Site = mergeString(ad.Site, config["site"], keepKnown)
+		ad.ComputerAccountOU = mergeString(ad.ComputerAccountOU, config["computer_account_ou"], keepKnown)
+		ad.UseDefaultDomain = mergeBool(ad.UseDefaultDomain, config["use_default_domain"], keepKnown)
+		ad.EnableTrustedDomains = mergeBool(ad.EnableTrustedDomains, config["enable_trusted_domains"], keepKnown)
+		ad.Idmap = mergeJSON(ad.Idmap, config["idmap"], keepKnown)
+		ad.TrustedDomains = mergeJSON(ad.TrustedDomains, config["trusted_domains"], keepKnown)
+		data.IPA, data.LDAP = nil, nil
+	case "IPA":
+		if data.IPA == nil {
+			data.IPA = &IPAConfigModel{}
+		}
+		ipa := data.IPA
+		ipa.TargetServer = mergeName(ipa.TargetServer, config["target_server"], keepKnown)
+		ipa.Hostname = mergeName(ipa.Hostname, config["hostname"], keepKnown)
+		ipa.Domain = mergeName(ipa.Domain, config["domain"], keepKnown)
+		ipa.BaseDN = mergeString(ipa.BaseDN, config["basedn"], keepKnown)
+		ipa.ValidateCertificates = mergeBool(ipa.ValidateCertificates, config["validate_certificates"], keepKnown)
+		ipa.SMBDomain = mergeJSON(ipa.SMBDomain, config["smb_domain"], keepKnown)
+		data.ActiveDirectory, data.LDAP = nil, nil
+	case "LDAP":
+		if data.LDAP == nil {
+			data.LDAP = &LDAPConfigModel{}
+		}
+		ldap := data.LDAP
+		if urls, ok := config["server_urls"].([]interface{}); ok && !keepValue(ldap.ServerURLs, keepKnown) {
+			vals := make([]attr.Value, len(urls))
+			for i, u := range urls {
+				vals[i] = types.StringValue(fmt.Sprintf("%v", u))
+			}
+			ldap.ServerURLs, _ = types.ListValue(types.StringType, vals)
+		}
+		ldap.BaseDN = mergeString(ldap.BaseDN, config["basedn"], keepKnown)
+		ldap.StartTLS = mergeBool(ldap.StartTLS, config["starttls"], keepKnown)
+		ldap.ValidateCertificates = mergeBool(ldap.ValidateCertificates, config["validate_certificates"], keepKnown)
+		ldap.Schema = mergeString(ldap.Schema, config["schema"], keepKnown)
+		ldap.AuxiliaryParameters = mergeString(ldap.AuxiliaryParameters, config["auxiliary_parameters"], keepKnown)
+		ldap.SearchBases = mergeJSON(ldap.SearchBases, config["search_bases"], keepKnown)
+		ldap.AttributeMaps = mergeJSON(ldap.AttributeMaps, config["attribute_maps"], keepKnown)
+		data.ActiveDirectory, data.IPA = nil, nil
+	}
+}
+
+// keepValue reports whether a model value stays as it is: with keepKnown,
+// any value known in the plan does
+func keepValue(cur attr.Value, keepKnown bool) bool {
+	return keepKnown && !cur.IsNull() && !cur.IsUnknown()
+}
+
+func mergeString(cur types.String, v interface{}, keepKnown bool) types.String {
+	if keepValue(cur, keepKnown) {
+		return cur
+	}
+	return stringOrNull(v)
+}
+
+// mergeName is mergeString for DNS and NetBIOS names, which compare
+// case-insensitively
+func mergeName(cur types.String, v interface{}, keepKnown bool) types.String {
+	if s, ok := v.(string); ok && !cur.IsNull() && !cur.IsUnknown() && strings.EqualFold(cur.ValueString(), s) {
+		return cur
+	}
+	return mergeString(cur, v, keepKnown)
+}
+
+func mergeBool(cur types.Bool, v interface{}, keepKnown bool) types.Bool {
+	if keepValue(cur, keepKnown) {
+		return cur
+	}
+	return boolOrNull(v)
+}
+
+// mergeJSON is mergeString for JSON-encoded objects, which compare by value
+// so key order and whitespace don't matter
+func mergeJSON(cur types.String, v interface{}, keepKnown bool) types.String {
+	if keepValue(cur, keepKnown) {
+		return cur
+	}
+	if !cur.IsNull() && !cur.IsUnknown() {
+		var written interface{}
+		if err := json.Unmarshal([]byte(cur.ValueString()), &written); err == nil && reflect.DeepEqual(written, v) {
+			return cur
+		}
+	}
+	return jsonOrNull(v)
+}
+
+func setString(params map[string]interface{}, key string, v types.String) {
+	if !v.IsNull() && !v.IsUnknown() {
+		params[key] = v.ValueString()
+	}
+}
+
+func setBool(params map[string]interface{}, key string, v types.Bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		params[key] = v.ValueBool()
+	}
+}
+
+func setJSON(params map[string]interface{}, key string, v types.String) error {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	var obj interface{}
+	if err := json.Unmarshal([]byte(v.ValueString()), &obj); err != nil {
+		return fmt.Errorf("%s is not valid JSON: %s", key, err)
+	}
+	params[key] = obj
+	return nil
+}
+
+func stringOrNull(v interface{}) types.String {
+	if s, ok := v.(string); ok {
+		return types.StringValue(s)
+	}
+	return types.StringNull()
+}
+
+func boolOrNull(v interface{}) types.Bool {
+	if b, ok := v.(bool); ok {
+		return types.BoolValue(b)
+	}
+	return types.BoolNull()
+}
+
+func jsonOrNull(v interface{}) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(b))
+}
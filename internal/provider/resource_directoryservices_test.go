@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDirectoryservicesResource_Schema(t *testing.T) {
+	ctx := context.Background()
+	resp := &resource.SchemaResponse{}
+	NewDirectoryservicesResource().Schema(ctx, resource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema returned errors: %v", resp.Diagnostics)
+	}
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("Schema is invalid: %v", diags)
+	}
+}
+
+func TestDirectoryServiceParams(t *testing.T) {
+	data := &DirectoryservicesResourceModel{
+		Enable:           types.BoolValue(true),
+		EnableDNSUpdates: types.BoolUnknown(),
+		Timeout:          types.Int64Null(),
+		KerberosRealm:    types.StringNull(),
+		Credential: &DirectoryCredentialModel{
+			CredentialType: types.StringValue("KERBEROS_USER"),
+			Username:       types.StringValue("Administrator"),
+		},
+		ActiveDirectory: &ActiveDirectoryConfigModel{
+			Hostname: types.StringValue("nas"),
+			Domain:   types.StringValue("ad.example.com"),
+			Idmap:    types.StringValue(`{"idmap_domain":{"range_low":100000}}`),
+		},
+	}
+
+	params, err := directoryServiceParams(context.Background(), data, types.StringValue("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params["service_type"] != "ACTIVEDIRECTORY" {
+		t.Errorf("Expected service_type ACTIVEDIRECTORY, got %v", params["service_type"])
+	}
+	if _, ok := params["enable_dns_updates"]; ok {
+		t.Error("Expected unknown enable_dns_updates to be omitted")
+	}
+	cred := params["credential"].(map[string]interface{})
+	if cred["password"] != "secret" || cred["username"] != "Administrator" {
+		t.Errorf("Unexpected credential %v", cred)
+	}
+	config := params["configuration"].(map[string]interface{})
+	if _, ok := config["idmap"].(map[string]interface{}); !ok {
+		t.Errorf("Expected idmap decoded from JSON, got %T", config["idmap"])
+	}
+
+	data.ActiveDirectory.Idmap = types.StringValue("{")
+	if _, err := directoryServiceParams(context.Background(), data, types.StringNull()); err == nil {
+		t.Error("Expected invalid idmap JSON to fail")
+	}
+}
+
+func TestDirectoryCredentialParams_LDAPBindPassword(t *testing.T) {
+	cred := directoryCredentialParams(&DirectoryCredentialModel{
+		CredentialType: types.StringValue("LDAP_PLAIN"),
+		BindDN:         types.StringValue("cn=nas,dc=example,dc=com"),
+	}, types.StringValue("secret"))
+	if cred["bindpw"] != "secret" {
+		t.Errorf("Expected LDAP_PLAIN secret in bindpw, got %v", cred)
+	}
+	if _, ok := cred["password"]; ok {
+		t.Error("Expected no password for LDAP_PLAIN")
+	}
+}
+
+func TestSetDirectoryServiceConfig(t *testing.T) {
+	data := &DirectoryservicesResourceModel{
+		IPA: &IPAConfigModel{},
+	}
+	setDirectoryServiceConfig(map[string]interface{}{
+		"service_type":   "LDAP",
+		"enable":         true,
+		"timeout":        float64(10),
+		"kerberos_realm": nil,
+		"configuration": map[string]interface{}{
+			"server_urls":  []interface{}{"ldaps://ldap.example.com"},
+			"basedn":       "dc=example,dc=com",
+			"schema":       "RFC2307",
+			"search_bases": map[string]interface{}{"base_user": nil},
+		},
+	}, data, false)
+
+	if data.IPA != nil || data.LDAP == nil {
+		t.Fatal("Expected the service type from the server to replace the configured one")
+	}
+	if len(data.LDAP.ServerURLs.Elements()) != 1 || data.LDAP.BaseDN.ValueString() != "dc=example,dc=com" {
+		t.Errorf("Unexpected LDAP config %+v", data.LDAP)
+	}
+	if data.LDAP.SearchBases.ValueString() != `{"base_user":null}` {
+		t.Errorf("Expected search_bases as JSON, got %s", data.LDAP.SearchBases.ValueString())
+	}
+	if data.Timeout.ValueInt64() != 10 || !data.KerberosRealm.IsNull() {
+		t.Errorf("Unexpected top-level values timeout=%v realm=%v", data.Timeout, data.KerberosRealm)
+	}
+}
+
+func TestSetDirectoryServiceConfig_Normalised(t *testing.T) {
+	server := map[string]interface{}{
+		"service_type": "ACTIVEDIRECTORY",
+		"enable":       true,
+		"configuration": map[string]interface{}{
+			"hostname": "NAS",
+			"domain":   "AD.EXAMPLE.COM",
+			"site":     "Default-First-Site-Name",
+			"idmap":    map[string]interface{}{"range_low": float64(100000), "range_high": float64(200000)},
+		},
+	}
+	planned := func() *DirectoryservicesResourceModel {
+		return &DirectoryservicesResourceModel{
+			Enable: types.BoolValue(true),
+			ActiveDirectory: &ActiveDirectoryConfigModel{
+				Hostname: types.StringValue("nas"),
+				Domain:   types.StringValue("ad.example.com"),
+				Site:     types.StringUnknown(),
+				Idmap:    types.StringValue(`{"range_high": 200000, "range_low": 100000}`),
+			},
+		}
+	}
+
+	// After an apply only values the plan left unknown come from the server
+	data := planned()
+	data.ActiveDirectory.Idmap = types.StringValue(`{"range_low": 1}`)
+	setDirectoryServiceConfig(server, data, true)
+	ad := data.ActiveDirectory
+	if ad.Hostname.ValueString() != "nas" || ad.Idmap.ValueString() != `{"range_low": 1}` {
+		t.Errorf("Expected planned values kept after apply, got %s and %s", ad.Hostname, ad.Idmap)
+	}
+	if ad.Site.ValueString() != "Default-First-Site-Name" {
+		t.Errorf("Expected the unknown site from the server, got %s", ad.Site)
+	}
+
+	// On refresh, equivalent values are kept as written
+	data = planned()
+	data.ActiveDirectory.Site = types.StringValue("Branch")
+	setDirectoryServiceConfig(server, data, false)
+	ad = data.ActiveDirectory
+	if ad.Hostname.ValueString() != "nas" || ad.Domain.ValueString() != "ad.example.com" {
+		t.Errorf("Expected names differing only in case kept, got %s and %s", ad.Hostname, ad.Domain)
+	}
+	if ad.Idmap.ValueString() != `{"range_high": 200000, "range_low": 100000}` {
+		t.Errorf("Expected equivalent idmap JSON kept, got %s", ad.Idmap)
+	}
+	if ad.Site.ValueString() != "Default-First-Site-Name" {
+		t.Errorf("Expected a changed site to show up as drift, got %s", ad.Site)
+	}
+}
+
+func TestDirectoryCredentialChanged(t *testing.T) {
+	state := &DirectoryCredentialModel{
+		CredentialType:    types.StringValue("KERBEROS_USER"),
+		Username:          types.StringValue("Administrator"),
+		PasswordWOVersion: types.Int64Value(1),
+	}
+	plan := *state
+	plan.PasswordWO = types.StringNull()
+	if directoryCredentialChanged(&plan, state) {
+		t.Error("Expected an unchanged credential not to be sent again")
+	}
+	plan.PasswordWOVersion = types.Int64Value(2)
+	if !directoryCredentialChanged(&plan, state) {
+		t.Error("Expected a new password_wo_version to send the credential")
+	}
+}
+
+func TestDirectoryResendCredential(t *testing.T) {
+	cred := &DirectoryCredentialModel{
+		CredentialType:    types.StringValue("KERBEROS_USER"),
+		Username:          types.StringValue("Administrator"),
+		PasswordWOVersion: types.Int64Value(1),
+	}
+	plan := &DirectoryservicesResourceModel{Enable: types.BoolValue(true), Credential: cred}
+	state := &DirectoryservicesResourceModel{Status: types.StringValue("HEALTHY"), Credential: cred}
+	secret := types.StringValue("secret")
+
+	if directoryResendCredential(plan, state, secret) {
+		t.Error("Expected a healthy join not to send the credential again")
+	}
+
+	// A broken trust is repaired by joining again
+	state.Status = types.StringValue("FAULTED")
+	if !directoryResendCredential(plan, state, secret) {
+		t.Error("Expected a FAULTED service to send the credential again")
+	}
+	if directoryResendCredential(plan, state, types.StringNull()) {
+		t.Error("Expected a password credential without password_wo not to be sent")
+	}
+
+	plan.Enable = types.BoolValue(false)
+	if directoryResendCredential(plan, state, secret) {
+		t.Error("Expected disabling not to send the credential")
+	}
+}
+
+func TestDirectoryservicesResource_DeleteLeavePassword(t *testing.T) {
+	ctx := context.Background()
+	del := func(env string) (*fakeServer, resource.DeleteResponse) {
+		if env != "" {
+			t.Setenv(directoryPasswordEnv, env)
+		}
+		c, fs := newFakeClient(t, func(method string, params []interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		r := &DirectoryservicesResource{client: c}
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		res := tfsdk.Resource{Schema: schemaResp.Schema}
+		if diags := nullAttributes(ctx, &res); diags.HasError() {
+			t.Fatal(diags)
+		}
+		state := tfsdk.State{Schema: res.Schema, Raw: res.Raw}
+		var data DirectoryservicesResourceModel
+		state.Get(ctx, &data)
+		data.Credential = &DirectoryCredentialModel{
+			CredentialType: types.StringValue("KERBEROS_USER"),
+			Username:       types.StringValue("Administrator"),
+		}
+		if diags := state.Set(ctx, &data); diags.HasError() {
+			t.Fatal(diags)
+		}
+		var resp resource.DeleteResponse
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+		return fs, resp
+	}
+
+	// Without the password, leave can't authenticate and isn't tried
+	fs, resp := del("")
+	if calls := fs.Calls(); len(calls) != 1 || calls[0].Method != "directoryservices.update" {
+		t.Errorf("Expected only the local reset, got %+v", calls)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected a warning naming %s, got %v", directoryPasswordEnv, resp.Diagnostics)
+	}
+
+	fs, resp = del("secret")
+	calls := fs.Calls()
+	if len(calls) != 1 || calls[0].Method != "directoryservices.leave" {
+		t.Fatalf("Expected one directoryservices.leave call, got %+v", calls)
+	}
+	if cred, _ := calls[0].Params[0].(map[string]interface{}); cred["password"] != "secret" {
+		t.Errorf("Expected the password from %s, got %v", directoryPasswordEnv, cred)
+	}
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 0 {
+		t.Errorf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -36,8 +39,13 @@ func (r *FcFcHostResource) ImportState(ctx context.Context, req resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *FcFcHostResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *FcFcHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Creates FC host (pairing).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -47,19 +55,25 @@ func (r *FcFcHostResource) Schema(ctx context.Context, req resource.SchemaReques
 				Description: "Human-readable alias for the Fibre Channel host.",
 			},
 			"wwpn": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "World Wide Port Name for port A or `null` if not configured.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "World Wide Port Name for port A or `null` if not configured.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"wwpn_b": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "World Wide Port Name for port B or `null` if not configured.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "World Wide Port Name for port B or `null` if not configured.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"npiv": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Number of N_Port ID Virtualization (NPIV) virtual ports to create.",
+				Default:     int64default.StaticInt64(0),
 			},
 		},
 	}
@@ -85,16 +99,16 @@ func (r *FcFcHostResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	params := map[string]interface{}{}
-	if !data.Alias.IsNull() {
+	if !data.Alias.IsNull() && !data.Alias.IsUnknown() {
 		params["alias"] = data.Alias.ValueString()
 	}
-	if !data.Wwpn.IsNull() {
+	if !data.Wwpn.IsNull() && !data.Wwpn.IsUnknown() {
 		params["wwpn"] = data.Wwpn.ValueString()
 	}
-	if !data.WwpnB.IsNull() {
+	if !data.WwpnB.IsNull() && !data.WwpnB.IsUnknown() {
 		params["wwpn_b"] = data.WwpnB.ValueString()
 	}
-	if !data.Npiv.IsNull() {
+	if !data.Npiv.IsNull() && !data.Npiv.IsUnknown() {
 		params["npiv"] = data.Npiv.ValueInt64()
 	}
 
@@ -116,6 +130,7 @@ func (r *FcFcHostResource) Create(ctx context.Context, req resource.CreateReques
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -194,26 +209,27 @@ func (r *FcFcHostResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	params := map[string]interface{}{}
-	if !data.Alias.IsNull() {
+	if !data.Alias.IsNull() && !data.Alias.IsUnknown() {
 		params["alias"] = data.Alias.ValueString()
 	}
-	if !data.Wwpn.IsNull() {
+	if !data.Wwpn.IsNull() && !data.Wwpn.IsUnknown() {
 		params["wwpn"] = data.Wwpn.ValueString()
 	}
-	if !data.WwpnB.IsNull() {
+	if !data.WwpnB.IsNull() && !data.WwpnB.IsUnknown() {
 		params["wwpn_b"] = data.WwpnB.ValueString()
 	}
-	if !data.Npiv.IsNull() {
+	if !data.Npiv.IsNull() && !data.Npiv.IsUnknown() {
 		params["npiv"] = data.Npiv.ValueInt64()
 	}
 
-	_, err = r.client.Call("fc.fc_host.update", []interface{}{id, params})
+	result, err := r.client.Call("fc.fc_host.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update fc_fc_host: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -238,3 +254,54 @@ func (r *FcFcHostResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *FcFcHostResource) setComputed(result interface{}, data *FcFcHostResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Wwpn.IsUnknown() {
+		data.Wwpn = types.StringNull()
+		if v, ok := resultMap["wwpn"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Wwpn = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Wwpn = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Wwpn = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.WwpnB.IsUnknown() {
+		data.WwpnB = types.StringNull()
+		if v, ok := resultMap["wwpn_b"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.WwpnB = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.WwpnB = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.WwpnB = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Npiv.IsUnknown() {
+		data.Npiv = types.Int64Null()
+		if v, ok := resultMap["npiv"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Npiv = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Npiv = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+}
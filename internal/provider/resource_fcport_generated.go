@@ -34,8 +34,13 @@ func (r *FcportResource) ImportState(ctx context.Context, req resource.ImportSta
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *FcportResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *FcportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Creates mapping between a FC port and a target.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -73,10 +78,10 @@ func (r *FcportResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	params := map[string]interface{}{}
-	if !data.Port.IsNull() {
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
 		params["port"] = data.Port.ValueString()
 	}
-	if !data.TargetId.IsNull() {
+	if !data.TargetId.IsNull() && !data.TargetId.IsUnknown() {
 		params["target_id"] = data.TargetId.ValueInt64()
 	}
 
@@ -98,6 +103,7 @@ func (r *FcportResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -188,20 +194,21 @@ func (r *FcportResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	params := map[string]interface{}{}
-	if !data.Port.IsNull() {
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
 		params["port"] = data.Port.ValueString()
 	}
-	if !data.TargetId.IsNull() {
+	if !data.TargetId.IsNull() && !data.TargetId.IsUnknown() {
 		params["target_id"] = data.TargetId.ValueInt64()
 	}
 
-	_, err = r.client.Call("fcport.update", []interface{}{id, params})
+	result, err := r.client.Call("fcport.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update fcport: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -226,3 +233,9 @@ func (r *FcportResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *FcportResource) setComputed(result interface{}, data *FcportResourceModel) {
+	_ = result // No computed fields
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -31,14 +33,30 @@ func NewFilesystemAcltemplateResource() resource.Resource {
 
 func (r *FilesystemAcltemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_filesystem_acltemplate"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *FilesystemAcltemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "filesystem.acltemplate.query", filesystemAcltemplateIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &FilesystemAcltemplateResource{}
+
+// filesystemAcltemplateIdentity lists the API fields that identify a filesystem_acltemplate
+var filesystemAcltemplateIdentity = []identityKey{{Name: "name"}}
+
+func (r *FilesystemAcltemplateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(filesystemAcltemplateIdentity)
+}
+
+func (r *FilesystemAcltemplateResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *FilesystemAcltemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a new filesystem ACL template.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -51,6 +69,7 @@ func (r *FilesystemAcltemplateResource) Schema(ctx context.Context, req resource
 				Required:    true,
 				Optional:    false,
 				Description: "ACL type this template provides.",
+				Validators:  []validator.String{stringvalidator.OneOf("NFS4", "POSIX1E")},
 			},
 			"acl": schema.ListAttribute{
 				Required:    true,
@@ -61,7 +80,9 @@ func (r *FilesystemAcltemplateResource) Schema(ctx context.Context, req resource
 			"comment": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Optional descriptive comment about the template's purpose.",
+				Default:     stringdefault.StaticString(""),
 			},
 		},
 	}
@@ -87,18 +108,18 @@ func (r *FilesystemAcltemplateResource) Create(ctx context.Context, req resource
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Acltype.IsNull() {
+	if !data.Acltype.IsNull() && !data.Acltype.IsUnknown() {
 		params["acltype"] = data.Acltype.ValueString()
 	}
-	if !data.Acl.IsNull() {
+	if !data.Acl.IsNull() && !data.Acl.IsUnknown() {
 		var aclList []string
 		data.Acl.ElementsAs(ctx, &aclList, false)
 		params["acl"] = aclList
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
 
@@ -120,6 +141,8 @@ func (r *FilesystemAcltemplateResource) Create(ctx context.Context, req resource
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, filesystemAcltemplateIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -193,6 +216,7 @@ func (r *FilesystemAcltemplateResource) Read(ctx context.Context, req resource.R
 			data.Acl, _ = types.ListValue(types.StringType, strVals)
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, filesystemAcltemplateIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -219,28 +243,30 @@ func (r *FilesystemAcltemplateResource) Update(ctx context.Context, req resource
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Acltype.IsNull() {
+	if !data.Acltype.IsNull() && !data.Acltype.IsUnknown() {
 		params["acltype"] = data.Acltype.ValueString()
 	}
-	if !data.Acl.IsNull() {
+	if !data.Acl.IsNull() && !data.Acl.IsUnknown() {
 		var aclList []string
 		data.Acl.ElementsAs(ctx, &aclList, false)
 		params["acl"] = aclList
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
 
-	_, err = r.client.Call("filesystem.acltemplate.update", []interface{}{id, params})
+	result, err := r.client.Call("filesystem.acltemplate.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update filesystem_acltemplate: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, filesystemAcltemplateIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -265,3 +291,24 @@ func (r *FilesystemAcltemplateResource) Delete(ctx context.Context, req resource
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *FilesystemAcltemplateResource) setComputed(result interface{}, data *FilesystemAcltemplateResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Comment.IsUnknown() {
+		data.Comment = types.StringNull()
+		if v, ok := resultMap["comment"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Comment = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
@@ -0,0 +1,1544 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FtpConfigResource manages the ftp settings. There is exactly one
+// instance, so create and update both call ftp.update.
+type FtpConfigResource struct {
+	client *client.Client
+}
+
+type FtpConfigResourceModel struct {
+	ID                              types.String `tfsdk:"id"`
+	Port                            types.Int64  `tfsdk:"port"`
+	Clients                         types.Int64  `tfsdk:"clients"`
+	Ipconnections                   types.Int64  `tfsdk:"ipconnections"`
+	Loginattempt                    types.Int64  `tfsdk:"loginattempt"`
+	Timeout                         types.Int64  `tfsdk:"timeout"`
+	TimeoutNotransfer               types.Int64  `tfsdk:"timeout_notransfer"`
+	Onlyanonymous                   types.Bool   `tfsdk:"onlyanonymous"`
+	Anonpath                        types.String `tfsdk:"anonpath"`
+	Onlylocal                       types.Bool   `tfsdk:"onlylocal"`
+	Banner                          types.String `tfsdk:"banner"`
+	Filemask                        types.String `tfsdk:"filemask"`
+	Dirmask                         types.String `tfsdk:"dirmask"`
+	Fxp                             types.Bool   `tfsdk:"fxp"`
+	Resume                          types.Bool   `tfsdk:"resume"`
+	Defaultroot                     types.Bool   `tfsdk:"defaultroot"`
+	Ident                           types.Bool   `tfsdk:"ident"`
+	Reversedns                      types.Bool   `tfsdk:"reversedns"`
+	Masqaddress                     types.String `tfsdk:"masqaddress"`
+	Passiveportsmin                 types.Int64  `tfsdk:"passiveportsmin"`
+	Passiveportsmax                 types.Int64  `tfsdk:"passiveportsmax"`
+	Localuserbw                     types.Int64  `tfsdk:"localuserbw"`
+	Localuserdlbw                   types.Int64  `tfsdk:"localuserdlbw"`
+	Anonuserbw                      types.Int64  `tfsdk:"anonuserbw"`
+	Anonuserdlbw                    types.Int64  `tfsdk:"anonuserdlbw"`
+	Tls                             types.Bool   `tfsdk:"tls"`
+	TlsPolicy                       types.String `tfsdk:"tls_policy"`
+	TlsOptAllowClientRenegotiations types.Bool   `tfsdk:"tls_opt_allow_client_renegotiations"`
+	TlsOptAllowDotLogin             types.Bool   `tfsdk:"tls_opt_allow_dot_login"`
+	TlsOptAllowPerUser              types.Bool   `tfsdk:"tls_opt_allow_per_user"`
+	TlsOptCommonNameRequired        types.Bool   `tfsdk:"tls_opt_common_name_required"`
+	TlsOptEnableDiags               types.Bool   `tfsdk:"tls_opt_enable_diags"`
+	TlsOptExportCertData            types.Bool   `tfsdk:"tls_opt_export_cert_data"`
+	TlsOptNoEmptyFragments          types.Bool   `tfsdk:"tls_opt_no_empty_fragments"`
+	TlsOptNoSessionReuseRequired    types.Bool   `tfsdk:"tls_opt_no_session_reuse_required"`
+	TlsOptStdenvvars                types.Bool   `tfsdk:"tls_opt_stdenvvars"`
+	TlsOptDnsNameRequired           types.Bool   `tfsdk:"tls_opt_dns_name_required"`
+	TlsOptIpAddressRequired         types.Bool   `tfsdk:"tls_opt_ip_address_required"`
+	SsltlsCertificate               types.Int64  `tfsdk:"ssltls_certificate"`
+	Options                         types.String `tfsdk:"options"`
+	RestoreOnDestroy                types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewFtpConfigResource() resource.Resource {
+	return &FtpConfigResource{}
+}
+
+func (r *FtpConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ftp_config"
+}
+
+func (r *FtpConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *FtpConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *FtpConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update ftp service configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"port": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "TCP port the FTP server listens on.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(1, 65535)},
+			},
+			"clients": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum number of simultaneous clients.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(1, 10000)},
+			},
+			"ipconnections": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum connections per IP address. 0 means unlimited.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 1000)},
+			},
+			"loginattempt": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Login attempts before a client is disconnected. 0 means unlimited.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 1000)},
+			},
+			"timeout": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Seconds a client may stay idle before being disconnected.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 10000)},
+			},
+			"timeout_notransfer": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Seconds a client may stay connected without transferring data.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 10000)},
+			},
+			"onlyanonymous": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow anonymous logins.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"anonpath": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Directory anonymous users are confined to.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"onlylocal": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow local user logins.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"banner": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Message shown to clients when they connect.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"filemask": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Default permissions (umask) for new files, e.g. `077`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"dirmask": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Default permissions (umask) for new directories, e.g. `022`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"fxp": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow File eXchange Protocol (server-to-server transfers).",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"resume": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow clients to resume interrupted transfers.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"defaultroot": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Confine users to their home directory.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"ident": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Look up client usernames with IDENT (RFC 1413).",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"reversedns": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Look up client host names.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"masqaddress": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Public IP address or host name reported to clients behind NAT.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"passiveportsmin": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Lowest passive port. 0 uses the server default.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 65535)},
+			},
+			"passiveportsmax": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Highest passive port. 0 uses the server default.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 65535)},
+			},
+			"localuserbw": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Upload limit for local users in KiB/s. 0 means unlimited.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"localuserdlbw": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Download limit for local users in KiB/s. 0 means unlimited.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"anonuserbw": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Upload limit for anonymous users in KiB/s. 0 means unlimited.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"anonuserdlbw": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Download limit for anonymous users in KiB/s. 0 means unlimited.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"tls": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable FTPS (FTP over TLS).",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tls_policy": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Which parts of the session require TLS, e.g. `on`, `auth`, `data`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"tls_opt_allow_client_renegotiations": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow clients to renegotiate TLS.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tls_opt_allow_dot_login": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow login with a .tlslogin certificate file in the user's home directory.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tls_opt_allow_per_user": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow per-user TLS settings.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tls_opt_common_name_required": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Require the client certificate common name to match the host name.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tls_opt_enable_diags": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Log TLS diagnostics.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tls_opt_export_cert_data": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Export the client certificate to environment variables.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tls_opt_no_empty_fragments": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Disable empty fragment insertion (CBC countermeasure).",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tls_opt_no_session_reuse_required": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Don't require TLS session reuse between control and data connections.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tls_opt_stdenvvars": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Set the standard TLS environment variables.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tls_opt_dns_name_required": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Require the client certificate DNS name to match the client host name.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tls_opt_ip_address_required": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Require the client certificate IP address to match the client address.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"ssltls_certificate": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "ID of the certificate used for TLS.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"options": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Extra lines appended to the proftpd configuration.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *FtpConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *FtpConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FtpConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("ftp.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read ftp_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
+		params["port"] = data.Port.ValueInt64()
+	}
+	if !data.Clients.IsNull() && !data.Clients.IsUnknown() {
+		params["clients"] = data.Clients.ValueInt64()
+	}
+	if !data.Ipconnections.IsNull() && !data.Ipconnections.IsUnknown() {
+		params["ipconnections"] = data.Ipconnections.ValueInt64()
+	}
+	if !data.Loginattempt.IsNull() && !data.Loginattempt.IsUnknown() {
+		params["loginattempt"] = data.Loginattempt.ValueInt64()
+	}
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() {
+		params["timeout"] = data.Timeout.ValueInt64()
+	}
+	if !data.TimeoutNotransfer.IsNull() && !data.TimeoutNotransfer.IsUnknown() {
+		params["timeout_notransfer"] = data.TimeoutNotransfer.ValueInt64()
+	}
+	if !data.Onlyanonymous.IsNull() && !data.Onlyanonymous.IsUnknown() {
+		params["onlyanonymous"] = data.Onlyanonymous.ValueBool()
+	}
+	if !data.Anonpath.IsNull() && !data.Anonpath.IsUnknown() {
+		params["anonpath"] = data.Anonpath.ValueString()
+	}
+	if !data.Onlylocal.IsNull() && !data.Onlylocal.IsUnknown() {
+		params["onlylocal"] = data.Onlylocal.ValueBool()
+	}
+	if !data.Banner.IsNull() && !data.Banner.IsUnknown() {
+		params["banner"] = data.Banner.ValueString()
+	}
+	if !data.Filemask.IsNull() && !data.Filemask.IsUnknown() {
+		params["filemask"] = data.Filemask.ValueString()
+	}
+	if !data.Dirmask.IsNull() && !data.Dirmask.IsUnknown() {
+		params["dirmask"] = data.Dirmask.ValueString()
+	}
+	if !data.Fxp.IsNull() && !data.Fxp.IsUnknown() {
+		params["fxp"] = data.Fxp.ValueBool()
+	}
+	if !data.Resume.IsNull() && !data.Resume.IsUnknown() {
+		params["resume"] = data.Resume.ValueBool()
+	}
+	if !data.Defaultroot.IsNull() && !data.Defaultroot.IsUnknown() {
+		params["defaultroot"] = data.Defaultroot.ValueBool()
+	}
+	if !data.Ident.IsNull() && !data.Ident.IsUnknown() {
+		params["ident"] = data.Ident.ValueBool()
+	}
+	if !data.Reversedns.IsNull() && !data.Reversedns.IsUnknown() {
+		params["reversedns"] = data.Reversedns.ValueBool()
+	}
+	if !data.Masqaddress.IsNull() && !data.Masqaddress.IsUnknown() {
+		params["masqaddress"] = data.Masqaddress.ValueString()
+	}
+	if !data.Passiveportsmin.IsNull() && !data.Passiveportsmin.IsUnknown() {
+		params["passiveportsmin"] = data.Passiveportsmin.ValueInt64()
+	}
+	if !data.Passiveportsmax.IsNull() && !data.Passiveportsmax.IsUnknown() {
+		params["passiveportsmax"] = data.Passiveportsmax.ValueInt64()
+	}
+	if !data.Localuserbw.IsNull() && !data.Localuserbw.IsUnknown() {
+		params["localuserbw"] = data.Localuserbw.ValueInt64()
+	}
+	if !data.Localuserdlbw.IsNull() && !data.Localuserdlbw.IsUnknown() {
+		params["localuserdlbw"] = data.Localuserdlbw.ValueInt64()
+	}
+	if !data.Anonuserbw.IsNull() && !data.Anonuserbw.IsUnknown() {
+		params["anonuserbw"] = data.Anonuserbw.ValueInt64()
+	}
+	if !data.Anonuserdlbw.IsNull() && !data.Anonuserdlbw.IsUnknown() {
+		params["anonuserdlbw"] = data.Anonuserdlbw.ValueInt64()
+	}
+	if !data.Tls.IsNull() && !data.Tls.IsUnknown() {
+		params["tls"] = data.Tls.ValueBool()
+	}
+	if !data.TlsPolicy.IsNull() && !data.TlsPolicy.IsUnknown() {
+		params["tls_policy"] = data.TlsPolicy.ValueString()
+	}
+	if !data.TlsOptAllowClientRenegotiations.IsNull() && !data.TlsOptAllowClientRenegotiations.IsUnknown() {
+		params["tls_opt_allow_client_renegotiations"] = data.TlsOptAllowClientRenegotiations.ValueBool()
+	}
+	if !data.TlsOptAllowDotLogin.IsNull() && !data.TlsOptAllowDotLogin.IsUnknown() {
+		params["tls_opt_allow_dot_login"] = data.TlsOptAllowDotLogin.ValueBool()
+	}
+	if !data.TlsOptAllowPerUser.IsNull() && !data.TlsOptAllowPerUser.IsUnknown() {
+		params["tls_opt_allow_per_user"] = data.TlsOptAllowPerUser.ValueBool()
+	}
+	if !data.TlsOptCommonNameRequired.IsNull() && !data.TlsOptCommonNameRequired.IsUnknown() {
+		params["tls_opt_common_name_required"] = data.TlsOptCommonNameRequired.ValueBool()
+	}
+	if !data.TlsOptEnableDiags.IsNull() && !data.TlsOptEnableDiags.IsUnknown() {
+		params["tls_opt_enable_diags"] = data.TlsOptEnableDiags.ValueBool()
+	}
+	if !data.TlsOptExportCertData.IsNull() && !data.TlsOptExportCertData.IsUnknown() {
+		params["tls_opt_export_cert_data"] = data.TlsOptExportCertData.ValueBool()
+	}
+	if !data.TlsOptNoEmptyFragments.IsNull() && !data.TlsOptNoEmptyFragments.IsUnknown() {
+		params["tls_opt_no_empty_fragments"] = data.TlsOptNoEmptyFragments.ValueBool()
+	}
+	if !data.TlsOptNoSessionReuseRequired.IsNull() && !data.TlsOptNoSessionReuseRequired.IsUnknown() {
+		params["tls_opt_no_session_reuse_required"] = data.TlsOptNoSessionReuseRequired.ValueBool()
+	}
+	if !data.TlsOptStdenvvars.IsNull() && !data.TlsOptStdenvvars.IsUnknown() {
+		params["tls_opt_stdenvvars"] = data.TlsOptStdenvvars.ValueBool()
+	}
+	if !data.TlsOptDnsNameRequired.IsNull() && !data.TlsOptDnsNameRequired.IsUnknown() {
+		params["tls_opt_dns_name_required"] = data.TlsOptDnsNameRequired.ValueBool()
+	}
+	if !data.TlsOptIpAddressRequired.IsNull() && !data.TlsOptIpAddressRequired.IsUnknown() {
+		params["tls_opt_ip_address_required"] = data.TlsOptIpAddressRequired.ValueBool()
+	}
+	if !data.SsltlsCertificate.IsNull() && !data.SsltlsCertificate.IsUnknown() {
+		params["ssltls_certificate"] = data.SsltlsCertificate.ValueInt64()
+	}
+	if !data.Options.IsNull() && !data.Options.IsUnknown() {
+		params["options"] = data.Options.ValueString()
+	}
+
+	result, err := r.client.Call("ftp.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update ftp_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("ftp")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FtpConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FtpConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("ftp.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read ftp_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["port"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Port = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Port = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["clients"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Clients = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Clients = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["ipconnections"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Ipconnections = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Ipconnections = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["loginattempt"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Loginattempt = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Loginattempt = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["timeout"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Timeout = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Timeout = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["timeout_notransfer"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.TimeoutNotransfer = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.TimeoutNotransfer = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["onlyanonymous"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Onlyanonymous = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["anonpath"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Anonpath = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Anonpath = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Anonpath = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["onlylocal"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Onlylocal = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["banner"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Banner = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Banner = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Banner = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["filemask"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Filemask = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Filemask = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Filemask = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["dirmask"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Dirmask = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Dirmask = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Dirmask = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["fxp"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Fxp = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["resume"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Resume = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["defaultroot"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Defaultroot = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["ident"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Ident = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["reversedns"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Reversedns = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["masqaddress"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Masqaddress = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Masqaddress = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Masqaddress = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["passiveportsmin"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Passiveportsmin = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Passiveportsmin = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["passiveportsmax"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Passiveportsmax = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Passiveportsmax = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["localuserbw"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Localuserbw = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Localuserbw = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["localuserdlbw"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Localuserdlbw = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Localuserdlbw = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["anonuserbw"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Anonuserbw = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Anonuserbw = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["anonuserdlbw"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Anonuserdlbw = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Anonuserdlbw = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["tls"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Tls = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tls_policy"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.TlsPolicy = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.TlsPolicy = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.TlsPolicy = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["tls_opt_allow_client_renegotiations"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.TlsOptAllowClientRenegotiations = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tls_opt_allow_dot_login"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.TlsOptAllowDotLogin = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tls_opt_allow_per_user"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.TlsOptAllowPerUser = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tls_opt_common_name_required"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.TlsOptCommonNameRequired = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tls_opt_enable_diags"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.TlsOptEnableDiags = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tls_opt_export_cert_data"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.TlsOptExportCertData = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tls_opt_no_empty_fragments"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.TlsOptNoEmptyFragments = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tls_opt_no_session_reuse_required"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.TlsOptNoSessionReuseRequired = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tls_opt_stdenvvars"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.TlsOptStdenvvars = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tls_opt_dns_name_required"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.TlsOptDnsNameRequired = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tls_opt_ip_address_required"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.TlsOptIpAddressRequired = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["ssltls_certificate"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.SsltlsCertificate = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.SsltlsCertificate = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["options"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Options = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Options = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Options = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	data.ID = types.StringValue("ftp")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FtpConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FtpConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
+		params["port"] = data.Port.ValueInt64()
+	}
+	if !data.Clients.IsNull() && !data.Clients.IsUnknown() {
+		params["clients"] = data.Clients.ValueInt64()
+	}
+	if !data.Ipconnections.IsNull() && !data.Ipconnections.IsUnknown() {
+		params["ipconnections"] = data.Ipconnections.ValueInt64()
+	}
+	if !data.Loginattempt.IsNull() && !data.Loginattempt.IsUnknown() {
+		params["loginattempt"] = data.Loginattempt.ValueInt64()
+	}
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() {
+		params["timeout"] = data.Timeout.ValueInt64()
+	}
+	if !data.TimeoutNotransfer.IsNull() && !data.TimeoutNotransfer.IsUnknown() {
+		params["timeout_notransfer"] = data.TimeoutNotransfer.ValueInt64()
+	}
+	if !data.Onlyanonymous.IsNull() && !data.Onlyanonymous.IsUnknown() {
+		params["onlyanonymous"] = data.Onlyanonymous.ValueBool()
+	}
+	if !data.Anonpath.IsNull() && !data.Anonpath.IsUnknown() {
+		params["anonpath"] = data.Anonpath.ValueString()
+	}
+	if !data.Onlylocal.IsNull() && !data.Onlylocal.IsUnknown() {
+		params["onlylocal"] = data.Onlylocal.ValueBool()
+	}
+	if !data.Banner.IsNull() && !data.Banner.IsUnknown() {
+		params["banner"] = data.Banner.ValueString()
+	}
+	if !data.Filemask.IsNull() && !data.Filemask.IsUnknown() {
+		params["filemask"] = data.Filemask.ValueString()
+	}
+	if !data.Dirmask.IsNull() && !data.Dirmask.IsUnknown() {
+		params["dirmask"] = data.Dirmask.ValueString()
+	}
+	if !data.Fxp.IsNull() && !data.Fxp.IsUnknown() {
+		params["fxp"] = data.Fxp.ValueBool()
+	}
+	if !data.Resume.IsNull() && !data.Resume.IsUnknown() {
+		params["resume"] = data.Resume.ValueBool()
+	}
+	if !data.Defaultroot.IsNull() && !data.Defaultroot.IsUnknown() {
+		params["defaultroot"] = data.Defaultroot.ValueBool()
+	}
+	if !data.Ident.IsNull() && !data.Ident.IsUnknown() {
+		params["ident"] = data.Ident.ValueBool()
+	}
+	if !data.Reversedns.IsNull() && !data.Reversedns.IsUnknown() {
+		params["reversedns"] = data.Reversedns.ValueBool()
+	}
+	if !data.Masqaddress.IsNull() && !data.Masqaddress.IsUnknown() {
+		params["masqaddress"] = data.Masqaddress.ValueString()
+	}
+	if !data.Passiveportsmin.IsNull() && !data.Passiveportsmin.IsUnknown() {
+		params["passiveportsmin"] = data.Passiveportsmin.ValueInt64()
+	}
+	if !data.Passiveportsmax.IsNull() && !data.Passiveportsmax.IsUnknown() {
+		params["passiveportsmax"] = data.Passiveportsmax.ValueInt64()
+	}
+	if !data.Localuserbw.IsNull() && !data.Localuserbw.IsUnknown() {
+		params["localuserbw"] = data.Localuserbw.ValueInt64()
+	}
+	if !data.Localuserdlbw.IsNull() && !data.Localuserdlbw.IsUnknown() {
+		params["localuserdlbw"] = data.Localuserdlbw.ValueInt64()
+	}
+	if !data.Anonuserbw.IsNull() && !data.Anonuserbw.IsUnknown() {
+		params["anonuserbw"] = data.Anonuserbw.ValueInt64()
+	}
+	if !data.Anonuserdlbw.IsNull() && !data.Anonuserdlbw.IsUnknown() {
+		params["anonuserdlbw"] = data.Anonuserdlbw.ValueInt64()
+	}
+	if !data.Tls.IsNull() && !data.Tls.IsUnknown() {
+		params["tls"] = data.Tls.ValueBool()
+	}
+	if !data.TlsPolicy.IsNull() && !data.TlsPolicy.IsUnknown() {
+		params["tls_policy"] = data.TlsPolicy.ValueString()
+	}
+	if !data.TlsOptAllowClientRenegotiations.IsNull() && !data.TlsOptAllowClientRenegotiations.IsUnknown() {
+		params["tls_opt_allow_client_renegotiations"] = data.TlsOptAllowClientRenegotiations.ValueBool()
+	}
+	if !data.TlsOptAllowDotLogin.IsNull() && !data.TlsOptAllowDotLogin.IsUnknown() {
+		params["tls_opt_allow_dot_login"] = data.TlsOptAllowDotLogin.ValueBool()
+	}
+	if !data.TlsOptAllowPerUser.IsNull() && !data.TlsOptAllowPerUser.IsUnknown() {
+		params["tls_opt_allow_per_user"] = data.TlsOptAllowPerUser.ValueBool()
+	}
+	if !data.TlsOptCommonNameRequired.IsNull() && !data.TlsOptCommonNameRequired.IsUnknown() {
+		params["tls_opt_common_name_required"] = data.TlsOptCommonNameRequired.ValueBool()
+	}
+	if !data.TlsOptEnableDiags.IsNull() && !data.TlsOptEnableDiags.IsUnknown() {
+		params["tls_opt_enable_diags"] = data.TlsOptEnableDiags.ValueBool()
+	}
+	if !data.TlsOptExportCertData.IsNull() && !data.TlsOptExportCertData.IsUnknown() {
+		params["tls_opt_export_cert_data"] = data.TlsOptExportCertData.ValueBool()
+	}
+	if !data.TlsOptNoEmptyFragments.IsNull() && !data.TlsOptNoEmptyFragments.IsUnknown() {
+		params["tls_opt_no_empty_fragments"] = data.TlsOptNoEmptyFragments.ValueBool()
+	}
+	if !data.TlsOptNoSessionReuseRequired.IsNull() && !data.TlsOptNoSessionReuseRequired.IsUnknown() {
+		params["tls_opt_no_session_reuse_required"] = data.TlsOptNoSessionReuseRequired.ValueBool()
+	}
+	if !data.TlsOptStdenvvars.IsNull() && !data.TlsOptStdenvvars.IsUnknown() {
+		params["tls_opt_stdenvvars"] = data.TlsOptStdenvvars.ValueBool()
+	}
+	if !data.TlsOptDnsNameRequired.IsNull() && !data.TlsOptDnsNameRequired.IsUnknown() {
+		params["tls_opt_dns_name_required"] = data.TlsOptDnsNameRequired.ValueBool()
+	}
+	if !data.TlsOptIpAddressRequired.IsNull() && !data.TlsOptIpAddressRequired.IsUnknown() {
+		params["tls_opt_ip_address_required"] = data.TlsOptIpAddressRequired.ValueBool()
+	}
+	if !data.SsltlsCertificate.IsNull() && !data.SsltlsCertificate.IsUnknown() {
+		params["ssltls_certificate"] = data.SsltlsCertificate.ValueInt64()
+	}
+	if !data.Options.IsNull() && !data.Options.IsUnknown() {
+		params["options"] = data.Options.ValueString()
+	}
+
+	result, err := r.client.Call("ftp.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update ftp_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("ftp")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FtpConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FtpConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when ftp_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"port", "clients", "ipconnections", "loginattempt", "timeout", "timeout_notransfer", "onlyanonymous", "anonpath", "onlylocal", "banner", "filemask", "dirmask", "fxp", "resume", "defaultroot", "ident", "reversedns", "masqaddress", "passiveportsmin", "passiveportsmax", "localuserbw", "localuserdlbw", "anonuserbw", "anonuserdlbw", "tls", "tls_policy", "tls_opt_allow_client_renegotiations", "tls_opt_allow_dot_login", "tls_opt_allow_per_user", "tls_opt_common_name_required", "tls_opt_enable_diags", "tls_opt_export_cert_data", "tls_opt_no_empty_fragments", "tls_opt_no_session_reuse_required", "tls_opt_stdenvvars", "tls_opt_dns_name_required", "tls_opt_ip_address_required", "ssltls_certificate", "options"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.Call("ftp.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore ftp_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *FtpConfigResource) setComputed(result interface{}, data *FtpConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Port.IsUnknown() {
+		data.Port = types.Int64Null()
+		if v, ok := resultMap["port"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Port = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Port = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Clients.IsUnknown() {
+		data.Clients = types.Int64Null()
+		if v, ok := resultMap["clients"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Clients = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Clients = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Ipconnections.IsUnknown() {
+		data.Ipconnections = types.Int64Null()
+		if v, ok := resultMap["ipconnections"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Ipconnections = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Ipconnections = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Loginattempt.IsUnknown() {
+		data.Loginattempt = types.Int64Null()
+		if v, ok := resultMap["loginattempt"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Loginattempt = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Loginattempt = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Timeout.IsUnknown() {
+		data.Timeout = types.Int64Null()
+		if v, ok := resultMap["timeout"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Timeout = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Timeout = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.TimeoutNotransfer.IsUnknown() {
+		data.TimeoutNotransfer = types.Int64Null()
+		if v, ok := resultMap["timeout_notransfer"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.TimeoutNotransfer = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.TimeoutNotransfer = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Onlyanonymous.IsUnknown() {
+		data.Onlyanonymous = types.BoolNull()
+		if v, ok := resultMap["onlyanonymous"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Onlyanonymous = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Anonpath.IsUnknown() {
+		data.Anonpath = types.StringNull()
+		if v, ok := resultMap["anonpath"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Anonpath = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Anonpath = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Anonpath = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Onlylocal.IsUnknown() {
+		data.Onlylocal = types.BoolNull()
+		if v, ok := resultMap["onlylocal"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Onlylocal = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Banner.IsUnknown() {
+		data.Banner = types.StringNull()
+		if v, ok := resultMap["banner"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Banner = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Banner = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Banner = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Filemask.IsUnknown() {
+		data.Filemask = types.StringNull()
+		if v, ok := resultMap["filemask"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Filemask = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Filemask = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Filemask = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Dirmask.IsUnknown() {
+		data.Dirmask = types.StringNull()
+		if v, ok := resultMap["dirmask"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Dirmask = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Dirmask = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Dirmask = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Fxp.IsUnknown() {
+		data.Fxp = types.BoolNull()
+		if v, ok := resultMap["fxp"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Fxp = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Resume.IsUnknown() {
+		data.Resume = types.BoolNull()
+		if v, ok := resultMap["resume"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Resume = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Defaultroot.IsUnknown() {
+		data.Defaultroot = types.BoolNull()
+		if v, ok := resultMap["defaultroot"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Defaultroot = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Ident.IsUnknown() {
+		data.Ident = types.BoolNull()
+		if v, ok := resultMap["ident"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Ident = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Reversedns.IsUnknown() {
+		data.Reversedns = types.BoolNull()
+		if v, ok := resultMap["reversedns"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Reversedns = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Masqaddress.IsUnknown() {
+		data.Masqaddress = types.StringNull()
+		if v, ok := resultMap["masqaddress"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Masqaddress = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Masqaddress = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Masqaddress = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Passiveportsmin.IsUnknown() {
+		data.Passiveportsmin = types.Int64Null()
+		if v, ok := resultMap["passiveportsmin"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Passiveportsmin = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Passiveportsmin = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Passiveportsmax.IsUnknown() {
+		data.Passiveportsmax = types.Int64Null()
+		if v, ok := resultMap["passiveportsmax"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Passiveportsmax = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Passiveportsmax = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Localuserbw.IsUnknown() {
+		data.Localuserbw = types.Int64Null()
+		if v, ok := resultMap["localuserbw"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Localuserbw = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Localuserbw = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Localuserdlbw.IsUnknown() {
+		data.Localuserdlbw = types.Int64Null()
+		if v, ok := resultMap["localuserdlbw"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Localuserdlbw = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Localuserdlbw = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Anonuserbw.IsUnknown() {
+		data.Anonuserbw = types.Int64Null()
+		if v, ok := resultMap["anonuserbw"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Anonuserbw = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Anonuserbw = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Anonuserdlbw.IsUnknown() {
+		data.Anonuserdlbw = types.Int64Null()
+		if v, ok := resultMap["anonuserdlbw"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Anonuserdlbw = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Anonuserdlbw = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Tls.IsUnknown() {
+		data.Tls = types.BoolNull()
+		if v, ok := resultMap["tls"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Tls = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TlsPolicy.IsUnknown() {
+		data.TlsPolicy = types.StringNull()
+		if v, ok := resultMap["tls_policy"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.TlsPolicy = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.TlsPolicy = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.TlsPolicy = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.TlsOptAllowClientRenegotiations.IsUnknown() {
+		data.TlsOptAllowClientRenegotiations = types.BoolNull()
+		if v, ok := resultMap["tls_opt_allow_client_renegotiations"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TlsOptAllowClientRenegotiations = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TlsOptAllowDotLogin.IsUnknown() {
+		data.TlsOptAllowDotLogin = types.BoolNull()
+		if v, ok := resultMap["tls_opt_allow_dot_login"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TlsOptAllowDotLogin = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TlsOptAllowPerUser.IsUnknown() {
+		data.TlsOptAllowPerUser = types.BoolNull()
+		if v, ok := resultMap["tls_opt_allow_per_user"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TlsOptAllowPerUser = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TlsOptCommonNameRequired.IsUnknown() {
+		data.TlsOptCommonNameRequired = types.BoolNull()
+		if v, ok := resultMap["tls_opt_common_name_required"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TlsOptCommonNameRequired = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TlsOptEnableDiags.IsUnknown() {
+		data.TlsOptEnableDiags = types.BoolNull()
+		if v, ok := resultMap["tls_opt_enable_diags"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TlsOptEnableDiags = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TlsOptExportCertData.IsUnknown() {
+		data.TlsOptExportCertData = types.BoolNull()
+		if v, ok := resultMap["tls_opt_export_cert_data"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TlsOptExportCertData = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TlsOptNoEmptyFragments.IsUnknown() {
+		data.TlsOptNoEmptyFragments = types.BoolNull()
+		if v, ok := resultMap["tls_opt_no_empty_fragments"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TlsOptNoEmptyFragments = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TlsOptNoSessionReuseRequired.IsUnknown() {
+		data.TlsOptNoSessionReuseRequired = types.BoolNull()
+		if v, ok := resultMap["tls_opt_no_session_reuse_required"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TlsOptNoSessionReuseRequired = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TlsOptStdenvvars.IsUnknown() {
+		data.TlsOptStdenvvars = types.BoolNull()
+		if v, ok := resultMap["tls_opt_stdenvvars"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TlsOptStdenvvars = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TlsOptDnsNameRequired.IsUnknown() {
+		data.TlsOptDnsNameRequired = types.BoolNull()
+		if v, ok := resultMap["tls_opt_dns_name_required"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TlsOptDnsNameRequired = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TlsOptIpAddressRequired.IsUnknown() {
+		data.TlsOptIpAddressRequired = types.BoolNull()
+		if v, ok := resultMap["tls_opt_ip_address_required"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TlsOptIpAddressRequired = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.SsltlsCertificate.IsUnknown() {
+		data.SsltlsCertificate = types.Int64Null()
+		if v, ok := resultMap["ssltls_certificate"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.SsltlsCertificate = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.SsltlsCertificate = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Options.IsUnknown() {
+		data.Options = types.StringNull()
+		if v, ok := resultMap["options"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Options = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Options = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Options = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
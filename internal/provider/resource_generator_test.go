@@ -1,9 +1,17 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // Unit Tests - Validate generator logic without requiring TrueNAS instance
@@ -50,3 +58,195 @@ func TestGeneratedResource_IDConversion(t *testing.T) {
 		t.Errorf("Expected ID '42', got '%s'", idStr)
 	}
 }
+
+func TestGeneratedResource_ServerDefaults(t *testing.T) {
+	r := NewSharingSmbResource()
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+
+	enabled, ok := resp.Schema.Attributes["enabled"].(schema.BoolAttribute)
+	if !ok {
+		t.Fatal("Expected enabled to be a bool attribute")
+	}
+	if !enabled.Optional || !enabled.Computed || enabled.Default == nil {
+		t.Error("Expected enabled to be optional+computed with a static default")
+	}
+
+	// Object-valued fields have no static default and keep prior state instead
+	audit, ok := resp.Schema.Attributes["audit"].(schema.StringAttribute)
+	if !ok {
+		t.Fatal("Expected audit to be a string attribute")
+	}
+	if !audit.Computed || audit.Default != nil || len(audit.PlanModifiers) == 0 {
+		t.Error("Expected audit to be computed with UseStateForUnknown")
+	}
+}
+
+func TestGeneratedResource_SetComputed(t *testing.T) {
+	r := &SharingSmbResource{}
+	data := SharingSmbResourceModel{
+		Comment: types.StringUnknown(),
+		Audit:   types.StringUnknown(),
+		Enabled: types.BoolValue(false),
+	}
+	result := map[string]interface{}{
+		"comment": "from server",
+		"audit":   map[string]interface{}{"enable": true},
+		"enabled": true,
+	}
+	r.setComputed(result, &data)
+
+	if data.Comment.ValueString() != "from server" {
+		t.Errorf("Expected comment from API response, got %q", data.Comment.ValueString())
+	}
+	if data.Audit.ValueString() != `{"enable":true}` {
+		t.Errorf("Expected audit as JSON, got %q", data.Audit.ValueString())
+	}
+	if !data.Enabled.Equal(types.BoolValue(false)) {
+		t.Error("Expected known planned value to be left untouched")
+	}
+	if !data.Readonly.IsNull() {
+		t.Error("Expected untouched null value to stay null")
+	}
+}
+
+func TestGeneratedResource_ComputedObjectList(t *testing.T) {
+	ctx := context.Background()
+	alias := map[string]interface{}{"type": "INET", "address": "192.0.2.10", "netmask": float64(24)}
+	c, fs := newFakeClient(t, func(method string, params []interface{}) (interface{}, error) {
+		return map[string]interface{}{"id": "eno1", "name": "eno1", "type": "PHYSICAL", "aliases": []interface{}{alias}}, nil
+	})
+	r := &InterfaceResource{client: c}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	null := tfsdk.Resource{Schema: schemaResp.Schema}
+	if diags := nullAttributes(ctx, &null); diags.HasError() {
+		t.Fatal(diags)
+	}
+	state := tfsdk.State{Schema: null.Schema, Raw: null.Raw}
+	var data InterfaceResourceModel
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// Create leaves aliases unknown, so they come from the API response
+	data.ID, data.Name, data.Type = types.StringValue("eno1"), types.StringValue("eno1"), types.StringValue("PHYSICAL")
+	data.Aliases = types.ListUnknown(types.StringType)
+	r.setComputed(map[string]interface{}{"aliases": []interface{}{alias}}, &data)
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// UseStateForUnknown carries them into the plan of the next update
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+	identityType := identityResp.IdentitySchema.Type().TerraformType(ctx)
+	resp := resource.UpdateResponse{
+		State:    state,
+		Identity: &tfsdk.ResourceIdentity{Schema: identityResp.IdentitySchema, Raw: tftypes.NewValue(identityType, nil)},
+	}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}, State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+
+	calls := fs.Calls()
+	if len(calls) != 1 || calls[0].Method != "interface.update" {
+		t.Fatalf("Expected one interface.update call, got %+v", calls)
+	}
+	params, _ := calls[0].Params[1].(map[string]interface{})
+	if !reflect.DeepEqual(params["aliases"], []interface{}{alias}) {
+		t.Errorf("Expected the aliases sent back as objects, got %v", params["aliases"])
+	}
+	var aliases []string
+	resp.State.GetAttribute(ctx, path.Root("aliases"), &aliases)
+	if want, _ := json.Marshal(alias); len(aliases) != 1 || aliases[0] != string(want) {
+		t.Errorf("Expected the aliases stored as JSON, got %v", aliases)
+	}
+}
+
+func TestGeneratedResource_JobTimeouts(t *testing.T) {
+	r := NewPoolResource()
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+
+	if _, ok := resp.Schema.Blocks["timeouts"]; !ok {
+		t.Error("Expected job-backed pool resource to have a timeouts block")
+	}
+
+	// Plain CRUD resources have no jobs to wait for
+	r = NewSharingSmbResource()
+	resp = resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if _, ok := resp.Schema.Blocks["timeouts"]; ok {
+		t.Error("Expected no timeouts block on sharing_smb")
+	}
+}
+
+func TestGeneratedResource_SingletonSchema(t *testing.T) {
+	r := NewSmbConfigResource()
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+
+	// Unset settings keep the server's value, so there must be no defaults
+	for name, a := range resp.Schema.Attributes {
+		if name == "id" || name == "restore_on_destroy" {
+			continue
+		}
+		if a.IsRequired() || !a.IsOptional() || !a.IsComputed() {
+			t.Errorf("Expected %s to be optional and computed", name)
+		}
+		if b, ok := a.(schema.BoolAttribute); ok && b.Default != nil {
+			t.Errorf("Expected no static default on %s", name)
+		}
+	}
+	restore, ok := resp.Schema.Attributes["restore_on_destroy"].(schema.BoolAttribute)
+	if !ok || restore.Default == nil {
+		t.Error("Expected restore_on_destroy to default to false")
+	}
+}
+
+func TestGeneratedResource_SingletonSensitive(t *testing.T) {
+	tests := map[string]struct {
+		r    resource.Resource
+		attr string
+	}{
+		"mail pass":              {NewMailConfigResource(), "pass"},
+		"mail oauth":             {NewMailConfigResource(), "oauth"},
+		"ups monpwd":             {NewUpsConfigResource(), "monpwd"},
+		"ups rmonitor_password":  {NewUpsConfigResource(), "rmonitor_password"},
+		"snmp v3_password":       {NewSnmpConfigResource(), "v3_password"},
+		"snmp v3_privpassphrase": {NewSnmpConfigResource(), "v3_privpassphrase"},
+		"system sed_passwd":      {NewSystemAdvancedConfigResource(), "sed_passwd"},
+	}
+	for name, tt := range tests {
+		var resp resource.SchemaResponse
+		tt.r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+		if !resp.Schema.Attributes[tt.attr].IsSensitive() {
+			t.Errorf("%s: expected %s to be sensitive", name, tt.attr)
+		}
+	}
+}
+
+func TestGeneratedResource_SingletonSetComputed(t *testing.T) {
+	r := &SshConfigResource{}
+	data := SshConfigResourceModel{
+		Tcpport:      types.Int64Value(2222),
+		Passwordauth: types.BoolUnknown(),
+		Bindiface:    types.ListUnknown(types.StringType),
+	}
+	result := map[string]interface{}{
+		"tcpport":      float64(2222),
+		"passwordauth": false,
+		"bindiface":    []interface{}{"eno1"},
+	}
+	r.setComputed(result, &data)
+
+	if !data.Passwordauth.Equal(types.BoolValue(false)) {
+		t.Error("Expected passwordauth from the update response")
+	}
+	if len(data.Bindiface.Elements()) != 1 {
+		t.Errorf("Expected bindiface from the update response, got %v", data.Bindiface)
+	}
+}
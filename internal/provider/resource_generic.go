@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithIdentity = &GenericResource{}
+
+type GenericResource struct {
+	client *client.Client
+}
+
+type GenericResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	Namespace types.String   `tfsdk:"namespace"`
+	Config    types.Dynamic  `tfsdk:"config"`
+	IDField   types.String   `tfsdk:"id_field"`
+	Result    types.Dynamic  `tfsdk:"result"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewGenericResource() resource.Resource {
+	return &GenericResource{}
+}
+
+func (r *GenericResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_generic"
+}
+
+// genericIdentity identifies an object by its namespace and ID, the same pair
+// as the import ID
+var genericIdentity = []identityKey{{Name: "namespace"}, {Name: "id"}}
+
+func (r *GenericResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(genericIdentity)
+}
+
+// ImportState accepts "<namespace>:<id>", e.g. "nvmet.port:3", or an identity
+func (r *GenericResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("namespace"), path.Root("namespace"), req, resp)
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id_field"), "id")...)
+		return
+	}
+	namespace, id, ok := strings.Cut(req.ID, ":")
+	if !ok || namespace == "" || id == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <namespace>:<id>, e.g. nvmet.port:3, got %q", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id_field"), "id")...)
+}
+
+func (r *GenericResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages any TrueNAS object through the `<namespace>.create`, `.get_instance`, `.update` and `.delete` methods. Use it for namespaces that have no dedicated resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Object identifier, taken from `id_field` in the create response.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"namespace": schema.StringAttribute{
+				Required:      true,
+				Description:   "Middleware namespace, e.g. `nvmet.port`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"config": schema.DynamicAttribute{
+				Required:    true,
+				Description: "Object sent to create and update. Only these fields are compared against the server for drift.",
+			},
+			"id_field": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("id"),
+				Description:   "Field of the server response holding the identifier. Default: `id`",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"result": schema.DynamicAttribute{
+				Computed:    true,
+				Description: "Object as last returned by the server.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *GenericResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *GenericResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GenericResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := dynamicToInterface(ctx, data.Config)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid Config", err.Error())
+		return
+	}
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := data.Namespace.ValueString()
+	result, err := r.call(namespace+".create", []interface{}{params}, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to create %s: %s", namespace, err))
+		return
+	}
+
+	resultMap, _ := result.(map[string]interface{})
+	if id, exists := resultMap[data.IDField.ValueString()]; exists && id != nil {
+		data.ID = types.StringValue(fmt.Sprintf("%v", id))
+	}
+	if data.ID.IsNull() || data.ID.IsUnknown() || data.ID.ValueString() == "" {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("API did not return %q in the %s.create response", data.IDField.ValueString(), namespace))
+		return
+	}
+
+	data.Result, err = interfaceToDynamic(result)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to decode %s response: %s", namespace, err))
+		return
+	}
+	resp.Diagnostics.Append(setGenericIdentity(ctx, resp.Identity, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GenericResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GenericResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := data.Namespace.ValueString()
+	result, err := r.client.CallWithTimeout(namespace+".get_instance", []interface{}{genericID(data.ID.ValueString())}, readTimeout)
+	if err != nil {
+		// Check if resource was deleted outside Terraform (ENOENT = entity not found)
+		if strings.Contains(err.Error(), "[ENOENT]") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read %s: %s", namespace, err))
+		return
+	}
+
+	// Refresh only the configured fields from the server, so changes made
+	// outside Terraform show up as a diff against config. After import there
+	// is no config yet and the whole object is adopted.
+	configured, err := dynamicToInterface(ctx, data.Config)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to decode %s config: %s", namespace, err))
+		return
+	}
+	refreshed := result
+	if configured != nil {
+		refreshed = genericDrift(configured, result)
+	}
+	data.Config, err = interfaceToDynamic(refreshed)
+	if err == nil {
+		data.Result, err = interfaceToDynamic(result)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to decode %s response: %s", namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(setGenericIdentity(ctx, resp.Identity, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GenericResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GenericResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state GenericResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := dynamicToInterface(ctx, data.Config)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid Config", err.Error())
+		return
+	}
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := data.Namespace.ValueString()
+	result, err := r.call(namespace+".update", []interface{}{genericID(state.ID.ValueString()), params}, updateTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update %s: %s", namespace, err))
+		return
+	}
+
+	data.ID = state.ID
+	data.Result, err = interfaceToDynamic(result)
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to decode %s response: %s", namespace, err))
+		return
+	}
+	resp.Diagnostics.Append(setGenericIdentity(ctx, resp.Identity, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GenericResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GenericResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := data.Namespace.ValueString()
+	_, err := r.call(namespace+".delete", []interface{}{genericID(data.ID.ValueString())}, deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to delete %s: %s", namespace, err))
+		return
+	}
+}
+
+// call calls a create, update or delete method, waiting for the job when the
+// API lists the method as a job. A plain result that is a number, such as an
+// ID, is not mistaken for a job ID.
+func (r *GenericResource) call(method string, params []interface{}, timeout time.Duration) (interface{}, error) {
+	job, err := r.client.IsJobMethod(method)
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up %s: %s", method, err)
+	}
+	if job {
+		return r.client.CallWithJobTimeout(method, params, timeout)
+	}
+	return r.client.CallWithTimeout(method, params, timeout)
+}
+
+// genericID sends numeric IDs as integers and anything else as a string
+func genericID(id string) interface{} {
+	if n, err := strconv.Atoi(id); err == nil {
+		return n
+	}
+	return id
+}
+
+// genericDrift returns configured with every field replaced by the server's
+// value. Fields the server doesn't return keep the configured value, and
+// values that only differ in representation ("10" vs 10) are left alone.
+func genericDrift(configured, server interface{}) interface{} {
+	switch cfg := configured.(type) {
+	case map[string]interface{}:
+		srv, ok := server.(map[string]interface{})
+		if !ok {
+			return server
+		}
+		out := make(map[string]interface{}, len(cfg))
+		for k, v := range cfg {
+			if sv, exists := srv[k]; exists {
+				out[k] = genericDrift(v, sv)
+			} else {
+				out[k] = v
+			}
+		}
+		return out
+	case []interface{}:
+		srv, ok := server.([]interface{})
+		if !ok || len(srv) != len(cfg) {
+			return server
+		}
+		out := make([]interface{}, len(cfg))
+		for i := range cfg {
+			out[i] = genericDrift(cfg[i], srv[i])
+		}
+		return out
+	}
+	if genericEqual(configured, server) {
+		return configured
+	}
+	return server
+}
+
+// genericEqual compares scalars, treating numbers of any Go type and their
+// string forms as equal
+func genericEqual(a, b interface{}) bool {
+	if an, ok := genericNumber(a); ok {
+		if bn, ok := genericNumber(b); ok {
+			return an.Cmp(bn) == 0
+		}
+	}
+	if as, ok := a.(string); ok {
+		switch b.(type) {
+		case bool, float64, int64, int:
+			return as == fmt.Sprintf("%v", b)
+		}
+	}
+	return a == b
+}
+
+func genericNumber(v interface{}) (*big.Float, bool) {
+	switch n := v.(type) {
+	case float64:
+		return big.NewFloat(n), true
+	case int64:
+		return new(big.Float).SetInt64(n), true
+	case int:
+		return new(big.Float).SetInt64(int64(n)), true
+	}
+	return nil, false
+}
+
+// setGenericIdentity records the namespace and ID of data as its identity
+func setGenericIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, data *GenericResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(identity.SetAttribute(ctx, path.Root("namespace"), data.Namespace)...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("id"), data.ID)...)
+	return diags
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGenericDrift(t *testing.T) {
+	server := map[string]interface{}{
+		"id":           float64(3),
+		"addr_trtype":  "TCP",
+		"addr_trsvcid": float64(4420),
+		"addr_traddr":  "10.0.0.1",
+		"inline_data":  true,
+		"hosts":        []interface{}{map[string]interface{}{"id": float64(1), "name": "a"}},
+	}
+	configured := map[string]interface{}{
+		"addr_trtype":  "TCP",
+		"addr_trsvcid": int64(4420),
+		"addr_traddr":  "0.0.0.0",
+		"hosts":        []interface{}{map[string]interface{}{"name": "a"}},
+		"missing":      "kept",
+	}
+	want := map[string]interface{}{
+		"addr_trtype":  "TCP",
+		"addr_trsvcid": int64(4420),
+		"addr_traddr":  "10.0.0.1",
+		"hosts":        []interface{}{map[string]interface{}{"name": "a"}},
+		"missing":      "kept",
+	}
+	if got := genericDrift(configured, server); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %#v, got %#v", want, got)
+	}
+}
+
+func TestGenericEqual(t *testing.T) {
+	tests := []struct {
+		a, b interface{}
+		want bool
+	}{
+		{int64(10), float64(10), true},
+		{"10", float64(10), true},
+		{"true", true, true},
+		{"10", float64(11), false},
+		{"a", "a", true},
+		{nil, nil, true},
+		{"a", nil, false},
+	}
+	for _, tt := range tests {
+		if got := genericEqual(tt.a, tt.b); got != tt.want {
+			t.Errorf("genericEqual(%#v, %#v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestGenericID(t *testing.T) {
+	if got := genericID("3"); got != 3 {
+		t.Errorf("Expected numeric ID 3, got %#v", got)
+	}
+	if got := genericID("tank/data"); got != "tank/data" {
+		t.Errorf("Expected string ID, got %#v", got)
+	}
+}
+
+func TestGenericCall_NumericResult(t *testing.T) {
+	c, fs := newFakeClient(t, func(method string, params []interface{}) (interface{}, error) {
+		switch method {
+		case "core.get_methods":
+			return map[string]interface{}{
+				"nvmet.port.create": map[string]interface{}{"job": false},
+				"nvmet.port.delete": map[string]interface{}{"job": false},
+			}, nil
+		case "nvmet.port.create":
+			return float64(7), nil
+		}
+		return float64(1), nil
+	})
+	r := &GenericResource{client: c}
+
+	// Numbers from methods that aren't jobs are results, not job IDs to wait for
+	for _, method := range []string{"nvmet.port.create", "nvmet.port.delete"} {
+		if _, err := r.call(method, []interface{}{}, time.Second); err != nil {
+			t.Fatalf("Unexpected error from %s: %v", method, err)
+		}
+	}
+	var methods []string
+	for _, call := range fs.Calls() {
+		methods = append(methods, call.Method)
+	}
+	want := []string{"core.get_methods", "nvmet.port.create", "nvmet.port.delete"}
+	if !reflect.DeepEqual(methods, want) {
+		t.Errorf("Expected calls %v with the job flags looked up once, got %v", want, methods)
+	}
+}
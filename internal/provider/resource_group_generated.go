@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
@@ -35,22 +37,39 @@ func NewGroupResource() resource.Resource {
 
 func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_group"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "group.query", groupIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &GroupResource{}
+
+// groupIdentity lists the API fields that identify a group
+var groupIdentity = []identityKey{{Name: "name"}}
+
+func (r *GroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(groupIdentity)
+}
+
+func (r *GroupResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a new group.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"gid": schema.Int64Attribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "If `null`, it is automatically filled with the next one available.",
-				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown(), int64planmodifier.RequiresReplace()},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -60,30 +79,40 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"sudo_commands": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "A list of commands that group members may execute with elevated privileges. User is prompted for pas",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"sudo_commands_nopasswd": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "A list of commands that group members may execute with elevated privileges. User is not prompted for",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"smb": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "If set to `True`, the group can be used for SMB share ACL entries. The group is mapped to an NT grou",
+				Default:     booldefault.StaticBool(true),
 			},
 			"userns_idmap": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Specifies the subgid mapping for this group. If DIRECT then the GID will be     directly mapped to a",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Specifies the subgid mapping for this group. If DIRECT then the GID will be     directly mapped to a",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"users": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "A list a API user identifiers for local users who are members of this group. These IDs match the `id",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 		},
 	}
@@ -109,29 +138,29 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	params := map[string]interface{}{}
-	if !data.Gid.IsNull() {
+	if !data.Gid.IsNull() && !data.Gid.IsUnknown() {
 		params["gid"] = data.Gid.ValueInt64()
 	}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.SudoCommands.IsNull() {
+	if !data.SudoCommands.IsNull() && !data.SudoCommands.IsUnknown() {
 		var sudo_commandsList []string
 		data.SudoCommands.ElementsAs(ctx, &sudo_commandsList, false)
 		params["sudo_commands"] = sudo_commandsList
 	}
-	if !data.SudoCommandsNopasswd.IsNull() {
+	if !data.SudoCommandsNopasswd.IsNull() && !data.SudoCommandsNopasswd.IsUnknown() {
 		var sudo_commands_nopasswdList []string
 		data.SudoCommandsNopasswd.ElementsAs(ctx, &sudo_commands_nopasswdList, false)
 		params["sudo_commands_nopasswd"] = sudo_commands_nopasswdList
 	}
-	if !data.Smb.IsNull() {
+	if !data.Smb.IsNull() && !data.Smb.IsUnknown() {
 		params["smb"] = data.Smb.ValueBool()
 	}
-	if !data.UsernsIdmap.IsNull() {
+	if !data.UsernsIdmap.IsNull() && !data.UsernsIdmap.IsUnknown() {
 		params["userns_idmap"] = data.UsernsIdmap.ValueInt64()
 	}
-	if !data.Users.IsNull() {
+	if !data.Users.IsNull() && !data.Users.IsUnknown() {
 		var usersList []string
 		data.Users.ElementsAs(ctx, &usersList, false)
 		params["users"] = usersList
@@ -155,6 +184,8 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, groupIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -207,6 +238,7 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			data.Name = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, groupIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -233,38 +265,40 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.SudoCommands.IsNull() {
+	if !data.SudoCommands.IsNull() && !data.SudoCommands.IsUnknown() {
 		var sudo_commandsList []string
 		data.SudoCommands.ElementsAs(ctx, &sudo_commandsList, false)
 		params["sudo_commands"] = sudo_commandsList
 	}
-	if !data.SudoCommandsNopasswd.IsNull() {
+	if !data.SudoCommandsNopasswd.IsNull() && !data.SudoCommandsNopasswd.IsUnknown() {
 		var sudo_commands_nopasswdList []string
 		data.SudoCommandsNopasswd.ElementsAs(ctx, &sudo_commands_nopasswdList, false)
 		params["sudo_commands_nopasswd"] = sudo_commands_nopasswdList
 	}
-	if !data.Smb.IsNull() {
+	if !data.Smb.IsNull() && !data.Smb.IsUnknown() {
 		params["smb"] = data.Smb.ValueBool()
 	}
-	if !data.UsernsIdmap.IsNull() {
+	if !data.UsernsIdmap.IsNull() && !data.UsernsIdmap.IsUnknown() {
 		params["userns_idmap"] = data.UsernsIdmap.ValueInt64()
 	}
-	if !data.Users.IsNull() {
+	if !data.Users.IsNull() && !data.Users.IsUnknown() {
 		var usersList []string
 		data.Users.ElementsAs(ctx, &usersList, false)
 		params["users"] = usersList
 	}
 
-	_, err = r.client.Call("group.update", []interface{}{id, params})
+	result, err := r.client.Call("group.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update group: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, groupIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -290,3 +324,83 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *GroupResource) setComputed(result interface{}, data *GroupResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Gid.IsUnknown() {
+		data.Gid = types.Int64Null()
+		if v, ok := resultMap["gid"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Gid = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Gid = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.SudoCommands.IsUnknown() {
+		data.SudoCommands = types.ListNull(types.StringType)
+		if v, ok := resultMap["sudo_commands"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.SudoCommands, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.SudoCommandsNopasswd.IsUnknown() {
+		data.SudoCommandsNopasswd = types.ListNull(types.StringType)
+		if v, ok := resultMap["sudo_commands_nopasswd"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.SudoCommandsNopasswd, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Smb.IsUnknown() {
+		data.Smb = types.BoolNull()
+		if v, ok := resultMap["smb"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Smb = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.UsernsIdmap.IsUnknown() {
+		data.UsernsIdmap = types.Int64Null()
+		if v, ok := resultMap["userns_idmap"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.UsernsIdmap = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.UsernsIdmap = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Users.IsUnknown() {
+		data.Users = types.ListNull(types.StringType)
+		if v, ok := resultMap["users"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Users, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+}
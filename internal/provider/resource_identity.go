@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// identityKey is one attribute of a resource identity, read from the API field
+// of the same name unless Field is set. Keys other than id are natural keys such
+// as a username.
+type identityKey struct {
+	Name  string
+	Field string
+	Int   bool
+}
+
+// field is the API field the key is read from and filtered on
+func (k identityKey) field() string {
+	if k.Field != "" {
+		return k.Field
+	}
+	return k.Name
+}
+
+// identitySchema returns the identity schema for keys; all of them are needed
+// to import by identity
+func identitySchema(keys []identityKey) identityschema.Schema {
+	attrs := make(map[string]identityschema.Attribute, len(keys))
+	for _, k := range keys {
+		if k.Int {
+			attrs[k.Name] = identityschema.Int64Attribute{RequiredForImport: true}
+		} else {
+			attrs[k.Name] = identityschema.StringAttribute{RequiredForImport: true}
+		}
+	}
+	return identityschema.Schema{Attributes: attrs}
+}
+
+// setIdentity records the identity of the API object in result
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, keys []identityKey, result interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	obj, ok := result.(map[string]interface{})
+	if !ok {
+		diags.AddError("Identity Error", fmt.Sprintf("Expected an object to read the resource identity from, got %T", result))
+		return diags
+	}
+	for _, k := range keys {
+		v, ok := obj[k.field()]
+		if !ok || v == nil {
+			diags.AddError("Identity Error", fmt.Sprintf("API response has no %q to identify the resource by", k.field()))
+			continue
+		}
+		if !k.Int {
+			diags.Append(identity.SetAttribute(ctx, path.Root(k.Name), types.StringValue(fmt.Sprintf("%v", v)))...)
+			continue
+		}
+		n, ok := v.(float64)
+		if !ok {
+			diags.AddError("Identity Error", fmt.Sprintf("Expected %q to be a number, got %T", k.field(), v))
+			continue
+		}
+		diags.Append(identity.SetAttribute(ctx, path.Root(k.Name), types.Int64Value(int64(n)))...)
+	}
+	return diags
+}
+
+// importByIdentity imports by ID, or by identity. Natural keys are resolved to
+// the ID through queryMethod.
+func importByIdentity(ctx context.Context, c *client.Client, queryMethod string, keys []identityKey, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	if len(keys) == 1 && keys[0].Name == "id" {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	filters := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		if k.Int {
+			var v types.Int64
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(k.Name), &v)...)
+			filters = append(filters, []interface{}{k.field(), "=", v.ValueInt64()})
+		} else {
+			var v types.String
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(k.Name), &v)...)
+			filters = append(filters, []interface{}{k.field(), "=", v.ValueString()})
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, err := queryMatch(c, queryMethod, filters)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to find the resource to import: %s", err))
+		return
+	}
+	obj, ok := item.(map[string]interface{})
+	if !ok || obj["id"] == nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unexpected %s response: %v", queryMethod, item))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%v", obj["id"]))...)
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProvider_ResourceIdentities(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.GetResourceIdentitySchemas(context.Background(), &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("GetResourceIdentitySchemas: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	// Natural keys rather than the numeric ID
+	tests := map[string]string{
+		"truenas_pool":          "name",
+		"truenas_pool_dataset":  "name,pool",
+		"truenas_pool_snapshot": "dataset,name",
+		"truenas_user":          "username",
+		"truenas_certificate":   "name",
+		"truenas_tunable":       "var",
+		"truenas_nvmet_host":    "hostnqn",
+		"truenas_service":       "service",
+		"truenas_generic":       "id,namespace",
+	}
+	for name, want := range tests {
+		s, ok := resp.IdentitySchemas[name]
+		if !ok {
+			t.Errorf("Expected resource %s to have an identity", name)
+			continue
+		}
+		var attrs []string
+		for _, a := range s.IdentityAttributes {
+			if !a.RequiredForImport {
+				t.Errorf("Expected %s.%s to be required for import", name, a.Name)
+			}
+			attrs = append(attrs, a.Name)
+		}
+		sort.Strings(attrs)
+		if got := strings.Join(attrs, ","); got != want {
+			t.Errorf("%s: expected identity %s, got %s", name, want, got)
+		}
+	}
+}
+
+func TestSetIdentity_Field(t *testing.T) {
+	ctx := context.Background()
+	s := identitySchema(poolSnapshotIdentity)
+	identity := &tfsdk.ResourceIdentity{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+	}
+
+	// The snapshot name is reported as snapshot_name; name is the full name
+	snapshot := map[string]interface{}{
+		"id":            "tank/data@daily",
+		"name":          "tank/data@daily",
+		"dataset":       "tank/data",
+		"snapshot_name": "daily",
+	}
+	if diags := setIdentity(ctx, identity, poolSnapshotIdentity, snapshot); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	var dataset, name types.String
+	identity.GetAttribute(ctx, path.Root("dataset"), &dataset)
+	identity.GetAttribute(ctx, path.Root("name"), &name)
+	if dataset.ValueString() != "tank/data" || name.ValueString() != "daily" {
+		t.Errorf("Expected tank/data and daily, got %s and %s", dataset, name)
+	}
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -39,8 +44,13 @@ func (r *InitshutdownscriptResource) ImportState(ctx context.Context, req resour
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *InitshutdownscriptResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *InitshutdownscriptResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an initshutdown script task.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -48,36 +58,48 @@ func (r *InitshutdownscriptResource) Schema(ctx context.Context, req resource.Sc
 				Required:    true,
 				Optional:    false,
 				Description: "Type of init/shutdown script to execute.  * `COMMAND`: Execute a single command * `SCRIPT`: Execute ",
+				Validators:  []validator.String{stringvalidator.OneOf("COMMAND", "SCRIPT")},
 			},
 			"command": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Must be given if `type=\"COMMAND\"`.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"script": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Must be given if `type=\"SCRIPT\"`.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"when": schema.StringAttribute{
 				Required:    true,
 				Optional:    false,
 				Description: "* \"PREINIT\": Early in the boot process before all services have started. * \"POSTINIT\": Late in the b",
+				Validators:  []validator.String{stringvalidator.OneOf("PREINIT", "POSTINIT", "SHUTDOWN")},
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether the init/shutdown script is enabled to execute.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"timeout": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "An integer time in seconds that the system should wait for the execution of the script/command.  A h",
+				Default:     int64default.StaticInt64(10),
 			},
 			"comment": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Optional comment describing the purpose of this script.",
+				Default:     stringdefault.StaticString(""),
 			},
 		},
 	}
@@ -103,25 +125,25 @@ func (r *InitshutdownscriptResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	params := map[string]interface{}{}
-	if !data.Type.IsNull() {
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
 		params["type"] = data.Type.ValueString()
 	}
-	if !data.Command.IsNull() {
+	if !data.Command.IsNull() && !data.Command.IsUnknown() {
 		params["command"] = data.Command.ValueString()
 	}
-	if !data.Script.IsNull() {
+	if !data.Script.IsNull() && !data.Script.IsUnknown() {
 		params["script"] = data.Script.ValueString()
 	}
-	if !data.When.IsNull() {
+	if !data.When.IsNull() && !data.When.IsUnknown() {
 		params["when"] = data.When.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Timeout.IsNull() {
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() {
 		params["timeout"] = data.Timeout.ValueInt64()
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
 
@@ -143,6 +165,7 @@ func (r *InitshutdownscriptResource) Create(ctx context.Context, req resource.Cr
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -233,35 +256,36 @@ func (r *InitshutdownscriptResource) Update(ctx context.Context, req resource.Up
 	}
 
 	params := map[string]interface{}{}
-	if !data.Type.IsNull() {
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
 		params["type"] = data.Type.ValueString()
 	}
-	if !data.Command.IsNull() {
+	if !data.Command.IsNull() && !data.Command.IsUnknown() {
 		params["command"] = data.Command.ValueString()
 	}
-	if !data.Script.IsNull() {
+	if !data.Script.IsNull() && !data.Script.IsUnknown() {
 		params["script"] = data.Script.ValueString()
 	}
-	if !data.When.IsNull() {
+	if !data.When.IsNull() && !data.When.IsUnknown() {
 		params["when"] = data.When.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Timeout.IsNull() {
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() {
 		params["timeout"] = data.Timeout.ValueInt64()
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
 
-	_, err = r.client.Call("initshutdownscript.update", []interface{}{id, params})
+	result, err := r.client.Call("initshutdownscript.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update initshutdownscript: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -286,3 +310,77 @@ func (r *InitshutdownscriptResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *InitshutdownscriptResource) setComputed(result interface{}, data *InitshutdownscriptResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Command.IsUnknown() {
+		data.Command = types.StringNull()
+		if v, ok := resultMap["command"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Command = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Command = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Command = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Script.IsUnknown() {
+		data.Script = types.StringNull()
+		if v, ok := resultMap["script"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Script = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Script = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Script = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Timeout.IsUnknown() {
+		data.Timeout = types.Int64Null()
+		if v, ok := resultMap["timeout"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Timeout = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Timeout = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Comment.IsUnknown() {
+		data.Comment = types.StringNull()
+		if v, ok := resultMap["comment"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Comment = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
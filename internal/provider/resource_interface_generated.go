@@ -5,11 +5,22 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strings"
 )
@@ -50,132 +61,202 @@ func NewInterfaceResource() resource.Resource {
 
 func (r *InterfaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_interface"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *InterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "interface.query", interfaceIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &InterfaceResource{}
+
+// interfaceIdentity lists the API fields that identify a interface
+var interfaceIdentity = []identityKey{{Name: "name"}}
+
+func (r *InterfaceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(interfaceIdentity)
+}
+
+func (r *InterfaceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *InterfaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create virtual interfaces (Link Aggregation, VLAN)",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"name": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "Generate a name if not provided based on `type`, e.g. \"br0\", \"bond1\", \"vlan0\".",
+				Optional:      true,
+				Computed:      true,
+				Description:   "Generate a name if not provided based on `type`, e.g. \"br0\", \"bond1\", \"vlan0\".",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"description": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Human-readable description of the interface.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"type": schema.StringAttribute{
 				Required:      true,
 				Optional:      false,
 				Description:   "Type of interface to create.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("BRIDGE", "LINK_AGGREGATION", "VLAN")},
 			},
 			"ipv4_dhcp": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Enable IPv4 DHCP for automatic IP address assignment.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"ipv6_auto": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Enable IPv6 autoconfiguration.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"aliases": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of IP address aliases to configure on the interface.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "List of IP address aliases to configure on the interface.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"failover_critical": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether this interface is critical for failover functionality. Critical interfaces are monitored for",
+				Default:     booldefault.StaticBool(false),
 			},
 			"failover_group": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Failover group identifier for clustering. Interfaces in the same group fail over together during    ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Failover group identifier for clustering. Interfaces in the same group fail over together during    ",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"failover_vhid": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Virtual Host ID for VRRP failover configuration. Must be unique within the VRRP group and match     ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Virtual Host ID for VRRP failover configuration. Must be unique within the VRRP group and match     ",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(1, 255)},
 			},
 			"failover_aliases": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of IP aliases for failover configuration. These IPs are assigned to the interface during normal",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "List of IP aliases for failover configuration. These IPs are assigned to the interface during normal",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"failover_virtual_aliases": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "List of virtual IP aliases for failover configuration. These are shared IPs that float between nodes",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "List of virtual IP aliases for failover configuration. These are shared IPs that float between nodes",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"bridge_members": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "List of interfaces to add as members of this bridge.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Validators:  []validator.List{listvalidator.ConflictsWith(path.MatchRoot("lacpdu_rate"), path.MatchRoot("lag_ports"), path.MatchRoot("lag_protocol"), path.MatchRoot("vlan_parent_interface"), path.MatchRoot("vlan_pcp"), path.MatchRoot("vlan_tag"), path.MatchRoot("xmit_hash_policy"))},
 			},
 			"enable_learning": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Enable MAC address learning for bridge interfaces. When enabled, the bridge learns MAC addresses    ",
+				Default:     booldefault.StaticBool(true),
+				Validators:  []validator.Bool{boolvalidator.ConflictsWith(path.MatchRoot("lacpdu_rate"), path.MatchRoot("lag_ports"), path.MatchRoot("lag_protocol"), path.MatchRoot("vlan_parent_interface"), path.MatchRoot("vlan_pcp"), path.MatchRoot("vlan_tag"), path.MatchRoot("xmit_hash_policy"))},
 			},
 			"stp": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Enable Spanning Tree Protocol for bridge interfaces. STP prevents network loops by blocking redundan",
+				Default:     booldefault.StaticBool(true),
+				Validators:  []validator.Bool{boolvalidator.ConflictsWith(path.MatchRoot("lacpdu_rate"), path.MatchRoot("lag_ports"), path.MatchRoot("lag_protocol"), path.MatchRoot("vlan_parent_interface"), path.MatchRoot("vlan_pcp"), path.MatchRoot("vlan_tag"), path.MatchRoot("xmit_hash_policy"))},
 			},
 			"lag_protocol": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Link aggregation protocol to use for bonding interfaces. LACP uses 802.3ad dynamic negotiation,     ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Link aggregation protocol to use for bonding interfaces. LACP uses 802.3ad dynamic negotiation,     ",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("LACP", "FAILOVER", "LOADBALANCE", "ROUNDROBIN", "NONE"), stringvalidator.ConflictsWith(path.MatchRoot("bridge_members"), path.MatchRoot("enable_learning"), path.MatchRoot("stp"), path.MatchRoot("vlan_parent_interface"), path.MatchRoot("vlan_pcp"), path.MatchRoot("vlan_tag"))},
 			},
 			"xmit_hash_policy": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Transmit hash policy for load balancing in link aggregation. LAYER2 uses MAC addresses, LAYER2+3 add",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Transmit hash policy for load balancing in link aggregation. LAYER2 uses MAC addresses, LAYER2+3 add",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("LAYER2", "LAYER2+3", "LAYER3+4"), stringvalidator.ConflictsWith(path.MatchRoot("bridge_members"), path.MatchRoot("enable_learning"), path.MatchRoot("stp"), path.MatchRoot("vlan_parent_interface"), path.MatchRoot("vlan_pcp"), path.MatchRoot("vlan_tag"))},
 			},
 			"lacpdu_rate": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "LACP data unit transmission rate. SLOW sends LACPDUs every 30 seconds, FAST sends every 1 second for",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "LACP data unit transmission rate. SLOW sends LACPDUs every 30 seconds, FAST sends every 1 second for",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("SLOW", "FAST"), stringvalidator.ConflictsWith(path.MatchRoot("bridge_members"), path.MatchRoot("enable_learning"), path.MatchRoot("stp"), path.MatchRoot("vlan_parent_interface"), path.MatchRoot("vlan_pcp"), path.MatchRoot("vlan_tag"))},
 			},
 			"lag_ports": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "List of interface names to include in the link aggregation group.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Validators:  []validator.List{listvalidator.ConflictsWith(path.MatchRoot("bridge_members"), path.MatchRoot("enable_learning"), path.MatchRoot("stp"), path.MatchRoot("vlan_parent_interface"), path.MatchRoot("vlan_pcp"), path.MatchRoot("vlan_tag"))},
 			},
 			"vlan_parent_interface": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Parent interface for VLAN configuration.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Parent interface for VLAN configuration.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("bridge_members"), path.MatchRoot("enable_learning"), path.MatchRoot("lacpdu_rate"), path.MatchRoot("lag_ports"), path.MatchRoot("lag_protocol"), path.MatchRoot("stp"), path.MatchRoot("xmit_hash_policy"))},
 			},
 			"vlan_tag": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "VLAN tag number (1-4094).",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "VLAN tag number (1-4094).",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(1, 4094), int64validator.ConflictsWith(path.MatchRoot("bridge_members"), path.MatchRoot("enable_learning"), path.MatchRoot("lacpdu_rate"), path.MatchRoot("lag_ports"), path.MatchRoot("lag_protocol"), path.MatchRoot("stp"), path.MatchRoot("xmit_hash_policy"))},
 			},
 			"vlan_pcp": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Priority Code Point for VLAN traffic prioritization (0-7). Values 0-7 map to different QoS priority ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Priority Code Point for VLAN traffic prioritization (0-7). Values 0-7 map to different QoS priority ",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 7), int64validator.ConflictsWith(path.MatchRoot("bridge_members"), path.MatchRoot("enable_learning"), path.MatchRoot("lacpdu_rate"), path.MatchRoot("lag_ports"), path.MatchRoot("lag_protocol"), path.MatchRoot("stp"), path.MatchRoot("xmit_hash_policy"))},
 			},
 			"mtu": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Maximum transmission unit size for the interface (68-9216 bytes).",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum transmission unit size for the interface (68-9216 bytes).",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(68, 9216)},
 			},
 		},
 	}
@@ -201,22 +282,22 @@ func (r *InterfaceResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Type.IsNull() {
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
 		params["type"] = data.Type.ValueString()
 	}
-	if !data.Ipv4Dhcp.IsNull() {
+	if !data.Ipv4Dhcp.IsNull() && !data.Ipv4Dhcp.IsUnknown() {
 		params["ipv4_dhcp"] = data.Ipv4Dhcp.ValueBool()
 	}
-	if !data.Ipv6Auto.IsNull() {
+	if !data.Ipv6Auto.IsNull() && !data.Ipv6Auto.IsUnknown() {
 		params["ipv6_auto"] = data.Ipv6Auto.ValueBool()
 	}
-	if !data.Aliases.IsNull() {
+	if !data.Aliases.IsNull() && !data.Aliases.IsUnknown() {
 		var aliasesList []string
 		data.Aliases.ElementsAs(ctx, &aliasesList, false)
 		var aliasesObjs []map[string]interface{}
@@ -230,16 +311,16 @@ func (r *InterfaceResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		params["aliases"] = aliasesObjs
 	}
-	if !data.FailoverCritical.IsNull() {
+	if !data.FailoverCritical.IsNull() && !data.FailoverCritical.IsUnknown() {
 		params["failover_critical"] = data.FailoverCritical.ValueBool()
 	}
-	if !data.FailoverGroup.IsNull() {
+	if !data.FailoverGroup.IsNull() && !data.FailoverGroup.IsUnknown() {
 		params["failover_group"] = data.FailoverGroup.ValueInt64()
 	}
-	if !data.FailoverVhid.IsNull() {
+	if !data.FailoverVhid.IsNull() && !data.FailoverVhid.IsUnknown() {
 		params["failover_vhid"] = data.FailoverVhid.ValueInt64()
 	}
-	if !data.FailoverAliases.IsNull() {
+	if !data.FailoverAliases.IsNull() && !data.FailoverAliases.IsUnknown() {
 		var failover_aliasesList []string
 		data.FailoverAliases.ElementsAs(ctx, &failover_aliasesList, false)
 		var failover_aliasesObjs []map[string]interface{}
@@ -253,7 +334,7 @@ func (r *InterfaceResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		params["failover_aliases"] = failover_aliasesObjs
 	}
-	if !data.FailoverVirtualAliases.IsNull() {
+	if !data.FailoverVirtualAliases.IsNull() && !data.FailoverVirtualAliases.IsUnknown() {
 		var failover_virtual_aliasesList []string
 		data.FailoverVirtualAliases.ElementsAs(ctx, &failover_virtual_aliasesList, false)
 		var failover_virtual_aliasesObjs []map[string]interface{}
@@ -267,41 +348,41 @@ func (r *InterfaceResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		params["failover_virtual_aliases"] = failover_virtual_aliasesObjs
 	}
-	if !data.BridgeMembers.IsNull() {
+	if !data.BridgeMembers.IsNull() && !data.BridgeMembers.IsUnknown() {
 		var bridge_membersList []string
 		data.BridgeMembers.ElementsAs(ctx, &bridge_membersList, false)
 		params["bridge_members"] = bridge_membersList
 	}
-	if !data.EnableLearning.IsNull() {
+	if !data.EnableLearning.IsNull() && !data.EnableLearning.IsUnknown() {
 		params["enable_learning"] = data.EnableLearning.ValueBool()
 	}
-	if !data.Stp.IsNull() {
+	if !data.Stp.IsNull() && !data.Stp.IsUnknown() {
 		params["stp"] = data.Stp.ValueBool()
 	}
-	if !data.LagProtocol.IsNull() {
+	if !data.LagProtocol.IsNull() && !data.LagProtocol.IsUnknown() {
 		params["lag_protocol"] = data.LagProtocol.ValueString()
 	}
-	if !data.XmitHashPolicy.IsNull() {
+	if !data.XmitHashPolicy.IsNull() && !data.XmitHashPolicy.IsUnknown() {
 		params["xmit_hash_policy"] = data.XmitHashPolicy.ValueString()
 	}
-	if !data.LacpduRate.IsNull() {
+	if !data.LacpduRate.IsNull() && !data.LacpduRate.IsUnknown() {
 		params["lacpdu_rate"] = data.LacpduRate.ValueString()
 	}
-	if !data.LagPorts.IsNull() {
+	if !data.LagPorts.IsNull() && !data.LagPorts.IsUnknown() {
 		var lag_portsList []string
 		data.LagPorts.ElementsAs(ctx, &lag_portsList, false)
 		params["lag_ports"] = lag_portsList
 	}
-	if !data.VlanParentInterface.IsNull() {
+	if !data.VlanParentInterface.IsNull() && !data.VlanParentInterface.IsUnknown() {
 		params["vlan_parent_interface"] = data.VlanParentInterface.ValueString()
 	}
-	if !data.VlanTag.IsNull() {
+	if !data.VlanTag.IsNull() && !data.VlanTag.IsUnknown() {
 		params["vlan_tag"] = data.VlanTag.ValueInt64()
 	}
-	if !data.VlanPcp.IsNull() {
+	if !data.VlanPcp.IsNull() && !data.VlanPcp.IsUnknown() {
 		params["vlan_pcp"] = data.VlanPcp.ValueInt64()
 	}
-	if !data.Mtu.IsNull() {
+	if !data.Mtu.IsNull() && !data.Mtu.IsUnknown() {
 		params["mtu"] = data.Mtu.ValueInt64()
 	}
 
@@ -323,6 +404,8 @@ func (r *InterfaceResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, interfaceIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -383,6 +466,7 @@ func (r *InterfaceResource) Read(ctx context.Context, req resource.ReadRequest,
 			data.Type = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, interfaceIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -405,19 +489,19 @@ func (r *InterfaceResource) Update(ctx context.Context, req resource.UpdateReque
 	id = state.ID.ValueString()
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Ipv4Dhcp.IsNull() {
+	if !data.Ipv4Dhcp.IsNull() && !data.Ipv4Dhcp.IsUnknown() {
 		params["ipv4_dhcp"] = data.Ipv4Dhcp.ValueBool()
 	}
-	if !data.Ipv6Auto.IsNull() {
+	if !data.Ipv6Auto.IsNull() && !data.Ipv6Auto.IsUnknown() {
 		params["ipv6_auto"] = data.Ipv6Auto.ValueBool()
 	}
-	if !data.Aliases.IsNull() {
+	if !data.Aliases.IsNull() && !data.Aliases.IsUnknown() {
 		var aliasesList []string
 		data.Aliases.ElementsAs(ctx, &aliasesList, false)
 		var aliasesObjs []map[string]interface{}
@@ -431,16 +515,16 @@ func (r *InterfaceResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		params["aliases"] = aliasesObjs
 	}
-	if !data.FailoverCritical.IsNull() {
+	if !data.FailoverCritical.IsNull() && !data.FailoverCritical.IsUnknown() {
 		params["failover_critical"] = data.FailoverCritical.ValueBool()
 	}
-	if !data.FailoverGroup.IsNull() {
+	if !data.FailoverGroup.IsNull() && !data.FailoverGroup.IsUnknown() {
 		params["failover_group"] = data.FailoverGroup.ValueInt64()
 	}
-	if !data.FailoverVhid.IsNull() {
+	if !data.FailoverVhid.IsNull() && !data.FailoverVhid.IsUnknown() {
 		params["failover_vhid"] = data.FailoverVhid.ValueInt64()
 	}
-	if !data.FailoverAliases.IsNull() {
+	if !data.FailoverAliases.IsNull() && !data.FailoverAliases.IsUnknown() {
 		var failover_aliasesList []string
 		data.FailoverAliases.ElementsAs(ctx, &failover_aliasesList, false)
 		var failover_aliasesObjs []map[string]interface{}
@@ -454,7 +538,7 @@ func (r *InterfaceResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		params["failover_aliases"] = failover_aliasesObjs
 	}
-	if !data.FailoverVirtualAliases.IsNull() {
+	if !data.FailoverVirtualAliases.IsNull() && !data.FailoverVirtualAliases.IsUnknown() {
 		var failover_virtual_aliasesList []string
 		data.FailoverVirtualAliases.ElementsAs(ctx, &failover_virtual_aliasesList, false)
 		var failover_virtual_aliasesObjs []map[string]interface{}
@@ -468,51 +552,53 @@ func (r *InterfaceResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		params["failover_virtual_aliases"] = failover_virtual_aliasesObjs
 	}
-	if !data.BridgeMembers.IsNull() {
+	if !data.BridgeMembers.IsNull() && !data.BridgeMembers.IsUnknown() {
 		var bridge_membersList []string
 		data.BridgeMembers.ElementsAs(ctx, &bridge_membersList, false)
 		params["bridge_members"] = bridge_membersList
 	}
-	if !data.EnableLearning.IsNull() {
+	if !data.EnableLearning.IsNull() && !data.EnableLearning.IsUnknown() {
 		params["enable_learning"] = data.EnableLearning.ValueBool()
 	}
-	if !data.Stp.IsNull() {
+	if !data.Stp.IsNull() && !data.Stp.IsUnknown() {
 		params["stp"] = data.Stp.ValueBool()
 	}
-	if !data.LagProtocol.IsNull() {
+	if !data.LagProtocol.IsNull() && !data.LagProtocol.IsUnknown() {
 		params["lag_protocol"] = data.LagProtocol.ValueString()
 	}
-	if !data.XmitHashPolicy.IsNull() {
+	if !data.XmitHashPolicy.IsNull() && !data.XmitHashPolicy.IsUnknown() {
 		params["xmit_hash_policy"] = data.XmitHashPolicy.ValueString()
 	}
-	if !data.LacpduRate.IsNull() {
+	if !data.LacpduRate.IsNull() && !data.LacpduRate.IsUnknown() {
 		params["lacpdu_rate"] = data.LacpduRate.ValueString()
 	}
-	if !data.LagPorts.IsNull() {
+	if !data.LagPorts.IsNull() && !data.LagPorts.IsUnknown() {
 		var lag_portsList []string
 		data.LagPorts.ElementsAs(ctx, &lag_portsList, false)
 		params["lag_ports"] = lag_portsList
 	}
-	if !data.VlanParentInterface.IsNull() {
+	if !data.VlanParentInterface.IsNull() && !data.VlanParentInterface.IsUnknown() {
 		params["vlan_parent_interface"] = data.VlanParentInterface.ValueString()
 	}
-	if !data.VlanTag.IsNull() {
+	if !data.VlanTag.IsNull() && !data.VlanTag.IsUnknown() {
 		params["vlan_tag"] = data.VlanTag.ValueInt64()
 	}
-	if !data.VlanPcp.IsNull() {
+	if !data.VlanPcp.IsNull() && !data.VlanPcp.IsUnknown() {
 		params["vlan_pcp"] = data.VlanPcp.ValueInt64()
 	}
-	if !data.Mtu.IsNull() {
+	if !data.Mtu.IsNull() && !data.Mtu.IsUnknown() {
 		params["mtu"] = data.Mtu.ValueInt64()
 	}
 
-	_, err = r.client.Call("interface.update", []interface{}{id, params})
+	result, err := r.client.Call("interface.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update interface: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, interfaceIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -533,3 +619,292 @@ func (r *InterfaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *InterfaceResource) setComputed(result interface{}, data *InterfaceResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Name.IsUnknown() {
+		data.Name = types.StringNull()
+		if v, ok := resultMap["name"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Name = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Name = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Description.IsUnknown() {
+		data.Description = types.StringNull()
+		if v, ok := resultMap["description"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Description = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Description = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Type.IsUnknown() {
+		data.Type = types.StringNull()
+		if v, ok := resultMap["type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Type = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Type = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Type = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Ipv4Dhcp.IsUnknown() {
+		data.Ipv4Dhcp = types.BoolNull()
+		if v, ok := resultMap["ipv4_dhcp"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Ipv4Dhcp = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Ipv6Auto.IsUnknown() {
+		data.Ipv6Auto = types.BoolNull()
+		if v, ok := resultMap["ipv6_auto"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Ipv6Auto = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Aliases.IsUnknown() {
+		data.Aliases = types.ListNull(types.StringType)
+		if v, ok := resultMap["aliases"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					b, _ := json.Marshal(item)
+					strVals[i] = types.StringValue(string(b))
+				}
+				data.Aliases, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.FailoverCritical.IsUnknown() {
+		data.FailoverCritical = types.BoolNull()
+		if v, ok := resultMap["failover_critical"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.FailoverCritical = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.FailoverGroup.IsUnknown() {
+		data.FailoverGroup = types.Int64Null()
+		if v, ok := resultMap["failover_group"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.FailoverGroup = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.FailoverGroup = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.FailoverVhid.IsUnknown() {
+		data.FailoverVhid = types.Int64Null()
+		if v, ok := resultMap["failover_vhid"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.FailoverVhid = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.FailoverVhid = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.FailoverAliases.IsUnknown() {
+		data.FailoverAliases = types.ListNull(types.StringType)
+		if v, ok := resultMap["failover_aliases"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					b, _ := json.Marshal(item)
+					strVals[i] = types.StringValue(string(b))
+				}
+				data.FailoverAliases, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.FailoverVirtualAliases.IsUnknown() {
+		data.FailoverVirtualAliases = types.ListNull(types.StringType)
+		if v, ok := resultMap["failover_virtual_aliases"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					b, _ := json.Marshal(item)
+					strVals[i] = types.StringValue(string(b))
+				}
+				data.FailoverVirtualAliases, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.BridgeMembers.IsUnknown() {
+		data.BridgeMembers = types.ListNull(types.StringType)
+		if v, ok := resultMap["bridge_members"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.BridgeMembers, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.EnableLearning.IsUnknown() {
+		data.EnableLearning = types.BoolNull()
+		if v, ok := resultMap["enable_learning"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.EnableLearning = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Stp.IsUnknown() {
+		data.Stp = types.BoolNull()
+		if v, ok := resultMap["stp"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Stp = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.LagProtocol.IsUnknown() {
+		data.LagProtocol = types.StringNull()
+		if v, ok := resultMap["lag_protocol"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.LagProtocol = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.LagProtocol = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.LagProtocol = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.XmitHashPolicy.IsUnknown() {
+		data.XmitHashPolicy = types.StringNull()
+		if v, ok := resultMap["xmit_hash_policy"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.XmitHashPolicy = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.XmitHashPolicy = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.XmitHashPolicy = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.LacpduRate.IsUnknown() {
+		data.LacpduRate = types.StringNull()
+		if v, ok := resultMap["lacpdu_rate"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.LacpduRate = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.LacpduRate = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.LacpduRate = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.LagPorts.IsUnknown() {
+		data.LagPorts = types.ListNull(types.StringType)
+		if v, ok := resultMap["lag_ports"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.LagPorts, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.VlanParentInterface.IsUnknown() {
+		data.VlanParentInterface = types.StringNull()
+		if v, ok := resultMap["vlan_parent_interface"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.VlanParentInterface = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.VlanParentInterface = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.VlanParentInterface = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.VlanTag.IsUnknown() {
+		data.VlanTag = types.Int64Null()
+		if v, ok := resultMap["vlan_tag"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.VlanTag = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.VlanTag = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.VlanPcp.IsUnknown() {
+		data.VlanPcp = types.Int64Null()
+		if v, ok := resultMap["vlan_pcp"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.VlanPcp = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.VlanPcp = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Mtu.IsUnknown() {
+		data.Mtu = types.Int64Null()
+		if v, ok := resultMap["mtu"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Mtu = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Mtu = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -35,11 +37,25 @@ func (r *IscsiAuthResource) Metadata(ctx context.Context, req resource.MetadataR
 }
 
 func (r *IscsiAuthResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "iscsi.auth.query", iscsiAuthIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &IscsiAuthResource{}
+
+// iscsiAuthIdentity lists the API fields that identify a iscsi_auth
+var iscsiAuthIdentity = []identityKey{{Name: "id"}}
+
+func (r *IscsiAuthResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(iscsiAuthIdentity)
+}
+
+func (r *IscsiAuthResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *IscsiAuthResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an iSCSI Authorized Access.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -61,17 +77,24 @@ func (r *IscsiAuthResource) Schema(ctx context.Context, req resource.SchemaReque
 			"peeruser": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Username for mutual CHAP authentication or empty string if not configured.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"peersecret": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Password/secret for mutual CHAP authentication or empty string if not configured.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"discovery_auth": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Authentication method for target discovery. If \"CHAP_MUTUAL\" is selected for target discovery, it is",
+				Default:     stringdefault.StaticString("NONE"),
+				Validators:  []validator.String{stringvalidator.OneOf("NONE", "CHAP", "CHAP_MUTUAL")},
 			},
 		},
 	}
@@ -97,22 +120,22 @@ func (r *IscsiAuthResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Tag.IsNull() {
+	if !data.Tag.IsNull() && !data.Tag.IsUnknown() {
 		params["tag"] = data.Tag.ValueInt64()
 	}
-	if !data.User.IsNull() {
+	if !data.User.IsNull() && !data.User.IsUnknown() {
 		params["user"] = data.User.ValueString()
 	}
-	if !data.Secret.IsNull() {
+	if !data.Secret.IsNull() && !data.Secret.IsUnknown() {
 		params["secret"] = data.Secret.ValueString()
 	}
-	if !data.Peeruser.IsNull() {
+	if !data.Peeruser.IsNull() && !data.Peeruser.IsUnknown() {
 		params["peeruser"] = data.Peeruser.ValueString()
 	}
-	if !data.Peersecret.IsNull() {
+	if !data.Peersecret.IsNull() && !data.Peersecret.IsUnknown() {
 		params["peersecret"] = data.Peersecret.ValueString()
 	}
-	if !data.DiscoveryAuth.IsNull() {
+	if !data.DiscoveryAuth.IsNull() && !data.DiscoveryAuth.IsUnknown() {
 		params["discovery_auth"] = data.DiscoveryAuth.ValueString()
 	}
 
@@ -134,6 +157,8 @@ func (r *IscsiAuthResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiAuthIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -210,6 +235,7 @@ func (r *IscsiAuthResource) Read(ctx context.Context, req resource.ReadRequest,
 			data.Secret = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiAuthIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -236,32 +262,34 @@ func (r *IscsiAuthResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Tag.IsNull() {
+	if !data.Tag.IsNull() && !data.Tag.IsUnknown() {
 		params["tag"] = data.Tag.ValueInt64()
 	}
-	if !data.User.IsNull() {
+	if !data.User.IsNull() && !data.User.IsUnknown() {
 		params["user"] = data.User.ValueString()
 	}
-	if !data.Secret.IsNull() {
+	if !data.Secret.IsNull() && !data.Secret.IsUnknown() {
 		params["secret"] = data.Secret.ValueString()
 	}
-	if !data.Peeruser.IsNull() {
+	if !data.Peeruser.IsNull() && !data.Peeruser.IsUnknown() {
 		params["peeruser"] = data.Peeruser.ValueString()
 	}
-	if !data.Peersecret.IsNull() {
+	if !data.Peersecret.IsNull() && !data.Peersecret.IsUnknown() {
 		params["peersecret"] = data.Peersecret.ValueString()
 	}
-	if !data.DiscoveryAuth.IsNull() {
+	if !data.DiscoveryAuth.IsNull() && !data.DiscoveryAuth.IsUnknown() {
 		params["discovery_auth"] = data.DiscoveryAuth.ValueString()
 	}
 
-	_, err = r.client.Call("iscsi.auth.update", []interface{}{id, params})
+	result, err := r.client.Call("iscsi.auth.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update iscsi_auth: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiAuthIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -286,3 +314,54 @@ func (r *IscsiAuthResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *IscsiAuthResource) setComputed(result interface{}, data *IscsiAuthResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Peeruser.IsUnknown() {
+		data.Peeruser = types.StringNull()
+		if v, ok := resultMap["peeruser"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Peeruser = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Peeruser = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Peeruser = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Peersecret.IsUnknown() {
+		data.Peersecret = types.StringNull()
+		if v, ok := resultMap["peersecret"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Peersecret = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Peersecret = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Peersecret = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.DiscoveryAuth.IsUnknown() {
+		data.DiscoveryAuth = types.StringNull()
+		if v, ok := resultMap["discovery_auth"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.DiscoveryAuth = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.DiscoveryAuth = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.DiscoveryAuth = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
@@ -4,9 +4,17 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -42,14 +50,30 @@ func NewIscsiExtentResource() resource.Resource {
 
 func (r *IscsiExtentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_iscsi_extent"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *IscsiExtentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "iscsi.extent.query", iscsiExtentIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &IscsiExtentResource{}
+
+// iscsiExtentIdentity lists the API fields that identify a iscsi_extent
+var iscsiExtentIdentity = []identityKey{{Name: "name"}}
+
+func (r *IscsiExtentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(iscsiExtentIdentity)
+}
+
+func (r *IscsiExtentResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *IscsiExtentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an iSCSI Extent.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -61,77 +85,109 @@ func (r *IscsiExtentResource) Schema(ctx context.Context, req resource.SchemaReq
 			"type": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Type of the extent storage backend.",
+				Default:     stringdefault.StaticString("DISK"),
+				Validators:  []validator.String{stringvalidator.OneOf("DISK", "FILE")},
 			},
 			"disk": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Disk device to use for the extent or `null` if using a file.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Disk device to use for the extent or `null` if using a file.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"serial": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "Serial number for the extent or `null` to auto-generate.",
+				Optional:      true,
+				Computed:      true,
+				Description:   "Serial number for the extent or `null` to auto-generate.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"path": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "File path for file-based extents or `null` if using a disk.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "File path for file-based extents or `null` if using a disk.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"filesize": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Size of the file-based extent in bytes.",
+				Default:     int64default.StaticInt64(0),
 			},
 			"blocksize": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Block size for the extent in bytes.",
+				Default:     int64default.StaticInt64(512),
+				Validators:  []validator.Int64{int64validator.OneOf(512, 1024, 2048, 4096)},
 			},
 			"pblocksize": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to use physical block size reporting.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"avail_threshold": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Available space threshold percentage or `null` to disable.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Available space threshold percentage or `null` to disable.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"comment": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Optional comment describing the extent.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"insecure_tpc": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to enable insecure Third Party Copy (TPC) operations.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"xen": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to enable Xen compatibility mode.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"rpm": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Reported RPM type for the extent.",
+				Default:     stringdefault.StaticString("SSD"),
+				Validators:  []validator.String{stringvalidator.OneOf("UNKNOWN", "SSD", "5400", "7200", "10000", "15000")},
 			},
 			"ro": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether the extent is read-only.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether the extent is enabled and available for use.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"product_id": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Product ID string for the extent or `null` for default.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Product ID string for the extent or `null` for default.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
 	}
@@ -157,52 +213,52 @@ func (r *IscsiExtentResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Type.IsNull() {
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
 		params["type"] = data.Type.ValueString()
 	}
-	if !data.Disk.IsNull() {
+	if !data.Disk.IsNull() && !data.Disk.IsUnknown() {
 		params["disk"] = data.Disk.ValueString()
 	}
-	if !data.Serial.IsNull() {
+	if !data.Serial.IsNull() && !data.Serial.IsUnknown() {
 		params["serial"] = data.Serial.ValueString()
 	}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.Filesize.IsNull() {
+	if !data.Filesize.IsNull() && !data.Filesize.IsUnknown() {
 		params["filesize"] = data.Filesize.ValueInt64()
 	}
-	if !data.Blocksize.IsNull() {
+	if !data.Blocksize.IsNull() && !data.Blocksize.IsUnknown() {
 		params["blocksize"] = data.Blocksize.ValueInt64()
 	}
-	if !data.Pblocksize.IsNull() {
+	if !data.Pblocksize.IsNull() && !data.Pblocksize.IsUnknown() {
 		params["pblocksize"] = data.Pblocksize.ValueBool()
 	}
-	if !data.AvailThreshold.IsNull() {
+	if !data.AvailThreshold.IsNull() && !data.AvailThreshold.IsUnknown() {
 		params["avail_threshold"] = data.AvailThreshold.ValueInt64()
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
-	if !data.InsecureTpc.IsNull() {
+	if !data.InsecureTpc.IsNull() && !data.InsecureTpc.IsUnknown() {
 		params["insecure_tpc"] = data.InsecureTpc.ValueBool()
 	}
-	if !data.Xen.IsNull() {
+	if !data.Xen.IsNull() && !data.Xen.IsUnknown() {
 		params["xen"] = data.Xen.ValueBool()
 	}
-	if !data.Rpm.IsNull() {
+	if !data.Rpm.IsNull() && !data.Rpm.IsUnknown() {
 		params["rpm"] = data.Rpm.ValueString()
 	}
-	if !data.Ro.IsNull() {
+	if !data.Ro.IsNull() && !data.Ro.IsUnknown() {
 		params["ro"] = data.Ro.ValueBool()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.ProductId.IsNull() {
+	if !data.ProductId.IsNull() && !data.ProductId.IsUnknown() {
 		params["product_id"] = data.ProductId.ValueString()
 	}
 
@@ -224,6 +280,8 @@ func (r *IscsiExtentResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiExtentIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -288,6 +346,7 @@ func (r *IscsiExtentResource) Read(ctx context.Context, req resource.ReadRequest
 			data.Type = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiExtentIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -314,62 +373,64 @@ func (r *IscsiExtentResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Type.IsNull() {
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
 		params["type"] = data.Type.ValueString()
 	}
-	if !data.Disk.IsNull() {
+	if !data.Disk.IsNull() && !data.Disk.IsUnknown() {
 		params["disk"] = data.Disk.ValueString()
 	}
-	if !data.Serial.IsNull() {
+	if !data.Serial.IsNull() && !data.Serial.IsUnknown() {
 		params["serial"] = data.Serial.ValueString()
 	}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.Filesize.IsNull() {
+	if !data.Filesize.IsNull() && !data.Filesize.IsUnknown() {
 		params["filesize"] = data.Filesize.ValueInt64()
 	}
-	if !data.Blocksize.IsNull() {
+	if !data.Blocksize.IsNull() && !data.Blocksize.IsUnknown() {
 		params["blocksize"] = data.Blocksize.ValueInt64()
 	}
-	if !data.Pblocksize.IsNull() {
+	if !data.Pblocksize.IsNull() && !data.Pblocksize.IsUnknown() {
 		params["pblocksize"] = data.Pblocksize.ValueBool()
 	}
-	if !data.AvailThreshold.IsNull() {
+	if !data.AvailThreshold.IsNull() && !data.AvailThreshold.IsUnknown() {
 		params["avail_threshold"] = data.AvailThreshold.ValueInt64()
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
-	if !data.InsecureTpc.IsNull() {
+	if !data.InsecureTpc.IsNull() && !data.InsecureTpc.IsUnknown() {
 		params["insecure_tpc"] = data.InsecureTpc.ValueBool()
 	}
-	if !data.Xen.IsNull() {
+	if !data.Xen.IsNull() && !data.Xen.IsUnknown() {
 		params["xen"] = data.Xen.ValueBool()
 	}
-	if !data.Rpm.IsNull() {
+	if !data.Rpm.IsNull() && !data.Rpm.IsUnknown() {
 		params["rpm"] = data.Rpm.ValueString()
 	}
-	if !data.Ro.IsNull() {
+	if !data.Ro.IsNull() && !data.Ro.IsUnknown() {
 		params["ro"] = data.Ro.ValueBool()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.ProductId.IsNull() {
+	if !data.ProductId.IsNull() && !data.ProductId.IsUnknown() {
 		params["product_id"] = data.ProductId.ValueString()
 	}
 
-	_, err = r.client.Call("iscsi.extent.update", []interface{}{id, params})
+	result, err := r.client.Call("iscsi.extent.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update iscsi_extent: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiExtentIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -395,3 +456,199 @@ func (r *IscsiExtentResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *IscsiExtentResource) setComputed(result interface{}, data *IscsiExtentResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Type.IsUnknown() {
+		data.Type = types.StringNull()
+		if v, ok := resultMap["type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Type = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Type = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Type = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Disk.IsUnknown() {
+		data.Disk = types.StringNull()
+		if v, ok := resultMap["disk"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Disk = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Disk = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Disk = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Serial.IsUnknown() {
+		data.Serial = types.StringNull()
+		if v, ok := resultMap["serial"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Serial = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Serial = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Serial = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Path.IsUnknown() {
+		data.Path = types.StringNull()
+		if v, ok := resultMap["path"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Path = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Path = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Path = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Filesize.IsUnknown() {
+		data.Filesize = types.Int64Null()
+		if v, ok := resultMap["filesize"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Filesize = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Filesize = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Blocksize.IsUnknown() {
+		data.Blocksize = types.Int64Null()
+		if v, ok := resultMap["blocksize"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Blocksize = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Blocksize = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Pblocksize.IsUnknown() {
+		data.Pblocksize = types.BoolNull()
+		if v, ok := resultMap["pblocksize"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Pblocksize = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.AvailThreshold.IsUnknown() {
+		data.AvailThreshold = types.Int64Null()
+		if v, ok := resultMap["avail_threshold"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.AvailThreshold = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.AvailThreshold = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Comment.IsUnknown() {
+		data.Comment = types.StringNull()
+		if v, ok := resultMap["comment"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Comment = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.InsecureTpc.IsUnknown() {
+		data.InsecureTpc = types.BoolNull()
+		if v, ok := resultMap["insecure_tpc"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.InsecureTpc = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Xen.IsUnknown() {
+		data.Xen = types.BoolNull()
+		if v, ok := resultMap["xen"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Xen = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Rpm.IsUnknown() {
+		data.Rpm = types.StringNull()
+		if v, ok := resultMap["rpm"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Rpm = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Rpm = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Rpm = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Ro.IsUnknown() {
+		data.Ro = types.BoolNull()
+		if v, ok := resultMap["ro"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Ro = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.ProductId.IsUnknown() {
+		data.ProductId = types.StringNull()
+		if v, ok := resultMap["product_id"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.ProductId = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.ProductId = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.ProductId = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
@@ -0,0 +1,419 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IscsiGlobalConfigResource manages the iscsi.global settings. There is exactly one
+// instance, so create and update both call iscsi.global.update.
+type IscsiGlobalConfigResource struct {
+	client *client.Client
+}
+
+type IscsiGlobalConfigResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Basename           types.String `tfsdk:"basename"`
+	IsnsServers        types.List   `tfsdk:"isns_servers"`
+	ListenPort         types.Int64  `tfsdk:"listen_port"`
+	PoolAvailThreshold types.Int64  `tfsdk:"pool_avail_threshold"`
+	Alua               types.Bool   `tfsdk:"alua"`
+	Iser               types.Bool   `tfsdk:"iser"`
+	RestoreOnDestroy   types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewIscsiGlobalConfigResource() resource.Resource {
+	return &IscsiGlobalConfigResource{}
+}
+
+func (r *IscsiGlobalConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iscsi_global_config"
+}
+
+func (r *IscsiGlobalConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *IscsiGlobalConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *IscsiGlobalConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update iSCSI Global Configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"basename": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Base name prepended to target names, e.g. `iqn.2005-10.org.freenas.ctl`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"isns_servers": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "iSNS servers to register targets with (host or host:port).",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"listen_port": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "TCP port iSCSI portals listen on.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(1025, 65535)},
+			},
+			"pool_avail_threshold": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Alert when a pool used by extents has less than this percentage free.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"alua": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable Asymmetric Logical Unit Access (HA systems only).",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"iser": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable iSCSI Extensions for RDMA.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *IscsiGlobalConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *IscsiGlobalConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IscsiGlobalConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("iscsi.global.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read iscsi_global_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Basename.IsNull() && !data.Basename.IsUnknown() {
+		params["basename"] = data.Basename.ValueString()
+	}
+	if !data.IsnsServers.IsNull() && !data.IsnsServers.IsUnknown() {
+		var isns_serversList []string
+		data.IsnsServers.ElementsAs(ctx, &isns_serversList, false)
+		params["isns_servers"] = isns_serversList
+	}
+	if !data.ListenPort.IsNull() && !data.ListenPort.IsUnknown() {
+		params["listen_port"] = data.ListenPort.ValueInt64()
+	}
+	if !data.PoolAvailThreshold.IsNull() && !data.PoolAvailThreshold.IsUnknown() {
+		params["pool_avail_threshold"] = data.PoolAvailThreshold.ValueInt64()
+	}
+	if !data.Alua.IsNull() && !data.Alua.IsUnknown() {
+		params["alua"] = data.Alua.ValueBool()
+	}
+	if !data.Iser.IsNull() && !data.Iser.IsUnknown() {
+		params["iser"] = data.Iser.ValueBool()
+	}
+
+	result, err := r.client.Call("iscsi.global.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update iscsi_global_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("iscsi.global")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IscsiGlobalConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IscsiGlobalConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("iscsi.global.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read iscsi_global_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["basename"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Basename = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Basename = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Basename = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["isns_servers"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.IsnsServers, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["listen_port"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.ListenPort = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.ListenPort = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["pool_avail_threshold"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.PoolAvailThreshold = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.PoolAvailThreshold = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["alua"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Alua = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["iser"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Iser = types.BoolValue(bv)
+		}
+	}
+
+	data.ID = types.StringValue("iscsi.global")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IscsiGlobalConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IscsiGlobalConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Basename.IsNull() && !data.Basename.IsUnknown() {
+		params["basename"] = data.Basename.ValueString()
+	}
+	if !data.IsnsServers.IsNull() && !data.IsnsServers.IsUnknown() {
+		var isns_serversList []string
+		data.IsnsServers.ElementsAs(ctx, &isns_serversList, false)
+		params["isns_servers"] = isns_serversList
+	}
+	if !data.ListenPort.IsNull() && !data.ListenPort.IsUnknown() {
+		params["listen_port"] = data.ListenPort.ValueInt64()
+	}
+	if !data.PoolAvailThreshold.IsNull() && !data.PoolAvailThreshold.IsUnknown() {
+		params["pool_avail_threshold"] = data.PoolAvailThreshold.ValueInt64()
+	}
+	if !data.Alua.IsNull() && !data.Alua.IsUnknown() {
+		params["alua"] = data.Alua.ValueBool()
+	}
+	if !data.Iser.IsNull() && !data.Iser.IsUnknown() {
+		params["iser"] = data.Iser.ValueBool()
+	}
+
+	result, err := r.client.Call("iscsi.global.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update iscsi_global_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("iscsi.global")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IscsiGlobalConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IscsiGlobalConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when iscsi_global_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"basename", "isns_servers", "listen_port", "pool_avail_threshold", "alua", "iser"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.Call("iscsi.global.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore iscsi_global_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *IscsiGlobalConfigResource) setComputed(result interface{}, data *IscsiGlobalConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Basename.IsUnknown() {
+		data.Basename = types.StringNull()
+		if v, ok := resultMap["basename"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Basename = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Basename = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Basename = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.IsnsServers.IsUnknown() {
+		data.IsnsServers = types.ListNull(types.StringType)
+		if v, ok := resultMap["isns_servers"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.IsnsServers, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.ListenPort.IsUnknown() {
+		data.ListenPort = types.Int64Null()
+		if v, ok := resultMap["listen_port"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.ListenPort = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.ListenPort = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.PoolAvailThreshold.IsUnknown() {
+		data.PoolAvailThreshold = types.Int64Null()
+		if v, ok := resultMap["pool_avail_threshold"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.PoolAvailThreshold = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.PoolAvailThreshold = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Alua.IsUnknown() {
+		data.Alua = types.BoolNull()
+		if v, ok := resultMap["alua"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Alua = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Iser.IsUnknown() {
+		data.Iser = types.BoolNull()
+		if v, ok := resultMap["iser"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Iser = types.BoolValue(bv)
+			}
+		}
+	}
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -31,11 +31,25 @@ func (r *IscsiInitiatorResource) Metadata(ctx context.Context, req resource.Meta
 }
 
 func (r *IscsiInitiatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "iscsi.initiator.query", iscsiInitiatorIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &IscsiInitiatorResource{}
+
+// iscsiInitiatorIdentity lists the API fields that identify a iscsi_initiator
+var iscsiInitiatorIdentity = []identityKey{{Name: "id"}}
+
+func (r *IscsiInitiatorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(iscsiInitiatorIdentity)
+}
+
+func (r *IscsiInitiatorResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *IscsiInitiatorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an iSCSI Initiator.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Required: true, Description: "Resource ID"},
@@ -47,6 +61,7 @@ func (r *IscsiInitiatorResource) Schema(ctx context.Context, req resource.Schema
 			"comment": schema.StringAttribute{
 				Computed:    true,
 				Description: "Optional comment describing the authorized initiator group.",
+				Default:     stringdefault.StaticString(""),
 			},
 		},
 	}
@@ -72,12 +87,12 @@ func (r *IscsiInitiatorResource) Create(ctx context.Context, req resource.Create
 	}
 
 	params := map[string]interface{}{}
-	if !data.Initiators.IsNull() {
+	if !data.Initiators.IsNull() && !data.Initiators.IsUnknown() {
 		var initiatorsList []string
 		data.Initiators.ElementsAs(ctx, &initiatorsList, false)
 		params["initiators"] = initiatorsList
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
 
@@ -99,6 +114,8 @@ func (r *IscsiInitiatorResource) Create(ctx context.Context, req resource.Create
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiInitiatorIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -139,6 +156,7 @@ func (r *IscsiInitiatorResource) Read(ctx context.Context, req resource.ReadRequ
 	if v, ok := resultMap["id"]; ok && v != nil {
 		data.ID = types.StringValue(fmt.Sprintf("%v", v))
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiInitiatorIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -165,22 +183,24 @@ func (r *IscsiInitiatorResource) Update(ctx context.Context, req resource.Update
 	}
 
 	params := map[string]interface{}{}
-	if !data.Initiators.IsNull() {
+	if !data.Initiators.IsNull() && !data.Initiators.IsUnknown() {
 		var initiatorsList []string
 		data.Initiators.ElementsAs(ctx, &initiatorsList, false)
 		params["initiators"] = initiatorsList
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
 
-	_, err = r.client.Call("iscsi.initiator.update", []interface{}{id, params})
+	result, err := r.client.Call("iscsi.initiator.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update iscsi_initiator: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiInitiatorIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -205,3 +225,24 @@ func (r *IscsiInitiatorResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *IscsiInitiatorResource) setComputed(result interface{}, data *IscsiInitiatorResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Comment.IsUnknown() {
+		data.Comment = types.StringNull()
+		if v, ok := resultMap["comment"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Comment = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
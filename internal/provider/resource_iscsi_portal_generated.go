@@ -6,9 +6,9 @@ import (
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -33,11 +33,25 @@ func (r *IscsiPortalResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 func (r *IscsiPortalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "iscsi.portal.query", iscsiPortalIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &IscsiPortalResource{}
+
+// iscsiPortalIdentity lists the API fields that identify a iscsi_portal
+var iscsiPortalIdentity = []identityKey{{Name: "id"}}
+
+func (r *IscsiPortalResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(iscsiPortalIdentity)
+}
+
+func (r *IscsiPortalResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *IscsiPortalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a new iSCSI Portal.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -50,7 +64,9 @@ func (r *IscsiPortalResource) Schema(ctx context.Context, req resource.SchemaReq
 			"comment": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Optional comment describing the portal.",
+				Default:     stringdefault.StaticString(""),
 			},
 		},
 	}
@@ -76,7 +92,7 @@ func (r *IscsiPortalResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Listen.IsNull() {
+	if !data.Listen.IsNull() && !data.Listen.IsUnknown() {
 		var listenList []string
 		data.Listen.ElementsAs(ctx, &listenList, false)
 		var listenObjs []map[string]interface{}
@@ -90,7 +106,7 @@ func (r *IscsiPortalResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["listen"] = listenObjs
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
 
@@ -112,6 +128,8 @@ func (r *IscsiPortalResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiPortalIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -156,11 +174,13 @@ func (r *IscsiPortalResource) Read(ctx context.Context, req resource.ReadRequest
 		if arr, ok := v.([]interface{}); ok {
 			strVals := make([]attr.Value, len(arr))
 			for i, item := range arr {
-				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				b, _ := json.Marshal(item)
+				strVals[i] = types.StringValue(string(b))
 			}
 			data.Listen, _ = types.ListValue(types.StringType, strVals)
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiPortalIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -187,7 +207,7 @@ func (r *IscsiPortalResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Listen.IsNull() {
+	if !data.Listen.IsNull() && !data.Listen.IsUnknown() {
 		var listenList []string
 		data.Listen.ElementsAs(ctx, &listenList, false)
 		var listenObjs []map[string]interface{}
@@ -201,17 +221,19 @@ func (r *IscsiPortalResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		params["listen"] = listenObjs
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
 
-	_, err = r.client.Call("iscsi.portal.update", []interface{}{id, params})
+	result, err := r.client.Call("iscsi.portal.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update iscsi_portal: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiPortalIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -236,3 +258,24 @@ func (r *IscsiPortalResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *IscsiPortalResource) setComputed(result interface{}, data *IscsiPortalResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Comment.IsUnknown() {
+		data.Comment = types.StringNull()
+		if v, ok := resultMap["comment"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Comment = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
@@ -5,9 +5,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -33,14 +40,30 @@ func NewIscsiTargetResource() resource.Resource {
 
 func (r *IscsiTargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_iscsi_target"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *IscsiTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "iscsi.target.query", iscsiTargetIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &IscsiTargetResource{}
+
+// iscsiTargetIdentity lists the API fields that identify a iscsi_target
+var iscsiTargetIdentity = []identityKey{{Name: "name"}}
+
+func (r *IscsiTargetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(iscsiTargetIdentity)
+}
+
+func (r *IscsiTargetResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *IscsiTargetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an iSCSI Target.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -48,33 +71,45 @@ func (r *IscsiTargetResource) Schema(ctx context.Context, req resource.SchemaReq
 				Required:    true,
 				Optional:    false,
 				Description: "Name of the iSCSI target (maximum 120 characters).",
+				Validators:  []validator.String{stringvalidator.LengthAtMost(120)},
 			},
 			"alias": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Optional alias name for the iSCSI target.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Optional alias name for the iSCSI target.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"mode": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Protocol mode for the target.  * `ISCSI`: iSCSI protocol only * `FC`: Fibre Channel protocol only * ",
+				Default:     stringdefault.StaticString("ISCSI"),
+				Validators:  []validator.String{stringvalidator.OneOf("ISCSI", "FC", "BOTH")},
 			},
 			"groups": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Array of portal-initiator group associations for this target.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Array of portal-initiator group associations for this target.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"auth_networks": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "Array of network addresses allowed to access this target.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"iscsi_parameters": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Optional iSCSI-specific parameters for this target.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Optional iSCSI-specific parameters for this target.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
 	}
@@ -100,16 +135,16 @@ func (r *IscsiTargetResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Alias.IsNull() {
+	if !data.Alias.IsNull() && !data.Alias.IsUnknown() {
 		params["alias"] = data.Alias.ValueString()
 	}
-	if !data.Mode.IsNull() {
+	if !data.Mode.IsNull() && !data.Mode.IsUnknown() {
 		params["mode"] = data.Mode.ValueString()
 	}
-	if !data.Groups.IsNull() {
+	if !data.Groups.IsNull() && !data.Groups.IsUnknown() {
 		var groupsList []string
 		data.Groups.ElementsAs(ctx, &groupsList, false)
 		var groupsObjs []map[string]interface{}
@@ -123,12 +158,12 @@ func (r *IscsiTargetResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["groups"] = groupsObjs
 	}
-	if !data.AuthNetworks.IsNull() {
+	if !data.AuthNetworks.IsNull() && !data.AuthNetworks.IsUnknown() {
 		var auth_networksList []string
 		data.AuthNetworks.ElementsAs(ctx, &auth_networksList, false)
 		params["auth_networks"] = auth_networksList
 	}
-	if !data.IscsiParameters.IsNull() {
+	if !data.IscsiParameters.IsNull() && !data.IscsiParameters.IsUnknown() {
 		var iscsi_parametersObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.IscsiParameters.ValueString()), &iscsi_parametersObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse iscsi_parameters: %s", err))
@@ -155,6 +190,8 @@ func (r *IscsiTargetResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiTargetIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -207,6 +244,7 @@ func (r *IscsiTargetResource) Read(ctx context.Context, req resource.ReadRequest
 			data.Name = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiTargetIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -233,16 +271,16 @@ func (r *IscsiTargetResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Alias.IsNull() {
+	if !data.Alias.IsNull() && !data.Alias.IsUnknown() {
 		params["alias"] = data.Alias.ValueString()
 	}
-	if !data.Mode.IsNull() {
+	if !data.Mode.IsNull() && !data.Mode.IsUnknown() {
 		params["mode"] = data.Mode.ValueString()
 	}
-	if !data.Groups.IsNull() {
+	if !data.Groups.IsNull() && !data.Groups.IsUnknown() {
 		var groupsList []string
 		data.Groups.ElementsAs(ctx, &groupsList, false)
 		var groupsObjs []map[string]interface{}
@@ -256,12 +294,12 @@ func (r *IscsiTargetResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		params["groups"] = groupsObjs
 	}
-	if !data.AuthNetworks.IsNull() {
+	if !data.AuthNetworks.IsNull() && !data.AuthNetworks.IsUnknown() {
 		var auth_networksList []string
 		data.AuthNetworks.ElementsAs(ctx, &auth_networksList, false)
 		params["auth_networks"] = auth_networksList
 	}
-	if !data.IscsiParameters.IsNull() {
+	if !data.IscsiParameters.IsNull() && !data.IscsiParameters.IsUnknown() {
 		var iscsi_parametersObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.IscsiParameters.ValueString()), &iscsi_parametersObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse iscsi_parameters: %s", err))
@@ -270,13 +308,15 @@ func (r *IscsiTargetResource) Update(ctx context.Context, req resource.UpdateReq
 		params["iscsi_parameters"] = iscsi_parametersObj
 	}
 
-	_, err = r.client.Call("iscsi.target.update", []interface{}{id, params})
+	result, err := r.client.Call("iscsi.target.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update iscsi_target: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiTargetIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -302,3 +342,72 @@ func (r *IscsiTargetResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *IscsiTargetResource) setComputed(result interface{}, data *IscsiTargetResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Alias.IsUnknown() {
+		data.Alias = types.StringNull()
+		if v, ok := resultMap["alias"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Alias = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Alias = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Alias = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Mode.IsUnknown() {
+		data.Mode = types.StringNull()
+		if v, ok := resultMap["mode"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Mode = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Mode = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Mode = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Groups.IsUnknown() {
+		data.Groups = types.ListNull(types.StringType)
+		if v, ok := resultMap["groups"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					b, _ := json.Marshal(item)
+					strVals[i] = types.StringValue(string(b))
+				}
+				data.Groups, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.AuthNetworks.IsUnknown() {
+		data.AuthNetworks = types.ListNull(types.StringType)
+		if v, ok := resultMap["auth_networks"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.AuthNetworks, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.IscsiParameters.IsUnknown() {
+		data.IscsiParameters = types.StringNull()
+		if v, ok := resultMap["iscsi_parameters"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.IscsiParameters = types.StringValue(string(b))
+			}
+		}
+	}
+}
@@ -4,9 +4,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -29,14 +30,30 @@ func NewIscsiTargetextentResource() resource.Resource {
 
 func (r *IscsiTargetextentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_iscsi_targetextent"
+	// target, extent can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *IscsiTargetextentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "iscsi.targetextent.query", iscsiTargetextentIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &IscsiTargetextentResource{}
+
+// iscsiTargetextentIdentity lists the API fields that identify a iscsi_targetextent
+var iscsiTargetextentIdentity = []identityKey{{Name: "target", Int: true}, {Name: "extent", Int: true}}
+
+func (r *IscsiTargetextentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(iscsiTargetextentIdentity)
+}
+
+func (r *IscsiTargetextentResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *IscsiTargetextentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an Associated Target.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -46,9 +63,11 @@ func (r *IscsiTargetextentResource) Schema(ctx context.Context, req resource.Sch
 				Description: "ID of the iSCSI target to associate with the extent.",
 			},
 			"lunid": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Logical Unit Number (LUN) ID for presenting the extent to the target.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Logical Unit Number (LUN) ID for presenting the extent to the target.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"extent": schema.Int64Attribute{
 				Required:    true,
@@ -79,13 +98,13 @@ func (r *IscsiTargetextentResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	params := map[string]interface{}{}
-	if !data.Target.IsNull() {
+	if !data.Target.IsNull() && !data.Target.IsUnknown() {
 		params["target"] = data.Target.ValueInt64()
 	}
-	if !data.Lunid.IsNull() {
+	if !data.Lunid.IsNull() && !data.Lunid.IsUnknown() {
 		params["lunid"] = data.Lunid.ValueInt64()
 	}
-	if !data.Extent.IsNull() {
+	if !data.Extent.IsNull() && !data.Extent.IsUnknown() {
 		params["extent"] = data.Extent.ValueInt64()
 	}
 
@@ -107,6 +126,8 @@ func (r *IscsiTargetextentResource) Create(ctx context.Context, req resource.Cre
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiTargetextentIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -171,6 +192,7 @@ func (r *IscsiTargetextentResource) Read(ctx context.Context, req resource.ReadR
 			}
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiTargetextentIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -197,23 +219,25 @@ func (r *IscsiTargetextentResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	params := map[string]interface{}{}
-	if !data.Target.IsNull() {
+	if !data.Target.IsNull() && !data.Target.IsUnknown() {
 		params["target"] = data.Target.ValueInt64()
 	}
-	if !data.Lunid.IsNull() {
+	if !data.Lunid.IsNull() && !data.Lunid.IsUnknown() {
 		params["lunid"] = data.Lunid.ValueInt64()
 	}
-	if !data.Extent.IsNull() {
+	if !data.Extent.IsNull() && !data.Extent.IsUnknown() {
 		params["extent"] = data.Extent.ValueInt64()
 	}
 
-	_, err = r.client.Call("iscsi.targetextent.update", []interface{}{id, params})
+	result, err := r.client.Call("iscsi.targetextent.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update iscsi_targetextent: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, iscsiTargetextentIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -239,3 +263,24 @@ func (r *IscsiTargetextentResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *IscsiTargetextentResource) setComputed(result interface{}, data *IscsiTargetextentResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Lunid.IsUnknown() {
+		data.Lunid = types.Int64Null()
+		if v, ok := resultMap["lunid"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Lunid = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Lunid = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+}
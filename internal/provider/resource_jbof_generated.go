@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -37,15 +39,22 @@ func (r *JbofResource) ImportState(ctx context.Context, req resource.ImportState
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *JbofResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *JbofResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a new JBOF.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"description": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Optional description of the JBOF.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Optional description of the JBOF.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"mgmt_ip1": schema.StringAttribute{
 				Required:    true,
@@ -53,9 +62,11 @@ func (r *JbofResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Description: "IP of first Redfish management interface.",
 			},
 			"mgmt_ip2": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Optional IP of second Redfish management interface.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Optional IP of second Redfish management interface.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"mgmt_username": schema.StringAttribute{
 				Required:    true,
@@ -91,19 +102,19 @@ func (r *JbofResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	params := map[string]interface{}{}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.MgmtIp1.IsNull() {
+	if !data.MgmtIp1.IsNull() && !data.MgmtIp1.IsUnknown() {
 		params["mgmt_ip1"] = data.MgmtIp1.ValueString()
 	}
-	if !data.MgmtIp2.IsNull() {
+	if !data.MgmtIp2.IsNull() && !data.MgmtIp2.IsUnknown() {
 		params["mgmt_ip2"] = data.MgmtIp2.ValueString()
 	}
-	if !data.MgmtUsername.IsNull() {
+	if !data.MgmtUsername.IsNull() && !data.MgmtUsername.IsUnknown() {
 		params["mgmt_username"] = data.MgmtUsername.ValueString()
 	}
-	if !data.MgmtPassword.IsNull() {
+	if !data.MgmtPassword.IsNull() && !data.MgmtPassword.IsUnknown() {
 		params["mgmt_password"] = data.MgmtPassword.ValueString()
 	}
 
@@ -125,6 +136,7 @@ func (r *JbofResource) Create(ctx context.Context, req resource.CreateRequest, r
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -227,29 +239,30 @@ func (r *JbofResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	params := map[string]interface{}{}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.MgmtIp1.IsNull() {
+	if !data.MgmtIp1.IsNull() && !data.MgmtIp1.IsUnknown() {
 		params["mgmt_ip1"] = data.MgmtIp1.ValueString()
 	}
-	if !data.MgmtIp2.IsNull() {
+	if !data.MgmtIp2.IsNull() && !data.MgmtIp2.IsUnknown() {
 		params["mgmt_ip2"] = data.MgmtIp2.ValueString()
 	}
-	if !data.MgmtUsername.IsNull() {
+	if !data.MgmtUsername.IsNull() && !data.MgmtUsername.IsUnknown() {
 		params["mgmt_username"] = data.MgmtUsername.ValueString()
 	}
-	if !data.MgmtPassword.IsNull() {
+	if !data.MgmtPassword.IsNull() && !data.MgmtPassword.IsUnknown() {
 		params["mgmt_password"] = data.MgmtPassword.ValueString()
 	}
 
-	_, err = r.client.Call("jbof.update", []interface{}{id, params})
+	result, err := r.client.Call("jbof.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update jbof: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -275,3 +288,39 @@ func (r *JbofResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *JbofResource) setComputed(result interface{}, data *JbofResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Description.IsUnknown() {
+		data.Description = types.StringNull()
+		if v, ok := resultMap["description"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Description = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Description = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.MgmtIp2.IsUnknown() {
+		data.MgmtIp2 = types.StringNull()
+		if v, ok := resultMap["mgmt_ip2"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.MgmtIp2 = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.MgmtIp2 = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.MgmtIp2 = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
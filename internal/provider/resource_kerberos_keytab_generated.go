@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -28,14 +27,30 @@ func NewKerberosKeytabResource() resource.Resource {
 
 func (r *KerberosKeytabResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_kerberos_keytab"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *KerberosKeytabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "kerberos.keytab.query", kerberosKeytabIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &KerberosKeytabResource{}
+
+// kerberosKeytabIdentity lists the API fields that identify a kerberos_keytab
+var kerberosKeytabIdentity = []identityKey{{Name: "name"}}
+
+func (r *KerberosKeytabResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(kerberosKeytabIdentity)
+}
+
+func (r *KerberosKeytabResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *KerberosKeytabResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a kerberos keytab. Uploaded keytab files will be merged with the system",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -73,10 +88,10 @@ func (r *KerberosKeytabResource) Create(ctx context.Context, req resource.Create
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.File.IsNull() {
+	if !data.File.IsNull() && !data.File.IsUnknown() {
 		params["file"] = data.File.ValueString()
 	}
 
@@ -98,6 +113,8 @@ func (r *KerberosKeytabResource) Create(ctx context.Context, req resource.Create
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, kerberosKeytabIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -162,6 +179,7 @@ func (r *KerberosKeytabResource) Read(ctx context.Context, req resource.ReadRequ
 			data.File = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, kerberosKeytabIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -188,20 +206,22 @@ func (r *KerberosKeytabResource) Update(ctx context.Context, req resource.Update
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.File.IsNull() {
+	if !data.File.IsNull() && !data.File.IsUnknown() {
 		params["file"] = data.File.ValueString()
 	}
 
-	_, err = r.client.Call("kerberos.keytab.update", []interface{}{id, params})
+	result, err := r.client.Call("kerberos.keytab.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update kerberos_keytab: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, kerberosKeytabIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -226,3 +246,9 @@ func (r *KerberosKeytabResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *KerberosKeytabResource) setComputed(result interface{}, data *KerberosKeytabResourceModel) {
+	_ = result // No computed fields
+}
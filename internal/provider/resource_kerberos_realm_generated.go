@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -31,14 +34,30 @@ func NewKerberosRealmResource() resource.Resource {
 
 func (r *KerberosRealmResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_kerberos_realm"
+	// realm can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *KerberosRealmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "kerberos.realm.query", kerberosRealmIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &KerberosRealmResource{}
+
+// kerberosRealmIdentity lists the API fields that identify a kerberos_realm
+var kerberosRealmIdentity = []identityKey{{Name: "realm"}}
+
+func (r *KerberosRealmResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(kerberosRealmIdentity)
+}
+
+func (r *KerberosRealmResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *KerberosRealmResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a new kerberos realm. This will be automatically populated during the",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -48,27 +67,35 @@ func (r *KerberosRealmResource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "Kerberos realm name. This is external to TrueNAS and is case-sensitive.     The general convention f",
 			},
 			"primary_kdc": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "The master Kerberos domain controller for this realm. TrueNAS uses this as a fallback if it cannot g",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The master Kerberos domain controller for this realm. TrueNAS uses this as a fallback if it cannot g",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"kdc": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "List of kerberos domain controllers. If the list is empty then the kerberos     libraries will use D",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"admin_server": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "List of kerberos admin servers. If the list is empty then the kerberos     libraries will use DNS to",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"kpasswd_server": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "List of kerberos kpasswd servers. If the list is empty then DNS will be used     to look them up if ",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 		},
 	}
@@ -94,23 +121,23 @@ func (r *KerberosRealmResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	params := map[string]interface{}{}
-	if !data.Realm.IsNull() {
+	if !data.Realm.IsNull() && !data.Realm.IsUnknown() {
 		params["realm"] = data.Realm.ValueString()
 	}
-	if !data.PrimaryKdc.IsNull() {
+	if !data.PrimaryKdc.IsNull() && !data.PrimaryKdc.IsUnknown() {
 		params["primary_kdc"] = data.PrimaryKdc.ValueString()
 	}
-	if !data.Kdc.IsNull() {
+	if !data.Kdc.IsNull() && !data.Kdc.IsUnknown() {
 		var kdcList []string
 		data.Kdc.ElementsAs(ctx, &kdcList, false)
 		params["kdc"] = kdcList
 	}
-	if !data.AdminServer.IsNull() {
+	if !data.AdminServer.IsNull() && !data.AdminServer.IsUnknown() {
 		var admin_serverList []string
 		data.AdminServer.ElementsAs(ctx, &admin_serverList, false)
 		params["admin_server"] = admin_serverList
 	}
-	if !data.KpasswdServer.IsNull() {
+	if !data.KpasswdServer.IsNull() && !data.KpasswdServer.IsUnknown() {
 		var kpasswd_serverList []string
 		data.KpasswdServer.ElementsAs(ctx, &kpasswd_serverList, false)
 		params["kpasswd_server"] = kpasswd_serverList
@@ -134,6 +161,8 @@ func (r *KerberosRealmResource) Create(ctx context.Context, req resource.CreateR
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, kerberosRealmIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -186,6 +215,7 @@ func (r *KerberosRealmResource) Read(ctx context.Context, req resource.ReadReque
 			data.Realm = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, kerberosRealmIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -212,35 +242,37 @@ func (r *KerberosRealmResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	params := map[string]interface{}{}
-	if !data.Realm.IsNull() {
+	if !data.Realm.IsNull() && !data.Realm.IsUnknown() {
 		params["realm"] = data.Realm.ValueString()
 	}
-	if !data.PrimaryKdc.IsNull() {
+	if !data.PrimaryKdc.IsNull() && !data.PrimaryKdc.IsUnknown() {
 		params["primary_kdc"] = data.PrimaryKdc.ValueString()
 	}
-	if !data.Kdc.IsNull() {
+	if !data.Kdc.IsNull() && !data.Kdc.IsUnknown() {
 		var kdcList []string
 		data.Kdc.ElementsAs(ctx, &kdcList, false)
 		params["kdc"] = kdcList
 	}
-	if !data.AdminServer.IsNull() {
+	if !data.AdminServer.IsNull() && !data.AdminServer.IsUnknown() {
 		var admin_serverList []string
 		data.AdminServer.ElementsAs(ctx, &admin_serverList, false)
 		params["admin_server"] = admin_serverList
 	}
-	if !data.KpasswdServer.IsNull() {
+	if !data.KpasswdServer.IsNull() && !data.KpasswdServer.IsUnknown() {
 		var kpasswd_serverList []string
 		data.KpasswdServer.ElementsAs(ctx, &kpasswd_serverList, false)
 		params["kpasswd_server"] = kpasswd_serverList
 	}
 
-	_, err = r.client.Call("kerberos.realm.update", []interface{}{id, params})
+	result, err := r.client.Call("kerberos.realm.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update kerberos_realm: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, kerberosRealmIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -265,3 +297,60 @@ func (r *KerberosRealmResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *KerberosRealmResource) setComputed(result interface{}, data *KerberosRealmResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.PrimaryKdc.IsUnknown() {
+		data.PrimaryKdc = types.StringNull()
+		if v, ok := resultMap["primary_kdc"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.PrimaryKdc = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.PrimaryKdc = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.PrimaryKdc = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Kdc.IsUnknown() {
+		data.Kdc = types.ListNull(types.StringType)
+		if v, ok := resultMap["kdc"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Kdc, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.AdminServer.IsUnknown() {
+		data.AdminServer = types.ListNull(types.StringType)
+		if v, ok := resultMap["admin_server"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.AdminServer, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.KpasswdServer.IsUnknown() {
+		data.KpasswdServer = types.ListNull(types.StringType)
+		if v, ok := resultMap["kpasswd_server"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.KpasswdServer, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+}
@@ -0,0 +1,555 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MailConfigResource manages the mail settings. There is exactly one
+// instance, so create and update both call mail.update.
+type MailConfigResource struct {
+	client *client.Client
+}
+
+type MailConfigResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Fromemail        types.String `tfsdk:"fromemail"`
+	Fromname         types.String `tfsdk:"fromname"`
+	Outgoingserver   types.String `tfsdk:"outgoingserver"`
+	Port             types.Int64  `tfsdk:"port"`
+	Security         types.String `tfsdk:"security"`
+	Smtp             types.Bool   `tfsdk:"smtp"`
+	User             types.String `tfsdk:"user"`
+	Pass             types.String `tfsdk:"pass"`
+	Oauth            types.String `tfsdk:"oauth"`
+	RestoreOnDestroy types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewMailConfigResource() resource.Resource {
+	return &MailConfigResource{}
+}
+
+func (r *MailConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_config"
+}
+
+func (r *MailConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *MailConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *MailConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update Mail Service Configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"fromemail": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Sender address of system email.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"fromname": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Sender name of system email.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"outgoingserver": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "SMTP relay host name or address.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"port": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "SMTP relay port.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(1, 65535)},
+			},
+			"security": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Connection security.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("PLAIN", "SSL", "TLS")},
+			},
+			"smtp": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Authenticate to the relay with `user` and `pass`.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"user": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "SMTP user name.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"pass": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "SMTP password.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"oauth": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "OAuth credentials for Gmail or Outlook relays.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *MailConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *MailConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MailConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("mail.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read mail_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Fromemail.IsNull() && !data.Fromemail.IsUnknown() {
+		params["fromemail"] = data.Fromemail.ValueString()
+	}
+	if !data.Fromname.IsNull() && !data.Fromname.IsUnknown() {
+		params["fromname"] = data.Fromname.ValueString()
+	}
+	if !data.Outgoingserver.IsNull() && !data.Outgoingserver.IsUnknown() {
+		params["outgoingserver"] = data.Outgoingserver.ValueString()
+	}
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
+		params["port"] = data.Port.ValueInt64()
+	}
+	if !data.Security.IsNull() && !data.Security.IsUnknown() {
+		params["security"] = data.Security.ValueString()
+	}
+	if !data.Smtp.IsNull() && !data.Smtp.IsUnknown() {
+		params["smtp"] = data.Smtp.ValueBool()
+	}
+	if !data.User.IsNull() && !data.User.IsUnknown() {
+		params["user"] = data.User.ValueString()
+	}
+	if !data.Pass.IsNull() && !data.Pass.IsUnknown() {
+		params["pass"] = data.Pass.ValueString()
+	}
+	if !data.Oauth.IsNull() && !data.Oauth.IsUnknown() {
+		var oauthObj map[string]interface{}
+		if err := json.Unmarshal([]byte(data.Oauth.ValueString()), &oauthObj); err != nil {
+			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse oauth: %s", err))
+			return
+		}
+		params["oauth"] = oauthObj
+	}
+
+	result, err := r.client.Call("mail.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update mail_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("mail")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MailConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("mail.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read mail_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["fromemail"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Fromemail = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Fromemail = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Fromemail = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["fromname"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Fromname = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Fromname = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Fromname = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["outgoingserver"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Outgoingserver = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Outgoingserver = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Outgoingserver = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["port"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Port = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Port = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["security"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Security = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Security = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Security = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["smtp"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Smtp = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["user"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.User = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.User = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.User = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["pass"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Pass = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Pass = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Pass = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["oauth"]; ok && v != nil {
+		if b, err := json.Marshal(v); err == nil {
+			data.Oauth = types.StringValue(string(b))
+		}
+	}
+
+	data.ID = types.StringValue("mail")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MailConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Fromemail.IsNull() && !data.Fromemail.IsUnknown() {
+		params["fromemail"] = data.Fromemail.ValueString()
+	}
+	if !data.Fromname.IsNull() && !data.Fromname.IsUnknown() {
+		params["fromname"] = data.Fromname.ValueString()
+	}
+	if !data.Outgoingserver.IsNull() && !data.Outgoingserver.IsUnknown() {
+		params["outgoingserver"] = data.Outgoingserver.ValueString()
+	}
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
+		params["port"] = data.Port.ValueInt64()
+	}
+	if !data.Security.IsNull() && !data.Security.IsUnknown() {
+		params["security"] = data.Security.ValueString()
+	}
+	if !data.Smtp.IsNull() && !data.Smtp.IsUnknown() {
+		params["smtp"] = data.Smtp.ValueBool()
+	}
+	if !data.User.IsNull() && !data.User.IsUnknown() {
+		params["user"] = data.User.ValueString()
+	}
+	if !data.Pass.IsNull() && !data.Pass.IsUnknown() {
+		params["pass"] = data.Pass.ValueString()
+	}
+	if !data.Oauth.IsNull() && !data.Oauth.IsUnknown() {
+		var oauthObj map[string]interface{}
+		if err := json.Unmarshal([]byte(data.Oauth.ValueString()), &oauthObj); err != nil {
+			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse oauth: %s", err))
+			return
+		}
+		params["oauth"] = oauthObj
+	}
+
+	result, err := r.client.Call("mail.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update mail_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("mail")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MailConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when mail_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"fromemail", "fromname", "outgoingserver", "port", "security", "smtp", "user", "pass", "oauth"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.Call("mail.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore mail_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *MailConfigResource) setComputed(result interface{}, data *MailConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Fromemail.IsUnknown() {
+		data.Fromemail = types.StringNull()
+		if v, ok := resultMap["fromemail"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Fromemail = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Fromemail = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Fromemail = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Fromname.IsUnknown() {
+		data.Fromname = types.StringNull()
+		if v, ok := resultMap["fromname"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Fromname = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Fromname = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Fromname = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Outgoingserver.IsUnknown() {
+		data.Outgoingserver = types.StringNull()
+		if v, ok := resultMap["outgoingserver"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Outgoingserver = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Outgoingserver = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Outgoingserver = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Port.IsUnknown() {
+		data.Port = types.Int64Null()
+		if v, ok := resultMap["port"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Port = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Port = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Security.IsUnknown() {
+		data.Security = types.StringNull()
+		if v, ok := resultMap["security"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Security = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Security = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Security = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Smtp.IsUnknown() {
+		data.Smtp = types.BoolNull()
+		if v, ok := resultMap["smtp"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Smtp = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.User.IsUnknown() {
+		data.User = types.StringNull()
+		if v, ok := resultMap["user"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.User = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.User = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.User = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Pass.IsUnknown() {
+		data.Pass = types.StringNull()
+		if v, ok := resultMap["pass"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Pass = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Pass = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Pass = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Oauth.IsUnknown() {
+		data.Oauth = types.StringNull()
+		if v, ok := resultMap["oauth"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Oauth = types.StringValue(string(b))
+			}
+		}
+	}
+}
@@ -0,0 +1,724 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NetworkConfigResource manages the network.configuration settings. There is exactly one
+// instance, so create and update both call network.configuration.update.
+type NetworkConfigResource struct {
+	client *client.Client
+}
+
+type NetworkConfigResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Hostname            types.String `tfsdk:"hostname"`
+	HostnameB           types.String `tfsdk:"hostname_b"`
+	HostnameVirtual     types.String `tfsdk:"hostname_virtual"`
+	Domain              types.String `tfsdk:"domain"`
+	Domains             types.List   `tfsdk:"domains"`
+	ServiceAnnouncement types.String `tfsdk:"service_announcement"`
+	Ipv4Gateway         types.String `tfsdk:"ipv4gateway"`
+	Ipv6Gateway         types.String `tfsdk:"ipv6gateway"`
+	Nameserver1         types.String `tfsdk:"nameserver1"`
+	Nameserver2         types.String `tfsdk:"nameserver2"`
+	Nameserver3         types.String `tfsdk:"nameserver3"`
+	Httpproxy           types.String `tfsdk:"httpproxy"`
+	Hosts               types.List   `tfsdk:"hosts"`
+	RestoreOnDestroy    types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewNetworkConfigResource() resource.Resource {
+	return &NetworkConfigResource{}
+}
+
+func (r *NetworkConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_config"
+}
+
+func (r *NetworkConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NetworkConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *NetworkConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update Network Configuration Service configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"hostname": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "System host name.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"hostname_b": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Host name of the second controller (HA systems only).",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"hostname_virtual": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Virtual host name shared by both controllers (HA systems only).",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"domain": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "System domain name.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"domains": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Additional DNS search domains.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"service_announcement": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Which discovery protocols announce this system.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ipv4gateway": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Default IPv4 gateway.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ipv6gateway": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Default IPv6 gateway.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"nameserver1": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Primary DNS server.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"nameserver2": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Secondary DNS server.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"nameserver3": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Tertiary DNS server.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"httpproxy": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "HTTP proxy used for updates and outbound requests, e.g. `http://proxy:3128`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"hosts": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Extra entries for /etc/hosts, one `address hostname` per item.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *NetworkConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *NetworkConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("network.configuration.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read network_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Hostname.IsNull() && !data.Hostname.IsUnknown() {
+		params["hostname"] = data.Hostname.ValueString()
+	}
+	if !data.HostnameB.IsNull() && !data.HostnameB.IsUnknown() {
+		params["hostname_b"] = data.HostnameB.ValueString()
+	}
+	if !data.HostnameVirtual.IsNull() && !data.HostnameVirtual.IsUnknown() {
+		params["hostname_virtual"] = data.HostnameVirtual.ValueString()
+	}
+	if !data.Domain.IsNull() && !data.Domain.IsUnknown() {
+		params["domain"] = data.Domain.ValueString()
+	}
+	if !data.Domains.IsNull() && !data.Domains.IsUnknown() {
+		var domainsList []string
+		data.Domains.ElementsAs(ctx, &domainsList, false)
+		params["domains"] = domainsList
+	}
+	if !data.ServiceAnnouncement.IsNull() && !data.ServiceAnnouncement.IsUnknown() {
+		var service_announcementObj map[string]interface{}
+		if err := json.Unmarshal([]byte(data.ServiceAnnouncement.ValueString()), &service_announcementObj); err != nil {
+			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse service_announcement: %s", err))
+			return
+		}
+		params["service_announcement"] = service_announcementObj
+	}
+	if !data.Ipv4Gateway.IsNull() && !data.Ipv4Gateway.IsUnknown() {
+		params["ipv4gateway"] = data.Ipv4Gateway.ValueString()
+	}
+	if !data.Ipv6Gateway.IsNull() && !data.Ipv6Gateway.IsUnknown() {
+		params["ipv6gateway"] = data.Ipv6Gateway.ValueString()
+	}
+	if !data.Nameserver1.IsNull() && !data.Nameserver1.IsUnknown() {
+		params["nameserver1"] = data.Nameserver1.ValueString()
+	}
+	if !data.Nameserver2.IsNull() && !data.Nameserver2.IsUnknown() {
+		params["nameserver2"] = data.Nameserver2.ValueString()
+	}
+	if !data.Nameserver3.IsNull() && !data.Nameserver3.IsUnknown() {
+		params["nameserver3"] = data.Nameserver3.ValueString()
+	}
+	if !data.Httpproxy.IsNull() && !data.Httpproxy.IsUnknown() {
+		params["httpproxy"] = data.Httpproxy.ValueString()
+	}
+	if !data.Hosts.IsNull() && !data.Hosts.IsUnknown() {
+		var hostsList []string
+		data.Hosts.ElementsAs(ctx, &hostsList, false)
+		params["hosts"] = hostsList
+	}
+
+	result, err := r.client.Call("network.configuration.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update network_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("network.configuration")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("network.configuration.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read network_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["hostname"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Hostname = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Hostname = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Hostname = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["hostname_b"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.HostnameB = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.HostnameB = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.HostnameB = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["hostname_virtual"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.HostnameVirtual = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.HostnameVirtual = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.HostnameVirtual = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["domain"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Domain = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Domain = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Domain = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["domains"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Domains, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["service_announcement"]; ok && v != nil {
+		if b, err := json.Marshal(v); err == nil {
+			data.ServiceAnnouncement = types.StringValue(string(b))
+		}
+	}
+	if v, ok := resultMap["ipv4gateway"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Ipv4Gateway = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Ipv4Gateway = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Ipv4Gateway = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["ipv6gateway"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Ipv6Gateway = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Ipv6Gateway = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Ipv6Gateway = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["nameserver1"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Nameserver1 = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Nameserver1 = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Nameserver1 = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["nameserver2"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Nameserver2 = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Nameserver2 = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Nameserver2 = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["nameserver3"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Nameserver3 = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Nameserver3 = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Nameserver3 = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["httpproxy"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Httpproxy = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Httpproxy = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Httpproxy = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["hosts"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Hosts, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+
+	data.ID = types.StringValue("network.configuration")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Hostname.IsNull() && !data.Hostname.IsUnknown() {
+		params["hostname"] = data.Hostname.ValueString()
+	}
+	if !data.HostnameB.IsNull() && !data.HostnameB.IsUnknown() {
+		params["hostname_b"] = data.HostnameB.ValueString()
+	}
+	if !data.HostnameVirtual.IsNull() && !data.HostnameVirtual.IsUnknown() {
+		params["hostname_virtual"] = data.HostnameVirtual.ValueString()
+	}
+	if !data.Domain.IsNull() && !data.Domain.IsUnknown() {
+		params["domain"] = data.Domain.ValueString()
+	}
+	if !data.Domains.IsNull() && !data.Domains.IsUnknown() {
+		var domainsList []string
+		data.Domains.ElementsAs(ctx, &domainsList, false)
+		params["domains"] = domainsList
+	}
+	if !data.ServiceAnnouncement.IsNull() && !data.ServiceAnnouncement.IsUnknown() {
+		var service_announcementObj map[string]interface{}
+		if err := json.Unmarshal([]byte(data.ServiceAnnouncement.ValueString()), &service_announcementObj); err != nil {
+			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse service_announcement: %s", err))
+			return
+		}
+		params["service_announcement"] = service_announcementObj
+	}
+	if !data.Ipv4Gateway.IsNull() && !data.Ipv4Gateway.IsUnknown() {
+		params["ipv4gateway"] = data.Ipv4Gateway.ValueString()
+	}
+	if !data.Ipv6Gateway.IsNull() && !data.Ipv6Gateway.IsUnknown() {
+		params["ipv6gateway"] = data.Ipv6Gateway.ValueString()
+	}
+	if !data.Nameserver1.IsNull() && !data.Nameserver1.IsUnknown() {
+		params["nameserver1"] = data.Nameserver1.ValueString()
+	}
+	if !data.Nameserver2.IsNull() && !data.Nameserver2.IsUnknown() {
+		params["nameserver2"] = data.Nameserver2.ValueString()
+	}
+	if !data.Nameserver3.IsNull() && !data.Nameserver3.IsUnknown() {
+		params["nameserver3"] = data.Nameserver3.ValueString()
+	}
+	if !data.Httpproxy.IsNull() && !data.Httpproxy.IsUnknown() {
+		params["httpproxy"] = data.Httpproxy.ValueString()
+	}
+	if !data.Hosts.IsNull() && !data.Hosts.IsUnknown() {
+		var hostsList []string
+		data.Hosts.ElementsAs(ctx, &hostsList, false)
+		params["hosts"] = hostsList
+	}
+
+	result, err := r.client.Call("network.configuration.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update network_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("network.configuration")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when network_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"hostname", "hostname_b", "hostname_virtual", "domain", "domains", "service_announcement", "ipv4gateway", "ipv6gateway", "nameserver1", "nameserver2", "nameserver3", "httpproxy", "hosts"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.Call("network.configuration.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore network_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *NetworkConfigResource) setComputed(result interface{}, data *NetworkConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Hostname.IsUnknown() {
+		data.Hostname = types.StringNull()
+		if v, ok := resultMap["hostname"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Hostname = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Hostname = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Hostname = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.HostnameB.IsUnknown() {
+		data.HostnameB = types.StringNull()
+		if v, ok := resultMap["hostname_b"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.HostnameB = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.HostnameB = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.HostnameB = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.HostnameVirtual.IsUnknown() {
+		data.HostnameVirtual = types.StringNull()
+		if v, ok := resultMap["hostname_virtual"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.HostnameVirtual = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.HostnameVirtual = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.HostnameVirtual = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Domain.IsUnknown() {
+		data.Domain = types.StringNull()
+		if v, ok := resultMap["domain"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Domain = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Domain = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Domain = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Domains.IsUnknown() {
+		data.Domains = types.ListNull(types.StringType)
+		if v, ok := resultMap["domains"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Domains, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.ServiceAnnouncement.IsUnknown() {
+		data.ServiceAnnouncement = types.StringNull()
+		if v, ok := resultMap["service_announcement"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.ServiceAnnouncement = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.Ipv4Gateway.IsUnknown() {
+		data.Ipv4Gateway = types.StringNull()
+		if v, ok := resultMap["ipv4gateway"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Ipv4Gateway = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Ipv4Gateway = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Ipv4Gateway = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Ipv6Gateway.IsUnknown() {
+		data.Ipv6Gateway = types.StringNull()
+		if v, ok := resultMap["ipv6gateway"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Ipv6Gateway = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Ipv6Gateway = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Ipv6Gateway = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Nameserver1.IsUnknown() {
+		data.Nameserver1 = types.StringNull()
+		if v, ok := resultMap["nameserver1"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Nameserver1 = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Nameserver1 = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Nameserver1 = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Nameserver2.IsUnknown() {
+		data.Nameserver2 = types.StringNull()
+		if v, ok := resultMap["nameserver2"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Nameserver2 = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Nameserver2 = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Nameserver2 = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Nameserver3.IsUnknown() {
+		data.Nameserver3 = types.StringNull()
+		if v, ok := resultMap["nameserver3"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Nameserver3 = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Nameserver3 = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Nameserver3 = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Httpproxy.IsUnknown() {
+		data.Httpproxy = types.StringNull()
+		if v, ok := resultMap["httpproxy"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Httpproxy = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Httpproxy = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Httpproxy = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Hosts.IsUnknown() {
+		data.Hosts = types.ListNull(types.StringType)
+		if v, ok := resultMap["hosts"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Hosts, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+}
@@ -0,0 +1,646 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NfsConfigResource manages the nfs settings. There is exactly one
+// instance, so create and update both call nfs.update.
+type NfsConfigResource struct {
+	client *client.Client
+}
+
+type NfsConfigResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Servers          types.Int64  `tfsdk:"servers"`
+	AllowNonroot     types.Bool   `tfsdk:"allow_nonroot"`
+	Protocols        types.List   `tfsdk:"protocols"`
+	V4Krb            types.Bool   `tfsdk:"v4_krb"`
+	V4Domain         types.String `tfsdk:"v4_domain"`
+	Bindip           types.List   `tfsdk:"bindip"`
+	MountdPort       types.Int64  `tfsdk:"mountd_port"`
+	RpcstatdPort     types.Int64  `tfsdk:"rpcstatd_port"`
+	RpclockdPort     types.Int64  `tfsdk:"rpclockd_port"`
+	MountdLog        types.Bool   `tfsdk:"mountd_log"`
+	StatdLockdLog    types.Bool   `tfsdk:"statd_lockd_log"`
+	UserdManageGids  types.Bool   `tfsdk:"userd_manage_gids"`
+	Rdma             types.Bool   `tfsdk:"rdma"`
+	RestoreOnDestroy types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewNfsConfigResource() resource.Resource {
+	return &NfsConfigResource{}
+}
+
+func (r *NfsConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nfs_config"
+}
+
+func (r *NfsConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NfsConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *NfsConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update NFS Service Configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"servers": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Number of nfsd server threads. Null lets the server choose based on CPU count.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"allow_nonroot": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow clients to connect from non-reserved ports.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"protocols": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "NFS protocol versions to serve: `NFSV3`, `NFSV4`.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"v4_krb": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Require Kerberos authentication for NFSv4.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"v4_domain": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "NFSv4 ID mapping domain.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"bindip": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "IP addresses the NFS server listens on. Empty means all addresses.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"mountd_port": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Port mountd binds to.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"rpcstatd_port": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Port rpc.statd binds to.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"rpclockd_port": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Port rpc.lockd binds to.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"mountd_log": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Log mountd requests to syslog.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"statd_lockd_log": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Log rpc.statd and rpc.lockd messages to syslog.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"userd_manage_gids": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Look up group membership on the server instead of trusting the client (more than 16 groups).",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"rdma": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Serve NFS over RDMA.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *NfsConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *NfsConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NfsConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("nfs.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read nfs_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Servers.IsNull() && !data.Servers.IsUnknown() {
+		params["servers"] = data.Servers.ValueInt64()
+	}
+	if !data.AllowNonroot.IsNull() && !data.AllowNonroot.IsUnknown() {
+		params["allow_nonroot"] = data.AllowNonroot.ValueBool()
+	}
+	if !data.Protocols.IsNull() && !data.Protocols.IsUnknown() {
+		var protocolsList []string
+		data.Protocols.ElementsAs(ctx, &protocolsList, false)
+		params["protocols"] = protocolsList
+	}
+	if !data.V4Krb.IsNull() && !data.V4Krb.IsUnknown() {
+		params["v4_krb"] = data.V4Krb.ValueBool()
+	}
+	if !data.V4Domain.IsNull() && !data.V4Domain.IsUnknown() {
+		params["v4_domain"] = data.V4Domain.ValueString()
+	}
+	if !data.Bindip.IsNull() && !data.Bindip.IsUnknown() {
+		var bindipList []string
+		data.Bindip.ElementsAs(ctx, &bindipList, false)
+		params["bindip"] = bindipList
+	}
+	if !data.MountdPort.IsNull() && !data.MountdPort.IsUnknown() {
+		params["mountd_port"] = data.MountdPort.ValueInt64()
+	}
+	if !data.RpcstatdPort.IsNull() && !data.RpcstatdPort.IsUnknown() {
+		params["rpcstatd_port"] = data.RpcstatdPort.ValueInt64()
+	}
+	if !data.RpclockdPort.IsNull() && !data.RpclockdPort.IsUnknown() {
+		params["rpclockd_port"] = data.RpclockdPort.ValueInt64()
+	}
+	if !data.MountdLog.IsNull() && !data.MountdLog.IsUnknown() {
+		params["mountd_log"] = data.MountdLog.ValueBool()
+	}
+	if !data.StatdLockdLog.IsNull() && !data.StatdLockdLog.IsUnknown() {
+		params["statd_lockd_log"] = data.StatdLockdLog.ValueBool()
+	}
+	if !data.UserdManageGids.IsNull() && !data.UserdManageGids.IsUnknown() {
+		params["userd_manage_gids"] = data.UserdManageGids.ValueBool()
+	}
+	if !data.Rdma.IsNull() && !data.Rdma.IsUnknown() {
+		params["rdma"] = data.Rdma.ValueBool()
+	}
+
+	result, err := r.client.Call("nfs.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update nfs_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("nfs")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NfsConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NfsConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("nfs.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read nfs_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["servers"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Servers = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Servers = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["allow_nonroot"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.AllowNonroot = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["protocols"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Protocols, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["v4_krb"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.V4Krb = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["v4_domain"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.V4Domain = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.V4Domain = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.V4Domain = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["bindip"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Bindip, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["mountd_port"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.MountdPort = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.MountdPort = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["rpcstatd_port"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.RpcstatdPort = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.RpcstatdPort = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["rpclockd_port"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.RpclockdPort = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.RpclockdPort = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["mountd_log"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.MountdLog = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["statd_lockd_log"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.StatdLockdLog = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["userd_manage_gids"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.UserdManageGids = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["rdma"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Rdma = types.BoolValue(bv)
+		}
+	}
+
+	data.ID = types.StringValue("nfs")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NfsConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NfsConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Servers.IsNull() && !data.Servers.IsUnknown() {
+		params["servers"] = data.Servers.ValueInt64()
+	}
+	if !data.AllowNonroot.IsNull() && !data.AllowNonroot.IsUnknown() {
+		params["allow_nonroot"] = data.AllowNonroot.ValueBool()
+	}
+	if !data.Protocols.IsNull() && !data.Protocols.IsUnknown() {
+		var protocolsList []string
+		data.Protocols.ElementsAs(ctx, &protocolsList, false)
+		params["protocols"] = protocolsList
+	}
+	if !data.V4Krb.IsNull() && !data.V4Krb.IsUnknown() {
+		params["v4_krb"] = data.V4Krb.ValueBool()
+	}
+	if !data.V4Domain.IsNull() && !data.V4Domain.IsUnknown() {
+		params["v4_domain"] = data.V4Domain.ValueString()
+	}
+	if !data.Bindip.IsNull() && !data.Bindip.IsUnknown() {
+		var bindipList []string
+		data.Bindip.ElementsAs(ctx, &bindipList, false)
+		params["bindip"] = bindipList
+	}
+	if !data.MountdPort.IsNull() && !data.MountdPort.IsUnknown() {
+		params["mountd_port"] = data.MountdPort.ValueInt64()
+	}
+	if !data.RpcstatdPort.IsNull() && !data.RpcstatdPort.IsUnknown() {
+		params["rpcstatd_port"] = data.RpcstatdPort.ValueInt64()
+	}
+	if !data.RpclockdPort.IsNull() && !data.RpclockdPort.IsUnknown() {
+		params["rpclockd_port"] = data.RpclockdPort.ValueInt64()
+	}
+	if !data.MountdLog.IsNull() && !data.MountdLog.IsUnknown() {
+		params["mountd_log"] = data.MountdLog.ValueBool()
+	}
+	if !data.StatdLockdLog.IsNull() && !data.StatdLockdLog.IsUnknown() {
+		params["statd_lockd_log"] = data.StatdLockdLog.ValueBool()
+	}
+	if !data.UserdManageGids.IsNull() && !data.UserdManageGids.IsUnknown() {
+		params["userd_manage_gids"] = data.UserdManageGids.ValueBool()
+	}
+	if !data.Rdma.IsNull() && !data.Rdma.IsUnknown() {
+		params["rdma"] = data.Rdma.ValueBool()
+	}
+
+	result, err := r.client.Call("nfs.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update nfs_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("nfs")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NfsConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NfsConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when nfs_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"servers", "allow_nonroot", "protocols", "v4_krb", "v4_domain", "bindip", "mountd_port", "rpcstatd_port", "rpclockd_port", "mountd_log", "statd_lockd_log", "userd_manage_gids", "rdma"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.Call("nfs.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore nfs_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *NfsConfigResource) setComputed(result interface{}, data *NfsConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Servers.IsUnknown() {
+		data.Servers = types.Int64Null()
+		if v, ok := resultMap["servers"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Servers = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Servers = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.AllowNonroot.IsUnknown() {
+		data.AllowNonroot = types.BoolNull()
+		if v, ok := resultMap["allow_nonroot"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.AllowNonroot = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Protocols.IsUnknown() {
+		data.Protocols = types.ListNull(types.StringType)
+		if v, ok := resultMap["protocols"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Protocols, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.V4Krb.IsUnknown() {
+		data.V4Krb = types.BoolNull()
+		if v, ok := resultMap["v4_krb"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.V4Krb = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.V4Domain.IsUnknown() {
+		data.V4Domain = types.StringNull()
+		if v, ok := resultMap["v4_domain"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.V4Domain = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.V4Domain = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.V4Domain = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Bindip.IsUnknown() {
+		data.Bindip = types.ListNull(types.StringType)
+		if v, ok := resultMap["bindip"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Bindip, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.MountdPort.IsUnknown() {
+		data.MountdPort = types.Int64Null()
+		if v, ok := resultMap["mountd_port"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.MountdPort = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.MountdPort = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.RpcstatdPort.IsUnknown() {
+		data.RpcstatdPort = types.Int64Null()
+		if v, ok := resultMap["rpcstatd_port"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.RpcstatdPort = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.RpcstatdPort = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.RpclockdPort.IsUnknown() {
+		data.RpclockdPort = types.Int64Null()
+		if v, ok := resultMap["rpclockd_port"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.RpclockdPort = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.RpclockdPort = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.MountdLog.IsUnknown() {
+		data.MountdLog = types.BoolNull()
+		if v, ok := resultMap["mountd_log"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.MountdLog = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.StatdLockdLog.IsUnknown() {
+		data.StatdLockdLog = types.BoolNull()
+		if v, ok := resultMap["statd_lockd_log"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.StatdLockdLog = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.UserdManageGids.IsUnknown() {
+		data.UserdManageGids = types.BoolNull()
+		if v, ok := resultMap["userd_manage_gids"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.UserdManageGids = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Rdma.IsUnknown() {
+		data.Rdma = types.BoolNull()
+		if v, ok := resultMap["rdma"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Rdma = types.BoolValue(bv)
+			}
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NvmetGlobalConfigResource manages the nvmet.global settings. There is exactly one
+// instance, so create and update both call nvmet.global.update.
+type NvmetGlobalConfigResource struct {
+	client *client.Client
+}
+
+type NvmetGlobalConfigResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Basenqn          types.String `tfsdk:"basenqn"`
+	Kernel           types.Bool   `tfsdk:"kernel"`
+	Ana              types.Bool   `tfsdk:"ana"`
+	Rdma             types.Bool   `tfsdk:"rdma"`
+	XportReferral    types.Bool   `tfsdk:"xport_referral"`
+	RestoreOnDestroy types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewNvmetGlobalConfigResource() resource.Resource {
+	return &NvmetGlobalConfigResource{}
+}
+
+func (r *NvmetGlobalConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nvmet_global_config"
+}
+
+func (r *NvmetGlobalConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *NvmetGlobalConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *NvmetGlobalConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update NVMe target global settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"basenqn": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Base NVMe Qualified Name prepended to subsystem names.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"kernel": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Use the kernel NVMe target implementation.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"ana": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable Asymmetric Namespace Access (HA systems only).",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"rdma": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow RDMA transports.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"xport_referral": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Report the other HA controller's ports as referrals.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *NvmetGlobalConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *NvmetGlobalConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NvmetGlobalConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("nvmet.global.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read nvmet_global_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Basenqn.IsNull() && !data.Basenqn.IsUnknown() {
+		params["basenqn"] = data.Basenqn.ValueString()
+	}
+	if !data.Kernel.IsNull() && !data.Kernel.IsUnknown() {
+		params["kernel"] = data.Kernel.ValueBool()
+	}
+	if !data.Ana.IsNull() && !data.Ana.IsUnknown() {
+		params["ana"] = data.Ana.ValueBool()
+	}
+	if !data.Rdma.IsNull() && !data.Rdma.IsUnknown() {
+		params["rdma"] = data.Rdma.ValueBool()
+	}
+	if !data.XportReferral.IsNull() && !data.XportReferral.IsUnknown() {
+		params["xport_referral"] = data.XportReferral.ValueBool()
+	}
+
+	result, err := r.client.Call("nvmet.global.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update nvmet_global_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("nvmet.global")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NvmetGlobalConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NvmetGlobalConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("nvmet.global.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read nvmet_global_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["basenqn"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Basenqn = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Basenqn = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Basenqn = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["kernel"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Kernel = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["ana"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Ana = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["rdma"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Rdma = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["xport_referral"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.XportReferral = types.BoolValue(bv)
+		}
+	}
+
+	data.ID = types.StringValue("nvmet.global")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NvmetGlobalConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NvmetGlobalConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Basenqn.IsNull() && !data.Basenqn.IsUnknown() {
+		params["basenqn"] = data.Basenqn.ValueString()
+	}
+	if !data.Kernel.IsNull() && !data.Kernel.IsUnknown() {
+		params["kernel"] = data.Kernel.ValueBool()
+	}
+	if !data.Ana.IsNull() && !data.Ana.IsUnknown() {
+		params["ana"] = data.Ana.ValueBool()
+	}
+	if !data.Rdma.IsNull() && !data.Rdma.IsUnknown() {
+		params["rdma"] = data.Rdma.ValueBool()
+	}
+	if !data.XportReferral.IsNull() && !data.XportReferral.IsUnknown() {
+		params["xport_referral"] = data.XportReferral.ValueBool()
+	}
+
+	result, err := r.client.Call("nvmet.global.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update nvmet_global_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("nvmet.global")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NvmetGlobalConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NvmetGlobalConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when nvmet_global_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"basenqn", "kernel", "ana", "rdma", "xport_referral"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.Call("nvmet.global.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore nvmet_global_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *NvmetGlobalConfigResource) setComputed(result interface{}, data *NvmetGlobalConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Basenqn.IsUnknown() {
+		data.Basenqn = types.StringNull()
+		if v, ok := resultMap["basenqn"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Basenqn = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Basenqn = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Basenqn = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Kernel.IsUnknown() {
+		data.Kernel = types.BoolNull()
+		if v, ok := resultMap["kernel"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Kernel = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Ana.IsUnknown() {
+		data.Ana = types.BoolNull()
+		if v, ok := resultMap["ana"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Ana = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Rdma.IsUnknown() {
+		data.Rdma = types.BoolNull()
+		if v, ok := resultMap["rdma"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Rdma = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.XportReferral.IsUnknown() {
+		data.XportReferral = types.BoolNull()
+		if v, ok := resultMap["xport_referral"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.XportReferral = types.BoolValue(bv)
+			}
+		}
+	}
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -31,14 +35,30 @@ func NewNvmetHostResource() resource.Resource {
 
 func (r *NvmetHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_nvmet_host"
+	// hostnqn can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *NvmetHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "nvmet.host.query", nvmetHostIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &NvmetHostResource{}
+
+// nvmetHostIdentity lists the API fields that identify a nvmet_host
+var nvmetHostIdentity = []identityKey{{Name: "hostnqn"}}
+
+func (r *NvmetHostResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(nvmetHostIdentity)
+}
+
+func (r *NvmetHostResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *NvmetHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an NVMe target `host`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -48,24 +68,31 @@ func (r *NvmetHostResource) Schema(ctx context.Context, req resource.SchemaReque
 				Description: "NQN of the host that will connect to this TrueNAS. ",
 			},
 			"dhchap_key": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "If set, the secret that the host must present when connecting.  A suitable secret can be generated u",
+				Optional:      true,
+				Computed:      true,
+				Description:   "If set, the secret that the host must present when connecting.  A suitable secret can be generated u",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"dhchap_ctrl_key": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "If set, the secret that this TrueNAS will present to the host when the host is connecting (Bi-Direct",
+				Optional:      true,
+				Computed:      true,
+				Description:   "If set, the secret that this TrueNAS will present to the host when the host is connecting (Bi-Direct",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"dhchap_dhgroup": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "If selected, the DH (Diffie-Hellman) key exchange built on top of CHAP to be used for authentication",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "If selected, the DH (Diffie-Hellman) key exchange built on top of CHAP to be used for authentication",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"dhchap_hash": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "HMAC (Hashed Message Authentication Code) to be used in conjunction if a `dhchap_dhgroup` is selecte",
+				Default:     stringdefault.StaticString("SHA-256"),
+				Validators:  []validator.String{stringvalidator.OneOf("SHA-256", "SHA-384", "SHA-512")},
 			},
 		},
 	}
@@ -91,19 +118,19 @@ func (r *NvmetHostResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Hostnqn.IsNull() {
+	if !data.Hostnqn.IsNull() && !data.Hostnqn.IsUnknown() {
 		params["hostnqn"] = data.Hostnqn.ValueString()
 	}
-	if !data.DhchapKey.IsNull() {
+	if !data.DhchapKey.IsNull() && !data.DhchapKey.IsUnknown() {
 		params["dhchap_key"] = data.DhchapKey.ValueString()
 	}
-	if !data.DhchapCtrlKey.IsNull() {
+	if !data.DhchapCtrlKey.IsNull() && !data.DhchapCtrlKey.IsUnknown() {
 		params["dhchap_ctrl_key"] = data.DhchapCtrlKey.ValueString()
 	}
-	if !data.DhchapDhgroup.IsNull() {
+	if !data.DhchapDhgroup.IsNull() && !data.DhchapDhgroup.IsUnknown() {
 		params["dhchap_dhgroup"] = data.DhchapDhgroup.ValueString()
 	}
-	if !data.DhchapHash.IsNull() {
+	if !data.DhchapHash.IsNull() && !data.DhchapHash.IsUnknown() {
 		params["dhchap_hash"] = data.DhchapHash.ValueString()
 	}
 
@@ -125,6 +152,8 @@ func (r *NvmetHostResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, nvmetHostIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -177,6 +206,7 @@ func (r *NvmetHostResource) Read(ctx context.Context, req resource.ReadRequest,
 			data.Hostnqn = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, nvmetHostIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -203,29 +233,31 @@ func (r *NvmetHostResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Hostnqn.IsNull() {
+	if !data.Hostnqn.IsNull() && !data.Hostnqn.IsUnknown() {
 		params["hostnqn"] = data.Hostnqn.ValueString()
 	}
-	if !data.DhchapKey.IsNull() {
+	if !data.DhchapKey.IsNull() && !data.DhchapKey.IsUnknown() {
 		params["dhchap_key"] = data.DhchapKey.ValueString()
 	}
-	if !data.DhchapCtrlKey.IsNull() {
+	if !data.DhchapCtrlKey.IsNull() && !data.DhchapCtrlKey.IsUnknown() {
 		params["dhchap_ctrl_key"] = data.DhchapCtrlKey.ValueString()
 	}
-	if !data.DhchapDhgroup.IsNull() {
+	if !data.DhchapDhgroup.IsNull() && !data.DhchapDhgroup.IsUnknown() {
 		params["dhchap_dhgroup"] = data.DhchapDhgroup.ValueString()
 	}
-	if !data.DhchapHash.IsNull() {
+	if !data.DhchapHash.IsNull() && !data.DhchapHash.IsUnknown() {
 		params["dhchap_hash"] = data.DhchapHash.ValueString()
 	}
 
-	_, err = r.client.Call("nvmet.host.update", []interface{}{id, params})
+	result, err := r.client.Call("nvmet.host.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update nvmet_host: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, nvmetHostIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -251,3 +283,69 @@ func (r *NvmetHostResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *NvmetHostResource) setComputed(result interface{}, data *NvmetHostResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.DhchapKey.IsUnknown() {
+		data.DhchapKey = types.StringNull()
+		if v, ok := resultMap["dhchap_key"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.DhchapKey = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.DhchapKey = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.DhchapKey = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.DhchapCtrlKey.IsUnknown() {
+		data.DhchapCtrlKey = types.StringNull()
+		if v, ok := resultMap["dhchap_ctrl_key"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.DhchapCtrlKey = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.DhchapCtrlKey = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.DhchapCtrlKey = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.DhchapDhgroup.IsUnknown() {
+		data.DhchapDhgroup = types.StringNull()
+		if v, ok := resultMap["dhchap_dhgroup"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.DhchapDhgroup = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.DhchapDhgroup = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.DhchapDhgroup = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.DhchapHash.IsUnknown() {
+		data.DhchapHash = types.StringNull()
+		if v, ok := resultMap["dhchap_hash"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.DhchapHash = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.DhchapHash = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.DhchapHash = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
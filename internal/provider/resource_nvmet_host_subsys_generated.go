@@ -34,8 +34,13 @@ func (r *NvmetHostSubsysResource) ImportState(ctx context.Context, req resource.
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *NvmetHostSubsysResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *NvmetHostSubsysResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an association between a `host` and a subsystem (`subsys`).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -73,10 +78,10 @@ func (r *NvmetHostSubsysResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	params := map[string]interface{}{}
-	if !data.HostId.IsNull() {
+	if !data.HostId.IsNull() && !data.HostId.IsUnknown() {
 		params["host_id"] = data.HostId.ValueInt64()
 	}
-	if !data.SubsysId.IsNull() {
+	if !data.SubsysId.IsNull() && !data.SubsysId.IsUnknown() {
 		params["subsys_id"] = data.SubsysId.ValueInt64()
 	}
 
@@ -98,6 +103,7 @@ func (r *NvmetHostSubsysResource) Create(ctx context.Context, req resource.Creat
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -188,20 +194,21 @@ func (r *NvmetHostSubsysResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	params := map[string]interface{}{}
-	if !data.HostId.IsNull() {
+	if !data.HostId.IsNull() && !data.HostId.IsUnknown() {
 		params["host_id"] = data.HostId.ValueInt64()
 	}
-	if !data.SubsysId.IsNull() {
+	if !data.SubsysId.IsNull() && !data.SubsysId.IsUnknown() {
 		params["subsys_id"] = data.SubsysId.ValueInt64()
 	}
 
-	_, err = r.client.Call("nvmet.host_subsys.update", []interface{}{id, params})
+	result, err := r.client.Call("nvmet.host_subsys.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update nvmet_host_subsys: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -226,3 +233,9 @@ func (r *NvmetHostSubsysResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *NvmetHostSubsysResource) setComputed(result interface{}, data *NvmetHostSubsysResourceModel) {
+	_ = result // No computed fields
+}
@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -38,20 +43,28 @@ func (r *NvmetNamespaceResource) ImportState(ctx context.Context, req resource.I
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *NvmetNamespaceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *NvmetNamespaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a NVMe target namespace in a subsystem (`subsys`).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"nsid": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Namespace ID (NSID).  Each namespace within a subsystem has an associated NSID, unique within that s",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Namespace ID (NSID).  Each namespace within a subsystem has an associated NSID, unique within that s",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"device_type": schema.StringAttribute{
 				Required:    true,
 				Optional:    false,
 				Description: "Type of device (or file) used to implement the namespace. ",
+				Validators:  []validator.String{stringvalidator.OneOf("ZVOL", "FILE")},
 			},
 			"device_path": schema.StringAttribute{
 				Required:    true,
@@ -59,14 +72,18 @@ func (r *NvmetNamespaceResource) Schema(ctx context.Context, req resource.Schema
 				Description: "Normalized path to the device or file for the namespace.",
 			},
 			"filesize": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "When `device_type` is \"FILE\" then this will be the size of the file in bytes.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "When `device_type` is \"FILE\" then this will be the size of the file in bytes.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "If `enabled` is `False` then the namespace will not be accessible.  Some namespace configuration cha",
+				Default:     booldefault.StaticBool(true),
 			},
 			"subsys_id": schema.Int64Attribute{
 				Required:    true,
@@ -97,22 +114,22 @@ func (r *NvmetNamespaceResource) Create(ctx context.Context, req resource.Create
 	}
 
 	params := map[string]interface{}{}
-	if !data.Nsid.IsNull() {
+	if !data.Nsid.IsNull() && !data.Nsid.IsUnknown() {
 		params["nsid"] = data.Nsid.ValueInt64()
 	}
-	if !data.DeviceType.IsNull() {
+	if !data.DeviceType.IsNull() && !data.DeviceType.IsUnknown() {
 		params["device_type"] = data.DeviceType.ValueString()
 	}
-	if !data.DevicePath.IsNull() {
+	if !data.DevicePath.IsNull() && !data.DevicePath.IsUnknown() {
 		params["device_path"] = data.DevicePath.ValueString()
 	}
-	if !data.Filesize.IsNull() {
+	if !data.Filesize.IsNull() && !data.Filesize.IsUnknown() {
 		params["filesize"] = data.Filesize.ValueInt64()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.SubsysId.IsNull() {
+	if !data.SubsysId.IsNull() && !data.SubsysId.IsUnknown() {
 		params["subsys_id"] = data.SubsysId.ValueInt64()
 	}
 
@@ -134,6 +151,7 @@ func (r *NvmetNamespaceResource) Create(ctx context.Context, req resource.Create
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -236,32 +254,33 @@ func (r *NvmetNamespaceResource) Update(ctx context.Context, req resource.Update
 	}
 
 	params := map[string]interface{}{}
-	if !data.Nsid.IsNull() {
+	if !data.Nsid.IsNull() && !data.Nsid.IsUnknown() {
 		params["nsid"] = data.Nsid.ValueInt64()
 	}
-	if !data.DeviceType.IsNull() {
+	if !data.DeviceType.IsNull() && !data.DeviceType.IsUnknown() {
 		params["device_type"] = data.DeviceType.ValueString()
 	}
-	if !data.DevicePath.IsNull() {
+	if !data.DevicePath.IsNull() && !data.DevicePath.IsUnknown() {
 		params["device_path"] = data.DevicePath.ValueString()
 	}
-	if !data.Filesize.IsNull() {
+	if !data.Filesize.IsNull() && !data.Filesize.IsUnknown() {
 		params["filesize"] = data.Filesize.ValueInt64()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.SubsysId.IsNull() {
+	if !data.SubsysId.IsNull() && !data.SubsysId.IsUnknown() {
 		params["subsys_id"] = data.SubsysId.ValueInt64()
 	}
 
-	_, err = r.client.Call("nvmet.namespace.update", []interface{}{id, params})
+	result, err := r.client.Call("nvmet.namespace.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update nvmet_namespace: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -287,3 +306,47 @@ func (r *NvmetNamespaceResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *NvmetNamespaceResource) setComputed(result interface{}, data *NvmetNamespaceResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Nsid.IsUnknown() {
+		data.Nsid = types.Int64Null()
+		if v, ok := resultMap["nsid"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Nsid = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Nsid = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Filesize.IsUnknown() {
+		data.Filesize = types.Int64Null()
+		if v, ok := resultMap["filesize"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Filesize = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Filesize = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+}
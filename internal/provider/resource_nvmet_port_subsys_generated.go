@@ -34,8 +34,13 @@ func (r *NvmetPortSubsysResource) ImportState(ctx context.Context, req resource.
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *NvmetPortSubsysResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *NvmetPortSubsysResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create an association between a `port` and a subsystem (`subsys`).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -73,10 +78,10 @@ func (r *NvmetPortSubsysResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	params := map[string]interface{}{}
-	if !data.PortId.IsNull() {
+	if !data.PortId.IsNull() && !data.PortId.IsUnknown() {
 		params["port_id"] = data.PortId.ValueInt64()
 	}
-	if !data.SubsysId.IsNull() {
+	if !data.SubsysId.IsNull() && !data.SubsysId.IsUnknown() {
 		params["subsys_id"] = data.SubsysId.ValueInt64()
 	}
 
@@ -98,6 +103,7 @@ func (r *NvmetPortSubsysResource) Create(ctx context.Context, req resource.Creat
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -188,20 +194,21 @@ func (r *NvmetPortSubsysResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	params := map[string]interface{}{}
-	if !data.PortId.IsNull() {
+	if !data.PortId.IsNull() && !data.PortId.IsUnknown() {
 		params["port_id"] = data.PortId.ValueInt64()
 	}
-	if !data.SubsysId.IsNull() {
+	if !data.SubsysId.IsNull() && !data.SubsysId.IsUnknown() {
 		params["subsys_id"] = data.SubsysId.ValueInt64()
 	}
 
-	_, err = r.client.Call("nvmet.port_subsys.update", []interface{}{id, params})
+	result, err := r.client.Call("nvmet.port_subsys.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update nvmet_port_subsys: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -226,3 +233,9 @@ func (r *NvmetPortSubsysResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *NvmetPortSubsysResource) setComputed(result interface{}, data *NvmetPortSubsysResourceModel) {
+	_ = result // No computed fields
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -33,14 +37,30 @@ func NewNvmetSubsysResource() resource.Resource {
 
 func (r *NvmetSubsysResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_nvmet_subsys"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *NvmetSubsysResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "nvmet.subsys.query", nvmetSubsysIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &NvmetSubsysResource{}
+
+// nvmetSubsysIdentity lists the API fields that identify a nvmet_subsys
+var nvmetSubsysIdentity = []identityKey{{Name: "name"}}
+
+func (r *NvmetSubsysResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(nvmetSubsysIdentity)
+}
+
+func (r *NvmetSubsysResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *NvmetSubsysResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a NVMe target subsystem (`subsys`).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -50,34 +70,46 @@ func (r *NvmetSubsysResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "Human readable name for the subsystem.  If `subnqn` is not provided on creation, then this name will",
 			},
 			"subnqn": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "NVMe Qualified Name (NQN) for the subsystem.  Must be a valid NQN format if provided.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "NVMe Qualified Name (NQN) for the subsystem.  Must be a valid NQN format if provided.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"allow_any_host": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Any host can access the storage associated with this subsystem (i.e. no access control).",
+				Default:     booldefault.StaticBool(false),
 			},
 			"pi_enable": schema.BoolAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Enable Protection Information (PI) for data integrity checking.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable Protection Information (PI) for data integrity checking.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
 			"qid_max": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Maximum number of queue IDs allowed for this subsystem.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum number of queue IDs allowed for this subsystem.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"ieee_oui": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "IEEE Organizationally Unique Identifier for the subsystem.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "IEEE Organizationally Unique Identifier for the subsystem.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"ana": schema.BoolAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "If set to either `True` or `False`, then *override* the global `ana` setting from `nvmet.global.conf",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "If set to either `True` or `False`, then *override* the global `ana` setting from `nvmet.global.conf",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
 		},
 	}
@@ -103,25 +135,25 @@ func (r *NvmetSubsysResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Subnqn.IsNull() {
+	if !data.Subnqn.IsNull() && !data.Subnqn.IsUnknown() {
 		params["subnqn"] = data.Subnqn.ValueString()
 	}
-	if !data.AllowAnyHost.IsNull() {
+	if !data.AllowAnyHost.IsNull() && !data.AllowAnyHost.IsUnknown() {
 		params["allow_any_host"] = data.AllowAnyHost.ValueBool()
 	}
-	if !data.PiEnable.IsNull() {
+	if !data.PiEnable.IsNull() && !data.PiEnable.IsUnknown() {
 		params["pi_enable"] = data.PiEnable.ValueBool()
 	}
-	if !data.QidMax.IsNull() {
+	if !data.QidMax.IsNull() && !data.QidMax.IsUnknown() {
 		params["qid_max"] = data.QidMax.ValueInt64()
 	}
-	if !data.IeeeOui.IsNull() {
+	if !data.IeeeOui.IsNull() && !data.IeeeOui.IsUnknown() {
 		params["ieee_oui"] = data.IeeeOui.ValueString()
 	}
-	if !data.Ana.IsNull() {
+	if !data.Ana.IsNull() && !data.Ana.IsUnknown() {
 		params["ana"] = data.Ana.ValueBool()
 	}
 
@@ -143,6 +175,8 @@ func (r *NvmetSubsysResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, nvmetSubsysIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -195,6 +229,7 @@ func (r *NvmetSubsysResource) Read(ctx context.Context, req resource.ReadRequest
 			data.Name = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, nvmetSubsysIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -221,35 +256,37 @@ func (r *NvmetSubsysResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Subnqn.IsNull() {
+	if !data.Subnqn.IsNull() && !data.Subnqn.IsUnknown() {
 		params["subnqn"] = data.Subnqn.ValueString()
 	}
-	if !data.AllowAnyHost.IsNull() {
+	if !data.AllowAnyHost.IsNull() && !data.AllowAnyHost.IsUnknown() {
 		params["allow_any_host"] = data.AllowAnyHost.ValueBool()
 	}
-	if !data.PiEnable.IsNull() {
+	if !data.PiEnable.IsNull() && !data.PiEnable.IsUnknown() {
 		params["pi_enable"] = data.PiEnable.ValueBool()
 	}
-	if !data.QidMax.IsNull() {
+	if !data.QidMax.IsNull() && !data.QidMax.IsUnknown() {
 		params["qid_max"] = data.QidMax.ValueInt64()
 	}
-	if !data.IeeeOui.IsNull() {
+	if !data.IeeeOui.IsNull() && !data.IeeeOui.IsUnknown() {
 		params["ieee_oui"] = data.IeeeOui.ValueString()
 	}
-	if !data.Ana.IsNull() {
+	if !data.Ana.IsNull() && !data.Ana.IsUnknown() {
 		params["ana"] = data.Ana.ValueBool()
 	}
 
-	_, err = r.client.Call("nvmet.subsys.update", []interface{}{id, params})
+	result, err := r.client.Call("nvmet.subsys.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update nvmet_subsys: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, nvmetSubsysIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -275,3 +312,78 @@ func (r *NvmetSubsysResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *NvmetSubsysResource) setComputed(result interface{}, data *NvmetSubsysResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Subnqn.IsUnknown() {
+		data.Subnqn = types.StringNull()
+		if v, ok := resultMap["subnqn"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Subnqn = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Subnqn = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Subnqn = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.AllowAnyHost.IsUnknown() {
+		data.AllowAnyHost = types.BoolNull()
+		if v, ok := resultMap["allow_any_host"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.AllowAnyHost = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.PiEnable.IsUnknown() {
+		data.PiEnable = types.BoolNull()
+		if v, ok := resultMap["pi_enable"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.PiEnable = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.QidMax.IsUnknown() {
+		data.QidMax = types.Int64Null()
+		if v, ok := resultMap["qid_max"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.QidMax = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.QidMax = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.IeeeOui.IsUnknown() {
+		data.IeeeOui = types.StringNull()
+		if v, ok := resultMap["ieee_oui"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.IeeeOui = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.IeeeOui = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.IeeeOui = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Ana.IsUnknown() {
+		data.Ana = types.BoolNull()
+		if v, ok := resultMap["ana"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Ana = types.BoolValue(bv)
+			}
+		}
+	}
+}
@@ -0,0 +1,756 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PoolResource manages a ZFS pool. Changes to the typed topology are turned
+// into pool.attach, pool.replace, pool.detach and pool.remove jobs and
+// pool.update extensions, so vdevs can be grown or swapped in place. With
+// import_guid or import_name an exported pool is imported instead of created,
+// and destroy exports the pool.
+type PoolResource struct {
+	client *client.Client
+}
+
+var (
+	_ resource.ResourceWithModifyPlan       = &PoolResource{}
+	_ resource.ResourceWithConfigValidators = &PoolResource{}
+	_ resource.ResourceWithUpgradeState     = &PoolResource{}
+	_ resource.ResourceWithIdentity         = &PoolResource{}
+)
+
+type PoolResourceModel struct {
+	ID                    types.String       `tfsdk:"id"`
+	Name                  types.String       `tfsdk:"name"`
+	Encryption            types.Bool         `tfsdk:"encryption"`
+	DedupTableQuota       types.String       `tfsdk:"dedup_table_quota"`
+	DedupTableQuotaValue  types.Int64        `tfsdk:"dedup_table_quota_value"`
+	Deduplication         types.String       `tfsdk:"deduplication"`
+	Checksum              types.String       `tfsdk:"checksum"`
+	EncryptionOptions     types.String       `tfsdk:"encryption_options"`
+	Topology              *PoolTopologyModel `tfsdk:"topology"`
+	AllowDuplicateSerials types.Bool         `tfsdk:"allow_duplicate_serials"`
+	Autotrim              types.String       `tfsdk:"autotrim"`
+	ImportGUID            types.String       `tfsdk:"import_guid"`
+	ImportName            types.String       `tfsdk:"import_name"`
+	DestroyOnDelete       types.Bool         `tfsdk:"destroy_on_delete"`
+	CascadeOnDelete       types.Bool         `tfsdk:"cascade_on_delete"`
+	Timeouts              timeouts.Value     `tfsdk:"timeouts"`
+}
+
+type PoolTopologyModel struct {
+	Data    []PoolVdevModel `tfsdk:"data"`
+	Special []PoolVdevModel `tfsdk:"special"`
+	Dedup   []PoolVdevModel `tfsdk:"dedup"`
+	Log     []PoolVdevModel `tfsdk:"log"`
+	Cache   types.Set       `tfsdk:"cache"`
+	Spares  types.Set       `tfsdk:"spares"`
+}
+
+type PoolVdevModel struct {
+	Type  types.String `tfsdk:"type"`
+	Disks types.Set    `tfsdk:"disks"`
+}
+
+func NewPoolResource() resource.Resource {
+	return &PoolResource{}
+}
+
+func (r *PoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pool"
+}
+
+func (r *PoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importByIdentity(ctx, r.client, "pool.query", poolIdentity, req, resp)
+}
+
+// poolIdentity lists the API fields that identify a pool
+var poolIdentity = []identityKey{{Name: "name"}}
+
+func (r *PoolResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(poolIdentity)
+}
+
+func (r *PoolResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradePoolStateV0},
+	}
+}
+
+// upgradePoolStateV0 migrates state from before the typed topology. Version 0
+// stored topology as a string, usually not even JSON once refreshed, so it is
+// dropped and read back from the pool on the next refresh.
+func upgradePoolStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState != nil && req.RawState.JSON != nil {
+		var prior map[string]json.RawMessage
+		if err := json.Unmarshal(req.RawState.JSON, &prior); err == nil {
+			delete(prior, "topology")
+			if b, err := json.Marshal(prior); err == nil {
+				req.RawState.JSON = b
+			}
+		}
+	}
+	upgradeStateFromJSON(ctx, req, resp)
+}
+
+func (r *PoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	vdevBlock := func(desc string, vdevTypes ...string) schema.SetNestedBlock {
+		return schema.SetNestedBlock{
+			MarkdownDescription: desc,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Vdev type: `" + strings.Join(vdevTypes, "`, `") + "`.",
+						Validators:          []validator.String{stringvalidator.OneOf(vdevTypes...)},
+					},
+					"disks": schema.SetAttribute{
+						Required:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Disks of the vdev, e.g. `[\"sda\", \"sdb\"]`. A disk that went missing is reported by its ZFS guid.",
+						Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+					},
+				},
+			},
+		}
+	}
+	diskSet := func(desc string) schema.SetAttribute {
+		return schema.SetAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: desc,
+			Validators:          []validator.Set{setvalidator.SizeAtLeast(1)},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Create a new ZFS Pool, or import an exported one. Changes to `topology` attach, replace, detach or remove disks and add vdevs in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name for the new storage pool.",
+			},
+			"encryption": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "If set, create a ZFS encrypted root dataset for this pool.",
+				Default:       booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"dedup_table_quota": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How to manage the deduplication table quota allocation.",
+				Default:     stringdefault.StaticString("AUTO"),
+				Validators:  []validator.String{stringvalidator.OneOf("AUTO", "CUSTOM")},
+			},
+			"dedup_table_quota_value": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Custom quota value in bytes when `dedup_table_quota` is set to CUSTOM.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"deduplication": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Make sure no block of data is duplicated in the pool. If set to `VERIFY` and two blocks have similar",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("ON", "VERIFY", "OFF")},
+			},
+			"checksum": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Checksum algorithm to use for data integrity verification.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("ON", "OFF", "FLETCHER2", "FLETCHER4", "SHA256", "SHA512", "SKEIN", "EDONR", "BLAKE3")},
+			},
+			"encryption_options": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Specify configuration for encryption of root dataset.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"allow_duplicate_serials": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to allow disks with duplicate serial numbers in the pool.",
+				Default:     booldefault.StaticBool(false),
+			},
+			"autotrim": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether to enable automatic TRIM operations on the pool.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"import_guid": schema.StringAttribute{
+				Optional:      true,
+				Description:   "Import the exported pool with this guid, as listed by `pool.import_find`, instead of creating a pool. `name` is the name it gets once imported.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"import_name": schema.StringAttribute{
+				Optional:      true,
+				Description:   "Import the exported pool with this name instead of creating a pool. Use `import_guid` when several exported pools share the name.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"destroy_on_delete": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, destroy the pool and the data on its disks. By default the pool is only exported, so it can be imported again.",
+			},
+			"cascade_on_delete": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, also delete the shares, tasks and other configuration that use the pool.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"topology": schema.SingleNestedBlock{
+				MarkdownDescription: "Vdev layout of the pool. Vdevs are matched to the pool by the disks they share: swapped disks are replaced, extra disks attached, missing disks detached, and vdevs added or removed. Required unless the pool is imported; an imported pool without it keeps the layout it has.",
+				Blocks: map[string]schema.Block{
+					"data":    vdevBlock("Data vdevs. At least one is required.", "STRIPE", "MIRROR", "RAIDZ1", "RAIDZ2", "RAIDZ3"),
+					"special": vdevBlock("Special allocation class vdevs for metadata and small blocks.", "STRIPE", "MIRROR"),
+					"dedup":   vdevBlock("Vdevs dedicated to the deduplication table.", "STRIPE", "MIRROR"),
+					"log":     vdevBlock("Separate intent log (SLOG) vdevs.", "STRIPE", "MIRROR"),
+				},
+				Attributes: map[string]schema.Attribute{
+					"cache":  diskSet("L2ARC cache disks."),
+					"spares": diskSet("Hot spare disks."),
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+func (r *PoolResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("topology"),
+			path.MatchRoot("import_guid"),
+			path.MatchRoot("import_name"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("import_guid"),
+			path.MatchRoot("import_name"),
+		),
+	}
+}
+
+func (r *PoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan checks the planned topology, and that the change from the last
+// known one is something ZFS can do in place
+func (r *PoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan *PoolTopologyModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("topology"), &plan)...)
+	if resp.Diagnostics.HasError() || plan == nil {
+		return
+	}
+	planned, known := plan.layout(ctx)
+	if !known {
+		return
+	}
+	if err := planned.validate(); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("topology"), "Invalid Pool Topology", err.Error())
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state *PoolTopologyModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("topology"), &state)...)
+	if resp.Diagnostics.HasError() || state == nil {
+		return
+	}
+	current, _ := state.layout(ctx)
+	if _, err := diffPoolLayout(current, planned); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("topology"), "Unsupported Pool Topology Change", err.Error())
+	}
+}
+
+func (r *PoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ImportGUID.IsNull() || !data.ImportName.IsNull() {
+		r.importPool(ctx, &data, resp)
+		return
+	}
+
+	params := map[string]interface{}{}
+	setString(params, "name", data.Name)
+	setBool(params, "encryption", data.Encryption)
+	setString(params, "dedup_table_quota", data.DedupTableQuota)
+	if !data.DedupTableQuotaValue.IsNull() && !data.DedupTableQuotaValue.IsUnknown() {
+		params["dedup_table_quota_value"] = data.DedupTableQuotaValue.ValueInt64()
+	}
+	setString(params, "deduplication", data.Deduplication)
+	setString(params, "checksum", data.Checksum)
+	if err := setJSON(params, "encryption_options", data.EncryptionOptions); err != nil {
+		resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse encryption_options: %s", err))
+		return
+	}
+	if data.Topology != nil {
+		layout, _ := data.Topology.layout(ctx)
+		params["topology"] = layout.params()
+	}
+	setBool(params, "allow_duplicate_serials", data.AllowDuplicateSerials)
+	setString(params, "autotrim", data.Autotrim)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithJobTimeout("pool.create", params, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to create pool: %s", err))
+		return
+	}
+
+	// Extract ID from result
+	if resultMap, ok := result.(map[string]interface{}); ok {
+		if id, exists := resultMap["id"]; exists && id != nil {
+			data.ID = types.StringValue(fmt.Sprintf("%v", id))
+		}
+	}
+
+	// Validate ID was set
+	if data.ID.IsNull() || data.ID.ValueString() == "" {
+		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
+		return
+	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolIdentity, result)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// importPool imports the exported pool chosen by import_guid or import_name,
+// then applies the rest of the plan to it like an update
+func (r *PoolResource) importPool(ctx context.Context, data *PoolResourceModel, resp *resource.CreateResponse) {
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deadline := time.Now().Add(createTimeout)
+
+	found, err := r.client.CallWithJobTimeout("pool.import_find", []interface{}{}, createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to list pools to import: %s", err))
+		return
+	}
+	guid, name, err := findImportablePool(found, data.ImportGUID.ValueString(), data.ImportName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", err.Error())
+		return
+	}
+
+	options := map[string]interface{}{"guid": guid}
+	if data.Name.ValueString() != name {
+		options["name"] = data.Name.ValueString()
+	}
+	if _, err := r.client.CallWithJobTimeout("pool.import_pool", []interface{}{options}, time.Until(deadline)); err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to import pool %s: %s", name, err))
+		return
+	}
+
+	pool, err := queryMatch(r.client, "pool.query", []interface{}{[]interface{}{"name", "=", data.Name.ValueString()}})
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to find pool %s after importing it: %s", data.Name.ValueString(), err))
+		return
+	}
+	poolMap, _ := pool.(map[string]interface{})
+	id, ok := poolMap["id"].(float64)
+	if !ok {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unexpected pool.query response: %v", pool))
+		return
+	}
+	data.ID = types.StringValue(strconv.Itoa(int(id)))
+
+	result, err := r.apply(ctx, int(id), *data, deadline)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Pool %s was imported, but updating it failed: %s", data.Name.ValueString(), err))
+		return
+	}
+	r.setComputed(result, data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolIdentity, result)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+// findImportablePool picks a pool from the pool.import_find result by guid,
+// or else by name, and returns its guid and name
+func findImportablePool(found interface{}, guid, name string) (string, string, error) {
+	pools, _ := found.([]interface{})
+	var available []string
+	var matches [][2]string
+	for _, p := range pools {
+		pool, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		g, n := fmt.Sprint(pool["guid"]), fmt.Sprint(pool["name"])
+		available = append(available, fmt.Sprintf("%s (guid %s)", n, g))
+		if (guid != "" && g == guid) || (guid == "" && n == name) {
+			matches = append(matches, [2]string{g, n})
+		}
+	}
+
+	wanted := "named " + name
+	if guid != "" {
+		wanted = "with guid " + guid
+	}
+	switch {
+	case len(matches) == 1:
+		return matches[0][0], matches[0][1], nil
+	case len(matches) > 1:
+		return "", "", fmt.Errorf("%d exported pools are %s; choose one with import_guid: %s", len(matches), wanted, strings.Join(available, ", "))
+	case len(available) == 0:
+		return "", "", fmt.Errorf("no exported pool %s found; there are no pools to import", wanted)
+	}
+	return "", "", fmt.Errorf("no exported pool %s found; pools available to import: %s", wanted, strings.Join(available, ", "))
+}
+
+func (r *PoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Cannot parse ID: %s", err))
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, r.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithTimeout("pool.get_instance", id, readTimeout)
+	if err != nil {
+		// Check if resource was deleted outside Terraform (ENOENT = entity not found)
+		if strings.Contains(err.Error(), "[ENOENT]") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read pool: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+	managed := data.Topology != nil || (data.ImportGUID.IsNull() && data.ImportName.IsNull())
+	resp.Diagnostics.Append(setPoolState(ctx, resultMap, &data)...)
+	if !managed {
+		// Imported without a topology block: the layout is left as it is
+		data.Topology = nil
+	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolIdentity, result)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state PoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Cannot parse ID: %s", err))
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.apply(ctx, id, data, time.Now().Add(updateTimeout))
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update pool: %s", err))
+		return
+	}
+
+	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolIdentity, result)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply brings the pool in line with the plan: the topology, when managed,
+// then the settings pool.update takes
+func (r *PoolResource) apply(ctx context.Context, id int, data PoolResourceModel, deadline time.Time) (interface{}, error) {
+	params := map[string]interface{}{}
+	setString(params, "dedup_table_quota", data.DedupTableQuota)
+	if !data.DedupTableQuotaValue.IsNull() && !data.DedupTableQuotaValue.IsUnknown() {
+		params["dedup_table_quota_value"] = data.DedupTableQuotaValue.ValueInt64()
+	}
+	setBool(params, "allow_duplicate_serials", data.AllowDuplicateSerials)
+	setString(params, "autotrim", data.Autotrim)
+
+	if data.Topology != nil {
+		extend, err := r.changeTopology(ctx, id, data, deadline)
+		if err != nil {
+			return nil, err
+		}
+		if len(extend) > 0 {
+			params["topology"] = extend.params()
+		}
+	}
+	return r.client.CallWithJobTimeout("pool.update", []interface{}{id, params}, time.Until(deadline))
+}
+
+// changeTopology runs the jobs that bring the pool's vdevs in line with the
+// plan, diffing against the pool as it is now for the guids the jobs take.
+// It returns the vdevs still to be added through pool.update.
+func (r *PoolResource) changeTopology(ctx context.Context, id int, data PoolResourceModel, deadline time.Time) (poolLayout, error) {
+	result, err := r.client.Call("pool.get_instance", id)
+	if err != nil {
+		return nil, err
+	}
+	resultMap, _ := result.(map[string]interface{})
+	topology, _ := resultMap["topology"].(map[string]interface{})
+
+	planned, _ := data.Topology.layout(ctx)
+	change, err := diffPoolLayout(poolLayoutFromAPI(topology), planned)
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range change.Ops {
+		if op.Method == "pool.attach" {
+			op.Options["allow_duplicate_serials"] = data.AllowDuplicateSerials.ValueBool()
+		}
+		if _, err := r.client.CallWithJobTimeout(op.Method, []interface{}{id, op.Options}, time.Until(deadline)); err != nil {
+			return nil, fmt.Errorf("unable to %s: %s", op.Summary, err)
+		}
+	}
+	return change.Extend, nil
+}
+
+func (r *PoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.Atoi(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Cannot parse ID: %s", err))
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Exported rather than destroyed unless asked, so the data survives
+	options := map[string]interface{}{
+		"destroy": data.DestroyOnDelete.ValueBool(),
+		"cascade": data.CascadeOnDelete.ValueBool(),
+	}
+	_, err = r.client.CallWithJobTimeout("pool.export", []interface{}{id, options}, deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to export pool: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *PoolResource) setComputed(result interface{}, data *PoolResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Encryption.IsUnknown() {
+		data.Encryption = boolOrNull(resultMap["encryption"])
+	}
+	if data.DedupTableQuota.IsUnknown() {
+		data.DedupTableQuota = stringOrNull(resultMap["dedup_table_quota"])
+	}
+	if data.DedupTableQuotaValue.IsUnknown() {
+		data.DedupTableQuotaValue = types.Int64Null()
+		if v, ok := resultMap["dedup_table_quota_value"].(float64); ok {
+			data.DedupTableQuotaValue = types.Int64Value(int64(v))
+		}
+	}
+	if data.Deduplication.IsUnknown() {
+		data.Deduplication = stringOrNull(resultMap["deduplication"])
+	}
+	if data.Checksum.IsUnknown() {
+		data.Checksum = stringOrNull(resultMap["checksum"])
+	}
+	if data.EncryptionOptions.IsUnknown() {
+		data.EncryptionOptions = jsonOrNull(resultMap["encryption_options"])
+	}
+	if data.AllowDuplicateSerials.IsUnknown() {
+		data.AllowDuplicateSerials = boolOrNull(resultMap["allow_duplicate_serials"])
+	}
+	if data.Autotrim.IsUnknown() {
+		data.Autotrim = types.StringNull()
+		switch v := resultMap["autotrim"].(type) {
+		case string:
+			data.Autotrim = types.StringValue(v)
+		case map[string]interface{}:
+			// Reported as a ZFS property
+			if value, ok := v["value"]; ok && value != nil {
+				data.Autotrim = types.StringValue(fmt.Sprintf("%v", value))
+			}
+		}
+	}
+}
+
+// setPoolState copies a pool from pool.query or pool.get_instance into data
+func setPoolState(ctx context.Context, resultMap map[string]interface{}, data *PoolResourceModel) diag.Diagnostics {
+	if v, ok := resultMap["id"]; ok && v != nil {
+		data.ID = types.StringValue(fmt.Sprintf("%v", v))
+	}
+	if v, ok := resultMap["name"].(string); ok {
+		data.Name = types.StringValue(v)
+	}
+	topology, ok := resultMap["topology"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var diags diag.Diagnostics
+	data.Topology, diags = poolTopologyModel(ctx, poolLayoutFromAPI(topology))
+	return diags
+}
+
+// layout converts the topology block. It reports false when a disk or type
+// isn't known until apply.
+func (t *PoolTopologyModel) layout(ctx context.Context) (poolLayout, bool) {
+	layout := poolLayout{}
+	known := true
+	vdevs := func(category string, models []PoolVdevModel) {
+		for _, m := range models {
+			var disks []string
+			if m.Type.IsUnknown() || m.Disks.IsUnknown() || m.Disks.ElementsAs(ctx, &disks, false).HasError() {
+				known = false
+				continue
+			}
+			sort.Strings(disks)
+			layout[category] = append(layout[category], poolVdev{Type: m.Type.ValueString(), Disks: disks})
+		}
+	}
+	singles := func(category string, set types.Set) {
+		var disks []string
+		if set.IsUnknown() || set.ElementsAs(ctx, &disks, false).HasError() {
+			known = false
+			return
+		}
+		sort.Strings(disks)
+		for _, disk := range disks {
+			layout[category] = append(layout[category], poolVdev{Type: "STRIPE", Disks: []string{disk}})
+		}
+	}
+	vdevs("data", t.Data)
+	vdevs("special", t.Special)
+	vdevs("dedup", t.Dedup)
+	vdevs("log", t.Log)
+	singles("cache", t.Cache)
+	singles("spares", t.Spares)
+	return layout, known
+}
+
+// poolTopologyModel converts a layout to the topology block. Classes without
+// vdevs are empty blocks, and null cache and spares, as when left out of the
+// configuration.
+func poolTopologyModel(ctx context.Context, layout poolLayout) (*PoolTopologyModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	vdevs := func(category string) []PoolVdevModel {
+		models := []PoolVdevModel{}
+		for _, v := range layout[category] {
+			disks, d := types.SetValueFrom(ctx, types.StringType, v.Disks)
+			diags.Append(d...)
+			models = append(models, PoolVdevModel{Type: types.StringValue(v.Type), Disks: disks})
+		}
+		return models
+	}
+	singles := func(category string) types.Set {
+		if len(layout[category]) == 0 {
+			return types.SetNull(types.StringType)
+		}
+		var disks []string
+		for _, v := range layout[category] {
+			disks = append(disks, v.Disks...)
+		}
+		set, d := types.SetValueFrom(ctx, types.StringType, disks)
+		diags.Append(d...)
+		return set
+	}
+	return &PoolTopologyModel{
+		Data:    vdevs("data"),
+		Special: vdevs("special"),
+		Dedup:   vdevs("dedup"),
+		Log:     vdevs("log"),
+		Cache:   singles("cache"),
+		Spares:  singles("spares"),
+	}, diags
+}
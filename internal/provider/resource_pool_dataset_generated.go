@@ -5,12 +5,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strings"
 )
@@ -70,11 +79,25 @@ func (r *PoolDatasetResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 func (r *PoolDatasetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "pool.dataset.query", poolDatasetIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &PoolDatasetResource{}
+
+// poolDatasetIdentity lists the API fields that identify a pool_dataset
+var poolDatasetIdentity = []identityKey{{Name: "pool"}, {Name: "name"}}
+
+func (r *PoolDatasetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(poolDatasetIdentity)
+}
+
+func (r *PoolDatasetResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *PoolDatasetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Creates a dataset/zvol.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -84,199 +107,293 @@ func (r *PoolDatasetResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "The name of the dataset to create.",
 			},
 			"comments": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Comments or description for the dataset.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Comments or description for the dataset.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"sync": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Synchronous write behavior for the dataset.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Synchronous write behavior for the dataset.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("STANDARD", "ALWAYS", "DISABLED", "INHERIT")},
 			},
 			"snapdev": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Controls visibility of volume snapshots under /dev/zvol/.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Controls visibility of volume snapshots under /dev/zvol/.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("HIDDEN", "VISIBLE", "INHERIT")},
 			},
 			"compression": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Compression algorithm to use for the dataset. Higher numbered variants provide better compression   ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Compression algorithm to use for the dataset. Higher numbered variants provide better compression   ",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("ON", "OFF", "LZ4", "GZIP", "GZIP-1", "GZIP-9", "ZSTD", "ZSTD-FAST", "ZLE", "LZJB", "ZSTD-1", "ZSTD-2", "ZSTD-3", "ZSTD-4", "ZSTD-5", "ZSTD-6", "ZSTD-7", "ZSTD-8", "ZSTD-9", "ZSTD-10", "ZSTD-11", "ZSTD-12", "ZSTD-13", "ZSTD-14", "ZSTD-15", "ZSTD-16", "ZSTD-17", "ZSTD-18", "ZSTD-19", "ZSTD-FAST-1", "ZSTD-FAST-2", "ZSTD-FAST-3", "ZSTD-FAST-4", "ZSTD-FAST-5", "ZSTD-FAST-6", "ZSTD-FAST-7", "ZSTD-FAST-8", "ZSTD-FAST-9", "ZSTD-FAST-10", "ZSTD-FAST-20", "ZSTD-FAST-30", "ZSTD-FAST-40", "ZSTD-FAST-50", "ZSTD-FAST-60", "ZSTD-FAST-70", "ZSTD-FAST-80", "ZSTD-FAST-90", "ZSTD-FAST-100", "ZSTD-FAST-500", "ZSTD-FAST-1000", "INHERIT")},
 			},
 			"exec": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Whether files in this dataset can be executed.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether files in this dataset can be executed.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("ON", "OFF", "INHERIT")},
 			},
 			"managedby": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Identifies which service or system manages this dataset.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Identifies which service or system manages this dataset.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"quota_warning": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Percentage of dataset quota at which to issue a warning. 0-100 or 'INHERIT'.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Percentage of dataset quota at which to issue a warning. 0-100 or 'INHERIT'.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 100)},
 			},
 			"quota_critical": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Percentage of dataset quota at which to issue a critical alert. 0-100 or 'INHERIT'.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Percentage of dataset quota at which to issue a critical alert. 0-100 or 'INHERIT'.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 100)},
 			},
 			"refquota_warning": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Percentage of reference quota at which to issue a warning. 0-100 or 'INHERIT'.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Percentage of reference quota at which to issue a warning. 0-100 or 'INHERIT'.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 100)},
 			},
 			"refquota_critical": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Percentage of reference quota at which to issue a critical alert. 0-100 or 'INHERIT'.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Percentage of reference quota at which to issue a critical alert. 0-100 or 'INHERIT'.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 100)},
 			},
 			"reservation": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Minimum disk space guaranteed to this dataset and its children in bytes.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Minimum disk space guaranteed to this dataset and its children in bytes.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"refreservation": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Minimum disk space guaranteed to this dataset itself in bytes.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Minimum disk space guaranteed to this dataset itself in bytes.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"special_small_block_size": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Size threshold below which blocks are stored on special vdevs.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Size threshold below which blocks are stored on special vdevs.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"copies": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Number of copies of data blocks to maintain for redundancy.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Number of copies of data blocks to maintain for redundancy.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"snapdir": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Controls visibility of the `.zfs/snapshot` directory. 'DISABLED' hides snapshots, 'VISIBLE' shows th",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Controls visibility of the `.zfs/snapshot` directory. 'DISABLED' hides snapshots, 'VISIBLE' shows th",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("DISABLED", "VISIBLE", "HIDDEN", "INHERIT")},
 			},
 			"deduplication": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Deduplication setting. 'ON' enables dedup, 'VERIFY' enables with checksum verification, 'OFF' disabl",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Deduplication setting. 'ON' enables dedup, 'VERIFY' enables with checksum verification, 'OFF' disabl",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("ON", "VERIFY", "OFF", "INHERIT")},
 			},
 			"checksum": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Checksum algorithm to verify data integrity. Higher security algorithms like SHA256 provide better  ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Checksum algorithm to verify data integrity. Higher security algorithms like SHA256 provide better  ",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("ON", "OFF", "FLETCHER2", "FLETCHER4", "SHA256", "SHA512", "SKEIN", "EDONR", "BLAKE3", "INHERIT")},
 			},
 			"readonly": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Whether the dataset is read-only.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether the dataset is read-only.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("ON", "OFF", "INHERIT")},
 			},
 			"share_type": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Optimization type for the dataset based on its intended use.",
+				Default:       stringdefault.StaticString("GENERIC"),
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("GENERIC", "MULTIPROTOCOL", "NFS", "SMB", "APPS")},
 			},
 			"encryption_options": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Configuration for encryption of dataset for `name` pool.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"encryption": schema.BoolAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Create a ZFS encrypted root dataset for `name` pool. There is 1 case where ZFS encryption is not all",
+				Default:       booldefault.StaticBool(false),
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 			},
 			"inherit_encryption": schema.BoolAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Whether to inherit encryption settings from the parent dataset.",
+				Default:       booldefault.StaticBool(true),
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 			},
 			"user_properties": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Custom user-defined properties to set on the dataset.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Custom user-defined properties to set on the dataset.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"create_ancestors": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to create any missing parent datasets.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"type": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Type of dataset to create - volume (zvol).",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("FILESYSTEM", "VOLUME")},
 			},
 			"aclmode": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "How Access Control Lists are handled when chmod is used.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "How Access Control Lists are handled when chmod is used.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("PASSTHROUGH", "RESTRICTED", "DISCARD", "INHERIT"), stringvalidator.ConflictsWith(path.MatchRoot("force_size"), path.MatchRoot("sparse"), path.MatchRoot("volblocksize"), path.MatchRoot("volsize"))},
 			},
 			"acltype": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "The type of Access Control List system to use.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The type of Access Control List system to use.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("OFF", "NFSV4", "POSIX", "INHERIT"), stringvalidator.ConflictsWith(path.MatchRoot("force_size"), path.MatchRoot("sparse"), path.MatchRoot("volblocksize"), path.MatchRoot("volsize"))},
 			},
 			"atime": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Whether file access times are updated when files are accessed.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether file access times are updated when files are accessed.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("ON", "OFF", "INHERIT"), stringvalidator.ConflictsWith(path.MatchRoot("force_size"), path.MatchRoot("sparse"), path.MatchRoot("volblocksize"), path.MatchRoot("volsize"))},
 			},
 			"casesensitivity": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "File name case sensitivity setting.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("SENSITIVE", "INSENSITIVE", "INHERIT"), stringvalidator.ConflictsWith(path.MatchRoot("force_size"), path.MatchRoot("sparse"), path.MatchRoot("volblocksize"), path.MatchRoot("volsize"))},
 			},
 			"quota": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Maximum disk space this dataset and its children can consume in bytes.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum disk space this dataset and its children can consume in bytes.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.ConflictsWith(path.MatchRoot("force_size"), path.MatchRoot("sparse"), path.MatchRoot("volblocksize"), path.MatchRoot("volsize"))},
 			},
 			"refquota": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Maximum disk space this dataset itself can consume in bytes.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum disk space this dataset itself can consume in bytes.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.ConflictsWith(path.MatchRoot("force_size"), path.MatchRoot("sparse"), path.MatchRoot("volblocksize"), path.MatchRoot("volsize"))},
 			},
 			"recordsize": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "The suggested block size for files in this filesystem dataset.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The suggested block size for files in this filesystem dataset.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("force_size"), path.MatchRoot("sparse"), path.MatchRoot("volblocksize"), path.MatchRoot("volsize"))},
 			},
 			"force_size": schema.BoolAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Force creation even if the size is not optimal.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Force creation even if the size is not optimal.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.Bool{boolvalidator.ConflictsWith(path.MatchRoot("aclmode"), path.MatchRoot("acltype"), path.MatchRoot("atime"), path.MatchRoot("casesensitivity"), path.MatchRoot("quota"), path.MatchRoot("recordsize"), path.MatchRoot("refquota"))},
 			},
 			"sparse": schema.BoolAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Whether to use sparse (thin) provisioning for the volume.",
-				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown(), boolplanmodifier.RequiresReplace()},
+				Validators:    []validator.Bool{boolvalidator.ConflictsWith(path.MatchRoot("aclmode"), path.MatchRoot("acltype"), path.MatchRoot("atime"), path.MatchRoot("casesensitivity"), path.MatchRoot("quota"), path.MatchRoot("recordsize"), path.MatchRoot("refquota"))},
 			},
 			"volsize": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "The volume size in bytes; supposed to be a multiple of the block size.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The volume size in bytes; supposed to be a multiple of the block size.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.ConflictsWith(path.MatchRoot("aclmode"), path.MatchRoot("acltype"), path.MatchRoot("atime"), path.MatchRoot("casesensitivity"), path.MatchRoot("quota"), path.MatchRoot("recordsize"), path.MatchRoot("refquota"))},
 			},
 			"volblocksize": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Defaults to `128K` if the parent pool is a DRAID pool or `16K` otherwise.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("512", "512B", "1K", "2K", "4K", "8K", "16K", "32K", "64K", "128K"), stringvalidator.ConflictsWith(path.MatchRoot("aclmode"), path.MatchRoot("acltype"), path.MatchRoot("atime"), path.MatchRoot("casesensitivity"), path.MatchRoot("quota"), path.MatchRoot("recordsize"), path.MatchRoot("refquota"))},
 			},
 			"user_properties_update": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Array of user property updates to apply to the dataset.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Array of user property updates to apply to the dataset.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 		},
 	}
@@ -302,67 +419,67 @@ func (r *PoolDatasetResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Comments.IsNull() {
+	if !data.Comments.IsNull() && !data.Comments.IsUnknown() {
 		params["comments"] = data.Comments.ValueString()
 	}
-	if !data.Sync.IsNull() {
+	if !data.Sync.IsNull() && !data.Sync.IsUnknown() {
 		params["sync"] = data.Sync.ValueString()
 	}
-	if !data.Snapdev.IsNull() {
+	if !data.Snapdev.IsNull() && !data.Snapdev.IsUnknown() {
 		params["snapdev"] = data.Snapdev.ValueString()
 	}
-	if !data.Compression.IsNull() {
+	if !data.Compression.IsNull() && !data.Compression.IsUnknown() {
 		params["compression"] = data.Compression.ValueString()
 	}
-	if !data.Exec.IsNull() {
+	if !data.Exec.IsNull() && !data.Exec.IsUnknown() {
 		params["exec"] = data.Exec.ValueString()
 	}
-	if !data.Managedby.IsNull() {
+	if !data.Managedby.IsNull() && !data.Managedby.IsUnknown() {
 		params["managedby"] = data.Managedby.ValueString()
 	}
-	if !data.QuotaWarning.IsNull() {
+	if !data.QuotaWarning.IsNull() && !data.QuotaWarning.IsUnknown() {
 		params["quota_warning"] = data.QuotaWarning.ValueInt64()
 	}
-	if !data.QuotaCritical.IsNull() {
+	if !data.QuotaCritical.IsNull() && !data.QuotaCritical.IsUnknown() {
 		params["quota_critical"] = data.QuotaCritical.ValueInt64()
 	}
-	if !data.RefquotaWarning.IsNull() {
+	if !data.RefquotaWarning.IsNull() && !data.RefquotaWarning.IsUnknown() {
 		params["refquota_warning"] = data.RefquotaWarning.ValueInt64()
 	}
-	if !data.RefquotaCritical.IsNull() {
+	if !data.RefquotaCritical.IsNull() && !data.RefquotaCritical.IsUnknown() {
 		params["refquota_critical"] = data.RefquotaCritical.ValueInt64()
 	}
-	if !data.Reservation.IsNull() {
+	if !data.Reservation.IsNull() && !data.Reservation.IsUnknown() {
 		params["reservation"] = data.Reservation.ValueInt64()
 	}
-	if !data.Refreservation.IsNull() {
+	if !data.Refreservation.IsNull() && !data.Refreservation.IsUnknown() {
 		params["refreservation"] = data.Refreservation.ValueInt64()
 	}
-	if !data.SpecialSmallBlockSize.IsNull() {
+	if !data.SpecialSmallBlockSize.IsNull() && !data.SpecialSmallBlockSize.IsUnknown() {
 		params["special_small_block_size"] = data.SpecialSmallBlockSize.ValueInt64()
 	}
-	if !data.Copies.IsNull() {
+	if !data.Copies.IsNull() && !data.Copies.IsUnknown() {
 		params["copies"] = data.Copies.ValueInt64()
 	}
-	if !data.Snapdir.IsNull() {
+	if !data.Snapdir.IsNull() && !data.Snapdir.IsUnknown() {
 		params["snapdir"] = data.Snapdir.ValueString()
 	}
-	if !data.Deduplication.IsNull() {
+	if !data.Deduplication.IsNull() && !data.Deduplication.IsUnknown() {
 		params["deduplication"] = data.Deduplication.ValueString()
 	}
-	if !data.Checksum.IsNull() {
+	if !data.Checksum.IsNull() && !data.Checksum.IsUnknown() {
 		params["checksum"] = data.Checksum.ValueString()
 	}
-	if !data.Readonly.IsNull() {
+	if !data.Readonly.IsNull() && !data.Readonly.IsUnknown() {
 		params["readonly"] = data.Readonly.ValueString()
 	}
-	if !data.ShareType.IsNull() {
+	if !data.ShareType.IsNull() && !data.ShareType.IsUnknown() {
 		params["share_type"] = data.ShareType.ValueString()
 	}
-	if !data.EncryptionOptions.IsNull() {
+	if !data.EncryptionOptions.IsNull() && !data.EncryptionOptions.IsUnknown() {
 		var encryption_optionsObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.EncryptionOptions.ValueString()), &encryption_optionsObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse encryption_options: %s", err))
@@ -370,13 +487,13 @@ func (r *PoolDatasetResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["encryption_options"] = encryption_optionsObj
 	}
-	if !data.Encryption.IsNull() {
+	if !data.Encryption.IsNull() && !data.Encryption.IsUnknown() {
 		params["encryption"] = data.Encryption.ValueBool()
 	}
-	if !data.InheritEncryption.IsNull() {
+	if !data.InheritEncryption.IsNull() && !data.InheritEncryption.IsUnknown() {
 		params["inherit_encryption"] = data.InheritEncryption.ValueBool()
 	}
-	if !data.UserProperties.IsNull() {
+	if !data.UserProperties.IsNull() && !data.UserProperties.IsUnknown() {
 		var user_propertiesList []string
 		data.UserProperties.ElementsAs(ctx, &user_propertiesList, false)
 		var user_propertiesObjs []map[string]interface{}
@@ -390,46 +507,46 @@ func (r *PoolDatasetResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["user_properties"] = user_propertiesObjs
 	}
-	if !data.CreateAncestors.IsNull() {
+	if !data.CreateAncestors.IsNull() && !data.CreateAncestors.IsUnknown() {
 		params["create_ancestors"] = data.CreateAncestors.ValueBool()
 	}
-	if !data.Type.IsNull() {
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
 		params["type"] = data.Type.ValueString()
 	}
-	if !data.Aclmode.IsNull() {
+	if !data.Aclmode.IsNull() && !data.Aclmode.IsUnknown() {
 		params["aclmode"] = data.Aclmode.ValueString()
 	}
-	if !data.Acltype.IsNull() {
+	if !data.Acltype.IsNull() && !data.Acltype.IsUnknown() {
 		params["acltype"] = data.Acltype.ValueString()
 	}
-	if !data.Atime.IsNull() {
+	if !data.Atime.IsNull() && !data.Atime.IsUnknown() {
 		params["atime"] = data.Atime.ValueString()
 	}
-	if !data.Casesensitivity.IsNull() {
+	if !data.Casesensitivity.IsNull() && !data.Casesensitivity.IsUnknown() {
 		params["casesensitivity"] = data.Casesensitivity.ValueString()
 	}
-	if !data.Quota.IsNull() {
+	if !data.Quota.IsNull() && !data.Quota.IsUnknown() {
 		params["quota"] = data.Quota.ValueInt64()
 	}
-	if !data.Refquota.IsNull() {
+	if !data.Refquota.IsNull() && !data.Refquota.IsUnknown() {
 		params["refquota"] = data.Refquota.ValueInt64()
 	}
-	if !data.Recordsize.IsNull() {
+	if !data.Recordsize.IsNull() && !data.Recordsize.IsUnknown() {
 		params["recordsize"] = data.Recordsize.ValueString()
 	}
-	if !data.ForceSize.IsNull() {
+	if !data.ForceSize.IsNull() && !data.ForceSize.IsUnknown() {
 		params["force_size"] = data.ForceSize.ValueBool()
 	}
-	if !data.Sparse.IsNull() {
+	if !data.Sparse.IsNull() && !data.Sparse.IsUnknown() {
 		params["sparse"] = data.Sparse.ValueBool()
 	}
-	if !data.Volsize.IsNull() {
+	if !data.Volsize.IsNull() && !data.Volsize.IsUnknown() {
 		params["volsize"] = data.Volsize.ValueInt64()
 	}
-	if !data.Volblocksize.IsNull() {
+	if !data.Volblocksize.IsNull() && !data.Volblocksize.IsUnknown() {
 		params["volblocksize"] = data.Volblocksize.ValueString()
 	}
-	if !data.UserPropertiesUpdate.IsNull() {
+	if !data.UserPropertiesUpdate.IsNull() && !data.UserPropertiesUpdate.IsUnknown() {
 		var user_properties_updateList []string
 		data.UserPropertiesUpdate.ElementsAs(ctx, &user_properties_updateList, false)
 		var user_properties_updateObjs []map[string]interface{}
@@ -462,6 +579,8 @@ func (r *PoolDatasetResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolDatasetIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -522,6 +641,7 @@ func (r *PoolDatasetResource) Read(ctx context.Context, req resource.ReadRequest
 			data.Type = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolDatasetIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -544,61 +664,61 @@ func (r *PoolDatasetResource) Update(ctx context.Context, req resource.UpdateReq
 	id = state.ID.ValueString()
 
 	params := map[string]interface{}{}
-	if !data.Comments.IsNull() {
+	if !data.Comments.IsNull() && !data.Comments.IsUnknown() {
 		params["comments"] = data.Comments.ValueString()
 	}
-	if !data.Sync.IsNull() {
+	if !data.Sync.IsNull() && !data.Sync.IsUnknown() {
 		params["sync"] = data.Sync.ValueString()
 	}
-	if !data.Snapdev.IsNull() {
+	if !data.Snapdev.IsNull() && !data.Snapdev.IsUnknown() {
 		params["snapdev"] = data.Snapdev.ValueString()
 	}
-	if !data.Compression.IsNull() {
+	if !data.Compression.IsNull() && !data.Compression.IsUnknown() {
 		params["compression"] = data.Compression.ValueString()
 	}
-	if !data.Exec.IsNull() {
+	if !data.Exec.IsNull() && !data.Exec.IsUnknown() {
 		params["exec"] = data.Exec.ValueString()
 	}
-	if !data.Managedby.IsNull() {
+	if !data.Managedby.IsNull() && !data.Managedby.IsUnknown() {
 		params["managedby"] = data.Managedby.ValueString()
 	}
-	if !data.QuotaWarning.IsNull() {
+	if !data.QuotaWarning.IsNull() && !data.QuotaWarning.IsUnknown() {
 		params["quota_warning"] = data.QuotaWarning.ValueInt64()
 	}
-	if !data.QuotaCritical.IsNull() {
+	if !data.QuotaCritical.IsNull() && !data.QuotaCritical.IsUnknown() {
 		params["quota_critical"] = data.QuotaCritical.ValueInt64()
 	}
-	if !data.RefquotaWarning.IsNull() {
+	if !data.RefquotaWarning.IsNull() && !data.RefquotaWarning.IsUnknown() {
 		params["refquota_warning"] = data.RefquotaWarning.ValueInt64()
 	}
-	if !data.RefquotaCritical.IsNull() {
+	if !data.RefquotaCritical.IsNull() && !data.RefquotaCritical.IsUnknown() {
 		params["refquota_critical"] = data.RefquotaCritical.ValueInt64()
 	}
-	if !data.Reservation.IsNull() {
+	if !data.Reservation.IsNull() && !data.Reservation.IsUnknown() {
 		params["reservation"] = data.Reservation.ValueInt64()
 	}
-	if !data.Refreservation.IsNull() {
+	if !data.Refreservation.IsNull() && !data.Refreservation.IsUnknown() {
 		params["refreservation"] = data.Refreservation.ValueInt64()
 	}
-	if !data.SpecialSmallBlockSize.IsNull() {
+	if !data.SpecialSmallBlockSize.IsNull() && !data.SpecialSmallBlockSize.IsUnknown() {
 		params["special_small_block_size"] = data.SpecialSmallBlockSize.ValueInt64()
 	}
-	if !data.Copies.IsNull() {
+	if !data.Copies.IsNull() && !data.Copies.IsUnknown() {
 		params["copies"] = data.Copies.ValueInt64()
 	}
-	if !data.Snapdir.IsNull() {
+	if !data.Snapdir.IsNull() && !data.Snapdir.IsUnknown() {
 		params["snapdir"] = data.Snapdir.ValueString()
 	}
-	if !data.Deduplication.IsNull() {
+	if !data.Deduplication.IsNull() && !data.Deduplication.IsUnknown() {
 		params["deduplication"] = data.Deduplication.ValueString()
 	}
-	if !data.Checksum.IsNull() {
+	if !data.Checksum.IsNull() && !data.Checksum.IsUnknown() {
 		params["checksum"] = data.Checksum.ValueString()
 	}
-	if !data.Readonly.IsNull() {
+	if !data.Readonly.IsNull() && !data.Readonly.IsUnknown() {
 		params["readonly"] = data.Readonly.ValueString()
 	}
-	if !data.UserProperties.IsNull() {
+	if !data.UserProperties.IsNull() && !data.UserProperties.IsUnknown() {
 		var user_propertiesList []string
 		data.UserProperties.ElementsAs(ctx, &user_propertiesList, false)
 		var user_propertiesObjs []map[string]interface{}
@@ -612,34 +732,34 @@ func (r *PoolDatasetResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		params["user_properties"] = user_propertiesObjs
 	}
-	if !data.CreateAncestors.IsNull() {
+	if !data.CreateAncestors.IsNull() && !data.CreateAncestors.IsUnknown() {
 		params["create_ancestors"] = data.CreateAncestors.ValueBool()
 	}
-	if !data.ForceSize.IsNull() {
+	if !data.ForceSize.IsNull() && !data.ForceSize.IsUnknown() {
 		params["force_size"] = data.ForceSize.ValueBool()
 	}
-	if !data.Volsize.IsNull() {
+	if !data.Volsize.IsNull() && !data.Volsize.IsUnknown() {
 		params["volsize"] = data.Volsize.ValueInt64()
 	}
-	if !data.Aclmode.IsNull() {
+	if !data.Aclmode.IsNull() && !data.Aclmode.IsUnknown() {
 		params["aclmode"] = data.Aclmode.ValueString()
 	}
-	if !data.Acltype.IsNull() {
+	if !data.Acltype.IsNull() && !data.Acltype.IsUnknown() {
 		params["acltype"] = data.Acltype.ValueString()
 	}
-	if !data.Atime.IsNull() {
+	if !data.Atime.IsNull() && !data.Atime.IsUnknown() {
 		params["atime"] = data.Atime.ValueString()
 	}
-	if !data.Quota.IsNull() {
+	if !data.Quota.IsNull() && !data.Quota.IsUnknown() {
 		params["quota"] = data.Quota.ValueInt64()
 	}
-	if !data.Refquota.IsNull() {
+	if !data.Refquota.IsNull() && !data.Refquota.IsUnknown() {
 		params["refquota"] = data.Refquota.ValueInt64()
 	}
-	if !data.Recordsize.IsNull() {
+	if !data.Recordsize.IsNull() && !data.Recordsize.IsUnknown() {
 		params["recordsize"] = data.Recordsize.ValueString()
 	}
-	if !data.UserPropertiesUpdate.IsNull() {
+	if !data.UserPropertiesUpdate.IsNull() && !data.UserPropertiesUpdate.IsUnknown() {
 		var user_properties_updateList []string
 		data.UserPropertiesUpdate.ElementsAs(ctx, &user_properties_updateList, false)
 		var user_properties_updateObjs []map[string]interface{}
@@ -654,13 +774,15 @@ func (r *PoolDatasetResource) Update(ctx context.Context, req resource.UpdateReq
 		params["user_properties_update"] = user_properties_updateObjs
 	}
 
-	_, err = r.client.Call("pool.dataset.update", []interface{}{id, params})
+	result, err := r.client.Call("pool.dataset.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update pool_dataset: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolDatasetIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -681,3 +803,518 @@ func (r *PoolDatasetResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *PoolDatasetResource) setComputed(result interface{}, data *PoolDatasetResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Comments.IsUnknown() {
+		data.Comments = types.StringNull()
+		if v, ok := resultMap["comments"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Comments = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Comments = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Comments = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Sync.IsUnknown() {
+		data.Sync = types.StringNull()
+		if v, ok := resultMap["sync"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Sync = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Sync = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Sync = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Snapdev.IsUnknown() {
+		data.Snapdev = types.StringNull()
+		if v, ok := resultMap["snapdev"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Snapdev = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Snapdev = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Snapdev = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Compression.IsUnknown() {
+		data.Compression = types.StringNull()
+		if v, ok := resultMap["compression"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Compression = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Compression = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Compression = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Exec.IsUnknown() {
+		data.Exec = types.StringNull()
+		if v, ok := resultMap["exec"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Exec = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Exec = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Exec = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Managedby.IsUnknown() {
+		data.Managedby = types.StringNull()
+		if v, ok := resultMap["managedby"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Managedby = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Managedby = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Managedby = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.QuotaWarning.IsUnknown() {
+		data.QuotaWarning = types.Int64Null()
+		if v, ok := resultMap["quota_warning"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.QuotaWarning = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.QuotaWarning = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.QuotaCritical.IsUnknown() {
+		data.QuotaCritical = types.Int64Null()
+		if v, ok := resultMap["quota_critical"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.QuotaCritical = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.QuotaCritical = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.RefquotaWarning.IsUnknown() {
+		data.RefquotaWarning = types.Int64Null()
+		if v, ok := resultMap["refquota_warning"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.RefquotaWarning = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.RefquotaWarning = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.RefquotaCritical.IsUnknown() {
+		data.RefquotaCritical = types.Int64Null()
+		if v, ok := resultMap["refquota_critical"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.RefquotaCritical = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.RefquotaCritical = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Reservation.IsUnknown() {
+		data.Reservation = types.Int64Null()
+		if v, ok := resultMap["reservation"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Reservation = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Reservation = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Refreservation.IsUnknown() {
+		data.Refreservation = types.Int64Null()
+		if v, ok := resultMap["refreservation"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Refreservation = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Refreservation = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.SpecialSmallBlockSize.IsUnknown() {
+		data.SpecialSmallBlockSize = types.Int64Null()
+		if v, ok := resultMap["special_small_block_size"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.SpecialSmallBlockSize = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.SpecialSmallBlockSize = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Copies.IsUnknown() {
+		data.Copies = types.Int64Null()
+		if v, ok := resultMap["copies"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Copies = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Copies = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Snapdir.IsUnknown() {
+		data.Snapdir = types.StringNull()
+		if v, ok := resultMap["snapdir"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Snapdir = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Snapdir = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Snapdir = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Deduplication.IsUnknown() {
+		data.Deduplication = types.StringNull()
+		if v, ok := resultMap["deduplication"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Deduplication = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Deduplication = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Deduplication = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Checksum.IsUnknown() {
+		data.Checksum = types.StringNull()
+		if v, ok := resultMap["checksum"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Checksum = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Checksum = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Checksum = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Readonly.IsUnknown() {
+		data.Readonly = types.StringNull()
+		if v, ok := resultMap["readonly"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Readonly = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Readonly = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Readonly = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.ShareType.IsUnknown() {
+		data.ShareType = types.StringNull()
+		if v, ok := resultMap["share_type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.ShareType = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.ShareType = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.ShareType = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.EncryptionOptions.IsUnknown() {
+		data.EncryptionOptions = types.StringNull()
+		if v, ok := resultMap["encryption_options"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.EncryptionOptions = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.Encryption.IsUnknown() {
+		data.Encryption = types.BoolNull()
+		if v, ok := resultMap["encryption"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Encryption = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.InheritEncryption.IsUnknown() {
+		data.InheritEncryption = types.BoolNull()
+		if v, ok := resultMap["inherit_encryption"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.InheritEncryption = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.UserProperties.IsUnknown() {
+		data.UserProperties = types.ListNull(types.StringType)
+		if v, ok := resultMap["user_properties"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					b, _ := json.Marshal(item)
+					strVals[i] = types.StringValue(string(b))
+				}
+				data.UserProperties, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.CreateAncestors.IsUnknown() {
+		data.CreateAncestors = types.BoolNull()
+		if v, ok := resultMap["create_ancestors"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.CreateAncestors = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Type.IsUnknown() {
+		data.Type = types.StringNull()
+		if v, ok := resultMap["type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Type = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Type = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Type = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Aclmode.IsUnknown() {
+		data.Aclmode = types.StringNull()
+		if v, ok := resultMap["aclmode"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Aclmode = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Aclmode = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Aclmode = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Acltype.IsUnknown() {
+		data.Acltype = types.StringNull()
+		if v, ok := resultMap["acltype"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Acltype = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Acltype = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Acltype = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Atime.IsUnknown() {
+		data.Atime = types.StringNull()
+		if v, ok := resultMap["atime"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Atime = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Atime = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Atime = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Casesensitivity.IsUnknown() {
+		data.Casesensitivity = types.StringNull()
+		if v, ok := resultMap["casesensitivity"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Casesensitivity = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Casesensitivity = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Casesensitivity = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Quota.IsUnknown() {
+		data.Quota = types.Int64Null()
+		if v, ok := resultMap["quota"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Quota = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Quota = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Refquota.IsUnknown() {
+		data.Refquota = types.Int64Null()
+		if v, ok := resultMap["refquota"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Refquota = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Refquota = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Recordsize.IsUnknown() {
+		data.Recordsize = types.StringNull()
+		if v, ok := resultMap["recordsize"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Recordsize = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Recordsize = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Recordsize = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.ForceSize.IsUnknown() {
+		data.ForceSize = types.BoolNull()
+		if v, ok := resultMap["force_size"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.ForceSize = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Sparse.IsUnknown() {
+		data.Sparse = types.BoolNull()
+		if v, ok := resultMap["sparse"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Sparse = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Volsize.IsUnknown() {
+		data.Volsize = types.Int64Null()
+		if v, ok := resultMap["volsize"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Volsize = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Volsize = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Volblocksize.IsUnknown() {
+		data.Volblocksize = types.StringNull()
+		if v, ok := resultMap["volblocksize"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Volblocksize = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Volblocksize = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Volblocksize = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.UserPropertiesUpdate.IsUnknown() {
+		data.UserPropertiesUpdate = types.ListNull(types.StringType)
+		if v, ok := resultMap["user_properties_update"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					b, _ := json.Marshal(item)
+					strVals[i] = types.StringValue(string(b))
+				}
+				data.UserPropertiesUpdate, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+}
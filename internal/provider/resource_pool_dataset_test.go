@@ -1,8 +1,13 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -94,3 +99,37 @@ func TestPoolDatasetResource_OptionalFieldsOmitted(t *testing.T) {
 		t.Error("Expected null volsize to be omitted")
 	}
 }
+
+func TestPoolDatasetResource_CompressionValidator(t *testing.T) {
+	r := NewPoolDatasetResource()
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+
+	attr, ok := resp.Schema.Attributes["compression"].(schema.StringAttribute)
+	if !ok {
+		t.Fatal("Expected compression to be a string attribute")
+	}
+	if len(attr.Validators) == 0 {
+		t.Fatal("Expected compression to carry validators from the spec enum")
+	}
+
+	tests := map[string]bool{
+		"LZ4":          false,
+		"ZSTD-FAST-10": false,
+		"INHERIT":      false,
+		"BOGUS":        true,
+	}
+	for value, wantErr := range tests {
+		req := validator.StringRequest{
+			Path:        path.Root("compression"),
+			ConfigValue: types.StringValue(value),
+		}
+		var vresp validator.StringResponse
+		for _, v := range attr.Validators {
+			v.ValidateString(context.Background(), req, &vresp)
+		}
+		if vresp.Diagnostics.HasError() != wantErr {
+			t.Errorf("compression=%q: got error=%v, want %v", value, vresp.Diagnostics.HasError(), wantErr)
+		}
+	}
+}
@@ -8,6 +8,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -38,8 +43,13 @@ func (r *PoolScrubResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *PoolScrubResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *PoolScrubResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a scrub task for a pool.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -51,22 +61,30 @@ func (r *PoolScrubResource) Schema(ctx context.Context, req resource.SchemaReque
 			"threshold": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Days before a scrub is due when a scrub should automatically start.",
+				Default:     int64default.StaticInt64(35),
 			},
 			"description": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Description or notes for this scrub schedule.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"schedule": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Cron schedule for when scrubs should run.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Cron schedule for when scrubs should run.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether this scrub schedule is enabled.",
+				Default:     booldefault.StaticBool(true),
 			},
 		},
 	}
@@ -92,16 +110,16 @@ func (r *PoolScrubResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Pool.IsNull() {
+	if !data.Pool.IsNull() && !data.Pool.IsUnknown() {
 		params["pool"] = data.Pool.ValueInt64()
 	}
-	if !data.Threshold.IsNull() {
+	if !data.Threshold.IsNull() && !data.Threshold.IsUnknown() {
 		params["threshold"] = data.Threshold.ValueInt64()
 	}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -109,7 +127,7 @@ func (r *PoolScrubResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
 
@@ -131,6 +149,7 @@ func (r *PoolScrubResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -209,16 +228,16 @@ func (r *PoolScrubResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Pool.IsNull() {
+	if !data.Pool.IsNull() && !data.Pool.IsUnknown() {
 		params["pool"] = data.Pool.ValueInt64()
 	}
-	if !data.Threshold.IsNull() {
+	if !data.Threshold.IsNull() && !data.Threshold.IsUnknown() {
 		params["threshold"] = data.Threshold.ValueInt64()
 	}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -226,17 +245,18 @@ func (r *PoolScrubResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
 
-	_, err = r.client.Call("pool.scrub.update", []interface{}{id, params})
+	result, err := r.client.Call("pool.scrub.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update pool_scrub: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -261,3 +281,55 @@ func (r *PoolScrubResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *PoolScrubResource) setComputed(result interface{}, data *PoolScrubResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Threshold.IsUnknown() {
+		data.Threshold = types.Int64Null()
+		if v, ok := resultMap["threshold"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Threshold = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Threshold = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Description.IsUnknown() {
+		data.Description = types.StringNull()
+		if v, ok := resultMap["description"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Description = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Description = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Schedule.IsUnknown() {
+		data.Schedule = types.StringNull()
+		if v, ok := resultMap["schedule"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Schedule = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,11 +44,25 @@ func (r *PoolSnapshotResource) Metadata(ctx context.Context, req resource.Metada
 }
 
 func (r *PoolSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "pool.snapshot.query", poolSnapshotIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &PoolSnapshotResource{}
+
+// poolSnapshotIdentity lists the API fields that identify a pool_snapshot
+var poolSnapshotIdentity = []identityKey{{Name: "dataset"}, {Name: "name", Field: "snapshot_name"}}
+
+func (r *PoolSnapshotResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(poolSnapshotIdentity)
+}
+
+func (r *PoolSnapshotResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *PoolSnapshotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Take a snapshot from a given dataset.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -53,54 +70,67 @@ func (r *PoolSnapshotResource) Schema(ctx context.Context, req resource.SchemaRe
 				Required:      true,
 				Optional:      false,
 				Description:   "Name of the dataset to create a snapshot of.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"recursive": schema.BoolAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Whether to recursively snapshot child datasets.",
+				Default:       booldefault.StaticBool(false),
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 			},
 			"exclude": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "Array of dataset patterns to exclude from recursive snapshots.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"vmware_sync": schema.BoolAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Whether to sync VMware VMs before taking the snapshot.",
+				Default:       booldefault.StaticBool(false),
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 			},
 			"properties": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Object mapping ZFS property names to values to set on the snapshot.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"name": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Explicit name for the snapshot.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Explicit name for the snapshot.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"naming_schema": schema.StringAttribute{
 				Optional:      true,
 				Computed:      true,
 				Description:   "Naming schema pattern to generate the snapshot name automatically.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"user_properties_update": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Properties to update.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Properties to update.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"user_properties_remove": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Properties to remove.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Properties to remove.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 		},
 	}
@@ -126,21 +156,21 @@ func (r *PoolSnapshotResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	params := map[string]interface{}{}
-	if !data.Dataset.IsNull() {
+	if !data.Dataset.IsNull() && !data.Dataset.IsUnknown() {
 		params["dataset"] = data.Dataset.ValueString()
 	}
-	if !data.Recursive.IsNull() {
+	if !data.Recursive.IsNull() && !data.Recursive.IsUnknown() {
 		params["recursive"] = data.Recursive.ValueBool()
 	}
-	if !data.Exclude.IsNull() {
+	if !data.Exclude.IsNull() && !data.Exclude.IsUnknown() {
 		var excludeList []string
 		data.Exclude.ElementsAs(ctx, &excludeList, false)
 		params["exclude"] = excludeList
 	}
-	if !data.VmwareSync.IsNull() {
+	if !data.VmwareSync.IsNull() && !data.VmwareSync.IsUnknown() {
 		params["vmware_sync"] = data.VmwareSync.ValueBool()
 	}
-	if !data.Properties.IsNull() {
+	if !data.Properties.IsNull() && !data.Properties.IsUnknown() {
 		var propertiesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Properties.ValueString()), &propertiesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse properties: %s", err))
@@ -148,13 +178,13 @@ func (r *PoolSnapshotResource) Create(ctx context.Context, req resource.CreateRe
 		}
 		params["properties"] = propertiesObj
 	}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.NamingSchema.IsNull() {
+	if !data.NamingSchema.IsNull() && !data.NamingSchema.IsUnknown() {
 		params["naming_schema"] = data.NamingSchema.ValueString()
 	}
-	if !data.UserPropertiesUpdate.IsNull() {
+	if !data.UserPropertiesUpdate.IsNull() && !data.UserPropertiesUpdate.IsUnknown() {
 		var user_properties_updateList []string
 		data.UserPropertiesUpdate.ElementsAs(ctx, &user_properties_updateList, false)
 		var user_properties_updateObjs []map[string]interface{}
@@ -168,7 +198,7 @@ func (r *PoolSnapshotResource) Create(ctx context.Context, req resource.CreateRe
 		}
 		params["user_properties_update"] = user_properties_updateObjs
 	}
-	if !data.UserPropertiesRemove.IsNull() {
+	if !data.UserPropertiesRemove.IsNull() && !data.UserPropertiesRemove.IsUnknown() {
 		var user_properties_removeList []string
 		data.UserPropertiesRemove.ElementsAs(ctx, &user_properties_removeList, false)
 		params["user_properties_remove"] = user_properties_removeList
@@ -192,6 +222,8 @@ func (r *PoolSnapshotResource) Create(ctx context.Context, req resource.CreateRe
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolSnapshotIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -240,6 +272,7 @@ func (r *PoolSnapshotResource) Read(ctx context.Context, req resource.ReadReques
 			data.Name = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolSnapshotIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -262,7 +295,7 @@ func (r *PoolSnapshotResource) Update(ctx context.Context, req resource.UpdateRe
 	id = state.ID.ValueString()
 
 	params := map[string]interface{}{}
-	if !data.UserPropertiesUpdate.IsNull() {
+	if !data.UserPropertiesUpdate.IsNull() && !data.UserPropertiesUpdate.IsUnknown() {
 		var user_properties_updateList []string
 		data.UserPropertiesUpdate.ElementsAs(ctx, &user_properties_updateList, false)
 		var user_properties_updateObjs []map[string]interface{}
@@ -276,19 +309,21 @@ func (r *PoolSnapshotResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		params["user_properties_update"] = user_properties_updateObjs
 	}
-	if !data.UserPropertiesRemove.IsNull() {
+	if !data.UserPropertiesRemove.IsNull() && !data.UserPropertiesRemove.IsUnknown() {
 		var user_properties_removeList []string
 		data.UserPropertiesRemove.ElementsAs(ctx, &user_properties_removeList, false)
 		params["user_properties_remove"] = user_properties_removeList
 	}
 
-	_, err = r.client.Call("pool.snapshot.update", []interface{}{id, params})
+	result, err := r.client.Call("pool.snapshot.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update pool_snapshot: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolSnapshotIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -309,3 +344,115 @@ func (r *PoolSnapshotResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *PoolSnapshotResource) setComputed(result interface{}, data *PoolSnapshotResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Dataset.IsUnknown() {
+		data.Dataset = types.StringNull()
+		if v, ok := resultMap["dataset"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Dataset = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Dataset = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Dataset = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Recursive.IsUnknown() {
+		data.Recursive = types.BoolNull()
+		if v, ok := resultMap["recursive"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Recursive = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Exclude.IsUnknown() {
+		data.Exclude = types.ListNull(types.StringType)
+		if v, ok := resultMap["exclude"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Exclude, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.VmwareSync.IsUnknown() {
+		data.VmwareSync = types.BoolNull()
+		if v, ok := resultMap["vmware_sync"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.VmwareSync = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Properties.IsUnknown() {
+		data.Properties = types.StringNull()
+		if v, ok := resultMap["properties"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Properties = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.Name.IsUnknown() {
+		data.Name = types.StringNull()
+		if v, ok := resultMap["name"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Name = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Name = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Name = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.NamingSchema.IsUnknown() {
+		data.NamingSchema = types.StringNull()
+		if v, ok := resultMap["naming_schema"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.NamingSchema = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.NamingSchema = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.NamingSchema = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.UserPropertiesUpdate.IsUnknown() {
+		data.UserPropertiesUpdate = types.ListNull(types.StringType)
+		if v, ok := resultMap["user_properties_update"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					b, _ := json.Marshal(item)
+					strVals[i] = types.StringValue(string(b))
+				}
+				data.UserPropertiesUpdate, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.UserPropertiesRemove.IsUnknown() {
+		data.UserPropertiesRemove = types.ListNull(types.StringType)
+		if v, ok := resultMap["user_properties_remove"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.UserPropertiesRemove, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+}
@@ -5,9 +5,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -40,11 +49,25 @@ func (r *PoolSnapshottaskResource) Metadata(ctx context.Context, req resource.Me
 }
 
 func (r *PoolSnapshottaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "pool.snapshottask.query", poolSnapshottaskIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &PoolSnapshottaskResource{}
+
+// poolSnapshottaskIdentity lists the API fields that identify a pool_snapshottask
+var poolSnapshottaskIdentity = []identityKey{{Name: "id"}}
+
+func (r *PoolSnapshottaskResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(poolSnapshottaskIdentity)
+}
+
+func (r *PoolSnapshottaskResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *PoolSnapshottaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a Periodic Snapshot Task",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -56,48 +79,66 @@ func (r *PoolSnapshottaskResource) Schema(ctx context.Context, req resource.Sche
 			"recursive": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to recursively snapshot child datasets.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"lifetime_value": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Number of time units to retain snapshots. `lifetime_unit` gives the time unit.",
+				Default:     int64default.StaticInt64(2),
 			},
 			"lifetime_unit": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Unit of time for snapshot retention.",
+				Default:     stringdefault.StaticString("WEEK"),
+				Validators:  []validator.String{stringvalidator.OneOf("HOUR", "DAY", "WEEK", "MONTH", "YEAR")},
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether this periodic snapshot task is enabled.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"exclude": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "Array of dataset patterns to exclude from recursive snapshots.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"naming_schema": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
 				Description: "Naming pattern for generated snapshots using strftime format.",
+				Default:     stringdefault.StaticString("auto-%Y-%m-%d_%H-%M"),
 			},
 			"allow_empty": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to take snapshots even if no data has changed.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"schedule": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Cron schedule for when snapshots should be taken.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Cron schedule for when snapshots should be taken.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"fixate_removal_date": schema.BoolAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Whether to fix the removal date of existing snapshots when retention settings change.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether to fix the removal date of existing snapshots when retention settings change.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
 		},
 	}
@@ -123,33 +164,33 @@ func (r *PoolSnapshottaskResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	params := map[string]interface{}{}
-	if !data.Dataset.IsNull() {
+	if !data.Dataset.IsNull() && !data.Dataset.IsUnknown() {
 		params["dataset"] = data.Dataset.ValueString()
 	}
-	if !data.Recursive.IsNull() {
+	if !data.Recursive.IsNull() && !data.Recursive.IsUnknown() {
 		params["recursive"] = data.Recursive.ValueBool()
 	}
-	if !data.LifetimeValue.IsNull() {
+	if !data.LifetimeValue.IsNull() && !data.LifetimeValue.IsUnknown() {
 		params["lifetime_value"] = data.LifetimeValue.ValueInt64()
 	}
-	if !data.LifetimeUnit.IsNull() {
+	if !data.LifetimeUnit.IsNull() && !data.LifetimeUnit.IsUnknown() {
 		params["lifetime_unit"] = data.LifetimeUnit.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Exclude.IsNull() {
+	if !data.Exclude.IsNull() && !data.Exclude.IsUnknown() {
 		var excludeList []string
 		data.Exclude.ElementsAs(ctx, &excludeList, false)
 		params["exclude"] = excludeList
 	}
-	if !data.NamingSchema.IsNull() {
+	if !data.NamingSchema.IsNull() && !data.NamingSchema.IsUnknown() {
 		params["naming_schema"] = data.NamingSchema.ValueString()
 	}
-	if !data.AllowEmpty.IsNull() {
+	if !data.AllowEmpty.IsNull() && !data.AllowEmpty.IsUnknown() {
 		params["allow_empty"] = data.AllowEmpty.ValueBool()
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -157,7 +198,7 @@ func (r *PoolSnapshottaskResource) Create(ctx context.Context, req resource.Crea
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.FixateRemovalDate.IsNull() {
+	if !data.FixateRemovalDate.IsNull() && !data.FixateRemovalDate.IsUnknown() {
 		params["fixate_removal_date"] = data.FixateRemovalDate.ValueBool()
 	}
 
@@ -179,6 +220,8 @@ func (r *PoolSnapshottaskResource) Create(ctx context.Context, req resource.Crea
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolSnapshottaskIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -231,6 +274,7 @@ func (r *PoolSnapshottaskResource) Read(ctx context.Context, req resource.ReadRe
 			data.Dataset = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolSnapshottaskIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -257,33 +301,33 @@ func (r *PoolSnapshottaskResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	params := map[string]interface{}{}
-	if !data.Dataset.IsNull() {
+	if !data.Dataset.IsNull() && !data.Dataset.IsUnknown() {
 		params["dataset"] = data.Dataset.ValueString()
 	}
-	if !data.Recursive.IsNull() {
+	if !data.Recursive.IsNull() && !data.Recursive.IsUnknown() {
 		params["recursive"] = data.Recursive.ValueBool()
 	}
-	if !data.LifetimeValue.IsNull() {
+	if !data.LifetimeValue.IsNull() && !data.LifetimeValue.IsUnknown() {
 		params["lifetime_value"] = data.LifetimeValue.ValueInt64()
 	}
-	if !data.LifetimeUnit.IsNull() {
+	if !data.LifetimeUnit.IsNull() && !data.LifetimeUnit.IsUnknown() {
 		params["lifetime_unit"] = data.LifetimeUnit.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Exclude.IsNull() {
+	if !data.Exclude.IsNull() && !data.Exclude.IsUnknown() {
 		var excludeList []string
 		data.Exclude.ElementsAs(ctx, &excludeList, false)
 		params["exclude"] = excludeList
 	}
-	if !data.NamingSchema.IsNull() {
+	if !data.NamingSchema.IsNull() && !data.NamingSchema.IsUnknown() {
 		params["naming_schema"] = data.NamingSchema.ValueString()
 	}
-	if !data.AllowEmpty.IsNull() {
+	if !data.AllowEmpty.IsNull() && !data.AllowEmpty.IsUnknown() {
 		params["allow_empty"] = data.AllowEmpty.ValueBool()
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -291,17 +335,19 @@ func (r *PoolSnapshottaskResource) Update(ctx context.Context, req resource.Upda
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.FixateRemovalDate.IsNull() {
+	if !data.FixateRemovalDate.IsNull() && !data.FixateRemovalDate.IsUnknown() {
 		params["fixate_removal_date"] = data.FixateRemovalDate.ValueBool()
 	}
 
-	_, err = r.client.Call("pool.snapshottask.update", []interface{}{id, params})
+	result, err := r.client.Call("pool.snapshottask.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update pool_snapshottask: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, poolSnapshottaskIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -327,3 +373,106 @@ func (r *PoolSnapshottaskResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *PoolSnapshottaskResource) setComputed(result interface{}, data *PoolSnapshottaskResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Recursive.IsUnknown() {
+		data.Recursive = types.BoolNull()
+		if v, ok := resultMap["recursive"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Recursive = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.LifetimeValue.IsUnknown() {
+		data.LifetimeValue = types.Int64Null()
+		if v, ok := resultMap["lifetime_value"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.LifetimeValue = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.LifetimeValue = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.LifetimeUnit.IsUnknown() {
+		data.LifetimeUnit = types.StringNull()
+		if v, ok := resultMap["lifetime_unit"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.LifetimeUnit = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.LifetimeUnit = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.LifetimeUnit = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Exclude.IsUnknown() {
+		data.Exclude = types.ListNull(types.StringType)
+		if v, ok := resultMap["exclude"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Exclude, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.NamingSchema.IsUnknown() {
+		data.NamingSchema = types.StringNull()
+		if v, ok := resultMap["naming_schema"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.NamingSchema = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.NamingSchema = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.NamingSchema = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.AllowEmpty.IsUnknown() {
+		data.AllowEmpty = types.BoolNull()
+		if v, ok := resultMap["allow_empty"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.AllowEmpty = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Schedule.IsUnknown() {
+		data.Schedule = types.StringNull()
+		if v, ok := resultMap["schedule"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Schedule = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.FixateRemovalDate.IsUnknown() {
+		data.FixateRemovalDate = types.BoolNull()
+		if v, ok := resultMap["fixate_removal_date"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.FixateRemovalDate = types.BoolValue(bv)
+			}
+		}
+	}
+}
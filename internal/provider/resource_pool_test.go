@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testPoolTopology is a pool.query topology: a mirror, a single-disk SLOG, a
+// cache disk and a spare whose disk is gone
+var testPoolTopology = map[string]interface{}{
+	"data": []interface{}{
+		map[string]interface{}{"type": "MIRROR", "guid": "100", "children": []interface{}{
+			map[string]interface{}{"type": "DISK", "guid": "101", "disk": "sda"},
+			map[string]interface{}{"type": "DISK", "guid": "102", "disk": "sdb"},
+		}},
+		map[string]interface{}{"type": "RAIDZ1", "guid": "200", "children": []interface{}{
+			map[string]interface{}{"type": "DISK", "guid": "201", "disk": "sdc"},
+			map[string]interface{}{"type": "DISK", "guid": "202", "disk": "sdd"},
+			map[string]interface{}{"type": "DISK", "guid": "203", "disk": "sde"},
+		}},
+	},
+	"log":     []interface{}{map[string]interface{}{"type": "DISK", "guid": "300", "disk": "nvme0n1", "children": []interface{}{}}},
+	"cache":   []interface{}{map[string]interface{}{"type": "DISK", "guid": "400", "disk": "nvme1n1", "children": []interface{}{}}},
+	"spare":   []interface{}{map[string]interface{}{"type": "DISK", "guid": "500", "disk": nil, "children": []interface{}{}}},
+	"special": []interface{}{},
+	"dedup":   []interface{}{},
+}
+
+func TestPoolLayoutFromAPI(t *testing.T) {
+	layout := poolLayoutFromAPI(testPoolTopology)
+	mirror := layout["data"][0]
+	if mirror.Type != "MIRROR" || !reflect.DeepEqual(mirror.Disks, []string{"sda", "sdb"}) || mirror.GUID != "100" || mirror.DiskGUIDs["sdb"] != "102" {
+		t.Errorf("Unexpected mirror vdev: %+v", mirror)
+	}
+	if log := layout["log"][0]; log.Type != "STRIPE" || !reflect.DeepEqual(log.Disks, []string{"nvme0n1"}) || log.GUID != "300" {
+		t.Errorf("Expected a single-disk log to be a STRIPE, got %+v", log)
+	}
+	if spare := layout["spares"][0]; !reflect.DeepEqual(spare.Disks, []string{"500"}) {
+		t.Errorf("Expected a missing spare to be named by its guid, got %+v", spare)
+	}
+
+	// During a resilver sdb is being replaced by sdf, a hot spare stands in
+	// for the failed sdd, and the single-disk log is being replaced
+	disk := func(guid string, name interface{}) map[string]interface{} {
+		return map[string]interface{}{"type": "DISK", "guid": guid, "disk": name, "children": []interface{}{}}
+	}
+	resilvering := map[string]interface{}{
+		"data": []interface{}{
+			map[string]interface{}{"type": "MIRROR", "guid": "100", "children": []interface{}{
+				disk("101", "sda"),
+				map[string]interface{}{"type": "REPLACING", "guid": "110", "children": []interface{}{disk("102", nil), disk("103", "sdf")}},
+			}},
+			map[string]interface{}{"type": "RAIDZ1", "guid": "200", "children": []interface{}{
+				disk("201", "sdc"),
+				map[string]interface{}{"type": "SPARE", "guid": "210", "children": []interface{}{disk("202", nil), disk("501", "sdg")}},
+				disk("203", "sde"),
+			}},
+		},
+		"log": []interface{}{
+			map[string]interface{}{"type": "REPLACING", "guid": "310", "children": []interface{}{disk("300", "nvme0n1"), disk("301", "nvme2n1")}},
+		},
+	}
+	layout = poolLayoutFromAPI(resilvering)
+	if mirror := layout["data"][0]; !reflect.DeepEqual(mirror.Disks, []string{"sda", "sdf"}) || mirror.DiskGUIDs["sdf"] != "103" {
+		t.Errorf("Expected the replacing disk to be the mirror member, got %+v", mirror)
+	}
+	if raidz := layout["data"][1]; !reflect.DeepEqual(raidz.Disks, []string{"202", "sdc", "sde"}) {
+		t.Errorf("Expected the disk a spare stands in for to stay the member, got %+v", raidz)
+	}
+	if log := layout["log"][0]; log.Type != "STRIPE" || !reflect.DeepEqual(log.Disks, []string{"nvme2n1"}) || log.GUID != "301" {
+		t.Errorf("Expected a single-disk log being replaced to be a STRIPE of the new disk, got %+v", log)
+	}
+
+	// The replacement already planned needs no further jobs
+	planned := poolLayout{
+		"data": {{Type: "MIRROR", Disks: []string{"sda", "sdf"}}, {Type: "RAIDZ1", Disks: []string{"202", "sdc", "sde"}}},
+		"log":  {{Type: "STRIPE", Disks: []string{"nvme2n1"}}},
+	}
+	if change, err := diffPoolLayout(layout, planned); err != nil || len(change.Ops) != 0 || len(change.Extend) != 0 {
+		t.Errorf("Expected no changes during a resilver, got %+v (%v)", change, err)
+	}
+}
+
+func TestDiffPoolLayout(t *testing.T) {
+	vdev := func(typ string, disks ...string) poolVdev { return poolVdev{Type: typ, Disks: disks} }
+	planned := func(change func(poolLayout)) poolLayout {
+		l := poolLayout{
+			"data":   {vdev("MIRROR", "sda", "sdb"), vdev("RAIDZ1", "sdc", "sdd", "sde")},
+			"log":    {vdev("STRIPE", "nvme0n1")},
+			"cache":  {vdev("STRIPE", "nvme1n1")},
+			"spares": {vdev("STRIPE", "500")},
+		}
+		change(l)
+		return l
+	}
+
+	tests := []struct {
+		name    string
+		planned poolLayout
+		ops     []string
+		extend  map[string]interface{}
+		err     string
+	}{
+		{
+			name:    "unchanged",
+			planned: planned(func(poolLayout) {}),
+		},
+		{
+			name: "add a mirror vdev and a special vdev",
+			planned: planned(func(l poolLayout) {
+				l["data"] = append(l["data"], vdev("MIRROR", "sdf", "sdg"))
+				l["special"] = []poolVdev{vdev("MIRROR", "nvme2n1", "nvme3n1")}
+			}),
+			extend: map[string]interface{}{
+				"data":    []interface{}{map[string]interface{}{"type": "MIRROR", "disks": []string{"sdf", "sdg"}}},
+				"special": []interface{}{map[string]interface{}{"type": "MIRROR", "disks": []string{"nvme2n1", "nvme3n1"}}},
+			},
+		},
+		{
+			name: "replace a failed disk",
+			planned: planned(func(l poolLayout) {
+				l["data"][1] = vdev("RAIDZ1", "sdc", "sdd", "sdf")
+			}),
+			ops: []string{"pool.replace map[disk:sdf label:203]"},
+		},
+		{
+			name: "mirror the SLOG and widen the data mirror",
+			planned: planned(func(l poolLayout) {
+				l["data"][0] = vdev("MIRROR", "sda", "sdb", "sdf")
+				l["log"] = []poolVdev{vdev("MIRROR", "nvme0n1", "nvme2n1")}
+			}),
+			ops: []string{
+				"pool.attach map[new_disk:sdf target_vdev:100]",
+				"pool.attach map[new_disk:nvme2n1 target_vdev:300]",
+			},
+		},
+		{
+			name: "expand a raidz",
+			planned: planned(func(l poolLayout) {
+				l["data"][1] = vdev("RAIDZ1", "sdc", "sdd", "sde", "sdf")
+			}),
+			ops: []string{"pool.attach map[new_disk:sdf target_vdev:200]"},
+		},
+		{
+			name: "split the mirror and drop cache and spare",
+			planned: planned(func(l poolLayout) {
+				l["data"][0] = vdev("STRIPE", "sda")
+				delete(l, "cache")
+				delete(l, "spares")
+			}),
+			ops: []string{
+				"pool.detach map[label:102]",
+				"pool.remove map[label:400]",
+				"pool.remove map[label:500]",
+			},
+		},
+		{
+			name: "swap every disk of the log",
+			planned: planned(func(l poolLayout) {
+				l["log"] = []poolVdev{vdev("STRIPE", "nvme2n1")}
+			}),
+			ops: []string{"pool.replace map[disk:nvme2n1 label:300]"},
+		},
+		{
+			name: "shrink a raidz",
+			planned: planned(func(l poolLayout) {
+				l["data"][1] = vdev("RAIDZ1", "sdc", "sdd")
+			}),
+			err: "cannot shrink data RAIDZ1",
+		},
+		{
+			name: "remove a raidz",
+			planned: planned(func(l poolLayout) {
+				l["data"] = l["data"][:1]
+			}),
+			err: "ZFS can't remove RAIDZ vdevs",
+		},
+		{
+			name: "remove a mirror next to a raidz",
+			planned: planned(func(l poolLayout) {
+				l["data"] = l["data"][1:]
+			}),
+			err: "cannot remove data MIRROR sda, sdb: ZFS can't remove data, special or dedup vdevs from a pool with a RAIDZ data vdev",
+		},
+		{
+			name: "change a vdev type",
+			planned: planned(func(l poolLayout) {
+				l["data"][1] = vdev("RAIDZ2", "sdc", "sdd", "sde")
+			}),
+			err: "cannot change data RAIDZ1 sdc, sdd, sde to RAIDZ2",
+		},
+		{
+			name: "move a disk between vdevs",
+			planned: planned(func(l poolLayout) {
+				l["data"][0] = vdev("MIRROR", "sda", "sdb", "nvme1n1")
+			}),
+			err: "disk nvme1n1 is already in another vdev",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change, err := diffPoolLayout(poolLayoutFromAPI(testPoolTopology), tt.planned)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("Expected an error containing %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var ops []string
+			for _, op := range change.Ops {
+				ops = append(ops, fmt.Sprint(op.Method, " ", op.Options))
+			}
+			if !reflect.DeepEqual(ops, tt.ops) {
+				t.Errorf("Expected jobs %v, got %v", tt.ops, ops)
+			}
+			extend := change.Extend.params()
+			if len(tt.extend) == 0 && len(extend) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(extend, tt.extend) {
+				t.Errorf("Expected extension %v, got %v", tt.extend, extend)
+			}
+		})
+	}
+}
+
+func TestPoolLayoutValidate(t *testing.T) {
+	for _, tt := range []struct {
+		layout poolLayout
+		err    string
+	}{
+		{poolLayout{"log": {{Type: "STRIPE", Disks: []string{"sda"}}}}, "at least one data vdev"},
+		{poolLayout{"data": {{Type: "STRIPE", Disks: []string{"sda", "sdb"}}}}, "declare one data block per disk"},
+		{poolLayout{"data": {{Type: "MIRROR", Disks: []string{"sda"}}}}, "at least two disks"},
+		{poolLayout{"data": {{Type: "STRIPE", Disks: []string{"sda"}}}, "cache": {{Type: "STRIPE", Disks: []string{"sda"}}}}, "disk sda is used more than once"},
+	} {
+		if err := tt.layout.validate(); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Expected an error containing %q, got %v", tt.err, err)
+		}
+	}
+	if err := poolLayoutFromAPI(testPoolTopology).validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestPoolTopologyModel_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	want := poolLayoutFromAPI(testPoolTopology)
+	model, diags := poolTopologyModel(ctx, want)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if model.Special == nil || len(model.Special) != 0 || !model.Cache.Equal(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("nvme1n1")})) {
+		t.Errorf("Unexpected model: %+v", model)
+	}
+
+	got, known := model.layout(ctx)
+	if !known {
+		t.Fatal("Expected a known layout")
+	}
+	change, err := diffPoolLayout(want, got)
+	if err != nil || len(change.Ops) != 0 || len(change.Extend) != 0 {
+		t.Errorf("Expected no changes after a round trip, got %+v (%v)", change, err)
+	}
+}
+
+func TestUpgradePoolStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := NewPoolResource()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	raw := `{"id": "1", "name": "tank", "topology": "map[data:[map[type:MIRROR]]]", "autotrim": "off"}`
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(raw)}}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.(resource.ResourceWithUpgradeState).UpgradeState(ctx)[0].StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 0 {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data PoolResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if data.Name.ValueString() != "tank" || data.Topology != nil {
+		t.Errorf("Expected the name kept and the topology dropped, got %s and %+v", data.Name, data.Topology)
+	}
+}
+
+func TestFindImportablePool(t *testing.T) {
+	found := []interface{}{
+		map[string]interface{}{"name": "tank", "guid": "111", "status": "ONLINE"},
+		map[string]interface{}{"name": "backup", "guid": "222", "status": "ONLINE"},
+		map[string]interface{}{"name": "backup", "guid": "333", "status": "DEGRADED"},
+	}
+
+	guid, name, err := findImportablePool(found, "", "tank")
+	if err != nil || guid != "111" || name != "tank" {
+		t.Errorf("Expected tank by name, got %s %s (%v)", guid, name, err)
+	}
+	guid, name, err = findImportablePool(found, "333", "")
+	if err != nil || guid != "333" || name != "backup" {
+		t.Errorf("Expected backup by guid, got %s %s (%v)", guid, name, err)
+	}
+
+	for _, tt := range []struct {
+		found      interface{}
+		guid, name string
+		err        string
+	}{
+		{found, "", "backup", "2 exported pools are named backup; choose one with import_guid"},
+		{found, "444", "", "no exported pool with guid 444 found; pools available to import: tank (guid 111)"},
+		{[]interface{}{}, "", "tank", "there are no pools to import"},
+	} {
+		if _, _, err := findImportablePool(tt.found, tt.guid, tt.name); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Expected an error containing %q, got %v", tt.err, err)
+		}
+	}
+}
+
+func TestPoolResource_ImportSchema(t *testing.T) {
+	ctx := context.Background()
+	r := NewPoolResource()
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	for _, name := range []string{"destroy_on_delete", "cascade_on_delete"} {
+		attr, ok := resp.Schema.Attributes[name].(schema.BoolAttribute)
+		if !ok || attr.Default == nil {
+			t.Fatalf("Expected %s to be a bool with a default", name)
+		}
+		var def defaults.BoolResponse
+		attr.Default.DefaultBool(ctx, defaults.BoolRequest{}, &def)
+		if def.PlanValue.ValueBool() {
+			t.Errorf("Expected %s to default to false, so destroy keeps the data", name)
+		}
+	}
+	if len(r.(resource.ResourceWithConfigValidators).ConfigValidators(ctx)) != 2 {
+		t.Error("Expected topology or an import source to be required, and the import sources to conflict")
+	}
+}
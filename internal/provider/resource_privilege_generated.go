@@ -4,9 +4,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -31,14 +32,30 @@ func NewPrivilegeResource() resource.Resource {
 
 func (r *PrivilegeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_privilege"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *PrivilegeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "privilege.query", privilegeIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &PrivilegeResource{}
+
+// privilegeIdentity lists the API fields that identify a privilege
+var privilegeIdentity = []identityKey{{Name: "name"}}
+
+func (r *PrivilegeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(privilegeIdentity)
+}
+
+func (r *PrivilegeResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *PrivilegeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Creates a privilege.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -50,20 +67,26 @@ func (r *PrivilegeResource) Schema(ctx context.Context, req resource.SchemaReque
 			"local_groups": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "Array of local group IDs to assign to this privilege.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"ds_groups": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "Array of directory service group IDs or SIDs to assign to this privilege.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"roles": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "Array of role names included in this privilege.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"web_shell": schema.BoolAttribute{
 				Required:    true,
@@ -94,25 +117,25 @@ func (r *PrivilegeResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.LocalGroups.IsNull() {
+	if !data.LocalGroups.IsNull() && !data.LocalGroups.IsUnknown() {
 		var local_groupsList []string
 		data.LocalGroups.ElementsAs(ctx, &local_groupsList, false)
 		params["local_groups"] = local_groupsList
 	}
-	if !data.DsGroups.IsNull() {
+	if !data.DsGroups.IsNull() && !data.DsGroups.IsUnknown() {
 		var ds_groupsList []string
 		data.DsGroups.ElementsAs(ctx, &ds_groupsList, false)
 		params["ds_groups"] = ds_groupsList
 	}
-	if !data.Roles.IsNull() {
+	if !data.Roles.IsNull() && !data.Roles.IsUnknown() {
 		var rolesList []string
 		data.Roles.ElementsAs(ctx, &rolesList, false)
 		params["roles"] = rolesList
 	}
-	if !data.WebShell.IsNull() {
+	if !data.WebShell.IsNull() && !data.WebShell.IsUnknown() {
 		params["web_shell"] = data.WebShell.ValueBool()
 	}
 
@@ -134,6 +157,8 @@ func (r *PrivilegeResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, privilegeIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -191,6 +216,7 @@ func (r *PrivilegeResource) Read(ctx context.Context, req resource.ReadRequest,
 			data.WebShell = types.BoolValue(bv)
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, privilegeIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -217,35 +243,37 @@ func (r *PrivilegeResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.LocalGroups.IsNull() {
+	if !data.LocalGroups.IsNull() && !data.LocalGroups.IsUnknown() {
 		var local_groupsList []string
 		data.LocalGroups.ElementsAs(ctx, &local_groupsList, false)
 		params["local_groups"] = local_groupsList
 	}
-	if !data.DsGroups.IsNull() {
+	if !data.DsGroups.IsNull() && !data.DsGroups.IsUnknown() {
 		var ds_groupsList []string
 		data.DsGroups.ElementsAs(ctx, &ds_groupsList, false)
 		params["ds_groups"] = ds_groupsList
 	}
-	if !data.Roles.IsNull() {
+	if !data.Roles.IsNull() && !data.Roles.IsUnknown() {
 		var rolesList []string
 		data.Roles.ElementsAs(ctx, &rolesList, false)
 		params["roles"] = rolesList
 	}
-	if !data.WebShell.IsNull() {
+	if !data.WebShell.IsNull() && !data.WebShell.IsUnknown() {
 		params["web_shell"] = data.WebShell.ValueBool()
 	}
 
-	_, err = r.client.Call("privilege.update", []interface{}{id, params})
+	result, err := r.client.Call("privilege.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update privilege: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, privilegeIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -270,3 +298,45 @@ func (r *PrivilegeResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *PrivilegeResource) setComputed(result interface{}, data *PrivilegeResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.LocalGroups.IsUnknown() {
+		data.LocalGroups = types.ListNull(types.StringType)
+		if v, ok := resultMap["local_groups"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.LocalGroups, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.DsGroups.IsUnknown() {
+		data.DsGroups = types.ListNull(types.StringType)
+		if v, ok := resultMap["ds_groups"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.DsGroups, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Roles.IsUnknown() {
+		data.Roles = types.ListNull(types.StringType)
+		if v, ok := resultMap["roles"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Roles, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+}
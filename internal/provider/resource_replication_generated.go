@@ -5,10 +5,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -74,14 +84,30 @@ func NewReplicationResource() resource.Resource {
 
 func (r *ReplicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_replication"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *ReplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "replication.query", replicationIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &ReplicationResource{}
+
+// replicationIdentity lists the API fields that identify a replication
+var replicationIdentity = []identityKey{{Name: "name"}}
+
+func (r *ReplicationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(replicationIdentity)
+}
+
+func (r *ReplicationResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *ReplicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a Replication Task that will push or pull ZFS snapshots to or from remote host.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -94,46 +120,62 @@ func (r *ReplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				Required:    true,
 				Optional:    false,
 				Description: "Whether task will `PUSH` or `PULL` snapshots.",
+				Validators:  []validator.String{stringvalidator.OneOf("PUSH", "PULL")},
 			},
 			"transport": schema.StringAttribute{
 				Required:    true,
 				Optional:    false,
 				Description: "Method of snapshots transfer.  * `SSH` transfers snapshots via SSH connection. This method is suppor",
+				Validators:  []validator.String{stringvalidator.OneOf("SSH", "SSH+NETCAT", "LOCAL")},
 			},
 			"ssh_credentials": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Keychain Credential ID of type `SSH_CREDENTIALS`.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Keychain Credential ID of type `SSH_CREDENTIALS`.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"netcat_active_side": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Which side actively establishes the netcat connection for `SSH+NETCAT` transport.  * `LOCAL`: Local ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Which side actively establishes the netcat connection for `SSH+NETCAT` transport.  * `LOCAL`: Local ",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"netcat_active_side_listen_address": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "IP address for the active side to listen on for `SSH+NETCAT` transport. `null` if not applicable.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "IP address for the active side to listen on for `SSH+NETCAT` transport. `null` if not applicable.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"netcat_active_side_port_min": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Minimum port number in the range for netcat connections. `null` if not applicable.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Minimum port number in the range for netcat connections. `null` if not applicable.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"netcat_active_side_port_max": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Maximum port number in the range for netcat connections. `null` if not applicable.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum port number in the range for netcat connections. `null` if not applicable.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"netcat_passive_side_connect_address": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "IP address for the passive side to connect to for `SSH+NETCAT` transport. `null` if not applicable.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "IP address for the passive side to connect to for `SSH+NETCAT` transport. `null` if not applicable.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"sudo": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "`SSH` and `SSH+NETCAT` transports should use sudo (which is expected to be passwordless) to run `zfs",
+				Default:     booldefault.StaticBool(false),
 			},
 			"source_datasets": schema.ListAttribute{
 				Required:    true,
@@ -154,77 +196,105 @@ func (r *ReplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 			"exclude": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "Array of dataset patterns to exclude from replication.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"properties": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Send dataset properties along with snapshots.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"properties_exclude": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "Array of dataset property names to exclude from replication.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"properties_override": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Object mapping dataset property names to override values during replication.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Object mapping dataset property names to override values during replication.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"replicate": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to use full ZFS replication.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"encryption": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to enable encryption for the replicated datasets.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"encryption_inherit": schema.BoolAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Whether replicated datasets should inherit encryption from parent. `null` if encryption is disabled.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether replicated datasets should inherit encryption from parent. `null` if encryption is disabled.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
 			"encryption_key": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Encryption key for replicated datasets. `null` if not specified.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Encryption key for replicated datasets. `null` if not specified.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"encryption_key_format": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Format of the encryption key.  * `HEX`: Hexadecimal-encoded key * `PASSPHRASE`: Text passphrase * `n",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Format of the encryption key.  * `HEX`: Hexadecimal-encoded key * `PASSPHRASE`: Text passphrase * `n",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"encryption_key_location": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Filesystem path where encryption key is stored. `null` if not using key file.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Filesystem path where encryption key is stored. `null` if not using key file.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"periodic_snapshot_tasks": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "List of periodic snapshot task IDs that are sources of snapshots for this replication task. Only pus",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"naming_schema": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "List of naming schemas for pull replication.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"also_include_naming_schema": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "List of naming schemas for push replication.",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"name_regex": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Replicate all snapshots which names match specified regular expression.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Replicate all snapshots which names match specified regular expression.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"auto": schema.BoolAttribute{
 				Required:    true,
@@ -232,95 +302,131 @@ func (r *ReplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "Allow replication to run automatically on schedule or after bound periodic snapshot task.",
 			},
 			"schedule": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Schedule to run replication task. Only `auto` replication tasks without bound periodic snapshot task",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Schedule to run replication task. Only `auto` replication tasks without bound periodic snapshot task",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"restrict_schedule": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Restricts when replication task with bound periodic snapshot tasks runs. For example, you can have p",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Restricts when replication task with bound periodic snapshot tasks runs. For example, you can have p",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"only_matching_schedule": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Will only replicate snapshots that match `schedule` or `restrict_schedule`.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"allow_from_scratch": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Will destroy all snapshots on target side and replicate everything from scratch if none of the snaps",
+				Default:     booldefault.StaticBool(false),
 			},
 			"readonly": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Controls destination datasets readonly property.  * `SET`: Set all destination datasets to readonly=",
+				Default:     stringdefault.StaticString("SET"),
+				Validators:  []validator.String{stringvalidator.OneOf("SET", "REQUIRE", "IGNORE")},
 			},
 			"hold_pending_snapshots": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Prevent source snapshots from being deleted by retention of replication fails for some reason.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"retention_policy": schema.StringAttribute{
 				Required:    true,
 				Optional:    false,
 				Description: "How to delete old snapshots on target side:  * `SOURCE`: Delete snapshots that are absent on source ",
+				Validators:  []validator.String{stringvalidator.OneOf("SOURCE", "CUSTOM", "NONE")},
 			},
 			"lifetime_value": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Number of time units to retain snapshots for custom retention policy. Only applies when `retention_p",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Number of time units to retain snapshots for custom retention policy. Only applies when `retention_p",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"lifetime_unit": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Time unit for snapshot retention for custom retention policy. Only applies when `retention_policy` i",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Time unit for snapshot retention for custom retention policy. Only applies when `retention_policy` i",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"lifetimes": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Array of different retention schedules with their own cron schedules and lifetime settings.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Array of different retention schedules with their own cron schedules and lifetime settings.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"compression": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Compresses SSH stream. Available only for SSH transport.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Compresses SSH stream. Available only for SSH transport.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"speed_limit": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Limits speed of SSH stream. Available only for SSH transport.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Limits speed of SSH stream. Available only for SSH transport.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"large_block": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Enable large block support for ZFS send streams.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"embed": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Enable embedded block support for ZFS send streams.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"compressed": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Enable compressed ZFS send streams.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"retries": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Number of retries before considering replication failed.",
+				Default:     int64default.StaticInt64(5),
 			},
 			"logging_level": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Log level for replication task execution. Controls verbosity of replication logs.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Log level for replication task execution. Controls verbosity of replication logs.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether this replication task is enabled.",
+				Default:     booldefault.StaticBool(true),
 			},
 		},
 	}
@@ -346,61 +452,61 @@ func (r *ReplicationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Direction.IsNull() {
+	if !data.Direction.IsNull() && !data.Direction.IsUnknown() {
 		params["direction"] = data.Direction.ValueString()
 	}
-	if !data.Transport.IsNull() {
+	if !data.Transport.IsNull() && !data.Transport.IsUnknown() {
 		params["transport"] = data.Transport.ValueString()
 	}
-	if !data.SshCredentials.IsNull() {
+	if !data.SshCredentials.IsNull() && !data.SshCredentials.IsUnknown() {
 		params["ssh_credentials"] = data.SshCredentials.ValueInt64()
 	}
-	if !data.NetcatActiveSide.IsNull() {
+	if !data.NetcatActiveSide.IsNull() && !data.NetcatActiveSide.IsUnknown() {
 		params["netcat_active_side"] = data.NetcatActiveSide.ValueString()
 	}
-	if !data.NetcatActiveSideListenAddress.IsNull() {
+	if !data.NetcatActiveSideListenAddress.IsNull() && !data.NetcatActiveSideListenAddress.IsUnknown() {
 		params["netcat_active_side_listen_address"] = data.NetcatActiveSideListenAddress.ValueString()
 	}
-	if !data.NetcatActiveSidePortMin.IsNull() {
+	if !data.NetcatActiveSidePortMin.IsNull() && !data.NetcatActiveSidePortMin.IsUnknown() {
 		params["netcat_active_side_port_min"] = data.NetcatActiveSidePortMin.ValueInt64()
 	}
-	if !data.NetcatActiveSidePortMax.IsNull() {
+	if !data.NetcatActiveSidePortMax.IsNull() && !data.NetcatActiveSidePortMax.IsUnknown() {
 		params["netcat_active_side_port_max"] = data.NetcatActiveSidePortMax.ValueInt64()
 	}
-	if !data.NetcatPassiveSideConnectAddress.IsNull() {
+	if !data.NetcatPassiveSideConnectAddress.IsNull() && !data.NetcatPassiveSideConnectAddress.IsUnknown() {
 		params["netcat_passive_side_connect_address"] = data.NetcatPassiveSideConnectAddress.ValueString()
 	}
-	if !data.Sudo.IsNull() {
+	if !data.Sudo.IsNull() && !data.Sudo.IsUnknown() {
 		params["sudo"] = data.Sudo.ValueBool()
 	}
-	if !data.SourceDatasets.IsNull() {
+	if !data.SourceDatasets.IsNull() && !data.SourceDatasets.IsUnknown() {
 		var source_datasetsList []string
 		data.SourceDatasets.ElementsAs(ctx, &source_datasetsList, false)
 		params["source_datasets"] = source_datasetsList
 	}
-	if !data.TargetDataset.IsNull() {
+	if !data.TargetDataset.IsNull() && !data.TargetDataset.IsUnknown() {
 		params["target_dataset"] = data.TargetDataset.ValueString()
 	}
-	if !data.Recursive.IsNull() {
+	if !data.Recursive.IsNull() && !data.Recursive.IsUnknown() {
 		params["recursive"] = data.Recursive.ValueBool()
 	}
-	if !data.Exclude.IsNull() {
+	if !data.Exclude.IsNull() && !data.Exclude.IsUnknown() {
 		var excludeList []string
 		data.Exclude.ElementsAs(ctx, &excludeList, false)
 		params["exclude"] = excludeList
 	}
-	if !data.Properties.IsNull() {
+	if !data.Properties.IsNull() && !data.Properties.IsUnknown() {
 		params["properties"] = data.Properties.ValueBool()
 	}
-	if !data.PropertiesExclude.IsNull() {
+	if !data.PropertiesExclude.IsNull() && !data.PropertiesExclude.IsUnknown() {
 		var properties_excludeList []string
 		data.PropertiesExclude.ElementsAs(ctx, &properties_excludeList, false)
 		params["properties_exclude"] = properties_excludeList
 	}
-	if !data.PropertiesOverride.IsNull() {
+	if !data.PropertiesOverride.IsNull() && !data.PropertiesOverride.IsUnknown() {
 		var properties_overrideObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.PropertiesOverride.ValueString()), &properties_overrideObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse properties_override: %s", err))
@@ -408,46 +514,46 @@ func (r *ReplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["properties_override"] = properties_overrideObj
 	}
-	if !data.Replicate.IsNull() {
+	if !data.Replicate.IsNull() && !data.Replicate.IsUnknown() {
 		params["replicate"] = data.Replicate.ValueBool()
 	}
-	if !data.Encryption.IsNull() {
+	if !data.Encryption.IsNull() && !data.Encryption.IsUnknown() {
 		params["encryption"] = data.Encryption.ValueBool()
 	}
-	if !data.EncryptionInherit.IsNull() {
+	if !data.EncryptionInherit.IsNull() && !data.EncryptionInherit.IsUnknown() {
 		params["encryption_inherit"] = data.EncryptionInherit.ValueBool()
 	}
-	if !data.EncryptionKey.IsNull() {
+	if !data.EncryptionKey.IsNull() && !data.EncryptionKey.IsUnknown() {
 		params["encryption_key"] = data.EncryptionKey.ValueString()
 	}
-	if !data.EncryptionKeyFormat.IsNull() {
+	if !data.EncryptionKeyFormat.IsNull() && !data.EncryptionKeyFormat.IsUnknown() {
 		params["encryption_key_format"] = data.EncryptionKeyFormat.ValueString()
 	}
-	if !data.EncryptionKeyLocation.IsNull() {
+	if !data.EncryptionKeyLocation.IsNull() && !data.EncryptionKeyLocation.IsUnknown() {
 		params["encryption_key_location"] = data.EncryptionKeyLocation.ValueString()
 	}
-	if !data.PeriodicSnapshotTasks.IsNull() {
+	if !data.PeriodicSnapshotTasks.IsNull() && !data.PeriodicSnapshotTasks.IsUnknown() {
 		var periodic_snapshot_tasksList []string
 		data.PeriodicSnapshotTasks.ElementsAs(ctx, &periodic_snapshot_tasksList, false)
 		params["periodic_snapshot_tasks"] = periodic_snapshot_tasksList
 	}
-	if !data.NamingSchema.IsNull() {
+	if !data.NamingSchema.IsNull() && !data.NamingSchema.IsUnknown() {
 		var naming_schemaList []string
 		data.NamingSchema.ElementsAs(ctx, &naming_schemaList, false)
 		params["naming_schema"] = naming_schemaList
 	}
-	if !data.AlsoIncludeNamingSchema.IsNull() {
+	if !data.AlsoIncludeNamingSchema.IsNull() && !data.AlsoIncludeNamingSchema.IsUnknown() {
 		var also_include_naming_schemaList []string
 		data.AlsoIncludeNamingSchema.ElementsAs(ctx, &also_include_naming_schemaList, false)
 		params["also_include_naming_schema"] = also_include_naming_schemaList
 	}
-	if !data.NameRegex.IsNull() {
+	if !data.NameRegex.IsNull() && !data.NameRegex.IsUnknown() {
 		params["name_regex"] = data.NameRegex.ValueString()
 	}
-	if !data.Auto.IsNull() {
+	if !data.Auto.IsNull() && !data.Auto.IsUnknown() {
 		params["auto"] = data.Auto.ValueBool()
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -455,7 +561,7 @@ func (r *ReplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.RestrictSchedule.IsNull() {
+	if !data.RestrictSchedule.IsNull() && !data.RestrictSchedule.IsUnknown() {
 		var restrict_scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.RestrictSchedule.ValueString()), &restrict_scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse restrict_schedule: %s", err))
@@ -463,28 +569,28 @@ func (r *ReplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["restrict_schedule"] = restrict_scheduleObj
 	}
-	if !data.OnlyMatchingSchedule.IsNull() {
+	if !data.OnlyMatchingSchedule.IsNull() && !data.OnlyMatchingSchedule.IsUnknown() {
 		params["only_matching_schedule"] = data.OnlyMatchingSchedule.ValueBool()
 	}
-	if !data.AllowFromScratch.IsNull() {
+	if !data.AllowFromScratch.IsNull() && !data.AllowFromScratch.IsUnknown() {
 		params["allow_from_scratch"] = data.AllowFromScratch.ValueBool()
 	}
-	if !data.Readonly.IsNull() {
+	if !data.Readonly.IsNull() && !data.Readonly.IsUnknown() {
 		params["readonly"] = data.Readonly.ValueString()
 	}
-	if !data.HoldPendingSnapshots.IsNull() {
+	if !data.HoldPendingSnapshots.IsNull() && !data.HoldPendingSnapshots.IsUnknown() {
 		params["hold_pending_snapshots"] = data.HoldPendingSnapshots.ValueBool()
 	}
-	if !data.RetentionPolicy.IsNull() {
+	if !data.RetentionPolicy.IsNull() && !data.RetentionPolicy.IsUnknown() {
 		params["retention_policy"] = data.RetentionPolicy.ValueString()
 	}
-	if !data.LifetimeValue.IsNull() {
+	if !data.LifetimeValue.IsNull() && !data.LifetimeValue.IsUnknown() {
 		params["lifetime_value"] = data.LifetimeValue.ValueInt64()
 	}
-	if !data.LifetimeUnit.IsNull() {
+	if !data.LifetimeUnit.IsNull() && !data.LifetimeUnit.IsUnknown() {
 		params["lifetime_unit"] = data.LifetimeUnit.ValueString()
 	}
-	if !data.Lifetimes.IsNull() {
+	if !data.Lifetimes.IsNull() && !data.Lifetimes.IsUnknown() {
 		var lifetimesList []string
 		data.Lifetimes.ElementsAs(ctx, &lifetimesList, false)
 		var lifetimesObjs []map[string]interface{}
@@ -498,28 +604,28 @@ func (r *ReplicationResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		params["lifetimes"] = lifetimesObjs
 	}
-	if !data.Compression.IsNull() {
+	if !data.Compression.IsNull() && !data.Compression.IsUnknown() {
 		params["compression"] = data.Compression.ValueString()
 	}
-	if !data.SpeedLimit.IsNull() {
+	if !data.SpeedLimit.IsNull() && !data.SpeedLimit.IsUnknown() {
 		params["speed_limit"] = data.SpeedLimit.ValueInt64()
 	}
-	if !data.LargeBlock.IsNull() {
+	if !data.LargeBlock.IsNull() && !data.LargeBlock.IsUnknown() {
 		params["large_block"] = data.LargeBlock.ValueBool()
 	}
-	if !data.Embed.IsNull() {
+	if !data.Embed.IsNull() && !data.Embed.IsUnknown() {
 		params["embed"] = data.Embed.ValueBool()
 	}
-	if !data.Compressed.IsNull() {
+	if !data.Compressed.IsNull() && !data.Compressed.IsUnknown() {
 		params["compressed"] = data.Compressed.ValueBool()
 	}
-	if !data.Retries.IsNull() {
+	if !data.Retries.IsNull() && !data.Retries.IsUnknown() {
 		params["retries"] = data.Retries.ValueInt64()
 	}
-	if !data.LoggingLevel.IsNull() {
+	if !data.LoggingLevel.IsNull() && !data.LoggingLevel.IsUnknown() {
 		params["logging_level"] = data.LoggingLevel.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
 
@@ -541,6 +647,8 @@ func (r *ReplicationResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, replicationIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -660,6 +768,7 @@ func (r *ReplicationResource) Read(ctx context.Context, req resource.ReadRequest
 			data.RetentionPolicy = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, replicationIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -686,61 +795,61 @@ func (r *ReplicationResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Direction.IsNull() {
+	if !data.Direction.IsNull() && !data.Direction.IsUnknown() {
 		params["direction"] = data.Direction.ValueString()
 	}
-	if !data.Transport.IsNull() {
+	if !data.Transport.IsNull() && !data.Transport.IsUnknown() {
 		params["transport"] = data.Transport.ValueString()
 	}
-	if !data.SshCredentials.IsNull() {
+	if !data.SshCredentials.IsNull() && !data.SshCredentials.IsUnknown() {
 		params["ssh_credentials"] = data.SshCredentials.ValueInt64()
 	}
-	if !data.NetcatActiveSide.IsNull() {
+	if !data.NetcatActiveSide.IsNull() && !data.NetcatActiveSide.IsUnknown() {
 		params["netcat_active_side"] = data.NetcatActiveSide.ValueString()
 	}
-	if !data.NetcatActiveSideListenAddress.IsNull() {
+	if !data.NetcatActiveSideListenAddress.IsNull() && !data.NetcatActiveSideListenAddress.IsUnknown() {
 		params["netcat_active_side_listen_address"] = data.NetcatActiveSideListenAddress.ValueString()
 	}
-	if !data.NetcatActiveSidePortMin.IsNull() {
+	if !data.NetcatActiveSidePortMin.IsNull() && !data.NetcatActiveSidePortMin.IsUnknown() {
 		params["netcat_active_side_port_min"] = data.NetcatActiveSidePortMin.ValueInt64()
 	}
-	if !data.NetcatActiveSidePortMax.IsNull() {
+	if !data.NetcatActiveSidePortMax.IsNull() && !data.NetcatActiveSidePortMax.IsUnknown() {
 		params["netcat_active_side_port_max"] = data.NetcatActiveSidePortMax.ValueInt64()
 	}
-	if !data.NetcatPassiveSideConnectAddress.IsNull() {
+	if !data.NetcatPassiveSideConnectAddress.IsNull() && !data.NetcatPassiveSideConnectAddress.IsUnknown() {
 		params["netcat_passive_side_connect_address"] = data.NetcatPassiveSideConnectAddress.ValueString()
 	}
-	if !data.Sudo.IsNull() {
+	if !data.Sudo.IsNull() && !data.Sudo.IsUnknown() {
 		params["sudo"] = data.Sudo.ValueBool()
 	}
-	if !data.SourceDatasets.IsNull() {
+	if !data.SourceDatasets.IsNull() && !data.SourceDatasets.IsUnknown() {
 		var source_datasetsList []string
 		data.SourceDatasets.ElementsAs(ctx, &source_datasetsList, false)
 		params["source_datasets"] = source_datasetsList
 	}
-	if !data.TargetDataset.IsNull() {
+	if !data.TargetDataset.IsNull() && !data.TargetDataset.IsUnknown() {
 		params["target_dataset"] = data.TargetDataset.ValueString()
 	}
-	if !data.Recursive.IsNull() {
+	if !data.Recursive.IsNull() && !data.Recursive.IsUnknown() {
 		params["recursive"] = data.Recursive.ValueBool()
 	}
-	if !data.Exclude.IsNull() {
+	if !data.Exclude.IsNull() && !data.Exclude.IsUnknown() {
 		var excludeList []string
 		data.Exclude.ElementsAs(ctx, &excludeList, false)
 		params["exclude"] = excludeList
 	}
-	if !data.Properties.IsNull() {
+	if !data.Properties.IsNull() && !data.Properties.IsUnknown() {
 		params["properties"] = data.Properties.ValueBool()
 	}
-	if !data.PropertiesExclude.IsNull() {
+	if !data.PropertiesExclude.IsNull() && !data.PropertiesExclude.IsUnknown() {
 		var properties_excludeList []string
 		data.PropertiesExclude.ElementsAs(ctx, &properties_excludeList, false)
 		params["properties_exclude"] = properties_excludeList
 	}
-	if !data.PropertiesOverride.IsNull() {
+	if !data.PropertiesOverride.IsNull() && !data.PropertiesOverride.IsUnknown() {
 		var properties_overrideObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.PropertiesOverride.ValueString()), &properties_overrideObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse properties_override: %s", err))
@@ -748,46 +857,46 @@ func (r *ReplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		params["properties_override"] = properties_overrideObj
 	}
-	if !data.Replicate.IsNull() {
+	if !data.Replicate.IsNull() && !data.Replicate.IsUnknown() {
 		params["replicate"] = data.Replicate.ValueBool()
 	}
-	if !data.Encryption.IsNull() {
+	if !data.Encryption.IsNull() && !data.Encryption.IsUnknown() {
 		params["encryption"] = data.Encryption.ValueBool()
 	}
-	if !data.EncryptionInherit.IsNull() {
+	if !data.EncryptionInherit.IsNull() && !data.EncryptionInherit.IsUnknown() {
 		params["encryption_inherit"] = data.EncryptionInherit.ValueBool()
 	}
-	if !data.EncryptionKey.IsNull() {
+	if !data.EncryptionKey.IsNull() && !data.EncryptionKey.IsUnknown() {
 		params["encryption_key"] = data.EncryptionKey.ValueString()
 	}
-	if !data.EncryptionKeyFormat.IsNull() {
+	if !data.EncryptionKeyFormat.IsNull() && !data.EncryptionKeyFormat.IsUnknown() {
 		params["encryption_key_format"] = data.EncryptionKeyFormat.ValueString()
 	}
-	if !data.EncryptionKeyLocation.IsNull() {
+	if !data.EncryptionKeyLocation.IsNull() && !data.EncryptionKeyLocation.IsUnknown() {
 		params["encryption_key_location"] = data.EncryptionKeyLocation.ValueString()
 	}
-	if !data.PeriodicSnapshotTasks.IsNull() {
+	if !data.PeriodicSnapshotTasks.IsNull() && !data.PeriodicSnapshotTasks.IsUnknown() {
 		var periodic_snapshot_tasksList []string
 		data.PeriodicSnapshotTasks.ElementsAs(ctx, &periodic_snapshot_tasksList, false)
 		params["periodic_snapshot_tasks"] = periodic_snapshot_tasksList
 	}
-	if !data.NamingSchema.IsNull() {
+	if !data.NamingSchema.IsNull() && !data.NamingSchema.IsUnknown() {
 		var naming_schemaList []string
 		data.NamingSchema.ElementsAs(ctx, &naming_schemaList, false)
 		params["naming_schema"] = naming_schemaList
 	}
-	if !data.AlsoIncludeNamingSchema.IsNull() {
+	if !data.AlsoIncludeNamingSchema.IsNull() && !data.AlsoIncludeNamingSchema.IsUnknown() {
 		var also_include_naming_schemaList []string
 		data.AlsoIncludeNamingSchema.ElementsAs(ctx, &also_include_naming_schemaList, false)
 		params["also_include_naming_schema"] = also_include_naming_schemaList
 	}
-	if !data.NameRegex.IsNull() {
+	if !data.NameRegex.IsNull() && !data.NameRegex.IsUnknown() {
 		params["name_regex"] = data.NameRegex.ValueString()
 	}
-	if !data.Auto.IsNull() {
+	if !data.Auto.IsNull() && !data.Auto.IsUnknown() {
 		params["auto"] = data.Auto.ValueBool()
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -795,7 +904,7 @@ func (r *ReplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.RestrictSchedule.IsNull() {
+	if !data.RestrictSchedule.IsNull() && !data.RestrictSchedule.IsUnknown() {
 		var restrict_scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.RestrictSchedule.ValueString()), &restrict_scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse restrict_schedule: %s", err))
@@ -803,28 +912,28 @@ func (r *ReplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		params["restrict_schedule"] = restrict_scheduleObj
 	}
-	if !data.OnlyMatchingSchedule.IsNull() {
+	if !data.OnlyMatchingSchedule.IsNull() && !data.OnlyMatchingSchedule.IsUnknown() {
 		params["only_matching_schedule"] = data.OnlyMatchingSchedule.ValueBool()
 	}
-	if !data.AllowFromScratch.IsNull() {
+	if !data.AllowFromScratch.IsNull() && !data.AllowFromScratch.IsUnknown() {
 		params["allow_from_scratch"] = data.AllowFromScratch.ValueBool()
 	}
-	if !data.Readonly.IsNull() {
+	if !data.Readonly.IsNull() && !data.Readonly.IsUnknown() {
 		params["readonly"] = data.Readonly.ValueString()
 	}
-	if !data.HoldPendingSnapshots.IsNull() {
+	if !data.HoldPendingSnapshots.IsNull() && !data.HoldPendingSnapshots.IsUnknown() {
 		params["hold_pending_snapshots"] = data.HoldPendingSnapshots.ValueBool()
 	}
-	if !data.RetentionPolicy.IsNull() {
+	if !data.RetentionPolicy.IsNull() && !data.RetentionPolicy.IsUnknown() {
 		params["retention_policy"] = data.RetentionPolicy.ValueString()
 	}
-	if !data.LifetimeValue.IsNull() {
+	if !data.LifetimeValue.IsNull() && !data.LifetimeValue.IsUnknown() {
 		params["lifetime_value"] = data.LifetimeValue.ValueInt64()
 	}
-	if !data.LifetimeUnit.IsNull() {
+	if !data.LifetimeUnit.IsNull() && !data.LifetimeUnit.IsUnknown() {
 		params["lifetime_unit"] = data.LifetimeUnit.ValueString()
 	}
-	if !data.Lifetimes.IsNull() {
+	if !data.Lifetimes.IsNull() && !data.Lifetimes.IsUnknown() {
 		var lifetimesList []string
 		data.Lifetimes.ElementsAs(ctx, &lifetimesList, false)
 		var lifetimesObjs []map[string]interface{}
@@ -838,38 +947,40 @@ func (r *ReplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		params["lifetimes"] = lifetimesObjs
 	}
-	if !data.Compression.IsNull() {
+	if !data.Compression.IsNull() && !data.Compression.IsUnknown() {
 		params["compression"] = data.Compression.ValueString()
 	}
-	if !data.SpeedLimit.IsNull() {
+	if !data.SpeedLimit.IsNull() && !data.SpeedLimit.IsUnknown() {
 		params["speed_limit"] = data.SpeedLimit.ValueInt64()
 	}
-	if !data.LargeBlock.IsNull() {
+	if !data.LargeBlock.IsNull() && !data.LargeBlock.IsUnknown() {
 		params["large_block"] = data.LargeBlock.ValueBool()
 	}
-	if !data.Embed.IsNull() {
+	if !data.Embed.IsNull() && !data.Embed.IsUnknown() {
 		params["embed"] = data.Embed.ValueBool()
 	}
-	if !data.Compressed.IsNull() {
+	if !data.Compressed.IsNull() && !data.Compressed.IsUnknown() {
 		params["compressed"] = data.Compressed.ValueBool()
 	}
-	if !data.Retries.IsNull() {
+	if !data.Retries.IsNull() && !data.Retries.IsUnknown() {
 		params["retries"] = data.Retries.ValueInt64()
 	}
-	if !data.LoggingLevel.IsNull() {
+	if !data.LoggingLevel.IsNull() && !data.LoggingLevel.IsUnknown() {
 		params["logging_level"] = data.LoggingLevel.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
 
-	_, err = r.client.Call("replication.update", []interface{}{id, params})
+	result, err := r.client.Call("replication.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update replication: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, replicationIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -894,3 +1005,457 @@ func (r *ReplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *ReplicationResource) setComputed(result interface{}, data *ReplicationResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.SshCredentials.IsUnknown() {
+		data.SshCredentials = types.Int64Null()
+		if v, ok := resultMap["ssh_credentials"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.SshCredentials = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.SshCredentials = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.NetcatActiveSide.IsUnknown() {
+		data.NetcatActiveSide = types.StringNull()
+		if v, ok := resultMap["netcat_active_side"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.NetcatActiveSide = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.NetcatActiveSide = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.NetcatActiveSide = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.NetcatActiveSideListenAddress.IsUnknown() {
+		data.NetcatActiveSideListenAddress = types.StringNull()
+		if v, ok := resultMap["netcat_active_side_listen_address"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.NetcatActiveSideListenAddress = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.NetcatActiveSideListenAddress = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.NetcatActiveSideListenAddress = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.NetcatActiveSidePortMin.IsUnknown() {
+		data.NetcatActiveSidePortMin = types.Int64Null()
+		if v, ok := resultMap["netcat_active_side_port_min"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.NetcatActiveSidePortMin = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.NetcatActiveSidePortMin = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.NetcatActiveSidePortMax.IsUnknown() {
+		data.NetcatActiveSidePortMax = types.Int64Null()
+		if v, ok := resultMap["netcat_active_side_port_max"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.NetcatActiveSidePortMax = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.NetcatActiveSidePortMax = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.NetcatPassiveSideConnectAddress.IsUnknown() {
+		data.NetcatPassiveSideConnectAddress = types.StringNull()
+		if v, ok := resultMap["netcat_passive_side_connect_address"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.NetcatPassiveSideConnectAddress = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.NetcatPassiveSideConnectAddress = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.NetcatPassiveSideConnectAddress = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Sudo.IsUnknown() {
+		data.Sudo = types.BoolNull()
+		if v, ok := resultMap["sudo"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Sudo = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Exclude.IsUnknown() {
+		data.Exclude = types.ListNull(types.StringType)
+		if v, ok := resultMap["exclude"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Exclude, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Properties.IsUnknown() {
+		data.Properties = types.BoolNull()
+		if v, ok := resultMap["properties"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Properties = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.PropertiesExclude.IsUnknown() {
+		data.PropertiesExclude = types.ListNull(types.StringType)
+		if v, ok := resultMap["properties_exclude"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.PropertiesExclude, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.PropertiesOverride.IsUnknown() {
+		data.PropertiesOverride = types.StringNull()
+		if v, ok := resultMap["properties_override"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.PropertiesOverride = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.Replicate.IsUnknown() {
+		data.Replicate = types.BoolNull()
+		if v, ok := resultMap["replicate"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Replicate = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Encryption.IsUnknown() {
+		data.Encryption = types.BoolNull()
+		if v, ok := resultMap["encryption"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Encryption = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.EncryptionInherit.IsUnknown() {
+		data.EncryptionInherit = types.BoolNull()
+		if v, ok := resultMap["encryption_inherit"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.EncryptionInherit = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.EncryptionKey.IsUnknown() {
+		data.EncryptionKey = types.StringNull()
+		if v, ok := resultMap["encryption_key"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.EncryptionKey = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.EncryptionKey = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.EncryptionKey = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.EncryptionKeyFormat.IsUnknown() {
+		data.EncryptionKeyFormat = types.StringNull()
+		if v, ok := resultMap["encryption_key_format"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.EncryptionKeyFormat = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.EncryptionKeyFormat = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.EncryptionKeyFormat = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.EncryptionKeyLocation.IsUnknown() {
+		data.EncryptionKeyLocation = types.StringNull()
+		if v, ok := resultMap["encryption_key_location"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.EncryptionKeyLocation = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.EncryptionKeyLocation = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.EncryptionKeyLocation = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.PeriodicSnapshotTasks.IsUnknown() {
+		data.PeriodicSnapshotTasks = types.ListNull(types.StringType)
+		if v, ok := resultMap["periodic_snapshot_tasks"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.PeriodicSnapshotTasks, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.NamingSchema.IsUnknown() {
+		data.NamingSchema = types.ListNull(types.StringType)
+		if v, ok := resultMap["naming_schema"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.NamingSchema, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.AlsoIncludeNamingSchema.IsUnknown() {
+		data.AlsoIncludeNamingSchema = types.ListNull(types.StringType)
+		if v, ok := resultMap["also_include_naming_schema"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.AlsoIncludeNamingSchema, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.NameRegex.IsUnknown() {
+		data.NameRegex = types.StringNull()
+		if v, ok := resultMap["name_regex"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.NameRegex = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.NameRegex = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.NameRegex = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Schedule.IsUnknown() {
+		data.Schedule = types.StringNull()
+		if v, ok := resultMap["schedule"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Schedule = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.RestrictSchedule.IsUnknown() {
+		data.RestrictSchedule = types.StringNull()
+		if v, ok := resultMap["restrict_schedule"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.RestrictSchedule = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.OnlyMatchingSchedule.IsUnknown() {
+		data.OnlyMatchingSchedule = types.BoolNull()
+		if v, ok := resultMap["only_matching_schedule"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.OnlyMatchingSchedule = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.AllowFromScratch.IsUnknown() {
+		data.AllowFromScratch = types.BoolNull()
+		if v, ok := resultMap["allow_from_scratch"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.AllowFromScratch = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Readonly.IsUnknown() {
+		data.Readonly = types.StringNull()
+		if v, ok := resultMap["readonly"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Readonly = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Readonly = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Readonly = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.HoldPendingSnapshots.IsUnknown() {
+		data.HoldPendingSnapshots = types.BoolNull()
+		if v, ok := resultMap["hold_pending_snapshots"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.HoldPendingSnapshots = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.LifetimeValue.IsUnknown() {
+		data.LifetimeValue = types.Int64Null()
+		if v, ok := resultMap["lifetime_value"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.LifetimeValue = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.LifetimeValue = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.LifetimeUnit.IsUnknown() {
+		data.LifetimeUnit = types.StringNull()
+		if v, ok := resultMap["lifetime_unit"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.LifetimeUnit = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.LifetimeUnit = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.LifetimeUnit = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Lifetimes.IsUnknown() {
+		data.Lifetimes = types.ListNull(types.StringType)
+		if v, ok := resultMap["lifetimes"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					b, _ := json.Marshal(item)
+					strVals[i] = types.StringValue(string(b))
+				}
+				data.Lifetimes, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Compression.IsUnknown() {
+		data.Compression = types.StringNull()
+		if v, ok := resultMap["compression"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Compression = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Compression = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Compression = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.SpeedLimit.IsUnknown() {
+		data.SpeedLimit = types.Int64Null()
+		if v, ok := resultMap["speed_limit"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.SpeedLimit = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.SpeedLimit = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.LargeBlock.IsUnknown() {
+		data.LargeBlock = types.BoolNull()
+		if v, ok := resultMap["large_block"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.LargeBlock = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Embed.IsUnknown() {
+		data.Embed = types.BoolNull()
+		if v, ok := resultMap["embed"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Embed = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Compressed.IsUnknown() {
+		data.Compressed = types.BoolNull()
+		if v, ok := resultMap["compressed"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Compressed = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Retries.IsUnknown() {
+		data.Retries = types.Int64Null()
+		if v, ok := resultMap["retries"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Retries = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Retries = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.LoggingLevel.IsUnknown() {
+		data.LoggingLevel = types.StringNull()
+		if v, ok := resultMap["logging_level"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.LoggingLevel = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.LoggingLevel = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.LoggingLevel = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+}
@@ -5,9 +5,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -57,8 +66,13 @@ func (r *RsynctaskResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *RsynctaskResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *RsynctaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a Rsync Task.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -75,113 +89,159 @@ func (r *RsynctaskResource) Schema(ctx context.Context, req resource.SchemaReque
 			"mode": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Operating mechanism for Rsync, i.e. Rsync Module mode or Rsync SSH mode.",
+				Default:     stringdefault.StaticString("MODULE"),
+				Validators:  []validator.String{stringvalidator.OneOf("MODULE", "SSH")},
 			},
 			"remotehost": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "IP address or hostname of the remote system. If username differs on the remote host, \"username@remot",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "IP address or hostname of the remote system. If username differs on the remote host, \"username@remot",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"remoteport": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Port number for SSH connection. Only applies when `mode` is SSH.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Port number for SSH connection. Only applies when `mode` is SSH.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"remotemodule": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Name of remote module, this attribute should be specified when `mode` is set to MODULE.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Name of remote module, this attribute should be specified when `mode` is set to MODULE.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"ssh_credentials": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Keychain credential ID for SSH authentication. `null` to use user's SSH keys.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Keychain credential ID for SSH authentication. `null` to use user's SSH keys.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"remotepath": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Path on the remote system to synchronize with.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"direction": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Specify if data should be PULLED or PUSHED from the remote system.",
+				Default:     stringdefault.StaticString("PUSH"),
+				Validators:  []validator.String{stringvalidator.OneOf("PULL", "PUSH")},
 			},
 			"desc": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Description of the rsync task.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"schedule": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Cron schedule for when the rsync task should run.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Cron schedule for when the rsync task should run.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"recursive": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Recursively transfer subdirectories.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"times": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Preserve modification times of files.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"compress": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Reduce the size of the data to be transmitted.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"archive": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Make rsync run recursively, preserving symlinks, permissions, modification times, group, and special",
+				Default:     booldefault.StaticBool(false),
 			},
 			"delete": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Delete files in the destination directory that do not exist in the source directory.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"quiet": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Suppress informational messages from rsync.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"preserveperm": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Preserve original file permissions.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"preserveattr": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Preserve extended attributes of files.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"delayupdates": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Delay updating destination files until all transfers are complete.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"extra": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Array of additional rsync command-line options.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Array of additional rsync command-line options.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether this rsync task is enabled.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"validate_rpath": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Validate the existence of the remote path.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"ssh_keyscan": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Automatically add remote host key to user's known_hosts file.",
+				Default:     booldefault.StaticBool(false),
 			},
 		},
 	}
@@ -207,37 +267,37 @@ func (r *RsynctaskResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.User.IsNull() {
+	if !data.User.IsNull() && !data.User.IsUnknown() {
 		params["user"] = data.User.ValueString()
 	}
-	if !data.Mode.IsNull() {
+	if !data.Mode.IsNull() && !data.Mode.IsUnknown() {
 		params["mode"] = data.Mode.ValueString()
 	}
-	if !data.Remotehost.IsNull() {
+	if !data.Remotehost.IsNull() && !data.Remotehost.IsUnknown() {
 		params["remotehost"] = data.Remotehost.ValueString()
 	}
-	if !data.Remoteport.IsNull() {
+	if !data.Remoteport.IsNull() && !data.Remoteport.IsUnknown() {
 		params["remoteport"] = data.Remoteport.ValueInt64()
 	}
-	if !data.Remotemodule.IsNull() {
+	if !data.Remotemodule.IsNull() && !data.Remotemodule.IsUnknown() {
 		params["remotemodule"] = data.Remotemodule.ValueString()
 	}
-	if !data.SshCredentials.IsNull() {
+	if !data.SshCredentials.IsNull() && !data.SshCredentials.IsUnknown() {
 		params["ssh_credentials"] = data.SshCredentials.ValueInt64()
 	}
-	if !data.Remotepath.IsNull() {
+	if !data.Remotepath.IsNull() && !data.Remotepath.IsUnknown() {
 		params["remotepath"] = data.Remotepath.ValueString()
 	}
-	if !data.Direction.IsNull() {
+	if !data.Direction.IsNull() && !data.Direction.IsUnknown() {
 		params["direction"] = data.Direction.ValueString()
 	}
-	if !data.Desc.IsNull() {
+	if !data.Desc.IsNull() && !data.Desc.IsUnknown() {
 		params["desc"] = data.Desc.ValueString()
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -245,45 +305,45 @@ func (r *RsynctaskResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.Recursive.IsNull() {
+	if !data.Recursive.IsNull() && !data.Recursive.IsUnknown() {
 		params["recursive"] = data.Recursive.ValueBool()
 	}
-	if !data.Times.IsNull() {
+	if !data.Times.IsNull() && !data.Times.IsUnknown() {
 		params["times"] = data.Times.ValueBool()
 	}
-	if !data.Compress.IsNull() {
+	if !data.Compress.IsNull() && !data.Compress.IsUnknown() {
 		params["compress"] = data.Compress.ValueBool()
 	}
-	if !data.Archive.IsNull() {
+	if !data.Archive.IsNull() && !data.Archive.IsUnknown() {
 		params["archive"] = data.Archive.ValueBool()
 	}
-	if !data.Delete.IsNull() {
+	if !data.Delete.IsNull() && !data.Delete.IsUnknown() {
 		params["delete"] = data.Delete.ValueBool()
 	}
-	if !data.Quiet.IsNull() {
+	if !data.Quiet.IsNull() && !data.Quiet.IsUnknown() {
 		params["quiet"] = data.Quiet.ValueBool()
 	}
-	if !data.Preserveperm.IsNull() {
+	if !data.Preserveperm.IsNull() && !data.Preserveperm.IsUnknown() {
 		params["preserveperm"] = data.Preserveperm.ValueBool()
 	}
-	if !data.Preserveattr.IsNull() {
+	if !data.Preserveattr.IsNull() && !data.Preserveattr.IsUnknown() {
 		params["preserveattr"] = data.Preserveattr.ValueBool()
 	}
-	if !data.Delayupdates.IsNull() {
+	if !data.Delayupdates.IsNull() && !data.Delayupdates.IsUnknown() {
 		params["delayupdates"] = data.Delayupdates.ValueBool()
 	}
-	if !data.Extra.IsNull() {
+	if !data.Extra.IsNull() && !data.Extra.IsUnknown() {
 		var extraList []string
 		data.Extra.ElementsAs(ctx, &extraList, false)
 		params["extra"] = extraList
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.ValidateRpath.IsNull() {
+	if !data.ValidateRpath.IsNull() && !data.ValidateRpath.IsUnknown() {
 		params["validate_rpath"] = data.ValidateRpath.ValueBool()
 	}
-	if !data.SshKeyscan.IsNull() {
+	if !data.SshKeyscan.IsNull() && !data.SshKeyscan.IsUnknown() {
 		params["ssh_keyscan"] = data.SshKeyscan.ValueBool()
 	}
 
@@ -305,6 +365,7 @@ func (r *RsynctaskResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -395,37 +456,37 @@ func (r *RsynctaskResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	params := map[string]interface{}{}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.User.IsNull() {
+	if !data.User.IsNull() && !data.User.IsUnknown() {
 		params["user"] = data.User.ValueString()
 	}
-	if !data.Mode.IsNull() {
+	if !data.Mode.IsNull() && !data.Mode.IsUnknown() {
 		params["mode"] = data.Mode.ValueString()
 	}
-	if !data.Remotehost.IsNull() {
+	if !data.Remotehost.IsNull() && !data.Remotehost.IsUnknown() {
 		params["remotehost"] = data.Remotehost.ValueString()
 	}
-	if !data.Remoteport.IsNull() {
+	if !data.Remoteport.IsNull() && !data.Remoteport.IsUnknown() {
 		params["remoteport"] = data.Remoteport.ValueInt64()
 	}
-	if !data.Remotemodule.IsNull() {
+	if !data.Remotemodule.IsNull() && !data.Remotemodule.IsUnknown() {
 		params["remotemodule"] = data.Remotemodule.ValueString()
 	}
-	if !data.SshCredentials.IsNull() {
+	if !data.SshCredentials.IsNull() && !data.SshCredentials.IsUnknown() {
 		params["ssh_credentials"] = data.SshCredentials.ValueInt64()
 	}
-	if !data.Remotepath.IsNull() {
+	if !data.Remotepath.IsNull() && !data.Remotepath.IsUnknown() {
 		params["remotepath"] = data.Remotepath.ValueString()
 	}
-	if !data.Direction.IsNull() {
+	if !data.Direction.IsNull() && !data.Direction.IsUnknown() {
 		params["direction"] = data.Direction.ValueString()
 	}
-	if !data.Desc.IsNull() {
+	if !data.Desc.IsNull() && !data.Desc.IsUnknown() {
 		params["desc"] = data.Desc.ValueString()
 	}
-	if !data.Schedule.IsNull() {
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
 		var scheduleObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Schedule.ValueString()), &scheduleObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse schedule: %s", err))
@@ -433,55 +494,56 @@ func (r *RsynctaskResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		params["schedule"] = scheduleObj
 	}
-	if !data.Recursive.IsNull() {
+	if !data.Recursive.IsNull() && !data.Recursive.IsUnknown() {
 		params["recursive"] = data.Recursive.ValueBool()
 	}
-	if !data.Times.IsNull() {
+	if !data.Times.IsNull() && !data.Times.IsUnknown() {
 		params["times"] = data.Times.ValueBool()
 	}
-	if !data.Compress.IsNull() {
+	if !data.Compress.IsNull() && !data.Compress.IsUnknown() {
 		params["compress"] = data.Compress.ValueBool()
 	}
-	if !data.Archive.IsNull() {
+	if !data.Archive.IsNull() && !data.Archive.IsUnknown() {
 		params["archive"] = data.Archive.ValueBool()
 	}
-	if !data.Delete.IsNull() {
+	if !data.Delete.IsNull() && !data.Delete.IsUnknown() {
 		params["delete"] = data.Delete.ValueBool()
 	}
-	if !data.Quiet.IsNull() {
+	if !data.Quiet.IsNull() && !data.Quiet.IsUnknown() {
 		params["quiet"] = data.Quiet.ValueBool()
 	}
-	if !data.Preserveperm.IsNull() {
+	if !data.Preserveperm.IsNull() && !data.Preserveperm.IsUnknown() {
 		params["preserveperm"] = data.Preserveperm.ValueBool()
 	}
-	if !data.Preserveattr.IsNull() {
+	if !data.Preserveattr.IsNull() && !data.Preserveattr.IsUnknown() {
 		params["preserveattr"] = data.Preserveattr.ValueBool()
 	}
-	if !data.Delayupdates.IsNull() {
+	if !data.Delayupdates.IsNull() && !data.Delayupdates.IsUnknown() {
 		params["delayupdates"] = data.Delayupdates.ValueBool()
 	}
-	if !data.Extra.IsNull() {
+	if !data.Extra.IsNull() && !data.Extra.IsUnknown() {
 		var extraList []string
 		data.Extra.ElementsAs(ctx, &extraList, false)
 		params["extra"] = extraList
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.ValidateRpath.IsNull() {
+	if !data.ValidateRpath.IsNull() && !data.ValidateRpath.IsUnknown() {
 		params["validate_rpath"] = data.ValidateRpath.ValueBool()
 	}
-	if !data.SshKeyscan.IsNull() {
+	if !data.SshKeyscan.IsNull() && !data.SshKeyscan.IsUnknown() {
 		params["ssh_keyscan"] = data.SshKeyscan.ValueBool()
 	}
 
-	_, err = r.client.Call("rsynctask.update", []interface{}{id, params})
+	result, err := r.client.Call("rsynctask.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update rsynctask: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -506,3 +568,245 @@ func (r *RsynctaskResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *RsynctaskResource) setComputed(result interface{}, data *RsynctaskResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Mode.IsUnknown() {
+		data.Mode = types.StringNull()
+		if v, ok := resultMap["mode"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Mode = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Mode = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Mode = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Remotehost.IsUnknown() {
+		data.Remotehost = types.StringNull()
+		if v, ok := resultMap["remotehost"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Remotehost = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Remotehost = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Remotehost = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Remoteport.IsUnknown() {
+		data.Remoteport = types.Int64Null()
+		if v, ok := resultMap["remoteport"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Remoteport = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Remoteport = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Remotemodule.IsUnknown() {
+		data.Remotemodule = types.StringNull()
+		if v, ok := resultMap["remotemodule"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Remotemodule = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Remotemodule = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Remotemodule = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.SshCredentials.IsUnknown() {
+		data.SshCredentials = types.Int64Null()
+		if v, ok := resultMap["ssh_credentials"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.SshCredentials = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.SshCredentials = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Remotepath.IsUnknown() {
+		data.Remotepath = types.StringNull()
+		if v, ok := resultMap["remotepath"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Remotepath = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Remotepath = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Remotepath = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Direction.IsUnknown() {
+		data.Direction = types.StringNull()
+		if v, ok := resultMap["direction"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Direction = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Direction = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Direction = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Desc.IsUnknown() {
+		data.Desc = types.StringNull()
+		if v, ok := resultMap["desc"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Desc = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Desc = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Desc = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Schedule.IsUnknown() {
+		data.Schedule = types.StringNull()
+		if v, ok := resultMap["schedule"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Schedule = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.Recursive.IsUnknown() {
+		data.Recursive = types.BoolNull()
+		if v, ok := resultMap["recursive"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Recursive = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Times.IsUnknown() {
+		data.Times = types.BoolNull()
+		if v, ok := resultMap["times"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Times = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Compress.IsUnknown() {
+		data.Compress = types.BoolNull()
+		if v, ok := resultMap["compress"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Compress = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Archive.IsUnknown() {
+		data.Archive = types.BoolNull()
+		if v, ok := resultMap["archive"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Archive = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Delete.IsUnknown() {
+		data.Delete = types.BoolNull()
+		if v, ok := resultMap["delete"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Delete = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Quiet.IsUnknown() {
+		data.Quiet = types.BoolNull()
+		if v, ok := resultMap["quiet"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Quiet = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Preserveperm.IsUnknown() {
+		data.Preserveperm = types.BoolNull()
+		if v, ok := resultMap["preserveperm"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Preserveperm = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Preserveattr.IsUnknown() {
+		data.Preserveattr = types.BoolNull()
+		if v, ok := resultMap["preserveattr"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Preserveattr = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Delayupdates.IsUnknown() {
+		data.Delayupdates = types.BoolNull()
+		if v, ok := resultMap["delayupdates"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Delayupdates = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Extra.IsUnknown() {
+		data.Extra = types.ListNull(types.StringType)
+		if v, ok := resultMap["extra"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Extra, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.ValidateRpath.IsUnknown() {
+		data.ValidateRpath = types.BoolNull()
+		if v, ok := resultMap["validate_rpath"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.ValidateRpath = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.SshKeyscan.IsUnknown() {
+		data.SshKeyscan = types.BoolNull()
+		if v, ok := resultMap["ssh_keyscan"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.SshKeyscan = types.BoolValue(bv)
+			}
+		}
+	}
+}
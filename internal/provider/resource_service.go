@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	serviceRunning = "RUNNING"
+	serviceStopped = "STOPPED"
+)
+
+var _ resource.ResourceWithIdentity = &ServiceResource{}
+
+// ServiceResource keeps a service enabled at boot and running (or not). The
+// service itself always exists; this resource only manages its state.
+type ServiceResource struct {
+	client *client.Client
+}
+
+type ServiceResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Service       types.String   `tfsdk:"service"`
+	Enable        types.Bool     `tfsdk:"enable"`
+	State         types.String   `tfsdk:"state"`
+	Triggers      types.Map      `tfsdk:"triggers"`
+	TriggerAction types.String   `tfsdk:"trigger_action"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewServiceResource() resource.Resource {
+	return &ServiceResource{}
+}
+
+func (r *ServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+// serviceIdentity identifies a service by its name, e.g. cifs
+var serviceIdentity = []identityKey{{Name: "service"}}
+
+func (r *ServiceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(serviceIdentity)
+}
+
+func (r *ServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name := req.ID
+	if name == "" {
+		var service types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("service"), &service)...)
+		name = service.ValueString()
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("trigger_action"), "RELOAD")...)
+}
+
+func (r *ServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Keeps a TrueNAS service enabled at boot and running or stopped. Destroying the resource leaves the service as it is.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "Service name",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"service": schema.StringAttribute{
+				Required:      true,
+				Description:   "Service name, e.g. `cifs`, `nfs`, `ssh` or `ups`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"enable": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Start the service at boot. Left as it is when unset.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"state": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether the service should be `RUNNING` or `STOPPED`. Left as it is when unset.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf(serviceRunning, serviceStopped)},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values; when any of them changes, a running service is reloaded with `trigger_action`.",
+			},
+			"trigger_action": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("RELOAD"),
+				Description: "Verb sent to `service.control` when `triggers` change: `RELOAD` or `RESTART`. Default: `RELOAD`",
+				Validators:  []validator.String{stringvalidator.OneOf("RELOAD", "RESTART")},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true}),
+		},
+	}
+}
+
+func (r *ServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *ServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(&data, false, createTimeout); err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to manage service %s: %s", data.Service.ValueString(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("service"), data.Service)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	svc, err := queryOne(r.client, "service.query", "service", data.Service.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read service %s: %s", data.Service.ValueString(), err))
+		return
+	}
+	setServiceState(svc, &data)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("service"), data.Service)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	triggered := !data.Triggers.Equal(state.Triggers)
+	if err := r.apply(&data, triggered, updateTimeout); err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to manage service %s: %s", data.Service.ValueString(), err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("service"), data.Service)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Services can't be removed; stop tracking it and leave it as it is
+}
+
+// apply brings the service to the planned enable/state, reloads it when
+// triggered and records what the server reports afterwards
+func (r *ServiceResource) apply(data *ServiceResourceModel, triggered bool, timeout time.Duration) error {
+	name := data.Service.ValueString()
+	svc, err := queryOne(r.client, "service.query", "service", name)
+	if err != nil {
+		return err
+	}
+	current, _ := svc.(map[string]interface{})
+
+	if !data.Enable.IsNull() && !data.Enable.IsUnknown() {
+		if enabled, _ := current["enable"].(bool); enabled != data.Enable.ValueBool() {
+			if _, err := r.client.Call("service.update", []interface{}{name, map[string]interface{}{"enable": data.Enable.ValueBool()}}); err != nil {
+				return err
+			}
+		}
+	}
+
+	want := data.State.ValueString()
+	if data.State.IsUnknown() {
+		want = ""
+	}
+	running := current["state"] == serviceRunning
+	switch {
+	case want == serviceRunning && !running:
+		err = r.control("START", name, timeout)
+	case want == serviceStopped && running:
+		err = r.control("STOP", name, timeout)
+	case triggered && running:
+		err = r.control(data.TriggerAction.ValueString(), name, timeout)
+	}
+	if err != nil {
+		return err
+	}
+
+	svc, err = queryOne(r.client, "service.query", "service", name)
+	if err != nil {
+		return err
+	}
+	setServiceState(svc, data)
+	if want != "" && data.State.ValueString() != want {
+		return fmt.Errorf("service is %s, expected %s", data.State.ValueString(), want)
+	}
+	return nil
+}
+
+func (r *ServiceResource) control(verb, name string, timeout time.Duration) error {
+	_, err := r.client.CallWithJobTimeout("service.control", []interface{}{verb, name, map[string]interface{}{}}, timeout)
+	return err
+}
+
+// setServiceState copies enable and state from a service.query entry
+func setServiceState(svc interface{}, data *ServiceResourceModel) {
+	m, _ := svc.(map[string]interface{})
+	if name, ok := m["service"].(string); ok {
+		data.ID = types.StringValue(name)
+		data.Service = types.StringValue(name)
+	}
+	if enabled, ok := m["enable"].(bool); ok {
+		data.Enable = types.BoolValue(enabled)
+	}
+	if state, ok := m["state"].(string); ok {
+		data.State = types.StringValue(state)
+	}
+}
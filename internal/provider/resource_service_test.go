@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSetServiceState(t *testing.T) {
+	data := ServiceResourceModel{
+		Service: types.StringValue("cifs"),
+		Enable:  types.BoolUnknown(),
+		State:   types.StringValue(serviceRunning),
+	}
+	setServiceState(map[string]interface{}{
+		"id":      float64(4),
+		"service": "cifs",
+		"enable":  true,
+		"state":   serviceStopped,
+		"pids":    []interface{}{},
+	}, &data)
+
+	if data.ID.ValueString() != "cifs" {
+		t.Errorf("Expected ID to be the service name, got %q", data.ID.ValueString())
+	}
+	if !data.Enable.ValueBool() {
+		t.Error("Expected enable from service.query")
+	}
+	if data.State.ValueString() != serviceStopped {
+		t.Errorf("Expected drifted state %s, got %s", serviceStopped, data.State.ValueString())
+	}
+}
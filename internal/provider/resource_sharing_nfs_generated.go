@@ -4,9 +4,14 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -39,14 +44,30 @@ func NewSharingNfsResource() resource.Resource {
 
 func (r *SharingNfsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_sharing_nfs"
+	// path can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *SharingNfsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "sharing.nfs.query", sharingNfsIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &SharingNfsResource{}
+
+// sharingNfsIdentity lists the API fields that identify a sharing_nfs
+var sharingNfsIdentity = []identityKey{{Name: "path"}}
+
+func (r *SharingNfsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(sharingNfsIdentity)
+}
+
+func (r *SharingNfsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *SharingNfsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a NFS Share.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -58,66 +79,90 @@ func (r *SharingNfsResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"aliases": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "IGNORED for now. ",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"comment": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "User comment associated with share. ",
+				Default:     stringdefault.StaticString(""),
 			},
 			"networks": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "List of authorized networks that are allowed to access the share having format     \"network/mask\" CI",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"hosts": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "List of IP's/hostnames which are allowed to access the share. No quotes or spaces are allowed. Each ",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"ro": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Export the share as read only. ",
+				Default:     booldefault.StaticBool(false),
 			},
 			"maproot_user": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Map root user client to a specified user. ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Map root user client to a specified user. ",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"maproot_group": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Map root group client to a specified group. ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Map root group client to a specified group. ",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"mapall_user": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Map all client users to a specified user. ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Map all client users to a specified user. ",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"mapall_group": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Map all client groups to a specified group. ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Map all client groups to a specified group. ",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"security": schema.ListAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				ElementType: types.StringType,
 				Description: "Specify the security schema. ",
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Enable or disable the share. ",
+				Default:     booldefault.StaticBool(true),
 			},
 			"expose_snapshots": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Enterprise feature to enable access to the ZFS snapshot directory for the export. Export path must b",
+				Default:     booldefault.StaticBool(false),
 			},
 		},
 	}
@@ -143,51 +188,51 @@ func (r *SharingNfsResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	params := map[string]interface{}{}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.Aliases.IsNull() {
+	if !data.Aliases.IsNull() && !data.Aliases.IsUnknown() {
 		var aliasesList []string
 		data.Aliases.ElementsAs(ctx, &aliasesList, false)
 		params["aliases"] = aliasesList
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
-	if !data.Networks.IsNull() {
+	if !data.Networks.IsNull() && !data.Networks.IsUnknown() {
 		var networksList []string
 		data.Networks.ElementsAs(ctx, &networksList, false)
 		params["networks"] = networksList
 	}
-	if !data.Hosts.IsNull() {
+	if !data.Hosts.IsNull() && !data.Hosts.IsUnknown() {
 		var hostsList []string
 		data.Hosts.ElementsAs(ctx, &hostsList, false)
 		params["hosts"] = hostsList
 	}
-	if !data.Ro.IsNull() {
+	if !data.Ro.IsNull() && !data.Ro.IsUnknown() {
 		params["ro"] = data.Ro.ValueBool()
 	}
-	if !data.MaprootUser.IsNull() {
+	if !data.MaprootUser.IsNull() && !data.MaprootUser.IsUnknown() {
 		params["maproot_user"] = data.MaprootUser.ValueString()
 	}
-	if !data.MaprootGroup.IsNull() {
+	if !data.MaprootGroup.IsNull() && !data.MaprootGroup.IsUnknown() {
 		params["maproot_group"] = data.MaprootGroup.ValueString()
 	}
-	if !data.MapallUser.IsNull() {
+	if !data.MapallUser.IsNull() && !data.MapallUser.IsUnknown() {
 		params["mapall_user"] = data.MapallUser.ValueString()
 	}
-	if !data.MapallGroup.IsNull() {
+	if !data.MapallGroup.IsNull() && !data.MapallGroup.IsUnknown() {
 		params["mapall_group"] = data.MapallGroup.ValueString()
 	}
-	if !data.Security.IsNull() {
+	if !data.Security.IsNull() && !data.Security.IsUnknown() {
 		var securityList []string
 		data.Security.ElementsAs(ctx, &securityList, false)
 		params["security"] = securityList
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.ExposeSnapshots.IsNull() {
+	if !data.ExposeSnapshots.IsNull() && !data.ExposeSnapshots.IsUnknown() {
 		params["expose_snapshots"] = data.ExposeSnapshots.ValueBool()
 	}
 
@@ -209,6 +254,8 @@ func (r *SharingNfsResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, sharingNfsIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -261,6 +308,7 @@ func (r *SharingNfsResource) Read(ctx context.Context, req resource.ReadRequest,
 			data.Path = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, sharingNfsIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -287,61 +335,63 @@ func (r *SharingNfsResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	params := map[string]interface{}{}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.Aliases.IsNull() {
+	if !data.Aliases.IsNull() && !data.Aliases.IsUnknown() {
 		var aliasesList []string
 		data.Aliases.ElementsAs(ctx, &aliasesList, false)
 		params["aliases"] = aliasesList
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
-	if !data.Networks.IsNull() {
+	if !data.Networks.IsNull() && !data.Networks.IsUnknown() {
 		var networksList []string
 		data.Networks.ElementsAs(ctx, &networksList, false)
 		params["networks"] = networksList
 	}
-	if !data.Hosts.IsNull() {
+	if !data.Hosts.IsNull() && !data.Hosts.IsUnknown() {
 		var hostsList []string
 		data.Hosts.ElementsAs(ctx, &hostsList, false)
 		params["hosts"] = hostsList
 	}
-	if !data.Ro.IsNull() {
+	if !data.Ro.IsNull() && !data.Ro.IsUnknown() {
 		params["ro"] = data.Ro.ValueBool()
 	}
-	if !data.MaprootUser.IsNull() {
+	if !data.MaprootUser.IsNull() && !data.MaprootUser.IsUnknown() {
 		params["maproot_user"] = data.MaprootUser.ValueString()
 	}
-	if !data.MaprootGroup.IsNull() {
+	if !data.MaprootGroup.IsNull() && !data.MaprootGroup.IsUnknown() {
 		params["maproot_group"] = data.MaprootGroup.ValueString()
 	}
-	if !data.MapallUser.IsNull() {
+	if !data.MapallUser.IsNull() && !data.MapallUser.IsUnknown() {
 		params["mapall_user"] = data.MapallUser.ValueString()
 	}
-	if !data.MapallGroup.IsNull() {
+	if !data.MapallGroup.IsNull() && !data.MapallGroup.IsUnknown() {
 		params["mapall_group"] = data.MapallGroup.ValueString()
 	}
-	if !data.Security.IsNull() {
+	if !data.Security.IsNull() && !data.Security.IsUnknown() {
 		var securityList []string
 		data.Security.ElementsAs(ctx, &securityList, false)
 		params["security"] = securityList
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.ExposeSnapshots.IsNull() {
+	if !data.ExposeSnapshots.IsNull() && !data.ExposeSnapshots.IsUnknown() {
 		params["expose_snapshots"] = data.ExposeSnapshots.ValueBool()
 	}
 
-	_, err = r.client.Call("sharing.nfs.update", []interface{}{id, params})
+	result, err := r.client.Call("sharing.nfs.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update sharing_nfs: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, sharingNfsIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -366,3 +416,156 @@ func (r *SharingNfsResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *SharingNfsResource) setComputed(result interface{}, data *SharingNfsResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Aliases.IsUnknown() {
+		data.Aliases = types.ListNull(types.StringType)
+		if v, ok := resultMap["aliases"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Aliases, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Comment.IsUnknown() {
+		data.Comment = types.StringNull()
+		if v, ok := resultMap["comment"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Comment = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Networks.IsUnknown() {
+		data.Networks = types.ListNull(types.StringType)
+		if v, ok := resultMap["networks"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Networks, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Hosts.IsUnknown() {
+		data.Hosts = types.ListNull(types.StringType)
+		if v, ok := resultMap["hosts"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Hosts, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Ro.IsUnknown() {
+		data.Ro = types.BoolNull()
+		if v, ok := resultMap["ro"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Ro = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.MaprootUser.IsUnknown() {
+		data.MaprootUser = types.StringNull()
+		if v, ok := resultMap["maproot_user"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.MaprootUser = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.MaprootUser = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.MaprootUser = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.MaprootGroup.IsUnknown() {
+		data.MaprootGroup = types.StringNull()
+		if v, ok := resultMap["maproot_group"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.MaprootGroup = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.MaprootGroup = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.MaprootGroup = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.MapallUser.IsUnknown() {
+		data.MapallUser = types.StringNull()
+		if v, ok := resultMap["mapall_user"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.MapallUser = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.MapallUser = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.MapallUser = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.MapallGroup.IsUnknown() {
+		data.MapallGroup = types.StringNull()
+		if v, ok := resultMap["mapall_group"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.MapallGroup = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.MapallGroup = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.MapallGroup = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Security.IsUnknown() {
+		data.Security = types.ListNull(types.StringType)
+		if v, ok := resultMap["security"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Security, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.ExposeSnapshots.IsUnknown() {
+		data.ExposeSnapshots = types.BoolNull()
+		if v, ok := resultMap["expose_snapshots"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.ExposeSnapshots = types.BoolValue(bv)
+			}
+		}
+	}
+}
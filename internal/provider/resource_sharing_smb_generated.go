@@ -5,9 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -37,26 +42,46 @@ func NewSharingSmbResource() resource.Resource {
 
 func (r *SharingSmbResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_sharing_smb"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *SharingSmbResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "sharing.smb.query", sharingSmbIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &SharingSmbResource{}
+
+// sharingSmbIdentity lists the API fields that identify a sharing_smb
+var sharingSmbIdentity = []identityKey{{Name: "name"}}
+
+func (r *SharingSmbResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(sharingSmbIdentity)
+}
+
+func (r *SharingSmbResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *SharingSmbResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "TrueNAS sharing_smb resource",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"purpose": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "This parameter sets the purpose of the SMB share. It controls how the SMB share behaves and what fea",
+				Default:     stringdefault.StaticString("DEFAULT_SHARE"),
+				Validators:  []validator.String{stringvalidator.OneOf("DEFAULT_SHARE", "LEGACY_SHARE", "TIMEMACHINE_SHARE", "MULTIPROTOCOL_SHARE", "TIME_LOCKED_SHARE", "PRIVATE_DATASETS_SHARE", "EXTERNAL_SHARE", "VEEAM_REPOSITORY_SHARE", "FCP_SHARE")},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
 				Optional:    false,
 				Description: "SMB share name. SMB share names are case-insensitive and must be unique, and are subject     to the ",
+				Validators:  []validator.String{stringvalidator.LengthAtMost(80)},
 			},
 			"path": schema.StringAttribute{
 				Required:    true,
@@ -66,37 +91,51 @@ func (r *SharingSmbResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "If unset, the SMB share is not available over the SMB protocol. ",
+				Default:     booldefault.StaticBool(true),
 			},
 			"comment": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Text field that is seen next to a share when an SMB client requests a list of SMB shares on the True",
+				Default:     stringdefault.StaticString(""),
 			},
 			"readonly": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "If set, SMB clients cannot create or change files and directories in the SMB share.  NOTE: If set, t",
+				Default:     booldefault.StaticBool(false),
 			},
 			"browsable": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "If set, the share is included when an SMB client requests a list of SMB shares on the TrueNAS server",
+				Default:     booldefault.StaticBool(true),
 			},
 			"access_based_share_enumeration": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "If set, the share is only included when an SMB client requests a list of shares on the SMB server if",
+				Default:     booldefault.StaticBool(false),
 			},
 			"audit": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Audit configuration for monitoring SMB share access and operations.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Audit configuration for monitoring SMB share access and operations.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"options": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Additional configuration related to the configured SMB share purpose. If null, then the default     ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Additional configuration related to the configured SMB share purpose. If null, then the default     ",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
 	}
@@ -122,31 +161,31 @@ func (r *SharingSmbResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	params := map[string]interface{}{}
-	if !data.Purpose.IsNull() {
+	if !data.Purpose.IsNull() && !data.Purpose.IsUnknown() {
 		params["purpose"] = data.Purpose.ValueString()
 	}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
-	if !data.Readonly.IsNull() {
+	if !data.Readonly.IsNull() && !data.Readonly.IsUnknown() {
 		params["readonly"] = data.Readonly.ValueBool()
 	}
-	if !data.Browsable.IsNull() {
+	if !data.Browsable.IsNull() && !data.Browsable.IsUnknown() {
 		params["browsable"] = data.Browsable.ValueBool()
 	}
-	if !data.AccessBasedShareEnumeration.IsNull() {
+	if !data.AccessBasedShareEnumeration.IsNull() && !data.AccessBasedShareEnumeration.IsUnknown() {
 		params["access_based_share_enumeration"] = data.AccessBasedShareEnumeration.ValueBool()
 	}
-	if !data.Audit.IsNull() {
+	if !data.Audit.IsNull() && !data.Audit.IsUnknown() {
 		var auditObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Audit.ValueString()), &auditObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse audit: %s", err))
@@ -154,7 +193,7 @@ func (r *SharingSmbResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		params["audit"] = auditObj
 	}
-	if !data.Options.IsNull() {
+	if !data.Options.IsNull() && !data.Options.IsUnknown() {
 		params["options"] = data.Options.ValueString()
 	}
 
@@ -176,6 +215,8 @@ func (r *SharingSmbResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, sharingSmbIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -240,6 +281,7 @@ func (r *SharingSmbResource) Read(ctx context.Context, req resource.ReadRequest,
 			data.Path = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, sharingSmbIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -266,31 +308,31 @@ func (r *SharingSmbResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	params := map[string]interface{}{}
-	if !data.Purpose.IsNull() {
+	if !data.Purpose.IsNull() && !data.Purpose.IsUnknown() {
 		params["purpose"] = data.Purpose.ValueString()
 	}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Path.IsNull() {
+	if !data.Path.IsNull() && !data.Path.IsUnknown() {
 		params["path"] = data.Path.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
-	if !data.Readonly.IsNull() {
+	if !data.Readonly.IsNull() && !data.Readonly.IsUnknown() {
 		params["readonly"] = data.Readonly.ValueBool()
 	}
-	if !data.Browsable.IsNull() {
+	if !data.Browsable.IsNull() && !data.Browsable.IsUnknown() {
 		params["browsable"] = data.Browsable.ValueBool()
 	}
-	if !data.AccessBasedShareEnumeration.IsNull() {
+	if !data.AccessBasedShareEnumeration.IsNull() && !data.AccessBasedShareEnumeration.IsUnknown() {
 		params["access_based_share_enumeration"] = data.AccessBasedShareEnumeration.ValueBool()
 	}
-	if !data.Audit.IsNull() {
+	if !data.Audit.IsNull() && !data.Audit.IsUnknown() {
 		var auditObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Audit.ValueString()), &auditObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse audit: %s", err))
@@ -298,17 +340,19 @@ func (r *SharingSmbResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		params["audit"] = auditObj
 	}
-	if !data.Options.IsNull() {
+	if !data.Options.IsNull() && !data.Options.IsUnknown() {
 		params["options"] = data.Options.ValueString()
 	}
 
-	_, err = r.client.Call("sharing.smb.update", []interface{}{id, params})
+	result, err := r.client.Call("sharing.smb.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update sharing_smb: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, sharingSmbIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -333,3 +377,94 @@ func (r *SharingSmbResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *SharingSmbResource) setComputed(result interface{}, data *SharingSmbResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Purpose.IsUnknown() {
+		data.Purpose = types.StringNull()
+		if v, ok := resultMap["purpose"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Purpose = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Purpose = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Purpose = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Comment.IsUnknown() {
+		data.Comment = types.StringNull()
+		if v, ok := resultMap["comment"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Comment = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Readonly.IsUnknown() {
+		data.Readonly = types.BoolNull()
+		if v, ok := resultMap["readonly"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Readonly = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Browsable.IsUnknown() {
+		data.Browsable = types.BoolNull()
+		if v, ok := resultMap["browsable"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Browsable = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.AccessBasedShareEnumeration.IsUnknown() {
+		data.AccessBasedShareEnumeration = types.BoolNull()
+		if v, ok := resultMap["access_based_share_enumeration"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.AccessBasedShareEnumeration = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Audit.IsUnknown() {
+		data.Audit = types.StringNull()
+		if v, ok := resultMap["audit"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Audit = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.Options.IsUnknown() {
+		data.Options = types.StringNull()
+		if v, ok := resultMap["options"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Options = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Options = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Options = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
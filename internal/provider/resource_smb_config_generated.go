@@ -0,0 +1,908 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SmbConfigResource manages the smb settings. There is exactly one
+// instance, so create and update both call smb.update.
+type SmbConfigResource struct {
+	client *client.Client
+}
+
+type SmbConfigResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Netbiosname      types.String `tfsdk:"netbiosname"`
+	Netbiosalias     types.List   `tfsdk:"netbiosalias"`
+	Workgroup        types.String `tfsdk:"workgroup"`
+	Description      types.String `tfsdk:"description"`
+	EnableSmb1       types.Bool   `tfsdk:"enable_smb1"`
+	Unixcharset      types.String `tfsdk:"unixcharset"`
+	Localmaster      types.Bool   `tfsdk:"localmaster"`
+	Syslog           types.Bool   `tfsdk:"syslog"`
+	AaplExtensions   types.Bool   `tfsdk:"aapl_extensions"`
+	AdminGroup       types.String `tfsdk:"admin_group"`
+	Guest            types.String `tfsdk:"guest"`
+	Filemask         types.String `tfsdk:"filemask"`
+	Dirmask          types.String `tfsdk:"dirmask"`
+	Ntlmv1Auth       types.Bool   `tfsdk:"ntlmv1_auth"`
+	Multichannel     types.Bool   `tfsdk:"multichannel"`
+	Encryption       types.String `tfsdk:"encryption"`
+	Bindip           types.List   `tfsdk:"bindip"`
+	Debug            types.Bool   `tfsdk:"debug"`
+	SearchProtocols  types.List   `tfsdk:"search_protocols"`
+	StatefulFailover types.Bool   `tfsdk:"stateful_failover"`
+	RestoreOnDestroy types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewSmbConfigResource() resource.Resource {
+	return &SmbConfigResource{}
+}
+
+func (r *SmbConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smb_config"
+}
+
+func (r *SmbConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SmbConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *SmbConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update SMB Service Configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"netbiosname": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "NetBIOS name of this server.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.LengthAtMost(15)},
+			},
+			"netbiosalias": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Alternative NetBIOS names for this server.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"workgroup": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Workgroup or domain name. Must differ from the NetBIOS name.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"description": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Description of the SMB server.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"enable_smb1": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow clients to use the deprecated SMB1 protocol.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"unixcharset": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Character set used on the server.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("UTF-8", "ISO-8859-1", "ISO-8859-15", "GB2312", "EUC-JP", "ASCII")},
+			},
+			"localmaster": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Take part in local master browser elections.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"syslog": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Send SMB server logs to the remote syslog server.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"aapl_extensions": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable support for Apple SMB2/3 protocol extensions (required for Time Machine).",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"admin_group": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Local group whose members have administrative privileges on the SMB server.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"guest": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Account used for guest access.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"filemask": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Default permissions (umask) for new files, e.g. `0644`, or `DEFAULT`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"dirmask": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Default permissions (umask) for new directories, e.g. `0755`, or `DEFAULT`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ntlmv1_auth": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow the insecure NTLMv1 authentication protocol.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"multichannel": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable SMB3 multichannel.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"encryption": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Transport encryption policy.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("DEFAULT", "NEGOTIATE", "DESIRED", "REQUIRED")},
+			},
+			"bindip": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "IP addresses the SMB server listens on. Empty means all addresses.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"debug": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Log more details to the SMB server logs.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"search_protocols": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Search protocols to enable, e.g. `SPOTLIGHT`.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"stateful_failover": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Preserve open file state across an HA failover.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *SmbConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *SmbConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SmbConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("smb.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read smb_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Netbiosname.IsNull() && !data.Netbiosname.IsUnknown() {
+		params["netbiosname"] = data.Netbiosname.ValueString()
+	}
+	if !data.Netbiosalias.IsNull() && !data.Netbiosalias.IsUnknown() {
+		var netbiosaliasList []string
+		data.Netbiosalias.ElementsAs(ctx, &netbiosaliasList, false)
+		params["netbiosalias"] = netbiosaliasList
+	}
+	if !data.Workgroup.IsNull() && !data.Workgroup.IsUnknown() {
+		params["workgroup"] = data.Workgroup.ValueString()
+	}
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		params["description"] = data.Description.ValueString()
+	}
+	if !data.EnableSmb1.IsNull() && !data.EnableSmb1.IsUnknown() {
+		params["enable_smb1"] = data.EnableSmb1.ValueBool()
+	}
+	if !data.Unixcharset.IsNull() && !data.Unixcharset.IsUnknown() {
+		params["unixcharset"] = data.Unixcharset.ValueString()
+	}
+	if !data.Localmaster.IsNull() && !data.Localmaster.IsUnknown() {
+		params["localmaster"] = data.Localmaster.ValueBool()
+	}
+	if !data.Syslog.IsNull() && !data.Syslog.IsUnknown() {
+		params["syslog"] = data.Syslog.ValueBool()
+	}
+	if !data.AaplExtensions.IsNull() && !data.AaplExtensions.IsUnknown() {
+		params["aapl_extensions"] = data.AaplExtensions.ValueBool()
+	}
+	if !data.AdminGroup.IsNull() && !data.AdminGroup.IsUnknown() {
+		params["admin_group"] = data.AdminGroup.ValueString()
+	}
+	if !data.Guest.IsNull() && !data.Guest.IsUnknown() {
+		params["guest"] = data.Guest.ValueString()
+	}
+	if !data.Filemask.IsNull() && !data.Filemask.IsUnknown() {
+		params["filemask"] = data.Filemask.ValueString()
+	}
+	if !data.Dirmask.IsNull() && !data.Dirmask.IsUnknown() {
+		params["dirmask"] = data.Dirmask.ValueString()
+	}
+	if !data.Ntlmv1Auth.IsNull() && !data.Ntlmv1Auth.IsUnknown() {
+		params["ntlmv1_auth"] = data.Ntlmv1Auth.ValueBool()
+	}
+	if !data.Multichannel.IsNull() && !data.Multichannel.IsUnknown() {
+		params["multichannel"] = data.Multichannel.ValueBool()
+	}
+	if !data.Encryption.IsNull() && !data.Encryption.IsUnknown() {
+		params["encryption"] = data.Encryption.ValueString()
+	}
+	if !data.Bindip.IsNull() && !data.Bindip.IsUnknown() {
+		var bindipList []string
+		data.Bindip.ElementsAs(ctx, &bindipList, false)
+		params["bindip"] = bindipList
+	}
+	if !data.Debug.IsNull() && !data.Debug.IsUnknown() {
+		params["debug"] = data.Debug.ValueBool()
+	}
+	if !data.SearchProtocols.IsNull() && !data.SearchProtocols.IsUnknown() {
+		var search_protocolsList []string
+		data.SearchProtocols.ElementsAs(ctx, &search_protocolsList, false)
+		params["search_protocols"] = search_protocolsList
+	}
+	if !data.StatefulFailover.IsNull() && !data.StatefulFailover.IsUnknown() {
+		params["stateful_failover"] = data.StatefulFailover.ValueBool()
+	}
+
+	result, err := r.client.Call("smb.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update smb_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("smb")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SmbConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SmbConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("smb.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read smb_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["netbiosname"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Netbiosname = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Netbiosname = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Netbiosname = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["netbiosalias"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Netbiosalias, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["workgroup"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Workgroup = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Workgroup = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Workgroup = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["description"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Description = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Description = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["enable_smb1"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.EnableSmb1 = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["unixcharset"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Unixcharset = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Unixcharset = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Unixcharset = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["localmaster"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Localmaster = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["syslog"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Syslog = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["aapl_extensions"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.AaplExtensions = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["admin_group"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.AdminGroup = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.AdminGroup = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.AdminGroup = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["guest"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Guest = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Guest = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Guest = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["filemask"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Filemask = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Filemask = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Filemask = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["dirmask"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Dirmask = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Dirmask = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Dirmask = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["ntlmv1_auth"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Ntlmv1Auth = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["multichannel"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Multichannel = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["encryption"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Encryption = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Encryption = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Encryption = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["bindip"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Bindip, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["debug"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Debug = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["search_protocols"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.SearchProtocols, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["stateful_failover"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.StatefulFailover = types.BoolValue(bv)
+		}
+	}
+
+	data.ID = types.StringValue("smb")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SmbConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SmbConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Netbiosname.IsNull() && !data.Netbiosname.IsUnknown() {
+		params["netbiosname"] = data.Netbiosname.ValueString()
+	}
+	if !data.Netbiosalias.IsNull() && !data.Netbiosalias.IsUnknown() {
+		var netbiosaliasList []string
+		data.Netbiosalias.ElementsAs(ctx, &netbiosaliasList, false)
+		params["netbiosalias"] = netbiosaliasList
+	}
+	if !data.Workgroup.IsNull() && !data.Workgroup.IsUnknown() {
+		params["workgroup"] = data.Workgroup.ValueString()
+	}
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		params["description"] = data.Description.ValueString()
+	}
+	if !data.EnableSmb1.IsNull() && !data.EnableSmb1.IsUnknown() {
+		params["enable_smb1"] = data.EnableSmb1.ValueBool()
+	}
+	if !data.Unixcharset.IsNull() && !data.Unixcharset.IsUnknown() {
+		params["unixcharset"] = data.Unixcharset.ValueString()
+	}
+	if !data.Localmaster.IsNull() && !data.Localmaster.IsUnknown() {
+		params["localmaster"] = data.Localmaster.ValueBool()
+	}
+	if !data.Syslog.IsNull() && !data.Syslog.IsUnknown() {
+		params["syslog"] = data.Syslog.ValueBool()
+	}
+	if !data.AaplExtensions.IsNull() && !data.AaplExtensions.IsUnknown() {
+		params["aapl_extensions"] = data.AaplExtensions.ValueBool()
+	}
+	if !data.AdminGroup.IsNull() && !data.AdminGroup.IsUnknown() {
+		params["admin_group"] = data.AdminGroup.ValueString()
+	}
+	if !data.Guest.IsNull() && !data.Guest.IsUnknown() {
+		params["guest"] = data.Guest.ValueString()
+	}
+	if !data.Filemask.IsNull() && !data.Filemask.IsUnknown() {
+		params["filemask"] = data.Filemask.ValueString()
+	}
+	if !data.Dirmask.IsNull() && !data.Dirmask.IsUnknown() {
+		params["dirmask"] = data.Dirmask.ValueString()
+	}
+	if !data.Ntlmv1Auth.IsNull() && !data.Ntlmv1Auth.IsUnknown() {
+		params["ntlmv1_auth"] = data.Ntlmv1Auth.ValueBool()
+	}
+	if !data.Multichannel.IsNull() && !data.Multichannel.IsUnknown() {
+		params["multichannel"] = data.Multichannel.ValueBool()
+	}
+	if !data.Encryption.IsNull() && !data.Encryption.IsUnknown() {
+		params["encryption"] = data.Encryption.ValueString()
+	}
+	if !data.Bindip.IsNull() && !data.Bindip.IsUnknown() {
+		var bindipList []string
+		data.Bindip.ElementsAs(ctx, &bindipList, false)
+		params["bindip"] = bindipList
+	}
+	if !data.Debug.IsNull() && !data.Debug.IsUnknown() {
+		params["debug"] = data.Debug.ValueBool()
+	}
+	if !data.SearchProtocols.IsNull() && !data.SearchProtocols.IsUnknown() {
+		var search_protocolsList []string
+		data.SearchProtocols.ElementsAs(ctx, &search_protocolsList, false)
+		params["search_protocols"] = search_protocolsList
+	}
+	if !data.StatefulFailover.IsNull() && !data.StatefulFailover.IsUnknown() {
+		params["stateful_failover"] = data.StatefulFailover.ValueBool()
+	}
+
+	result, err := r.client.Call("smb.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update smb_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("smb")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SmbConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SmbConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when smb_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"netbiosname", "netbiosalias", "workgroup", "description", "enable_smb1", "unixcharset", "localmaster", "syslog", "aapl_extensions", "admin_group", "guest", "filemask", "dirmask", "ntlmv1_auth", "multichannel", "encryption", "bindip", "debug", "search_protocols", "stateful_failover"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.Call("smb.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore smb_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *SmbConfigResource) setComputed(result interface{}, data *SmbConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Netbiosname.IsUnknown() {
+		data.Netbiosname = types.StringNull()
+		if v, ok := resultMap["netbiosname"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Netbiosname = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Netbiosname = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Netbiosname = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Netbiosalias.IsUnknown() {
+		data.Netbiosalias = types.ListNull(types.StringType)
+		if v, ok := resultMap["netbiosalias"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Netbiosalias, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Workgroup.IsUnknown() {
+		data.Workgroup = types.StringNull()
+		if v, ok := resultMap["workgroup"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Workgroup = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Workgroup = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Workgroup = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Description.IsUnknown() {
+		data.Description = types.StringNull()
+		if v, ok := resultMap["description"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Description = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Description = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.EnableSmb1.IsUnknown() {
+		data.EnableSmb1 = types.BoolNull()
+		if v, ok := resultMap["enable_smb1"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.EnableSmb1 = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Unixcharset.IsUnknown() {
+		data.Unixcharset = types.StringNull()
+		if v, ok := resultMap["unixcharset"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Unixcharset = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Unixcharset = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Unixcharset = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Localmaster.IsUnknown() {
+		data.Localmaster = types.BoolNull()
+		if v, ok := resultMap["localmaster"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Localmaster = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Syslog.IsUnknown() {
+		data.Syslog = types.BoolNull()
+		if v, ok := resultMap["syslog"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Syslog = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.AaplExtensions.IsUnknown() {
+		data.AaplExtensions = types.BoolNull()
+		if v, ok := resultMap["aapl_extensions"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.AaplExtensions = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.AdminGroup.IsUnknown() {
+		data.AdminGroup = types.StringNull()
+		if v, ok := resultMap["admin_group"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.AdminGroup = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.AdminGroup = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.AdminGroup = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Guest.IsUnknown() {
+		data.Guest = types.StringNull()
+		if v, ok := resultMap["guest"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Guest = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Guest = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Guest = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Filemask.IsUnknown() {
+		data.Filemask = types.StringNull()
+		if v, ok := resultMap["filemask"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Filemask = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Filemask = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Filemask = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Dirmask.IsUnknown() {
+		data.Dirmask = types.StringNull()
+		if v, ok := resultMap["dirmask"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Dirmask = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Dirmask = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Dirmask = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Ntlmv1Auth.IsUnknown() {
+		data.Ntlmv1Auth = types.BoolNull()
+		if v, ok := resultMap["ntlmv1_auth"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Ntlmv1Auth = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Multichannel.IsUnknown() {
+		data.Multichannel = types.BoolNull()
+		if v, ok := resultMap["multichannel"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Multichannel = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Encryption.IsUnknown() {
+		data.Encryption = types.StringNull()
+		if v, ok := resultMap["encryption"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Encryption = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Encryption = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Encryption = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Bindip.IsUnknown() {
+		data.Bindip = types.ListNull(types.StringType)
+		if v, ok := resultMap["bindip"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Bindip, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Debug.IsUnknown() {
+		data.Debug = types.BoolNull()
+		if v, ok := resultMap["debug"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Debug = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.SearchProtocols.IsUnknown() {
+		data.SearchProtocols = types.ListNull(types.StringType)
+		if v, ok := resultMap["search_protocols"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.SearchProtocols, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.StatefulFailover.IsUnknown() {
+		data.StatefulFailover = types.BoolNull()
+		if v, ok := resultMap["stateful_failover"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.StatefulFailover = types.BoolValue(bv)
+			}
+		}
+	}
+}
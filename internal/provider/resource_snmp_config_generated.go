@@ -0,0 +1,695 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SnmpConfigResource manages the snmp settings. There is exactly one
+// instance, so create and update both call snmp.update.
+type SnmpConfigResource struct {
+	client *client.Client
+}
+
+type SnmpConfigResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Location         types.String `tfsdk:"location"`
+	Contact          types.String `tfsdk:"contact"`
+	Traps            types.Bool   `tfsdk:"traps"`
+	V3               types.Bool   `tfsdk:"v3"`
+	Community        types.String `tfsdk:"community"`
+	V3Username       types.String `tfsdk:"v3_username"`
+	V3Authtype       types.String `tfsdk:"v3_authtype"`
+	V3Password       types.String `tfsdk:"v3_password"`
+	V3Privproto      types.String `tfsdk:"v3_privproto"`
+	V3Privpassphrase types.String `tfsdk:"v3_privpassphrase"`
+	Loglevel         types.Int64  `tfsdk:"loglevel"`
+	Options          types.String `tfsdk:"options"`
+	Zilstat          types.Bool   `tfsdk:"zilstat"`
+	RestoreOnDestroy types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewSnmpConfigResource() resource.Resource {
+	return &SnmpConfigResource{}
+}
+
+func (r *SnmpConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snmp_config"
+}
+
+func (r *SnmpConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SnmpConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *SnmpConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update SNMP Service Configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"location": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Physical location of this system.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"contact": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Email address or name of the administrator.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"traps": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable SNMP traps.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"v3": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable SNMPv3.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"community": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "SNMPv1/v2c community string.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"v3_username": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "SNMPv3 user name.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"v3_authtype": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "SNMPv3 authentication protocol.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("", "MD5", "SHA")},
+			},
+			"v3_password": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "SNMPv3 authentication password. At least 8 characters.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"v3_privproto": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "SNMPv3 privacy protocol: `AES`, `DES` or null.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"v3_privpassphrase": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "SNMPv3 privacy passphrase. At least 8 characters.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"loglevel": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Log level, from 0 (emergencies) to 7 (debug).",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(0, 7)},
+			},
+			"options": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Extra lines appended to snmpd.conf.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"zilstat": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Expose ZIL statistics.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *SnmpConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *SnmpConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnmpConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("snmp.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read snmp_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Location.IsNull() && !data.Location.IsUnknown() {
+		params["location"] = data.Location.ValueString()
+	}
+	if !data.Contact.IsNull() && !data.Contact.IsUnknown() {
+		params["contact"] = data.Contact.ValueString()
+	}
+	if !data.Traps.IsNull() && !data.Traps.IsUnknown() {
+		params["traps"] = data.Traps.ValueBool()
+	}
+	if !data.V3.IsNull() && !data.V3.IsUnknown() {
+		params["v3"] = data.V3.ValueBool()
+	}
+	if !data.Community.IsNull() && !data.Community.IsUnknown() {
+		params["community"] = data.Community.ValueString()
+	}
+	if !data.V3Username.IsNull() && !data.V3Username.IsUnknown() {
+		params["v3_username"] = data.V3Username.ValueString()
+	}
+	if !data.V3Authtype.IsNull() && !data.V3Authtype.IsUnknown() {
+		params["v3_authtype"] = data.V3Authtype.ValueString()
+	}
+	if !data.V3Password.IsNull() && !data.V3Password.IsUnknown() {
+		params["v3_password"] = data.V3Password.ValueString()
+	}
+	if !data.V3Privproto.IsNull() && !data.V3Privproto.IsUnknown() {
+		params["v3_privproto"] = data.V3Privproto.ValueString()
+	}
+	if !data.V3Privpassphrase.IsNull() && !data.V3Privpassphrase.IsUnknown() {
+		params["v3_privpassphrase"] = data.V3Privpassphrase.ValueString()
+	}
+	if !data.Loglevel.IsNull() && !data.Loglevel.IsUnknown() {
+		params["loglevel"] = data.Loglevel.ValueInt64()
+	}
+	if !data.Options.IsNull() && !data.Options.IsUnknown() {
+		params["options"] = data.Options.ValueString()
+	}
+	if !data.Zilstat.IsNull() && !data.Zilstat.IsUnknown() {
+		params["zilstat"] = data.Zilstat.ValueBool()
+	}
+
+	result, err := r.client.Call("snmp.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update snmp_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("snmp")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnmpConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("snmp.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read snmp_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["location"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Location = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Location = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Location = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["contact"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Contact = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Contact = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Contact = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["traps"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Traps = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["v3"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.V3 = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["community"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Community = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Community = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Community = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["v3_username"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.V3Username = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.V3Username = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.V3Username = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["v3_authtype"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.V3Authtype = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.V3Authtype = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.V3Authtype = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["v3_password"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.V3Password = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.V3Password = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.V3Password = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["v3_privproto"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.V3Privproto = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.V3Privproto = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.V3Privproto = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["v3_privpassphrase"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.V3Privpassphrase = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.V3Privpassphrase = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.V3Privpassphrase = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["loglevel"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Loglevel = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Loglevel = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["options"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Options = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Options = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Options = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["zilstat"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Zilstat = types.BoolValue(bv)
+		}
+	}
+
+	data.ID = types.StringValue("snmp")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SnmpConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Location.IsNull() && !data.Location.IsUnknown() {
+		params["location"] = data.Location.ValueString()
+	}
+	if !data.Contact.IsNull() && !data.Contact.IsUnknown() {
+		params["contact"] = data.Contact.ValueString()
+	}
+	if !data.Traps.IsNull() && !data.Traps.IsUnknown() {
+		params["traps"] = data.Traps.ValueBool()
+	}
+	if !data.V3.IsNull() && !data.V3.IsUnknown() {
+		params["v3"] = data.V3.ValueBool()
+	}
+	if !data.Community.IsNull() && !data.Community.IsUnknown() {
+		params["community"] = data.Community.ValueString()
+	}
+	if !data.V3Username.IsNull() && !data.V3Username.IsUnknown() {
+		params["v3_username"] = data.V3Username.ValueString()
+	}
+	if !data.V3Authtype.IsNull() && !data.V3Authtype.IsUnknown() {
+		params["v3_authtype"] = data.V3Authtype.ValueString()
+	}
+	if !data.V3Password.IsNull() && !data.V3Password.IsUnknown() {
+		params["v3_password"] = data.V3Password.ValueString()
+	}
+	if !data.V3Privproto.IsNull() && !data.V3Privproto.IsUnknown() {
+		params["v3_privproto"] = data.V3Privproto.ValueString()
+	}
+	if !data.V3Privpassphrase.IsNull() && !data.V3Privpassphrase.IsUnknown() {
+		params["v3_privpassphrase"] = data.V3Privpassphrase.ValueString()
+	}
+	if !data.Loglevel.IsNull() && !data.Loglevel.IsUnknown() {
+		params["loglevel"] = data.Loglevel.ValueInt64()
+	}
+	if !data.Options.IsNull() && !data.Options.IsUnknown() {
+		params["options"] = data.Options.ValueString()
+	}
+	if !data.Zilstat.IsNull() && !data.Zilstat.IsUnknown() {
+		params["zilstat"] = data.Zilstat.ValueBool()
+	}
+
+	result, err := r.client.Call("snmp.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update snmp_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("snmp")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnmpConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when snmp_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"location", "contact", "traps", "v3", "community", "v3_username", "v3_authtype", "v3_password", "v3_privproto", "v3_privpassphrase", "loglevel", "options", "zilstat"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.Call("snmp.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore snmp_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *SnmpConfigResource) setComputed(result interface{}, data *SnmpConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Location.IsUnknown() {
+		data.Location = types.StringNull()
+		if v, ok := resultMap["location"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Location = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Location = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Location = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Contact.IsUnknown() {
+		data.Contact = types.StringNull()
+		if v, ok := resultMap["contact"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Contact = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Contact = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Contact = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Traps.IsUnknown() {
+		data.Traps = types.BoolNull()
+		if v, ok := resultMap["traps"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Traps = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.V3.IsUnknown() {
+		data.V3 = types.BoolNull()
+		if v, ok := resultMap["v3"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.V3 = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Community.IsUnknown() {
+		data.Community = types.StringNull()
+		if v, ok := resultMap["community"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Community = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Community = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Community = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.V3Username.IsUnknown() {
+		data.V3Username = types.StringNull()
+		if v, ok := resultMap["v3_username"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.V3Username = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.V3Username = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.V3Username = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.V3Authtype.IsUnknown() {
+		data.V3Authtype = types.StringNull()
+		if v, ok := resultMap["v3_authtype"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.V3Authtype = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.V3Authtype = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.V3Authtype = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.V3Password.IsUnknown() {
+		data.V3Password = types.StringNull()
+		if v, ok := resultMap["v3_password"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.V3Password = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.V3Password = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.V3Password = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.V3Privproto.IsUnknown() {
+		data.V3Privproto = types.StringNull()
+		if v, ok := resultMap["v3_privproto"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.V3Privproto = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.V3Privproto = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.V3Privproto = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.V3Privpassphrase.IsUnknown() {
+		data.V3Privpassphrase = types.StringNull()
+		if v, ok := resultMap["v3_privpassphrase"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.V3Privpassphrase = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.V3Privpassphrase = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.V3Privpassphrase = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Loglevel.IsUnknown() {
+		data.Loglevel = types.Int64Null()
+		if v, ok := resultMap["loglevel"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Loglevel = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Loglevel = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Options.IsUnknown() {
+		data.Options = types.StringNull()
+		if v, ok := resultMap["options"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Options = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Options = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Options = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Zilstat.IsUnknown() {
+		data.Zilstat = types.BoolNull()
+		if v, ok := resultMap["zilstat"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Zilstat = types.BoolValue(bv)
+			}
+		}
+	}
+}
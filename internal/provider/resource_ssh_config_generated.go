@@ -0,0 +1,597 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SshConfigResource manages the ssh settings. There is exactly one
+// instance, so create and update both call ssh.update.
+type SshConfigResource struct {
+	client *client.Client
+}
+
+type SshConfigResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Bindiface           types.List   `tfsdk:"bindiface"`
+	Tcpport             types.Int64  `tfsdk:"tcpport"`
+	PasswordLoginGroups types.List   `tfsdk:"password_login_groups"`
+	Passwordauth        types.Bool   `tfsdk:"passwordauth"`
+	Kerberosauth        types.Bool   `tfsdk:"kerberosauth"`
+	Tcpfwd              types.Bool   `tfsdk:"tcpfwd"`
+	Compression         types.Bool   `tfsdk:"compression"`
+	SftpLogLevel        types.String `tfsdk:"sftp_log_level"`
+	SftpLogFacility     types.String `tfsdk:"sftp_log_facility"`
+	WeakCiphers         types.List   `tfsdk:"weak_ciphers"`
+	Options             types.String `tfsdk:"options"`
+	RestoreOnDestroy    types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewSshConfigResource() resource.Resource {
+	return &SshConfigResource{}
+}
+
+func (r *SshConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_config"
+}
+
+func (r *SshConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SshConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *SshConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update settings of SSH daemon service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"bindiface": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Network interfaces sshd listens on. Empty means all interfaces.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"tcpport": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "TCP port sshd listens on.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(1, 65535)},
+			},
+			"password_login_groups": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Groups whose members may log in with a password.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"passwordauth": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow password authentication.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"kerberosauth": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow Kerberos authentication.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"tcpfwd": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow TCP port forwarding.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"compression": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Compress traffic on slow links.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"sftp_log_level": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Log level of the SFTP subsystem.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("", "QUIET", "FATAL", "ERROR", "INFO", "VERBOSE", "DEBUG", "DEBUG2", "DEBUG3")},
+			},
+			"sftp_log_facility": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Syslog facility of the SFTP subsystem.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("", "DAEMON", "USER", "AUTH", "LOCAL0", "LOCAL1", "LOCAL2", "LOCAL3", "LOCAL4", "LOCAL5", "LOCAL6", "LOCAL7")},
+			},
+			"weak_ciphers": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Weak ciphers to allow: `AES128-CBC`, `NONE`.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"options": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Extra lines appended to sshd_config.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *SshConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *SshConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SshConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("ssh.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read ssh_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Bindiface.IsNull() && !data.Bindiface.IsUnknown() {
+		var bindifaceList []string
+		data.Bindiface.ElementsAs(ctx, &bindifaceList, false)
+		params["bindiface"] = bindifaceList
+	}
+	if !data.Tcpport.IsNull() && !data.Tcpport.IsUnknown() {
+		params["tcpport"] = data.Tcpport.ValueInt64()
+	}
+	if !data.PasswordLoginGroups.IsNull() && !data.PasswordLoginGroups.IsUnknown() {
+		var password_login_groupsList []string
+		data.PasswordLoginGroups.ElementsAs(ctx, &password_login_groupsList, false)
+		params["password_login_groups"] = password_login_groupsList
+	}
+	if !data.Passwordauth.IsNull() && !data.Passwordauth.IsUnknown() {
+		params["passwordauth"] = data.Passwordauth.ValueBool()
+	}
+	if !data.Kerberosauth.IsNull() && !data.Kerberosauth.IsUnknown() {
+		params["kerberosauth"] = data.Kerberosauth.ValueBool()
+	}
+	if !data.Tcpfwd.IsNull() && !data.Tcpfwd.IsUnknown() {
+		params["tcpfwd"] = data.Tcpfwd.ValueBool()
+	}
+	if !data.Compression.IsNull() && !data.Compression.IsUnknown() {
+		params["compression"] = data.Compression.ValueBool()
+	}
+	if !data.SftpLogLevel.IsNull() && !data.SftpLogLevel.IsUnknown() {
+		params["sftp_log_level"] = data.SftpLogLevel.ValueString()
+	}
+	if !data.SftpLogFacility.IsNull() && !data.SftpLogFacility.IsUnknown() {
+		params["sftp_log_facility"] = data.SftpLogFacility.ValueString()
+	}
+	if !data.WeakCiphers.IsNull() && !data.WeakCiphers.IsUnknown() {
+		var weak_ciphersList []string
+		data.WeakCiphers.ElementsAs(ctx, &weak_ciphersList, false)
+		params["weak_ciphers"] = weak_ciphersList
+	}
+	if !data.Options.IsNull() && !data.Options.IsUnknown() {
+		params["options"] = data.Options.ValueString()
+	}
+
+	result, err := r.client.Call("ssh.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update ssh_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("ssh")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SshConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SshConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("ssh.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read ssh_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["bindiface"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.Bindiface, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["tcpport"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Tcpport = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Tcpport = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["password_login_groups"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.PasswordLoginGroups, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["passwordauth"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Passwordauth = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["kerberosauth"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Kerberosauth = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["tcpfwd"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Tcpfwd = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["compression"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Compression = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["sftp_log_level"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.SftpLogLevel = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.SftpLogLevel = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.SftpLogLevel = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["sftp_log_facility"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.SftpLogFacility = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.SftpLogFacility = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.SftpLogFacility = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["weak_ciphers"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.WeakCiphers, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["options"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Options = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Options = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Options = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	data.ID = types.StringValue("ssh")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SshConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SshConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Bindiface.IsNull() && !data.Bindiface.IsUnknown() {
+		var bindifaceList []string
+		data.Bindiface.ElementsAs(ctx, &bindifaceList, false)
+		params["bindiface"] = bindifaceList
+	}
+	if !data.Tcpport.IsNull() && !data.Tcpport.IsUnknown() {
+		params["tcpport"] = data.Tcpport.ValueInt64()
+	}
+	if !data.PasswordLoginGroups.IsNull() && !data.PasswordLoginGroups.IsUnknown() {
+		var password_login_groupsList []string
+		data.PasswordLoginGroups.ElementsAs(ctx, &password_login_groupsList, false)
+		params["password_login_groups"] = password_login_groupsList
+	}
+	if !data.Passwordauth.IsNull() && !data.Passwordauth.IsUnknown() {
+		params["passwordauth"] = data.Passwordauth.ValueBool()
+	}
+	if !data.Kerberosauth.IsNull() && !data.Kerberosauth.IsUnknown() {
+		params["kerberosauth"] = data.Kerberosauth.ValueBool()
+	}
+	if !data.Tcpfwd.IsNull() && !data.Tcpfwd.IsUnknown() {
+		params["tcpfwd"] = data.Tcpfwd.ValueBool()
+	}
+	if !data.Compression.IsNull() && !data.Compression.IsUnknown() {
+		params["compression"] = data.Compression.ValueBool()
+	}
+	if !data.SftpLogLevel.IsNull() && !data.SftpLogLevel.IsUnknown() {
+		params["sftp_log_level"] = data.SftpLogLevel.ValueString()
+	}
+	if !data.SftpLogFacility.IsNull() && !data.SftpLogFacility.IsUnknown() {
+		params["sftp_log_facility"] = data.SftpLogFacility.ValueString()
+	}
+	if !data.WeakCiphers.IsNull() && !data.WeakCiphers.IsUnknown() {
+		var weak_ciphersList []string
+		data.WeakCiphers.ElementsAs(ctx, &weak_ciphersList, false)
+		params["weak_ciphers"] = weak_ciphersList
+	}
+	if !data.Options.IsNull() && !data.Options.IsUnknown() {
+		params["options"] = data.Options.ValueString()
+	}
+
+	result, err := r.client.Call("ssh.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update ssh_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("ssh")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SshConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SshConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when ssh_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"bindiface", "tcpport", "password_login_groups", "passwordauth", "kerberosauth", "tcpfwd", "compression", "sftp_log_level", "sftp_log_facility", "weak_ciphers", "options"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.Call("ssh.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore ssh_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *SshConfigResource) setComputed(result interface{}, data *SshConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Bindiface.IsUnknown() {
+		data.Bindiface = types.ListNull(types.StringType)
+		if v, ok := resultMap["bindiface"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Bindiface, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Tcpport.IsUnknown() {
+		data.Tcpport = types.Int64Null()
+		if v, ok := resultMap["tcpport"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Tcpport = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Tcpport = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.PasswordLoginGroups.IsUnknown() {
+		data.PasswordLoginGroups = types.ListNull(types.StringType)
+		if v, ok := resultMap["password_login_groups"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.PasswordLoginGroups, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Passwordauth.IsUnknown() {
+		data.Passwordauth = types.BoolNull()
+		if v, ok := resultMap["passwordauth"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Passwordauth = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Kerberosauth.IsUnknown() {
+		data.Kerberosauth = types.BoolNull()
+		if v, ok := resultMap["kerberosauth"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Kerberosauth = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Tcpfwd.IsUnknown() {
+		data.Tcpfwd = types.BoolNull()
+		if v, ok := resultMap["tcpfwd"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Tcpfwd = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Compression.IsUnknown() {
+		data.Compression = types.BoolNull()
+		if v, ok := resultMap["compression"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Compression = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.SftpLogLevel.IsUnknown() {
+		data.SftpLogLevel = types.StringNull()
+		if v, ok := resultMap["sftp_log_level"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.SftpLogLevel = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.SftpLogLevel = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.SftpLogLevel = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.SftpLogFacility.IsUnknown() {
+		data.SftpLogFacility = types.StringNull()
+		if v, ok := resultMap["sftp_log_facility"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.SftpLogFacility = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.SftpLogFacility = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.SftpLogFacility = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.WeakCiphers.IsUnknown() {
+		data.WeakCiphers = types.ListNull(types.StringType)
+		if v, ok := resultMap["weak_ciphers"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.WeakCiphers, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Options.IsUnknown() {
+		data.Options = types.StringNull()
+		if v, ok := resultMap["options"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Options = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Options = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Options = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
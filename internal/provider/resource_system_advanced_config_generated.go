@@ -0,0 +1,1105 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SystemAdvancedConfigResource manages the system.advanced settings. There is exactly one
+// instance, so create and update both call system.advanced.update.
+type SystemAdvancedConfigResource struct {
+	client *client.Client
+}
+
+type SystemAdvancedConfigResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Advancedmode         types.Bool   `tfsdk:"advancedmode"`
+	Autotune             types.Bool   `tfsdk:"autotune"`
+	KdumpEnabled         types.Bool   `tfsdk:"kdump_enabled"`
+	BootScrub            types.Int64  `tfsdk:"boot_scrub"`
+	Consolemenu          types.Bool   `tfsdk:"consolemenu"`
+	Consolemsg           types.Bool   `tfsdk:"consolemsg"`
+	Debugkernel          types.Bool   `tfsdk:"debugkernel"`
+	FqdnSyslog           types.Bool   `tfsdk:"fqdn_syslog"`
+	Motd                 types.String `tfsdk:"motd"`
+	LoginBanner          types.String `tfsdk:"login_banner"`
+	Powerdaemon          types.Bool   `tfsdk:"powerdaemon"`
+	Serialconsole        types.Bool   `tfsdk:"serialconsole"`
+	Serialport           types.String `tfsdk:"serialport"`
+	Serialspeed          types.String `tfsdk:"serialspeed"`
+	Overprovision        types.Int64  `tfsdk:"overprovision"`
+	Traceback            types.Bool   `tfsdk:"traceback"`
+	Uploadcrash          types.Bool   `tfsdk:"uploadcrash"`
+	SedUser              types.String `tfsdk:"sed_user"`
+	SedPasswd            types.String `tfsdk:"sed_passwd"`
+	Sysloglevel          types.String `tfsdk:"sysloglevel"`
+	Syslogserver         types.String `tfsdk:"syslogserver"`
+	SyslogTransport      types.String `tfsdk:"syslog_transport"`
+	SyslogTlsCertificate types.Int64  `tfsdk:"syslog_tls_certificate"`
+	SyslogAudit          types.Bool   `tfsdk:"syslog_audit"`
+	IsolatedGpuPciIds    types.List   `tfsdk:"isolated_gpu_pci_ids"`
+	KernelExtraOptions   types.String `tfsdk:"kernel_extra_options"`
+	RestoreOnDestroy     types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewSystemAdvancedConfigResource() resource.Resource {
+	return &SystemAdvancedConfigResource{}
+}
+
+func (r *SystemAdvancedConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_advanced_config"
+}
+
+func (r *SystemAdvancedConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SystemAdvancedConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *SystemAdvancedConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update System Advanced Service Configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"advancedmode": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Show advanced fields in the web UI by default.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"autotune": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Tune kernel parameters for the installed memory.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"kdump_enabled": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Capture a kernel crash dump when the kernel panics.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"boot_scrub": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Days between boot pool scrubs.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"consolemenu": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Show the text console menu instead of a login prompt.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"consolemsg": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Show console messages in the web UI footer.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"debugkernel": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Boot the debug kernel.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"fqdn_syslog": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Use the fully qualified host name in syslog messages.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"motd": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Message of the day shown after shell login.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"login_banner": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Banner shown before SSH and console login.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"powerdaemon": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Run powerd to reduce power use when idle.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"serialconsole": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Enable the serial console.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"serialport": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Serial console port, e.g. `ttyS0`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"serialspeed": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Serial console speed in baud.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("9600", "19200", "38400", "57600", "115200")},
+			},
+			"overprovision": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Size in GiB to leave unpartitioned on new SLOG devices.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"traceback": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Show Python tracebacks in error messages.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"uploadcrash": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Upload crash reports to iXsystems.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"sed_user": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "SED user for unlocking self-encrypting drives.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("USER", "MASTER")},
+			},
+			"sed_passwd": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "Global password for self-encrypting drives.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"sysloglevel": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Lowest severity sent to the remote syslog server.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("F_EMERG", "F_ALERT", "F_CRIT", "F_ERR", "F_WARNING", "F_NOTICE", "F_INFO", "F_DEBUG")},
+			},
+			"syslogserver": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Remote syslog server, as `host` or `host:port`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"syslog_transport": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Transport used for remote syslog.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("UDP", "TCP", "TLS")},
+			},
+			"syslog_tls_certificate": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "ID of the client certificate for TLS syslog.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"syslog_audit": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Also send audit messages to the remote syslog server.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"isolated_gpu_pci_ids": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "PCI IDs of GPUs reserved for VMs.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"kernel_extra_options": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Extra kernel command line options.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *SystemAdvancedConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *SystemAdvancedConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemAdvancedConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("system.advanced.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read system_advanced_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Advancedmode.IsNull() && !data.Advancedmode.IsUnknown() {
+		params["advancedmode"] = data.Advancedmode.ValueBool()
+	}
+	if !data.Autotune.IsNull() && !data.Autotune.IsUnknown() {
+		params["autotune"] = data.Autotune.ValueBool()
+	}
+	if !data.KdumpEnabled.IsNull() && !data.KdumpEnabled.IsUnknown() {
+		params["kdump_enabled"] = data.KdumpEnabled.ValueBool()
+	}
+	if !data.BootScrub.IsNull() && !data.BootScrub.IsUnknown() {
+		params["boot_scrub"] = data.BootScrub.ValueInt64()
+	}
+	if !data.Consolemenu.IsNull() && !data.Consolemenu.IsUnknown() {
+		params["consolemenu"] = data.Consolemenu.ValueBool()
+	}
+	if !data.Consolemsg.IsNull() && !data.Consolemsg.IsUnknown() {
+		params["consolemsg"] = data.Consolemsg.ValueBool()
+	}
+	if !data.Debugkernel.IsNull() && !data.Debugkernel.IsUnknown() {
+		params["debugkernel"] = data.Debugkernel.ValueBool()
+	}
+	if !data.FqdnSyslog.IsNull() && !data.FqdnSyslog.IsUnknown() {
+		params["fqdn_syslog"] = data.FqdnSyslog.ValueBool()
+	}
+	if !data.Motd.IsNull() && !data.Motd.IsUnknown() {
+		params["motd"] = data.Motd.ValueString()
+	}
+	if !data.LoginBanner.IsNull() && !data.LoginBanner.IsUnknown() {
+		params["login_banner"] = data.LoginBanner.ValueString()
+	}
+	if !data.Powerdaemon.IsNull() && !data.Powerdaemon.IsUnknown() {
+		params["powerdaemon"] = data.Powerdaemon.ValueBool()
+	}
+	if !data.Serialconsole.IsNull() && !data.Serialconsole.IsUnknown() {
+		params["serialconsole"] = data.Serialconsole.ValueBool()
+	}
+	if !data.Serialport.IsNull() && !data.Serialport.IsUnknown() {
+		params["serialport"] = data.Serialport.ValueString()
+	}
+	if !data.Serialspeed.IsNull() && !data.Serialspeed.IsUnknown() {
+		params["serialspeed"] = data.Serialspeed.ValueString()
+	}
+	if !data.Overprovision.IsNull() && !data.Overprovision.IsUnknown() {
+		params["overprovision"] = data.Overprovision.ValueInt64()
+	}
+	if !data.Traceback.IsNull() && !data.Traceback.IsUnknown() {
+		params["traceback"] = data.Traceback.ValueBool()
+	}
+	if !data.Uploadcrash.IsNull() && !data.Uploadcrash.IsUnknown() {
+		params["uploadcrash"] = data.Uploadcrash.ValueBool()
+	}
+	if !data.SedUser.IsNull() && !data.SedUser.IsUnknown() {
+		params["sed_user"] = data.SedUser.ValueString()
+	}
+	if !data.SedPasswd.IsNull() && !data.SedPasswd.IsUnknown() {
+		params["sed_passwd"] = data.SedPasswd.ValueString()
+	}
+	if !data.Sysloglevel.IsNull() && !data.Sysloglevel.IsUnknown() {
+		params["sysloglevel"] = data.Sysloglevel.ValueString()
+	}
+	if !data.Syslogserver.IsNull() && !data.Syslogserver.IsUnknown() {
+		params["syslogserver"] = data.Syslogserver.ValueString()
+	}
+	if !data.SyslogTransport.IsNull() && !data.SyslogTransport.IsUnknown() {
+		params["syslog_transport"] = data.SyslogTransport.ValueString()
+	}
+	if !data.SyslogTlsCertificate.IsNull() && !data.SyslogTlsCertificate.IsUnknown() {
+		params["syslog_tls_certificate"] = data.SyslogTlsCertificate.ValueInt64()
+	}
+	if !data.SyslogAudit.IsNull() && !data.SyslogAudit.IsUnknown() {
+		params["syslog_audit"] = data.SyslogAudit.ValueBool()
+	}
+	if !data.IsolatedGpuPciIds.IsNull() && !data.IsolatedGpuPciIds.IsUnknown() {
+		var isolated_gpu_pci_idsList []string
+		data.IsolatedGpuPciIds.ElementsAs(ctx, &isolated_gpu_pci_idsList, false)
+		params["isolated_gpu_pci_ids"] = isolated_gpu_pci_idsList
+	}
+	if !data.KernelExtraOptions.IsNull() && !data.KernelExtraOptions.IsUnknown() {
+		params["kernel_extra_options"] = data.KernelExtraOptions.ValueString()
+	}
+
+	result, err := r.client.Call("system.advanced.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update system_advanced_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("system.advanced")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemAdvancedConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("system.advanced.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read system_advanced_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["advancedmode"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Advancedmode = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["autotune"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Autotune = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["kdump_enabled"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.KdumpEnabled = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["boot_scrub"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.BootScrub = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.BootScrub = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["consolemenu"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Consolemenu = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["consolemsg"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Consolemsg = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["debugkernel"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Debugkernel = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["fqdn_syslog"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.FqdnSyslog = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["motd"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Motd = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Motd = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Motd = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["login_banner"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.LoginBanner = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.LoginBanner = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.LoginBanner = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["powerdaemon"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Powerdaemon = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["serialconsole"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Serialconsole = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["serialport"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Serialport = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Serialport = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Serialport = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["serialspeed"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Serialspeed = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Serialspeed = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Serialspeed = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["overprovision"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Overprovision = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Overprovision = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["traceback"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Traceback = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["uploadcrash"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Uploadcrash = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["sed_user"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.SedUser = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.SedUser = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.SedUser = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["sed_passwd"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.SedPasswd = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.SedPasswd = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.SedPasswd = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["sysloglevel"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Sysloglevel = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Sysloglevel = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Sysloglevel = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["syslogserver"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Syslogserver = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Syslogserver = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Syslogserver = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["syslog_transport"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.SyslogTransport = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.SyslogTransport = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.SyslogTransport = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["syslog_tls_certificate"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.SyslogTlsCertificate = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.SyslogTlsCertificate = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["syslog_audit"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.SyslogAudit = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["isolated_gpu_pci_ids"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.IsolatedGpuPciIds, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["kernel_extra_options"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.KernelExtraOptions = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.KernelExtraOptions = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.KernelExtraOptions = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	data.ID = types.StringValue("system.advanced")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemAdvancedConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Advancedmode.IsNull() && !data.Advancedmode.IsUnknown() {
+		params["advancedmode"] = data.Advancedmode.ValueBool()
+	}
+	if !data.Autotune.IsNull() && !data.Autotune.IsUnknown() {
+		params["autotune"] = data.Autotune.ValueBool()
+	}
+	if !data.KdumpEnabled.IsNull() && !data.KdumpEnabled.IsUnknown() {
+		params["kdump_enabled"] = data.KdumpEnabled.ValueBool()
+	}
+	if !data.BootScrub.IsNull() && !data.BootScrub.IsUnknown() {
+		params["boot_scrub"] = data.BootScrub.ValueInt64()
+	}
+	if !data.Consolemenu.IsNull() && !data.Consolemenu.IsUnknown() {
+		params["consolemenu"] = data.Consolemenu.ValueBool()
+	}
+	if !data.Consolemsg.IsNull() && !data.Consolemsg.IsUnknown() {
+		params["consolemsg"] = data.Consolemsg.ValueBool()
+	}
+	if !data.Debugkernel.IsNull() && !data.Debugkernel.IsUnknown() {
+		params["debugkernel"] = data.Debugkernel.ValueBool()
+	}
+	if !data.FqdnSyslog.IsNull() && !data.FqdnSyslog.IsUnknown() {
+		params["fqdn_syslog"] = data.FqdnSyslog.ValueBool()
+	}
+	if !data.Motd.IsNull() && !data.Motd.IsUnknown() {
+		params["motd"] = data.Motd.ValueString()
+	}
+	if !data.LoginBanner.IsNull() && !data.LoginBanner.IsUnknown() {
+		params["login_banner"] = data.LoginBanner.ValueString()
+	}
+	if !data.Powerdaemon.IsNull() && !data.Powerdaemon.IsUnknown() {
+		params["powerdaemon"] = data.Powerdaemon.ValueBool()
+	}
+	if !data.Serialconsole.IsNull() && !data.Serialconsole.IsUnknown() {
+		params["serialconsole"] = data.Serialconsole.ValueBool()
+	}
+	if !data.Serialport.IsNull() && !data.Serialport.IsUnknown() {
+		params["serialport"] = data.Serialport.ValueString()
+	}
+	if !data.Serialspeed.IsNull() && !data.Serialspeed.IsUnknown() {
+		params["serialspeed"] = data.Serialspeed.ValueString()
+	}
+	if !data.Overprovision.IsNull() && !data.Overprovision.IsUnknown() {
+		params["overprovision"] = data.Overprovision.ValueInt64()
+	}
+	if !data.Traceback.IsNull() && !data.Traceback.IsUnknown() {
+		params["traceback"] = data.Traceback.ValueBool()
+	}
+	if !data.Uploadcrash.IsNull() && !data.Uploadcrash.IsUnknown() {
+		params["uploadcrash"] = data.Uploadcrash.ValueBool()
+	}
+	if !data.SedUser.IsNull() && !data.SedUser.IsUnknown() {
+		params["sed_user"] = data.SedUser.ValueString()
+	}
+	if !data.SedPasswd.IsNull() && !data.SedPasswd.IsUnknown() {
+		params["sed_passwd"] = data.SedPasswd.ValueString()
+	}
+	if !data.Sysloglevel.IsNull() && !data.Sysloglevel.IsUnknown() {
+		params["sysloglevel"] = data.Sysloglevel.ValueString()
+	}
+	if !data.Syslogserver.IsNull() && !data.Syslogserver.IsUnknown() {
+		params["syslogserver"] = data.Syslogserver.ValueString()
+	}
+	if !data.SyslogTransport.IsNull() && !data.SyslogTransport.IsUnknown() {
+		params["syslog_transport"] = data.SyslogTransport.ValueString()
+	}
+	if !data.SyslogTlsCertificate.IsNull() && !data.SyslogTlsCertificate.IsUnknown() {
+		params["syslog_tls_certificate"] = data.SyslogTlsCertificate.ValueInt64()
+	}
+	if !data.SyslogAudit.IsNull() && !data.SyslogAudit.IsUnknown() {
+		params["syslog_audit"] = data.SyslogAudit.ValueBool()
+	}
+	if !data.IsolatedGpuPciIds.IsNull() && !data.IsolatedGpuPciIds.IsUnknown() {
+		var isolated_gpu_pci_idsList []string
+		data.IsolatedGpuPciIds.ElementsAs(ctx, &isolated_gpu_pci_idsList, false)
+		params["isolated_gpu_pci_ids"] = isolated_gpu_pci_idsList
+	}
+	if !data.KernelExtraOptions.IsNull() && !data.KernelExtraOptions.IsUnknown() {
+		params["kernel_extra_options"] = data.KernelExtraOptions.ValueString()
+	}
+
+	result, err := r.client.Call("system.advanced.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update system_advanced_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("system.advanced")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SystemAdvancedConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when system_advanced_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"advancedmode", "autotune", "kdump_enabled", "boot_scrub", "consolemenu", "consolemsg", "debugkernel", "fqdn_syslog", "motd", "login_banner", "powerdaemon", "serialconsole", "serialport", "serialspeed", "overprovision", "traceback", "uploadcrash", "sed_user", "sed_passwd", "sysloglevel", "syslogserver", "syslog_transport", "syslog_tls_certificate", "syslog_audit", "isolated_gpu_pci_ids", "kernel_extra_options"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.Call("system.advanced.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore system_advanced_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *SystemAdvancedConfigResource) setComputed(result interface{}, data *SystemAdvancedConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Advancedmode.IsUnknown() {
+		data.Advancedmode = types.BoolNull()
+		if v, ok := resultMap["advancedmode"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Advancedmode = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Autotune.IsUnknown() {
+		data.Autotune = types.BoolNull()
+		if v, ok := resultMap["autotune"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Autotune = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.KdumpEnabled.IsUnknown() {
+		data.KdumpEnabled = types.BoolNull()
+		if v, ok := resultMap["kdump_enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.KdumpEnabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.BootScrub.IsUnknown() {
+		data.BootScrub = types.Int64Null()
+		if v, ok := resultMap["boot_scrub"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.BootScrub = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.BootScrub = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Consolemenu.IsUnknown() {
+		data.Consolemenu = types.BoolNull()
+		if v, ok := resultMap["consolemenu"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Consolemenu = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Consolemsg.IsUnknown() {
+		data.Consolemsg = types.BoolNull()
+		if v, ok := resultMap["consolemsg"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Consolemsg = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Debugkernel.IsUnknown() {
+		data.Debugkernel = types.BoolNull()
+		if v, ok := resultMap["debugkernel"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Debugkernel = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.FqdnSyslog.IsUnknown() {
+		data.FqdnSyslog = types.BoolNull()
+		if v, ok := resultMap["fqdn_syslog"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.FqdnSyslog = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Motd.IsUnknown() {
+		data.Motd = types.StringNull()
+		if v, ok := resultMap["motd"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Motd = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Motd = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Motd = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.LoginBanner.IsUnknown() {
+		data.LoginBanner = types.StringNull()
+		if v, ok := resultMap["login_banner"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.LoginBanner = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.LoginBanner = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.LoginBanner = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Powerdaemon.IsUnknown() {
+		data.Powerdaemon = types.BoolNull()
+		if v, ok := resultMap["powerdaemon"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Powerdaemon = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Serialconsole.IsUnknown() {
+		data.Serialconsole = types.BoolNull()
+		if v, ok := resultMap["serialconsole"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Serialconsole = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Serialport.IsUnknown() {
+		data.Serialport = types.StringNull()
+		if v, ok := resultMap["serialport"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Serialport = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Serialport = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Serialport = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Serialspeed.IsUnknown() {
+		data.Serialspeed = types.StringNull()
+		if v, ok := resultMap["serialspeed"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Serialspeed = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Serialspeed = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Serialspeed = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Overprovision.IsUnknown() {
+		data.Overprovision = types.Int64Null()
+		if v, ok := resultMap["overprovision"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Overprovision = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Overprovision = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Traceback.IsUnknown() {
+		data.Traceback = types.BoolNull()
+		if v, ok := resultMap["traceback"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Traceback = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Uploadcrash.IsUnknown() {
+		data.Uploadcrash = types.BoolNull()
+		if v, ok := resultMap["uploadcrash"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Uploadcrash = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.SedUser.IsUnknown() {
+		data.SedUser = types.StringNull()
+		if v, ok := resultMap["sed_user"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.SedUser = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.SedUser = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.SedUser = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.SedPasswd.IsUnknown() {
+		data.SedPasswd = types.StringNull()
+		if v, ok := resultMap["sed_passwd"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.SedPasswd = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.SedPasswd = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.SedPasswd = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Sysloglevel.IsUnknown() {
+		data.Sysloglevel = types.StringNull()
+		if v, ok := resultMap["sysloglevel"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Sysloglevel = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Sysloglevel = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Sysloglevel = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Syslogserver.IsUnknown() {
+		data.Syslogserver = types.StringNull()
+		if v, ok := resultMap["syslogserver"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Syslogserver = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Syslogserver = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Syslogserver = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.SyslogTransport.IsUnknown() {
+		data.SyslogTransport = types.StringNull()
+		if v, ok := resultMap["syslog_transport"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.SyslogTransport = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.SyslogTransport = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.SyslogTransport = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.SyslogTlsCertificate.IsUnknown() {
+		data.SyslogTlsCertificate = types.Int64Null()
+		if v, ok := resultMap["syslog_tls_certificate"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.SyslogTlsCertificate = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.SyslogTlsCertificate = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.SyslogAudit.IsUnknown() {
+		data.SyslogAudit = types.BoolNull()
+		if v, ok := resultMap["syslog_audit"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.SyslogAudit = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.IsolatedGpuPciIds.IsUnknown() {
+		data.IsolatedGpuPciIds = types.ListNull(types.StringType)
+		if v, ok := resultMap["isolated_gpu_pci_ids"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.IsolatedGpuPciIds, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.KernelExtraOptions.IsUnknown() {
+		data.KernelExtraOptions = types.StringNull()
+		if v, ok := resultMap["kernel_extra_options"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.KernelExtraOptions = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.KernelExtraOptions = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.KernelExtraOptions = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
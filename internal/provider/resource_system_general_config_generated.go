@@ -0,0 +1,791 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SystemGeneralConfigResource manages the system.general settings. There is exactly one
+// instance, so create and update both call system.general.update.
+type SystemGeneralConfigResource struct {
+	client *client.Client
+}
+
+type SystemGeneralConfigResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	UiCertificate    types.Int64  `tfsdk:"ui_certificate"`
+	UiHttpsport      types.Int64  `tfsdk:"ui_httpsport"`
+	UiHttpsredirect  types.Bool   `tfsdk:"ui_httpsredirect"`
+	UiHttpsprotocols types.List   `tfsdk:"ui_httpsprotocols"`
+	UiPort           types.Int64  `tfsdk:"ui_port"`
+	UiAddress        types.List   `tfsdk:"ui_address"`
+	UiV6Address      types.List   `tfsdk:"ui_v6address"`
+	UiAllowlist      types.List   `tfsdk:"ui_allowlist"`
+	UiConsolemsg     types.Bool   `tfsdk:"ui_consolemsg"`
+	UiXFrameOptions  types.String `tfsdk:"ui_x_frame_options"`
+	Kbdmap           types.String `tfsdk:"kbdmap"`
+	Language         types.String `tfsdk:"language"`
+	Timezone         types.String `tfsdk:"timezone"`
+	UsageCollection  types.Bool   `tfsdk:"usage_collection"`
+	DsAuth           types.Bool   `tfsdk:"ds_auth"`
+	RestoreOnDestroy types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewSystemGeneralConfigResource() resource.Resource {
+	return &SystemGeneralConfigResource{}
+}
+
+func (r *SystemGeneralConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_general_config"
+}
+
+func (r *SystemGeneralConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SystemGeneralConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *SystemGeneralConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update System General Service Configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"ui_certificate": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "ID of the certificate the web UI serves.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"ui_httpsport": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "HTTPS port of the web UI.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(1, 65535)},
+			},
+			"ui_httpsredirect": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Redirect HTTP requests to HTTPS.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"ui_httpsprotocols": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "TLS versions the web UI accepts, e.g. `TLSv1.2`, `TLSv1.3`.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"ui_port": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "HTTP port of the web UI.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(1, 65535)},
+			},
+			"ui_address": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "IPv4 addresses the web UI listens on. `0.0.0.0` means all.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"ui_v6address": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "IPv6 addresses the web UI listens on. `::` means all.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"ui_allowlist": schema.ListAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Networks allowed to reach the web UI and API. Empty allows all.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"ui_consolemsg": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Show console messages in the web UI.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"ui_x_frame_options": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "X-Frame-Options header of the web UI.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("SAMEORIGIN", "DENY", "ALLOW_ALL")},
+			},
+			"kbdmap": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Console keyboard layout.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"language": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Web UI language code, e.g. `en`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"timezone": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "System time zone, e.g. `Europe/Berlin`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"usage_collection": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Send anonymous usage statistics.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"ds_auth": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow directory service users to log in to the web UI.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *SystemGeneralConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *SystemGeneralConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemGeneralConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("system.general.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read system_general_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.UiCertificate.IsNull() && !data.UiCertificate.IsUnknown() {
+		params["ui_certificate"] = data.UiCertificate.ValueInt64()
+	}
+	if !data.UiHttpsport.IsNull() && !data.UiHttpsport.IsUnknown() {
+		params["ui_httpsport"] = data.UiHttpsport.ValueInt64()
+	}
+	if !data.UiHttpsredirect.IsNull() && !data.UiHttpsredirect.IsUnknown() {
+		params["ui_httpsredirect"] = data.UiHttpsredirect.ValueBool()
+	}
+	if !data.UiHttpsprotocols.IsNull() && !data.UiHttpsprotocols.IsUnknown() {
+		var ui_httpsprotocolsList []string
+		data.UiHttpsprotocols.ElementsAs(ctx, &ui_httpsprotocolsList, false)
+		params["ui_httpsprotocols"] = ui_httpsprotocolsList
+	}
+	if !data.UiPort.IsNull() && !data.UiPort.IsUnknown() {
+		params["ui_port"] = data.UiPort.ValueInt64()
+	}
+	if !data.UiAddress.IsNull() && !data.UiAddress.IsUnknown() {
+		var ui_addressList []string
+		data.UiAddress.ElementsAs(ctx, &ui_addressList, false)
+		params["ui_address"] = ui_addressList
+	}
+	if !data.UiV6Address.IsNull() && !data.UiV6Address.IsUnknown() {
+		var ui_v6addressList []string
+		data.UiV6Address.ElementsAs(ctx, &ui_v6addressList, false)
+		params["ui_v6address"] = ui_v6addressList
+	}
+	if !data.UiAllowlist.IsNull() && !data.UiAllowlist.IsUnknown() {
+		var ui_allowlistList []string
+		data.UiAllowlist.ElementsAs(ctx, &ui_allowlistList, false)
+		params["ui_allowlist"] = ui_allowlistList
+	}
+	if !data.UiConsolemsg.IsNull() && !data.UiConsolemsg.IsUnknown() {
+		params["ui_consolemsg"] = data.UiConsolemsg.ValueBool()
+	}
+	if !data.UiXFrameOptions.IsNull() && !data.UiXFrameOptions.IsUnknown() {
+		params["ui_x_frame_options"] = data.UiXFrameOptions.ValueString()
+	}
+	if !data.Kbdmap.IsNull() && !data.Kbdmap.IsUnknown() {
+		params["kbdmap"] = data.Kbdmap.ValueString()
+	}
+	if !data.Language.IsNull() && !data.Language.IsUnknown() {
+		params["language"] = data.Language.ValueString()
+	}
+	if !data.Timezone.IsNull() && !data.Timezone.IsUnknown() {
+		params["timezone"] = data.Timezone.ValueString()
+	}
+	if !data.UsageCollection.IsNull() && !data.UsageCollection.IsUnknown() {
+		params["usage_collection"] = data.UsageCollection.ValueBool()
+	}
+	if !data.DsAuth.IsNull() && !data.DsAuth.IsUnknown() {
+		params["ds_auth"] = data.DsAuth.ValueBool()
+	}
+
+	result, err := r.client.Call("system.general.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update system_general_config: %s", err))
+		return
+	}
+
+	if err := restartUIIfChanged(r.client, original, result); err != nil {
+		resp.Diagnostics.AddError("UI Restart Error", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue("system.general")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemGeneralConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemGeneralConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("system.general.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read system_general_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["ui_certificate"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.UiCertificate = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.UiCertificate = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["ui_httpsport"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.UiHttpsport = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.UiHttpsport = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["ui_httpsredirect"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.UiHttpsredirect = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["ui_httpsprotocols"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.UiHttpsprotocols, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["ui_port"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.UiPort = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.UiPort = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["ui_address"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.UiAddress, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["ui_v6address"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.UiV6Address, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["ui_allowlist"]; ok && v != nil {
+		if arr, ok := v.([]interface{}); ok {
+			strVals := make([]attr.Value, len(arr))
+			for i, item := range arr {
+				strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+			}
+			data.UiAllowlist, _ = types.ListValue(types.StringType, strVals)
+		}
+	}
+	if v, ok := resultMap["ui_consolemsg"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.UiConsolemsg = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["ui_x_frame_options"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.UiXFrameOptions = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.UiXFrameOptions = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.UiXFrameOptions = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["kbdmap"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Kbdmap = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Kbdmap = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Kbdmap = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["language"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Language = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Language = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Language = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["timezone"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Timezone = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Timezone = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Timezone = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["usage_collection"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.UsageCollection = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["ds_auth"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.DsAuth = types.BoolValue(bv)
+		}
+	}
+
+	data.ID = types.StringValue("system.general")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemGeneralConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemGeneralConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// UI settings only take effect once the web UI restarts; keep the current
+	// values to tell whether that is needed
+	original, err := r.client.Call("system.general.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to read system_general_config: %s", err))
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.UiCertificate.IsNull() && !data.UiCertificate.IsUnknown() {
+		params["ui_certificate"] = data.UiCertificate.ValueInt64()
+	}
+	if !data.UiHttpsport.IsNull() && !data.UiHttpsport.IsUnknown() {
+		params["ui_httpsport"] = data.UiHttpsport.ValueInt64()
+	}
+	if !data.UiHttpsredirect.IsNull() && !data.UiHttpsredirect.IsUnknown() {
+		params["ui_httpsredirect"] = data.UiHttpsredirect.ValueBool()
+	}
+	if !data.UiHttpsprotocols.IsNull() && !data.UiHttpsprotocols.IsUnknown() {
+		var ui_httpsprotocolsList []string
+		data.UiHttpsprotocols.ElementsAs(ctx, &ui_httpsprotocolsList, false)
+		params["ui_httpsprotocols"] = ui_httpsprotocolsList
+	}
+	if !data.UiPort.IsNull() && !data.UiPort.IsUnknown() {
+		params["ui_port"] = data.UiPort.ValueInt64()
+	}
+	if !data.UiAddress.IsNull() && !data.UiAddress.IsUnknown() {
+		var ui_addressList []string
+		data.UiAddress.ElementsAs(ctx, &ui_addressList, false)
+		params["ui_address"] = ui_addressList
+	}
+	if !data.UiV6Address.IsNull() && !data.UiV6Address.IsUnknown() {
+		var ui_v6addressList []string
+		data.UiV6Address.ElementsAs(ctx, &ui_v6addressList, false)
+		params["ui_v6address"] = ui_v6addressList
+	}
+	if !data.UiAllowlist.IsNull() && !data.UiAllowlist.IsUnknown() {
+		var ui_allowlistList []string
+		data.UiAllowlist.ElementsAs(ctx, &ui_allowlistList, false)
+		params["ui_allowlist"] = ui_allowlistList
+	}
+	if !data.UiConsolemsg.IsNull() && !data.UiConsolemsg.IsUnknown() {
+		params["ui_consolemsg"] = data.UiConsolemsg.ValueBool()
+	}
+	if !data.UiXFrameOptions.IsNull() && !data.UiXFrameOptions.IsUnknown() {
+		params["ui_x_frame_options"] = data.UiXFrameOptions.ValueString()
+	}
+	if !data.Kbdmap.IsNull() && !data.Kbdmap.IsUnknown() {
+		params["kbdmap"] = data.Kbdmap.ValueString()
+	}
+	if !data.Language.IsNull() && !data.Language.IsUnknown() {
+		params["language"] = data.Language.ValueString()
+	}
+	if !data.Timezone.IsNull() && !data.Timezone.IsUnknown() {
+		params["timezone"] = data.Timezone.ValueString()
+	}
+	if !data.UsageCollection.IsNull() && !data.UsageCollection.IsUnknown() {
+		params["usage_collection"] = data.UsageCollection.ValueBool()
+	}
+	if !data.DsAuth.IsNull() && !data.DsAuth.IsUnknown() {
+		params["ds_auth"] = data.DsAuth.ValueBool()
+	}
+
+	result, err := r.client.Call("system.general.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update system_general_config: %s", err))
+		return
+	}
+
+	if err := restartUIIfChanged(r.client, original, result); err != nil {
+		resp.Diagnostics.AddError("UI Restart Error", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue("system.general")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemGeneralConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SystemGeneralConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when system_general_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"ui_certificate", "ui_httpsport", "ui_httpsredirect", "ui_httpsprotocols", "ui_port", "ui_address", "ui_v6address", "ui_allowlist", "ui_consolemsg", "ui_x_frame_options", "kbdmap", "language", "timezone", "usage_collection", "ds_auth"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+
+	// Restored UI settings need a web UI restart too
+	current, err := r.client.Call("system.general.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to read system_general_config: %s", err))
+		return
+	}
+
+	result, err := r.client.Call("system.general.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore system_general_config: %s", err))
+		return
+	}
+
+	if err := restartUIIfChanged(r.client, current, result); err != nil {
+		resp.Diagnostics.AddError("UI Restart Error", err.Error())
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *SystemGeneralConfigResource) setComputed(result interface{}, data *SystemGeneralConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.UiCertificate.IsUnknown() {
+		data.UiCertificate = types.Int64Null()
+		if v, ok := resultMap["ui_certificate"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.UiCertificate = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.UiCertificate = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.UiHttpsport.IsUnknown() {
+		data.UiHttpsport = types.Int64Null()
+		if v, ok := resultMap["ui_httpsport"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.UiHttpsport = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.UiHttpsport = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.UiHttpsredirect.IsUnknown() {
+		data.UiHttpsredirect = types.BoolNull()
+		if v, ok := resultMap["ui_httpsredirect"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.UiHttpsredirect = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.UiHttpsprotocols.IsUnknown() {
+		data.UiHttpsprotocols = types.ListNull(types.StringType)
+		if v, ok := resultMap["ui_httpsprotocols"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.UiHttpsprotocols, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.UiPort.IsUnknown() {
+		data.UiPort = types.Int64Null()
+		if v, ok := resultMap["ui_port"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.UiPort = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.UiPort = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.UiAddress.IsUnknown() {
+		data.UiAddress = types.ListNull(types.StringType)
+		if v, ok := resultMap["ui_address"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.UiAddress, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.UiV6Address.IsUnknown() {
+		data.UiV6Address = types.ListNull(types.StringType)
+		if v, ok := resultMap["ui_v6address"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.UiV6Address, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.UiAllowlist.IsUnknown() {
+		data.UiAllowlist = types.ListNull(types.StringType)
+		if v, ok := resultMap["ui_allowlist"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.UiAllowlist, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.UiConsolemsg.IsUnknown() {
+		data.UiConsolemsg = types.BoolNull()
+		if v, ok := resultMap["ui_consolemsg"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.UiConsolemsg = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.UiXFrameOptions.IsUnknown() {
+		data.UiXFrameOptions = types.StringNull()
+		if v, ok := resultMap["ui_x_frame_options"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.UiXFrameOptions = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.UiXFrameOptions = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.UiXFrameOptions = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Kbdmap.IsUnknown() {
+		data.Kbdmap = types.StringNull()
+		if v, ok := resultMap["kbdmap"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Kbdmap = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Kbdmap = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Kbdmap = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Language.IsUnknown() {
+		data.Language = types.StringNull()
+		if v, ok := resultMap["language"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Language = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Language = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Language = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Timezone.IsUnknown() {
+		data.Timezone = types.StringNull()
+		if v, ok := resultMap["timezone"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Timezone = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Timezone = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Timezone = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.UsageCollection.IsUnknown() {
+		data.UsageCollection = types.BoolNull()
+		if v, ok := resultMap["usage_collection"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.UsageCollection = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.DsAuth.IsUnknown() {
+		data.DsAuth = types.BoolNull()
+		if v, ok := resultMap["ds_auth"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.DsAuth = types.BoolValue(bv)
+			}
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -39,8 +41,13 @@ func (r *SystemNtpserverResource) ImportState(ctx context.Context, req resource.
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *SystemNtpserverResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *SystemNtpserverResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Add an NTP Server.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -52,32 +59,44 @@ func (r *SystemNtpserverResource) Schema(ctx context.Context, req resource.Schem
 			"burst": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Send a burst of packets when the server is reachable.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"iburst": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Send a burst of packets when the server is unreachable.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"prefer": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Mark this server as preferred for time synchronization.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"minpoll": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Minimum polling interval (log2 seconds).",
+				Default:     int64default.StaticInt64(6),
 			},
 			"maxpoll": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Maximum polling interval (log2 seconds).",
+				Default:     int64default.StaticInt64(10),
 			},
 			"force": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Force creation even if the server is unreachable.",
+				Default:     booldefault.StaticBool(false),
 			},
 		},
 	}
@@ -103,25 +122,25 @@ func (r *SystemNtpserverResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	params := map[string]interface{}{}
-	if !data.Address.IsNull() {
+	if !data.Address.IsNull() && !data.Address.IsUnknown() {
 		params["address"] = data.Address.ValueString()
 	}
-	if !data.Burst.IsNull() {
+	if !data.Burst.IsNull() && !data.Burst.IsUnknown() {
 		params["burst"] = data.Burst.ValueBool()
 	}
-	if !data.Iburst.IsNull() {
+	if !data.Iburst.IsNull() && !data.Iburst.IsUnknown() {
 		params["iburst"] = data.Iburst.ValueBool()
 	}
-	if !data.Prefer.IsNull() {
+	if !data.Prefer.IsNull() && !data.Prefer.IsUnknown() {
 		params["prefer"] = data.Prefer.ValueBool()
 	}
-	if !data.Minpoll.IsNull() {
+	if !data.Minpoll.IsNull() && !data.Minpoll.IsUnknown() {
 		params["minpoll"] = data.Minpoll.ValueInt64()
 	}
-	if !data.Maxpoll.IsNull() {
+	if !data.Maxpoll.IsNull() && !data.Maxpoll.IsUnknown() {
 		params["maxpoll"] = data.Maxpoll.ValueInt64()
 	}
-	if !data.Force.IsNull() {
+	if !data.Force.IsNull() && !data.Force.IsUnknown() {
 		params["force"] = data.Force.ValueBool()
 	}
 
@@ -143,6 +162,7 @@ func (r *SystemNtpserverResource) Create(ctx context.Context, req resource.Creat
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -221,35 +241,36 @@ func (r *SystemNtpserverResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	params := map[string]interface{}{}
-	if !data.Address.IsNull() {
+	if !data.Address.IsNull() && !data.Address.IsUnknown() {
 		params["address"] = data.Address.ValueString()
 	}
-	if !data.Burst.IsNull() {
+	if !data.Burst.IsNull() && !data.Burst.IsUnknown() {
 		params["burst"] = data.Burst.ValueBool()
 	}
-	if !data.Iburst.IsNull() {
+	if !data.Iburst.IsNull() && !data.Iburst.IsUnknown() {
 		params["iburst"] = data.Iburst.ValueBool()
 	}
-	if !data.Prefer.IsNull() {
+	if !data.Prefer.IsNull() && !data.Prefer.IsUnknown() {
 		params["prefer"] = data.Prefer.ValueBool()
 	}
-	if !data.Minpoll.IsNull() {
+	if !data.Minpoll.IsNull() && !data.Minpoll.IsUnknown() {
 		params["minpoll"] = data.Minpoll.ValueInt64()
 	}
-	if !data.Maxpoll.IsNull() {
+	if !data.Maxpoll.IsNull() && !data.Maxpoll.IsUnknown() {
 		params["maxpoll"] = data.Maxpoll.ValueInt64()
 	}
-	if !data.Force.IsNull() {
+	if !data.Force.IsNull() && !data.Force.IsUnknown() {
 		params["force"] = data.Force.ValueBool()
 	}
 
-	_, err = r.client.Call("system.ntpserver.update", []interface{}{id, params})
+	result, err := r.client.Call("system.ntpserver.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update system_ntpserver: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -274,3 +295,71 @@ func (r *SystemNtpserverResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *SystemNtpserverResource) setComputed(result interface{}, data *SystemNtpserverResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Burst.IsUnknown() {
+		data.Burst = types.BoolNull()
+		if v, ok := resultMap["burst"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Burst = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Iburst.IsUnknown() {
+		data.Iburst = types.BoolNull()
+		if v, ok := resultMap["iburst"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Iburst = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Prefer.IsUnknown() {
+		data.Prefer = types.BoolNull()
+		if v, ok := resultMap["prefer"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Prefer = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Minpoll.IsUnknown() {
+		data.Minpoll = types.Int64Null()
+		if v, ok := resultMap["minpoll"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Minpoll = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Minpoll = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Maxpoll.IsUnknown() {
+		data.Maxpoll = types.Int64Null()
+		if v, ok := resultMap["maxpoll"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Maxpoll = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Maxpoll = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Force.IsUnknown() {
+		data.Force = types.BoolNull()
+		if v, ok := resultMap["force"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Force = types.BoolValue(bv)
+			}
+		}
+	}
+}
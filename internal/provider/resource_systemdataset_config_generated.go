@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SystemdatasetConfigResource manages the systemdataset settings. There is exactly one
+// instance, so create and update both call systemdataset.update.
+type SystemdatasetConfigResource struct {
+	client *client.Client
+}
+
+type SystemdatasetConfigResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Pool             types.String `tfsdk:"pool"`
+	PoolExclude      types.String `tfsdk:"pool_exclude"`
+	RestoreOnDestroy types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewSystemdatasetConfigResource() resource.Resource {
+	return &SystemdatasetConfigResource{}
+}
+
+func (r *SystemdatasetConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_systemdataset_config"
+}
+
+func (r *SystemdatasetConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SystemdatasetConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *SystemdatasetConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update System Dataset Service Configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"pool": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Pool holding the system dataset. Null picks the boot pool or the first data pool.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"pool_exclude": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Pool to move the system dataset away from, e.g. before exporting it.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *SystemdatasetConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *SystemdatasetConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemdatasetConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("systemdataset.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read systemdataset_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Pool.IsNull() && !data.Pool.IsUnknown() {
+		params["pool"] = data.Pool.ValueString()
+	}
+	if !data.PoolExclude.IsNull() && !data.PoolExclude.IsUnknown() {
+		params["pool_exclude"] = data.PoolExclude.ValueString()
+	}
+
+	result, err := r.client.CallWithJob("systemdataset.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update systemdataset_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("systemdataset")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemdatasetConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemdatasetConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("systemdataset.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read systemdataset_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["pool"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Pool = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Pool = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Pool = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["pool_exclude"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.PoolExclude = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.PoolExclude = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.PoolExclude = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+
+	data.ID = types.StringValue("systemdataset")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemdatasetConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemdatasetConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Pool.IsNull() && !data.Pool.IsUnknown() {
+		params["pool"] = data.Pool.ValueString()
+	}
+	if !data.PoolExclude.IsNull() && !data.PoolExclude.IsUnknown() {
+		params["pool_exclude"] = data.PoolExclude.ValueString()
+	}
+
+	result, err := r.client.CallWithJob("systemdataset.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update systemdataset_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("systemdataset")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemdatasetConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SystemdatasetConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when systemdataset_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"pool", "pool_exclude"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.CallWithJob("systemdataset.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore systemdataset_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *SystemdatasetConfigResource) setComputed(result interface{}, data *SystemdatasetConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Pool.IsUnknown() {
+		data.Pool = types.StringNull()
+		if v, ok := resultMap["pool"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Pool = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Pool = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Pool = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.PoolExclude.IsUnknown() {
+		data.PoolExclude = types.StringNull()
+		if v, ok := resultMap["pool_exclude"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.PoolExclude = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.PoolExclude = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.PoolExclude = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
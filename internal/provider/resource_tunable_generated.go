@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -19,13 +23,14 @@ type TunableResource struct {
 }
 
 type TunableResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Type            types.String `tfsdk:"type"`
-	Var             types.String `tfsdk:"var"`
-	Value           types.String `tfsdk:"value"`
-	Comment         types.String `tfsdk:"comment"`
-	Enabled         types.Bool   `tfsdk:"enabled"`
-	UpdateInitramfs types.Bool   `tfsdk:"update_initramfs"`
+	ID              types.String   `tfsdk:"id"`
+	Type            types.String   `tfsdk:"type"`
+	Var             types.String   `tfsdk:"var"`
+	Value           types.String   `tfsdk:"value"`
+	Comment         types.String   `tfsdk:"comment"`
+	Enabled         types.Bool     `tfsdk:"enabled"`
+	UpdateInitramfs types.Bool     `tfsdk:"update_initramfs"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewTunableResource() resource.Resource {
@@ -37,25 +42,42 @@ func (r *TunableResource) Metadata(ctx context.Context, req resource.MetadataReq
 }
 
 func (r *TunableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "tunable.query", tunableIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &TunableResource{}
+
+// tunableIdentity lists the API fields that identify a tunable
+var tunableIdentity = []identityKey{{Name: "var"}}
+
+func (r *TunableResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(tunableIdentity)
+}
+
+func (r *TunableResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *TunableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a tunable.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"type": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "* `SYSCTL`: `var` is a sysctl name (e.g. `kernel.watchdog`) and `value` is its corresponding value (",
+				Default:       stringdefault.StaticString("SYSCTL"),
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("SYSCTL", "UDEV", "ZFS")},
 			},
 			"var": schema.StringAttribute{
 				Required:      true,
 				Optional:      false,
 				Description:   "Name or identifier of the system parameter to tune.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"value": schema.StringAttribute{
 				Required:    true,
@@ -65,19 +87,28 @@ func (r *TunableResource) Schema(ctx context.Context, req resource.SchemaRequest
 			"comment": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Optional descriptive comment explaining the purpose of this tunable.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether this tunable is active and should be applied.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"update_initramfs": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "If `false`, then initramfs will not be updated after creating a ZFS tunable and you will need to run",
+				Default:     booldefault.StaticBool(true),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -101,26 +132,32 @@ func (r *TunableResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	params := map[string]interface{}{}
-	if !data.Type.IsNull() {
+	if !data.Type.IsNull() && !data.Type.IsUnknown() {
 		params["type"] = data.Type.ValueString()
 	}
-	if !data.Var.IsNull() {
+	if !data.Var.IsNull() && !data.Var.IsUnknown() {
 		params["var"] = data.Var.ValueString()
 	}
-	if !data.Value.IsNull() {
+	if !data.Value.IsNull() && !data.Value.IsUnknown() {
 		params["value"] = data.Value.ValueString()
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.UpdateInitramfs.IsNull() {
+	if !data.UpdateInitramfs.IsNull() && !data.UpdateInitramfs.IsUnknown() {
 		params["update_initramfs"] = data.UpdateInitramfs.ValueBool()
 	}
 
-	result, err := r.client.CallWithJob("tunable.create", params)
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithJobTimeout("tunable.create", params, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to create tunable: %s", err))
 		return
@@ -138,6 +175,8 @@ func (r *TunableResource) Create(ctx context.Context, req resource.CreateRequest
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, tunableIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -157,7 +196,13 @@ func (r *TunableResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	result, err := r.client.Call("tunable.get_instance", id)
+	readTimeout, diags := data.Timeouts.Read(ctx, r.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithTimeout("tunable.get_instance", id, readTimeout)
 	if err != nil {
 		// Check if resource was deleted outside Terraform (ENOENT = entity not found)
 		if strings.Contains(err.Error(), "[ENOENT]") {
@@ -202,6 +247,7 @@ func (r *TunableResource) Read(ctx context.Context, req resource.ReadRequest, re
 			data.Value = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, tunableIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -228,26 +274,34 @@ func (r *TunableResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	params := map[string]interface{}{}
-	if !data.Value.IsNull() {
+	if !data.Value.IsNull() && !data.Value.IsUnknown() {
 		params["value"] = data.Value.ValueString()
 	}
-	if !data.Comment.IsNull() {
+	if !data.Comment.IsNull() && !data.Comment.IsUnknown() {
 		params["comment"] = data.Comment.ValueString()
 	}
-	if !data.Enabled.IsNull() {
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() {
 		params["enabled"] = data.Enabled.ValueBool()
 	}
-	if !data.UpdateInitramfs.IsNull() {
+	if !data.UpdateInitramfs.IsNull() && !data.UpdateInitramfs.IsUnknown() {
 		params["update_initramfs"] = data.UpdateInitramfs.ValueBool()
 	}
 
-	_, err = r.client.CallWithJob("tunable.update", []interface{}{id, params})
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithJobTimeout("tunable.update", []interface{}{id, params}, updateTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update tunable: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, tunableIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -266,9 +320,82 @@ func (r *TunableResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	_, err = r.client.CallWithJob("tunable.delete", id)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = r.client.CallWithJobTimeout("tunable.delete", id, deleteTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to delete tunable: %s", err))
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *TunableResource) setComputed(result interface{}, data *TunableResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Type.IsUnknown() {
+		data.Type = types.StringNull()
+		if v, ok := resultMap["type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Type = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Type = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Type = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Var.IsUnknown() {
+		data.Var = types.StringNull()
+		if v, ok := resultMap["var"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Var = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Var = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Var = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Comment.IsUnknown() {
+		data.Comment = types.StringNull()
+		if v, ok := resultMap["comment"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Comment = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Comment = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Comment = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Enabled.IsUnknown() {
+		data.Enabled = types.BoolNull()
+		if v, ok := resultMap["enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Enabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.UpdateInitramfs.IsUnknown() {
+		data.UpdateInitramfs = types.BoolNull()
+		if v, ok := resultMap["update_initramfs"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.UpdateInitramfs = types.BoolValue(bv)
+			}
+		}
+	}
+}
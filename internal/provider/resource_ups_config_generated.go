@@ -0,0 +1,958 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpsConfigResource manages the ups settings. There is exactly one
+// instance, so create and update both call ups.update.
+type UpsConfigResource struct {
+	client *client.Client
+}
+
+type UpsConfigResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Powerdown        types.Bool   `tfsdk:"powerdown"`
+	Rmonitor         types.Bool   `tfsdk:"rmonitor"`
+	RmonitorPassword types.String `tfsdk:"rmonitor_password"`
+	Identifier       types.String `tfsdk:"identifier"`
+	Mode             types.String `tfsdk:"mode"`
+	Shutdown         types.String `tfsdk:"shutdown"`
+	Shutdowntimer    types.Int64  `tfsdk:"shutdowntimer"`
+	Shutdowncmd      types.String `tfsdk:"shutdowncmd"`
+	Monuser          types.String `tfsdk:"monuser"`
+	Monpwd           types.String `tfsdk:"monpwd"`
+	Options          types.String `tfsdk:"options"`
+	Optionsupsd      types.String `tfsdk:"optionsupsd"`
+	Port             types.String `tfsdk:"port"`
+	Driver           types.String `tfsdk:"driver"`
+	Remotehost       types.String `tfsdk:"remotehost"`
+	Remoteport       types.Int64  `tfsdk:"remoteport"`
+	Description      types.String `tfsdk:"description"`
+	Hostsync         types.Int64  `tfsdk:"hostsync"`
+	Nocommwarntime   types.Int64  `tfsdk:"nocommwarntime"`
+	RestoreOnDestroy types.Bool   `tfsdk:"restore_on_destroy"`
+}
+
+func NewUpsConfigResource() resource.Resource {
+	return &UpsConfigResource{}
+}
+
+func (r *UpsConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ups_config"
+}
+
+func (r *UpsConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *UpsConfigResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *UpsConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: "Update UPS Service Configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
+			"powerdown": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Tell the UPS to power off after the system shuts down.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"rmonitor": schema.BoolAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Allow remote systems to monitor this UPS.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"rmonitor_password": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "Password remote monitors authenticate with.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"identifier": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Name that identifies this UPS.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"mode": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether the UPS is attached to this system or monitored over the network.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("MASTER", "SLAVE")},
+			},
+			"shutdown": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "When to shut down.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.String{stringvalidator.OneOf("LOWBATT", "BATT")},
+			},
+			"shutdowntimer": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Seconds on battery before shutting down when `shutdown` is `BATT`.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"shutdowncmd": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Command run instead of the default shutdown.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"monuser": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "User remote monitors authenticate as.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"monpwd": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "Password of the monitoring user.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"options": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Extra lines appended to ups.conf.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"optionsupsd": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Extra lines appended to upsd.conf.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"port": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Serial or USB port the UPS is attached to, or `auto`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"driver": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "NUT driver and model, as listed by ups.driver_choices.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"remotehost": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Host of the UPS monitored in `SLAVE` mode.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"remoteport": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Port of the UPS monitored in `SLAVE` mode.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.Between(1, 65535)},
+			},
+			"description": schema.StringAttribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Description of the UPS.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"hostsync": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Seconds to wait for remote monitors to disconnect before shutting down.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"nocommwarntime": schema.Int64Attribute{
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Seconds without contact before warning that the UPS is unreachable.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "On destroy, put back the settings captured before the first apply instead of leaving them as they are",
+			},
+		},
+	}
+}
+
+func (r *UpsConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", "Expected *client.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *UpsConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UpsConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Capture the settings in place before Terraform took over, so destroy
+	// can put them back when restore_on_destroy is set
+	original, err := r.client.Call("ups.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to read ups_config: %s", err))
+		return
+	}
+	if b, err := json.Marshal(original); err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original", b)...)
+	}
+
+	params := map[string]interface{}{}
+	if !data.Powerdown.IsNull() && !data.Powerdown.IsUnknown() {
+		params["powerdown"] = data.Powerdown.ValueBool()
+	}
+	if !data.Rmonitor.IsNull() && !data.Rmonitor.IsUnknown() {
+		params["rmonitor"] = data.Rmonitor.ValueBool()
+	}
+	if !data.RmonitorPassword.IsNull() && !data.RmonitorPassword.IsUnknown() {
+		params["rmonitor_password"] = data.RmonitorPassword.ValueString()
+	}
+	if !data.Identifier.IsNull() && !data.Identifier.IsUnknown() {
+		params["identifier"] = data.Identifier.ValueString()
+	}
+	if !data.Mode.IsNull() && !data.Mode.IsUnknown() {
+		params["mode"] = data.Mode.ValueString()
+	}
+	if !data.Shutdown.IsNull() && !data.Shutdown.IsUnknown() {
+		params["shutdown"] = data.Shutdown.ValueString()
+	}
+	if !data.Shutdowntimer.IsNull() && !data.Shutdowntimer.IsUnknown() {
+		params["shutdowntimer"] = data.Shutdowntimer.ValueInt64()
+	}
+	if !data.Shutdowncmd.IsNull() && !data.Shutdowncmd.IsUnknown() {
+		params["shutdowncmd"] = data.Shutdowncmd.ValueString()
+	}
+	if !data.Monuser.IsNull() && !data.Monuser.IsUnknown() {
+		params["monuser"] = data.Monuser.ValueString()
+	}
+	if !data.Monpwd.IsNull() && !data.Monpwd.IsUnknown() {
+		params["monpwd"] = data.Monpwd.ValueString()
+	}
+	if !data.Options.IsNull() && !data.Options.IsUnknown() {
+		params["options"] = data.Options.ValueString()
+	}
+	if !data.Optionsupsd.IsNull() && !data.Optionsupsd.IsUnknown() {
+		params["optionsupsd"] = data.Optionsupsd.ValueString()
+	}
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
+		params["port"] = data.Port.ValueString()
+	}
+	if !data.Driver.IsNull() && !data.Driver.IsUnknown() {
+		params["driver"] = data.Driver.ValueString()
+	}
+	if !data.Remotehost.IsNull() && !data.Remotehost.IsUnknown() {
+		params["remotehost"] = data.Remotehost.ValueString()
+	}
+	if !data.Remoteport.IsNull() && !data.Remoteport.IsUnknown() {
+		params["remoteport"] = data.Remoteport.ValueInt64()
+	}
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		params["description"] = data.Description.ValueString()
+	}
+	if !data.Hostsync.IsNull() && !data.Hostsync.IsUnknown() {
+		params["hostsync"] = data.Hostsync.ValueInt64()
+	}
+	if !data.Nocommwarntime.IsNull() && !data.Nocommwarntime.IsUnknown() {
+		params["nocommwarntime"] = data.Nocommwarntime.ValueInt64()
+	}
+
+	result, err := r.client.Call("ups.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to update ups_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("ups")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UpsConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UpsConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Call("ups.config", []interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read ups_config: %s", err))
+		return
+	}
+
+	// Map result back to state
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", "Failed to parse API response")
+		return
+	}
+
+	if v, ok := resultMap["powerdown"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Powerdown = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["rmonitor"]; ok && v != nil {
+		if bv, ok := v.(bool); ok {
+			data.Rmonitor = types.BoolValue(bv)
+		}
+	}
+	if v, ok := resultMap["rmonitor_password"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.RmonitorPassword = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.RmonitorPassword = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.RmonitorPassword = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["identifier"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Identifier = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Identifier = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Identifier = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["mode"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Mode = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Mode = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Mode = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["shutdown"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Shutdown = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Shutdown = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Shutdown = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["shutdowntimer"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Shutdowntimer = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Shutdowntimer = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["shutdowncmd"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Shutdowncmd = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Shutdowncmd = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Shutdowncmd = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["monuser"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Monuser = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Monuser = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Monuser = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["monpwd"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Monpwd = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Monpwd = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Monpwd = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["options"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Options = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Options = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Options = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["optionsupsd"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Optionsupsd = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Optionsupsd = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Optionsupsd = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["port"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Port = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Port = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Port = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["driver"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Driver = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Driver = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Driver = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["remotehost"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Remotehost = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Remotehost = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Remotehost = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["remoteport"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Remoteport = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Remoteport = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["description"]; ok && v != nil {
+		switch val := v.(type) {
+		case string:
+			data.Description = types.StringValue(val)
+		case map[string]interface{}:
+			if strVal, ok := val["value"]; ok && strVal != nil {
+				data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+			}
+		default:
+			data.Description = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	if v, ok := resultMap["hostsync"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Hostsync = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Hostsync = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+	if v, ok := resultMap["nocommwarntime"]; ok && v != nil {
+		switch val := v.(type) {
+		case float64:
+			data.Nocommwarntime = types.Int64Value(int64(val))
+		case map[string]interface{}:
+			if parsed, ok := val["parsed"]; ok && parsed != nil {
+				if fv, ok := parsed.(float64); ok {
+					data.Nocommwarntime = types.Int64Value(int64(fv))
+				}
+			}
+		}
+	}
+
+	data.ID = types.StringValue("ups")
+	if data.RestoreOnDestroy.IsNull() {
+		data.RestoreOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UpsConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UpsConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]interface{}{}
+	if !data.Powerdown.IsNull() && !data.Powerdown.IsUnknown() {
+		params["powerdown"] = data.Powerdown.ValueBool()
+	}
+	if !data.Rmonitor.IsNull() && !data.Rmonitor.IsUnknown() {
+		params["rmonitor"] = data.Rmonitor.ValueBool()
+	}
+	if !data.RmonitorPassword.IsNull() && !data.RmonitorPassword.IsUnknown() {
+		params["rmonitor_password"] = data.RmonitorPassword.ValueString()
+	}
+	if !data.Identifier.IsNull() && !data.Identifier.IsUnknown() {
+		params["identifier"] = data.Identifier.ValueString()
+	}
+	if !data.Mode.IsNull() && !data.Mode.IsUnknown() {
+		params["mode"] = data.Mode.ValueString()
+	}
+	if !data.Shutdown.IsNull() && !data.Shutdown.IsUnknown() {
+		params["shutdown"] = data.Shutdown.ValueString()
+	}
+	if !data.Shutdowntimer.IsNull() && !data.Shutdowntimer.IsUnknown() {
+		params["shutdowntimer"] = data.Shutdowntimer.ValueInt64()
+	}
+	if !data.Shutdowncmd.IsNull() && !data.Shutdowncmd.IsUnknown() {
+		params["shutdowncmd"] = data.Shutdowncmd.ValueString()
+	}
+	if !data.Monuser.IsNull() && !data.Monuser.IsUnknown() {
+		params["monuser"] = data.Monuser.ValueString()
+	}
+	if !data.Monpwd.IsNull() && !data.Monpwd.IsUnknown() {
+		params["monpwd"] = data.Monpwd.ValueString()
+	}
+	if !data.Options.IsNull() && !data.Options.IsUnknown() {
+		params["options"] = data.Options.ValueString()
+	}
+	if !data.Optionsupsd.IsNull() && !data.Optionsupsd.IsUnknown() {
+		params["optionsupsd"] = data.Optionsupsd.ValueString()
+	}
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
+		params["port"] = data.Port.ValueString()
+	}
+	if !data.Driver.IsNull() && !data.Driver.IsUnknown() {
+		params["driver"] = data.Driver.ValueString()
+	}
+	if !data.Remotehost.IsNull() && !data.Remotehost.IsUnknown() {
+		params["remotehost"] = data.Remotehost.ValueString()
+	}
+	if !data.Remoteport.IsNull() && !data.Remoteport.IsUnknown() {
+		params["remoteport"] = data.Remoteport.ValueInt64()
+	}
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		params["description"] = data.Description.ValueString()
+	}
+	if !data.Hostsync.IsNull() && !data.Hostsync.IsUnknown() {
+		params["hostsync"] = data.Hostsync.ValueInt64()
+	}
+	if !data.Nocommwarntime.IsNull() && !data.Nocommwarntime.IsUnknown() {
+		params["nocommwarntime"] = data.Nocommwarntime.ValueInt64()
+	}
+
+	result, err := r.client.Call("ups.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update ups_config: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("ups")
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UpsConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UpsConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings can't be deleted; by default they are left as they are
+	if !data.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	b, diags := req.Private.GetKey(ctx, "original")
+	resp.Diagnostics.Append(diags...)
+	var original map[string]interface{}
+	if len(b) == 0 || json.Unmarshal(b, &original) != nil {
+		resp.Diagnostics.AddWarning("Restore Skipped", "No settings were captured when ups_config was created (e.g. it was imported); leaving them as they are.")
+		return
+	}
+
+	params := map[string]interface{}{}
+	for _, k := range []string{"powerdown", "rmonitor", "rmonitor_password", "identifier", "mode", "shutdown", "shutdowntimer", "shutdowncmd", "monuser", "monpwd", "options", "optionsupsd", "port", "driver", "remotehost", "remoteport", "description", "hostsync", "nocommwarntime"} {
+		v, ok := original[k]
+		if !ok {
+			continue
+		}
+		// Config expands references such as certificates into objects;
+		// update takes their ID
+		if ref, isRef := v.(map[string]interface{}); isRef && ref["id"] != nil {
+			v = ref["id"]
+		}
+		params[k] = v
+	}
+	_, err := r.client.Call("ups.update", []interface{}{params})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to restore ups_config: %s", err))
+		return
+	}
+}
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// settings not managed in configuration are recorded in state as they are.
+func (r *UpsConfigResource) setComputed(result interface{}, data *UpsConfigResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Powerdown.IsUnknown() {
+		data.Powerdown = types.BoolNull()
+		if v, ok := resultMap["powerdown"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Powerdown = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Rmonitor.IsUnknown() {
+		data.Rmonitor = types.BoolNull()
+		if v, ok := resultMap["rmonitor"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Rmonitor = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.RmonitorPassword.IsUnknown() {
+		data.RmonitorPassword = types.StringNull()
+		if v, ok := resultMap["rmonitor_password"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.RmonitorPassword = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.RmonitorPassword = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.RmonitorPassword = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Identifier.IsUnknown() {
+		data.Identifier = types.StringNull()
+		if v, ok := resultMap["identifier"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Identifier = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Identifier = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Identifier = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Mode.IsUnknown() {
+		data.Mode = types.StringNull()
+		if v, ok := resultMap["mode"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Mode = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Mode = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Mode = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Shutdown.IsUnknown() {
+		data.Shutdown = types.StringNull()
+		if v, ok := resultMap["shutdown"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Shutdown = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Shutdown = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Shutdown = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Shutdowntimer.IsUnknown() {
+		data.Shutdowntimer = types.Int64Null()
+		if v, ok := resultMap["shutdowntimer"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Shutdowntimer = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Shutdowntimer = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Shutdowncmd.IsUnknown() {
+		data.Shutdowncmd = types.StringNull()
+		if v, ok := resultMap["shutdowncmd"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Shutdowncmd = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Shutdowncmd = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Shutdowncmd = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Monuser.IsUnknown() {
+		data.Monuser = types.StringNull()
+		if v, ok := resultMap["monuser"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Monuser = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Monuser = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Monuser = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Monpwd.IsUnknown() {
+		data.Monpwd = types.StringNull()
+		if v, ok := resultMap["monpwd"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Monpwd = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Monpwd = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Monpwd = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Options.IsUnknown() {
+		data.Options = types.StringNull()
+		if v, ok := resultMap["options"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Options = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Options = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Options = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Optionsupsd.IsUnknown() {
+		data.Optionsupsd = types.StringNull()
+		if v, ok := resultMap["optionsupsd"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Optionsupsd = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Optionsupsd = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Optionsupsd = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Port.IsUnknown() {
+		data.Port = types.StringNull()
+		if v, ok := resultMap["port"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Port = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Port = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Port = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Driver.IsUnknown() {
+		data.Driver = types.StringNull()
+		if v, ok := resultMap["driver"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Driver = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Driver = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Driver = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Remotehost.IsUnknown() {
+		data.Remotehost = types.StringNull()
+		if v, ok := resultMap["remotehost"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Remotehost = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Remotehost = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Remotehost = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Remoteport.IsUnknown() {
+		data.Remoteport = types.Int64Null()
+		if v, ok := resultMap["remoteport"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Remoteport = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Remoteport = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Description.IsUnknown() {
+		data.Description = types.StringNull()
+		if v, ok := resultMap["description"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Description = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Description = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Hostsync.IsUnknown() {
+		data.Hostsync = types.Int64Null()
+		if v, ok := resultMap["hostsync"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Hostsync = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Hostsync = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Nocommwarntime.IsUnknown() {
+		data.Nocommwarntime = types.Int64Null()
+		if v, ok := resultMap["nocommwarntime"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Nocommwarntime = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Nocommwarntime = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+}
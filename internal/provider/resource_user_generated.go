@@ -4,12 +4,18 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -50,37 +56,59 @@ func NewUserResource() resource.Resource {
 
 func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
+	// username can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "user.query", userIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &UserResource{}
+
+// userIdentity lists the API fields that identify a user
+var userIdentity = []identityKey{{Name: "username"}}
+
+func (r *UserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(userIdentity)
+}
+
+func (r *UserResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a new user.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
 			"uid": schema.Int64Attribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "UNIX UID. If not provided, it is automatically filled with the next one available.",
-				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown(), int64planmodifier.RequiresReplace()},
 			},
 			"username": schema.StringAttribute{
 				Required:    true,
 				Optional:    false,
 				Description: "String used to uniquely identify the user on the server. In order to be portable across     systems,",
+				Validators:  []validator.String{stringvalidator.LengthBetween(1, 32)},
 			},
 			"home": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "The local file system path for the user account's home directory. Typically, this is required only i",
+				Default:     stringdefault.StaticString("/var/empty"),
 			},
 			"shell": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Available choices can be retrieved with `user.shell_choices`.",
+				Default:     stringdefault.StaticString("/usr/bin/zsh"),
 			},
 			"full_name": schema.StringAttribute{
 				Required:    true,
@@ -90,86 +118,117 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 			"smb": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "The user account may be used to access SMB shares. If set to `true` then TrueNAS stores an NT hash o",
+				Default:     booldefault.StaticBool(true),
 			},
 			"userns_idmap": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Specifies the subuid mapping for this user. If DIRECT then the UID will be     directly mapped to al",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Specifies the subuid mapping for this user. If DIRECT then the UID will be     directly mapped to al",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"group": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "The group entry `id` for the user's primary group. This is not the same as the Unix group `gid` valu",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The group entry `id` for the user's primary group. This is not the same as the Unix group `gid` valu",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"groups": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Array of additional groups to which the user belongs. NOTE: Groups are identified by their group ent",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Array of additional groups to which the user belongs. NOTE: Groups are identified by their group ent",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"password_disabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "If set to `true` password authentication for the user account is disabled.  NOTE: Users with passwor",
+				Default:     booldefault.StaticBool(false),
 			},
 			"ssh_password_enabled": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Allow the user to authenticate to the TrueNAS SSH server using a password.  WARNING: The established",
+				Default:     booldefault.StaticBool(false),
 			},
 			"sshpubkey": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "SSH public keys corresponding to private keys that authenticate this user to the TrueNAS SSH server.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "SSH public keys corresponding to private keys that authenticate this user to the TrueNAS SSH server.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"locked": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "If set to `true` the account is locked. The account cannot be used to authenticate to the TrueNAS se",
+				Default:     booldefault.StaticBool(false),
 			},
 			"sudo_commands": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "An array of commands the user may execute with elevated privileges. User is prompted for password   ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "An array of commands the user may execute with elevated privileges. User is prompted for password   ",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"sudo_commands_nopasswd": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "An array of commands the user may execute with elevated privileges. User is *not* prompted for passw",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "An array of commands the user may execute with elevated privileges. User is *not* prompted for passw",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"email": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Email address of the user. If the user has the `FULL_ADMIN` role, they will receive email alerts and",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Email address of the user. If the user has the `FULL_ADMIN` role, they will receive email alerts and",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"group_create": schema.BoolAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "If set to `true`, the TrueNAS server automatically creates a new local group as the user's primary g",
+				Default:       booldefault.StaticBool(false),
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 			},
 			"home_create": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Create a new home directory for the user in the specified `home` path. ",
+				Default:     booldefault.StaticBool(false),
 			},
 			"home_mode": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Filesystem permission to set on the user's home directory. ",
+				Default:     stringdefault.StaticString("700"),
 			},
 			"password": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "The password for the user account. This is required if `random_password` is not set. ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The password for the user account. This is required if `random_password` is not set. ",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"random_password": schema.BoolAttribute{
 				Optional:    true,
 				Computed:    true,
 				Description: "Generate a random 20 character password for the user.",
+				Default:     booldefault.StaticBool(false),
 			},
 		},
 	}
@@ -195,73 +254,73 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	params := map[string]interface{}{}
-	if !data.Uid.IsNull() {
+	if !data.Uid.IsNull() && !data.Uid.IsUnknown() {
 		params["uid"] = data.Uid.ValueInt64()
 	}
-	if !data.Username.IsNull() {
+	if !data.Username.IsNull() && !data.Username.IsUnknown() {
 		params["username"] = data.Username.ValueString()
 	}
-	if !data.Home.IsNull() {
+	if !data.Home.IsNull() && !data.Home.IsUnknown() {
 		params["home"] = data.Home.ValueString()
 	}
-	if !data.Shell.IsNull() {
+	if !data.Shell.IsNull() && !data.Shell.IsUnknown() {
 		params["shell"] = data.Shell.ValueString()
 	}
-	if !data.FullName.IsNull() {
+	if !data.FullName.IsNull() && !data.FullName.IsUnknown() {
 		params["full_name"] = data.FullName.ValueString()
 	}
-	if !data.Smb.IsNull() {
+	if !data.Smb.IsNull() && !data.Smb.IsUnknown() {
 		params["smb"] = data.Smb.ValueBool()
 	}
-	if !data.UsernsIdmap.IsNull() {
+	if !data.UsernsIdmap.IsNull() && !data.UsernsIdmap.IsUnknown() {
 		params["userns_idmap"] = data.UsernsIdmap.ValueInt64()
 	}
-	if !data.Group.IsNull() {
+	if !data.Group.IsNull() && !data.Group.IsUnknown() {
 		params["group"] = data.Group.ValueInt64()
 	}
-	if !data.Groups.IsNull() {
+	if !data.Groups.IsNull() && !data.Groups.IsUnknown() {
 		var groupsList []string
 		data.Groups.ElementsAs(ctx, &groupsList, false)
 		params["groups"] = groupsList
 	}
-	if !data.PasswordDisabled.IsNull() {
+	if !data.PasswordDisabled.IsNull() && !data.PasswordDisabled.IsUnknown() {
 		params["password_disabled"] = data.PasswordDisabled.ValueBool()
 	}
-	if !data.SshPasswordEnabled.IsNull() {
+	if !data.SshPasswordEnabled.IsNull() && !data.SshPasswordEnabled.IsUnknown() {
 		params["ssh_password_enabled"] = data.SshPasswordEnabled.ValueBool()
 	}
-	if !data.Sshpubkey.IsNull() {
+	if !data.Sshpubkey.IsNull() && !data.Sshpubkey.IsUnknown() {
 		params["sshpubkey"] = data.Sshpubkey.ValueString()
 	}
-	if !data.Locked.IsNull() {
+	if !data.Locked.IsNull() && !data.Locked.IsUnknown() {
 		params["locked"] = data.Locked.ValueBool()
 	}
-	if !data.SudoCommands.IsNull() {
+	if !data.SudoCommands.IsNull() && !data.SudoCommands.IsUnknown() {
 		var sudo_commandsList []string
 		data.SudoCommands.ElementsAs(ctx, &sudo_commandsList, false)
 		params["sudo_commands"] = sudo_commandsList
 	}
-	if !data.SudoCommandsNopasswd.IsNull() {
+	if !data.SudoCommandsNopasswd.IsNull() && !data.SudoCommandsNopasswd.IsUnknown() {
 		var sudo_commands_nopasswdList []string
 		data.SudoCommandsNopasswd.ElementsAs(ctx, &sudo_commands_nopasswdList, false)
 		params["sudo_commands_nopasswd"] = sudo_commands_nopasswdList
 	}
-	if !data.Email.IsNull() {
+	if !data.Email.IsNull() && !data.Email.IsUnknown() {
 		params["email"] = data.Email.ValueString()
 	}
-	if !data.GroupCreate.IsNull() {
+	if !data.GroupCreate.IsNull() && !data.GroupCreate.IsUnknown() {
 		params["group_create"] = data.GroupCreate.ValueBool()
 	}
-	if !data.HomeCreate.IsNull() {
+	if !data.HomeCreate.IsNull() && !data.HomeCreate.IsUnknown() {
 		params["home_create"] = data.HomeCreate.ValueBool()
 	}
-	if !data.HomeMode.IsNull() {
+	if !data.HomeMode.IsNull() && !data.HomeMode.IsUnknown() {
 		params["home_mode"] = data.HomeMode.ValueString()
 	}
-	if !data.Password.IsNull() {
+	if !data.Password.IsNull() && !data.Password.IsUnknown() {
 		params["password"] = data.Password.ValueString()
 	}
-	if !data.RandomPassword.IsNull() {
+	if !data.RandomPassword.IsNull() && !data.RandomPassword.IsUnknown() {
 		params["random_password"] = data.RandomPassword.ValueBool()
 	}
 
@@ -283,6 +342,8 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, userIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -347,6 +408,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			data.FullName = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, userIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -373,77 +435,79 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	params := map[string]interface{}{}
-	if !data.Username.IsNull() {
+	if !data.Username.IsNull() && !data.Username.IsUnknown() {
 		params["username"] = data.Username.ValueString()
 	}
-	if !data.Home.IsNull() {
+	if !data.Home.IsNull() && !data.Home.IsUnknown() {
 		params["home"] = data.Home.ValueString()
 	}
-	if !data.Shell.IsNull() {
+	if !data.Shell.IsNull() && !data.Shell.IsUnknown() {
 		params["shell"] = data.Shell.ValueString()
 	}
-	if !data.FullName.IsNull() {
+	if !data.FullName.IsNull() && !data.FullName.IsUnknown() {
 		params["full_name"] = data.FullName.ValueString()
 	}
-	if !data.Smb.IsNull() {
+	if !data.Smb.IsNull() && !data.Smb.IsUnknown() {
 		params["smb"] = data.Smb.ValueBool()
 	}
-	if !data.UsernsIdmap.IsNull() {
+	if !data.UsernsIdmap.IsNull() && !data.UsernsIdmap.IsUnknown() {
 		params["userns_idmap"] = data.UsernsIdmap.ValueInt64()
 	}
-	if !data.Group.IsNull() {
+	if !data.Group.IsNull() && !data.Group.IsUnknown() {
 		params["group"] = data.Group.ValueInt64()
 	}
-	if !data.Groups.IsNull() {
+	if !data.Groups.IsNull() && !data.Groups.IsUnknown() {
 		var groupsList []string
 		data.Groups.ElementsAs(ctx, &groupsList, false)
 		params["groups"] = groupsList
 	}
-	if !data.PasswordDisabled.IsNull() {
+	if !data.PasswordDisabled.IsNull() && !data.PasswordDisabled.IsUnknown() {
 		params["password_disabled"] = data.PasswordDisabled.ValueBool()
 	}
-	if !data.SshPasswordEnabled.IsNull() {
+	if !data.SshPasswordEnabled.IsNull() && !data.SshPasswordEnabled.IsUnknown() {
 		params["ssh_password_enabled"] = data.SshPasswordEnabled.ValueBool()
 	}
-	if !data.Sshpubkey.IsNull() {
+	if !data.Sshpubkey.IsNull() && !data.Sshpubkey.IsUnknown() {
 		params["sshpubkey"] = data.Sshpubkey.ValueString()
 	}
-	if !data.Locked.IsNull() {
+	if !data.Locked.IsNull() && !data.Locked.IsUnknown() {
 		params["locked"] = data.Locked.ValueBool()
 	}
-	if !data.SudoCommands.IsNull() {
+	if !data.SudoCommands.IsNull() && !data.SudoCommands.IsUnknown() {
 		var sudo_commandsList []string
 		data.SudoCommands.ElementsAs(ctx, &sudo_commandsList, false)
 		params["sudo_commands"] = sudo_commandsList
 	}
-	if !data.SudoCommandsNopasswd.IsNull() {
+	if !data.SudoCommandsNopasswd.IsNull() && !data.SudoCommandsNopasswd.IsUnknown() {
 		var sudo_commands_nopasswdList []string
 		data.SudoCommandsNopasswd.ElementsAs(ctx, &sudo_commands_nopasswdList, false)
 		params["sudo_commands_nopasswd"] = sudo_commands_nopasswdList
 	}
-	if !data.Email.IsNull() {
+	if !data.Email.IsNull() && !data.Email.IsUnknown() {
 		params["email"] = data.Email.ValueString()
 	}
-	if !data.HomeCreate.IsNull() {
+	if !data.HomeCreate.IsNull() && !data.HomeCreate.IsUnknown() {
 		params["home_create"] = data.HomeCreate.ValueBool()
 	}
-	if !data.HomeMode.IsNull() {
+	if !data.HomeMode.IsNull() && !data.HomeMode.IsUnknown() {
 		params["home_mode"] = data.HomeMode.ValueString()
 	}
-	if !data.Password.IsNull() {
+	if !data.Password.IsNull() && !data.Password.IsUnknown() {
 		params["password"] = data.Password.ValueString()
 	}
-	if !data.RandomPassword.IsNull() {
+	if !data.RandomPassword.IsNull() && !data.RandomPassword.IsUnknown() {
 		params["random_password"] = data.RandomPassword.ValueBool()
 	}
 
-	_, err = r.client.Call("user.update", []interface{}{id, params})
+	result, err := r.client.Call("user.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update user: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, userIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -469,3 +533,236 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *UserResource) setComputed(result interface{}, data *UserResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Uid.IsUnknown() {
+		data.Uid = types.Int64Null()
+		if v, ok := resultMap["uid"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Uid = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Uid = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Home.IsUnknown() {
+		data.Home = types.StringNull()
+		if v, ok := resultMap["home"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Home = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Home = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Home = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Shell.IsUnknown() {
+		data.Shell = types.StringNull()
+		if v, ok := resultMap["shell"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Shell = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Shell = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Shell = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Smb.IsUnknown() {
+		data.Smb = types.BoolNull()
+		if v, ok := resultMap["smb"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Smb = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.UsernsIdmap.IsUnknown() {
+		data.UsernsIdmap = types.Int64Null()
+		if v, ok := resultMap["userns_idmap"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.UsernsIdmap = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.UsernsIdmap = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Group.IsUnknown() {
+		data.Group = types.Int64Null()
+		if v, ok := resultMap["group"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Group = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Group = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Groups.IsUnknown() {
+		data.Groups = types.ListNull(types.StringType)
+		if v, ok := resultMap["groups"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Groups, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.PasswordDisabled.IsUnknown() {
+		data.PasswordDisabled = types.BoolNull()
+		if v, ok := resultMap["password_disabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.PasswordDisabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.SshPasswordEnabled.IsUnknown() {
+		data.SshPasswordEnabled = types.BoolNull()
+		if v, ok := resultMap["ssh_password_enabled"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.SshPasswordEnabled = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Sshpubkey.IsUnknown() {
+		data.Sshpubkey = types.StringNull()
+		if v, ok := resultMap["sshpubkey"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Sshpubkey = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Sshpubkey = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Sshpubkey = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Locked.IsUnknown() {
+		data.Locked = types.BoolNull()
+		if v, ok := resultMap["locked"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Locked = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.SudoCommands.IsUnknown() {
+		data.SudoCommands = types.ListNull(types.StringType)
+		if v, ok := resultMap["sudo_commands"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.SudoCommands, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.SudoCommandsNopasswd.IsUnknown() {
+		data.SudoCommandsNopasswd = types.ListNull(types.StringType)
+		if v, ok := resultMap["sudo_commands_nopasswd"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.SudoCommandsNopasswd, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Email.IsUnknown() {
+		data.Email = types.StringNull()
+		if v, ok := resultMap["email"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Email = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Email = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Email = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.GroupCreate.IsUnknown() {
+		data.GroupCreate = types.BoolNull()
+		if v, ok := resultMap["group_create"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.GroupCreate = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.HomeCreate.IsUnknown() {
+		data.HomeCreate = types.BoolNull()
+		if v, ok := resultMap["home_create"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.HomeCreate = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.HomeMode.IsUnknown() {
+		data.HomeMode = types.StringNull()
+		if v, ok := resultMap["home_mode"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.HomeMode = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.HomeMode = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.HomeMode = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Password.IsUnknown() {
+		data.Password = types.StringNull()
+		if v, ok := resultMap["password"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Password = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Password = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Password = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.RandomPassword.IsUnknown() {
+		data.RandomPassword = types.BoolNull()
+		if v, ok := resultMap["random_password"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.RandomPassword = types.BoolValue(bv)
+			}
+		}
+	}
+}
@@ -5,11 +5,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strings"
 	"time"
@@ -20,27 +29,28 @@ type VirtInstanceResource struct {
 }
 
 type VirtInstanceResourceModel struct {
-	ID             types.String `tfsdk:"id"`
-	StartOnCreate  types.Bool   `tfsdk:"start_on_create"`
-	Name           types.String `tfsdk:"name"`
-	SourceType     types.String `tfsdk:"source_type"`
-	StoragePool    types.String `tfsdk:"storage_pool"`
-	Image          types.String `tfsdk:"image"`
-	RootDiskSize   types.Int64  `tfsdk:"root_disk_size"`
-	RootDiskIoBus  types.String `tfsdk:"root_disk_io_bus"`
-	Remote         types.String `tfsdk:"remote"`
-	InstanceType   types.String `tfsdk:"instance_type"`
-	Environment    types.String `tfsdk:"environment"`
-	Autostart      types.Bool   `tfsdk:"autostart"`
-	Cpu            types.String `tfsdk:"cpu"`
-	Devices        types.List   `tfsdk:"devices"`
-	Memory         types.Int64  `tfsdk:"memory"`
-	PrivilegedMode types.Bool   `tfsdk:"privileged_mode"`
-	VncPort        types.Int64  `tfsdk:"vnc_port"`
-	EnableVnc      types.Bool   `tfsdk:"enable_vnc"`
-	VncPassword    types.String `tfsdk:"vnc_password"`
-	SecureBoot     types.Bool   `tfsdk:"secure_boot"`
-	ImageOs        types.String `tfsdk:"image_os"`
+	ID             types.String   `tfsdk:"id"`
+	StartOnCreate  types.Bool     `tfsdk:"start_on_create"`
+	Name           types.String   `tfsdk:"name"`
+	SourceType     types.String   `tfsdk:"source_type"`
+	StoragePool    types.String   `tfsdk:"storage_pool"`
+	Image          types.String   `tfsdk:"image"`
+	RootDiskSize   types.Int64    `tfsdk:"root_disk_size"`
+	RootDiskIoBus  types.String   `tfsdk:"root_disk_io_bus"`
+	Remote         types.String   `tfsdk:"remote"`
+	InstanceType   types.String   `tfsdk:"instance_type"`
+	Environment    types.String   `tfsdk:"environment"`
+	Autostart      types.Bool     `tfsdk:"autostart"`
+	Cpu            types.String   `tfsdk:"cpu"`
+	Devices        types.List     `tfsdk:"devices"`
+	Memory         types.Int64    `tfsdk:"memory"`
+	PrivilegedMode types.Bool     `tfsdk:"privileged_mode"`
+	VncPort        types.Int64    `tfsdk:"vnc_port"`
+	EnableVnc      types.Bool     `tfsdk:"enable_vnc"`
+	VncPassword    types.String   `tfsdk:"vnc_password"`
+	SecureBoot     types.Bool     `tfsdk:"secure_boot"`
+	ImageOs        types.String   `tfsdk:"image_os"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewVirtInstanceResource() resource.Resource {
@@ -52,11 +62,25 @@ func (r *VirtInstanceResource) Metadata(ctx context.Context, req resource.Metada
 }
 
 func (r *VirtInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "virt.instance.query", virtInstanceIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &VirtInstanceResource{}
+
+// virtInstanceIdentity lists the API fields that identify a virt_instance
+var virtInstanceIdentity = []identityKey{{Name: "name"}}
+
+func (r *VirtInstanceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(virtInstanceIdentity)
+}
+
+func (r *VirtInstanceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *VirtInstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a new virtualized instance.",
 		Attributes: map[string]schema.Attribute{
 			"id":              schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -69,100 +93,134 @@ func (r *VirtInstanceResource) Schema(ctx context.Context, req resource.SchemaRe
 			"source_type": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Source type for instance creation.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"storage_pool": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Storage pool under which to allocate root filesystem. Must be one of the pools     listed in virt.gl",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"image": schema.StringAttribute{
 				Required:      true,
 				Optional:      false,
 				Description:   "Image identifier to use for creating the instance.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"root_disk_size": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Size of the root disk in GB (minimum 5GB) or `null` to keep current size.",
+				Default:     int64default.StaticInt64(10),
 			},
 			"root_disk_io_bus": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "I/O bus type for the root disk or `null` to keep current setting.",
+				Default:     stringdefault.StaticString("NVME"),
+				Validators:  []validator.String{stringvalidator.OneOf("NVME", "VIRTIO-BLK", "VIRTIO-SCSI")},
 			},
 			"remote": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Remote image source to use.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"instance_type": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Type of instance to create.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"environment": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Environment variables to set inside the instance.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Environment variables to set inside the instance.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"autostart": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether the instance should automatically start when the host boots.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"cpu": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "CPU allocation specification or `null` for automatic allocation.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "CPU allocation specification or `null` for automatic allocation.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"devices": schema.ListAttribute{
-				Required:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-				Description: "Array of devices to attach to the instance.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				ElementType:   types.StringType,
+				Description:   "Array of devices to attach to the instance.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
 			},
 			"memory": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Memory allocation in bytes or `null` for automatic allocation.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Memory allocation in bytes or `null` for automatic allocation.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"privileged_mode": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "This is only valid for containers and should only be set when container instance which is to be depl",
+				Default:     booldefault.StaticBool(false),
 			},
 			"vnc_port": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "TCP port number for VNC access (5900-65535) or `null` to disable VNC.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "TCP port number for VNC access (5900-65535) or `null` to disable VNC.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 			"enable_vnc": schema.BoolAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Whether to enable VNC remote access for the instance.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether to enable VNC remote access for the instance.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
 			"vnc_password": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Setting vnc_password to null will unset VNC password.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Setting vnc_password to null will unset VNC password.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"secure_boot": schema.BoolAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Whether to enable UEFI Secure Boot (VMs only).",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether to enable UEFI Secure Boot (VMs only).",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
 			"image_os": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Operating system type for the instance or `null` for auto-detection.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Operating system type for the instance or `null` for auto-detection.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -186,31 +244,31 @@ func (r *VirtInstanceResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.SourceType.IsNull() {
+	if !data.SourceType.IsNull() && !data.SourceType.IsUnknown() {
 		params["source_type"] = data.SourceType.ValueString()
 	}
-	if !data.StoragePool.IsNull() {
+	if !data.StoragePool.IsNull() && !data.StoragePool.IsUnknown() {
 		params["storage_pool"] = data.StoragePool.ValueString()
 	}
-	if !data.Image.IsNull() {
+	if !data.Image.IsNull() && !data.Image.IsUnknown() {
 		params["image"] = data.Image.ValueString()
 	}
-	if !data.RootDiskSize.IsNull() {
+	if !data.RootDiskSize.IsNull() && !data.RootDiskSize.IsUnknown() {
 		params["root_disk_size"] = data.RootDiskSize.ValueInt64()
 	}
-	if !data.RootDiskIoBus.IsNull() {
+	if !data.RootDiskIoBus.IsNull() && !data.RootDiskIoBus.IsUnknown() {
 		params["root_disk_io_bus"] = data.RootDiskIoBus.ValueString()
 	}
-	if !data.Remote.IsNull() {
+	if !data.Remote.IsNull() && !data.Remote.IsUnknown() {
 		params["remote"] = data.Remote.ValueString()
 	}
-	if !data.InstanceType.IsNull() {
+	if !data.InstanceType.IsNull() && !data.InstanceType.IsUnknown() {
 		params["instance_type"] = data.InstanceType.ValueString()
 	}
-	if !data.Environment.IsNull() {
+	if !data.Environment.IsNull() && !data.Environment.IsUnknown() {
 		var environmentObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Environment.ValueString()), &environmentObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse environment: %s", err))
@@ -218,40 +276,46 @@ func (r *VirtInstanceResource) Create(ctx context.Context, req resource.CreateRe
 		}
 		params["environment"] = environmentObj
 	}
-	if !data.Autostart.IsNull() {
+	if !data.Autostart.IsNull() && !data.Autostart.IsUnknown() {
 		params["autostart"] = data.Autostart.ValueBool()
 	}
-	if !data.Cpu.IsNull() {
+	if !data.Cpu.IsNull() && !data.Cpu.IsUnknown() {
 		params["cpu"] = data.Cpu.ValueString()
 	}
-	if !data.Devices.IsNull() {
+	if !data.Devices.IsNull() && !data.Devices.IsUnknown() {
 		var devicesList []string
 		data.Devices.ElementsAs(ctx, &devicesList, false)
 		params["devices"] = devicesList
 	}
-	if !data.Memory.IsNull() {
+	if !data.Memory.IsNull() && !data.Memory.IsUnknown() {
 		params["memory"] = data.Memory.ValueInt64()
 	}
-	if !data.PrivilegedMode.IsNull() {
+	if !data.PrivilegedMode.IsNull() && !data.PrivilegedMode.IsUnknown() {
 		params["privileged_mode"] = data.PrivilegedMode.ValueBool()
 	}
-	if !data.VncPort.IsNull() {
+	if !data.VncPort.IsNull() && !data.VncPort.IsUnknown() {
 		params["vnc_port"] = data.VncPort.ValueInt64()
 	}
-	if !data.EnableVnc.IsNull() {
+	if !data.EnableVnc.IsNull() && !data.EnableVnc.IsUnknown() {
 		params["enable_vnc"] = data.EnableVnc.ValueBool()
 	}
-	if !data.VncPassword.IsNull() {
+	if !data.VncPassword.IsNull() && !data.VncPassword.IsUnknown() {
 		params["vnc_password"] = data.VncPassword.ValueString()
 	}
-	if !data.SecureBoot.IsNull() {
+	if !data.SecureBoot.IsNull() && !data.SecureBoot.IsUnknown() {
 		params["secure_boot"] = data.SecureBoot.ValueBool()
 	}
-	if !data.ImageOs.IsNull() {
+	if !data.ImageOs.IsNull() && !data.ImageOs.IsUnknown() {
 		params["image_os"] = data.ImageOs.ValueString()
 	}
 
-	result, err := r.client.CallWithJob("virt.instance.create", params)
+	createTimeout, diags := data.Timeouts.Create(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithJobTimeout("virt.instance.create", params, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Create Error", fmt.Sprintf("Unable to create virt_instance: %s", err))
 		return
@@ -269,6 +333,8 @@ func (r *VirtInstanceResource) Create(ctx context.Context, req resource.CreateRe
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, virtInstanceIdentity, result)...)
 
 	startOnCreate := true
 	if !data.StartOnCreate.IsNull() {
@@ -294,7 +360,13 @@ func (r *VirtInstanceResource) Read(ctx context.Context, req resource.ReadReques
 	var err error
 	id = data.ID.ValueString()
 
-	result, err := r.client.Call("virt.instance.get_instance", id)
+	readTimeout, diags := data.Timeouts.Read(ctx, r.client.RPCTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithTimeout("virt.instance.get_instance", id, readTimeout)
 	if err != nil {
 		// Check if resource was deleted outside Terraform (ENOENT = entity not found)
 		if strings.Contains(err.Error(), "[ENOENT]") {
@@ -327,6 +399,7 @@ func (r *VirtInstanceResource) Read(ctx context.Context, req resource.ReadReques
 			data.Name = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, virtInstanceIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -349,7 +422,7 @@ func (r *VirtInstanceResource) Update(ctx context.Context, req resource.UpdateRe
 	id = state.ID.ValueString()
 
 	params := map[string]interface{}{}
-	if !data.Environment.IsNull() {
+	if !data.Environment.IsNull() && !data.Environment.IsUnknown() {
 		var environmentObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Environment.ValueString()), &environmentObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse environment: %s", err))
@@ -357,47 +430,55 @@ func (r *VirtInstanceResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		params["environment"] = environmentObj
 	}
-	if !data.Autostart.IsNull() {
+	if !data.Autostart.IsNull() && !data.Autostart.IsUnknown() {
 		params["autostart"] = data.Autostart.ValueBool()
 	}
-	if !data.Cpu.IsNull() {
+	if !data.Cpu.IsNull() && !data.Cpu.IsUnknown() {
 		params["cpu"] = data.Cpu.ValueString()
 	}
-	if !data.Memory.IsNull() {
+	if !data.Memory.IsNull() && !data.Memory.IsUnknown() {
 		params["memory"] = data.Memory.ValueInt64()
 	}
-	if !data.VncPort.IsNull() {
+	if !data.VncPort.IsNull() && !data.VncPort.IsUnknown() {
 		params["vnc_port"] = data.VncPort.ValueInt64()
 	}
-	if !data.EnableVnc.IsNull() {
+	if !data.EnableVnc.IsNull() && !data.EnableVnc.IsUnknown() {
 		params["enable_vnc"] = data.EnableVnc.ValueBool()
 	}
-	if !data.VncPassword.IsNull() {
+	if !data.VncPassword.IsNull() && !data.VncPassword.IsUnknown() {
 		params["vnc_password"] = data.VncPassword.ValueString()
 	}
-	if !data.SecureBoot.IsNull() {
+	if !data.SecureBoot.IsNull() && !data.SecureBoot.IsUnknown() {
 		params["secure_boot"] = data.SecureBoot.ValueBool()
 	}
-	if !data.RootDiskSize.IsNull() {
+	if !data.RootDiskSize.IsNull() && !data.RootDiskSize.IsUnknown() {
 		params["root_disk_size"] = data.RootDiskSize.ValueInt64()
 	}
-	if !data.RootDiskIoBus.IsNull() {
+	if !data.RootDiskIoBus.IsNull() && !data.RootDiskIoBus.IsUnknown() {
 		params["root_disk_io_bus"] = data.RootDiskIoBus.ValueString()
 	}
-	if !data.ImageOs.IsNull() {
+	if !data.ImageOs.IsNull() && !data.ImageOs.IsUnknown() {
 		params["image_os"] = data.ImageOs.ValueString()
 	}
-	if !data.PrivilegedMode.IsNull() {
+	if !data.PrivilegedMode.IsNull() && !data.PrivilegedMode.IsUnknown() {
 		params["privileged_mode"] = data.PrivilegedMode.ValueBool()
 	}
 
-	_, err = r.client.CallWithJob("virt.instance.update", []interface{}{id, params})
+	updateTimeout, diags := data.Timeouts.Update(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CallWithJobTimeout("virt.instance.update", []interface{}{id, params}, updateTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update virt_instance: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, virtInstanceIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -415,9 +496,253 @@ func (r *VirtInstanceResource) Delete(ctx context.Context, req resource.DeleteRe
 	_, _ = r.client.Call("virt.instance.stop", data.ID.ValueString())
 	time.Sleep(2 * time.Second)
 
-	_, err = r.client.CallWithJob("virt.instance.delete", id)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, r.client.JobTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = r.client.CallWithJobTimeout("virt.instance.delete", id, deleteTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Delete Error", fmt.Sprintf("Unable to delete virt_instance: %s", err))
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *VirtInstanceResource) setComputed(result interface{}, data *VirtInstanceResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.SourceType.IsUnknown() {
+		data.SourceType = types.StringNull()
+		if v, ok := resultMap["source_type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.SourceType = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.SourceType = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.SourceType = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.StoragePool.IsUnknown() {
+		data.StoragePool = types.StringNull()
+		if v, ok := resultMap["storage_pool"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.StoragePool = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.StoragePool = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.StoragePool = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Image.IsUnknown() {
+		data.Image = types.StringNull()
+		if v, ok := resultMap["image"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Image = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Image = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Image = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.RootDiskSize.IsUnknown() {
+		data.RootDiskSize = types.Int64Null()
+		if v, ok := resultMap["root_disk_size"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.RootDiskSize = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.RootDiskSize = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.RootDiskIoBus.IsUnknown() {
+		data.RootDiskIoBus = types.StringNull()
+		if v, ok := resultMap["root_disk_io_bus"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.RootDiskIoBus = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.RootDiskIoBus = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.RootDiskIoBus = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Remote.IsUnknown() {
+		data.Remote = types.StringNull()
+		if v, ok := resultMap["remote"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Remote = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Remote = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Remote = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.InstanceType.IsUnknown() {
+		data.InstanceType = types.StringNull()
+		if v, ok := resultMap["instance_type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.InstanceType = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.InstanceType = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.InstanceType = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Environment.IsUnknown() {
+		data.Environment = types.StringNull()
+		if v, ok := resultMap["environment"]; ok && v != nil {
+			if b, err := json.Marshal(v); err == nil {
+				data.Environment = types.StringValue(string(b))
+			}
+		}
+	}
+	if data.Autostart.IsUnknown() {
+		data.Autostart = types.BoolNull()
+		if v, ok := resultMap["autostart"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Autostart = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Cpu.IsUnknown() {
+		data.Cpu = types.StringNull()
+		if v, ok := resultMap["cpu"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Cpu = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Cpu = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Cpu = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Devices.IsUnknown() {
+		data.Devices = types.ListNull(types.StringType)
+		if v, ok := resultMap["devices"]; ok && v != nil {
+			if arr, ok := v.([]interface{}); ok {
+				strVals := make([]attr.Value, len(arr))
+				for i, item := range arr {
+					strVals[i] = types.StringValue(fmt.Sprintf("%v", item))
+				}
+				data.Devices, _ = types.ListValue(types.StringType, strVals)
+			}
+		}
+	}
+	if data.Memory.IsUnknown() {
+		data.Memory = types.Int64Null()
+		if v, ok := resultMap["memory"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Memory = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Memory = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.PrivilegedMode.IsUnknown() {
+		data.PrivilegedMode = types.BoolNull()
+		if v, ok := resultMap["privileged_mode"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.PrivilegedMode = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.VncPort.IsUnknown() {
+		data.VncPort = types.Int64Null()
+		if v, ok := resultMap["vnc_port"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.VncPort = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.VncPort = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.EnableVnc.IsUnknown() {
+		data.EnableVnc = types.BoolNull()
+		if v, ok := resultMap["enable_vnc"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.EnableVnc = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.VncPassword.IsUnknown() {
+		data.VncPassword = types.StringNull()
+		if v, ok := resultMap["vnc_password"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.VncPassword = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.VncPassword = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.VncPassword = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.SecureBoot.IsUnknown() {
+		data.SecureBoot = types.BoolNull()
+		if v, ok := resultMap["secure_boot"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.SecureBoot = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.ImageOs.IsUnknown() {
+		data.ImageOs = types.StringNull()
+		if v, ok := resultMap["image_os"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.ImageOs = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.ImageOs = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.ImageOs = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strings"
@@ -34,11 +35,25 @@ func (r *VirtVolumeResource) Metadata(ctx context.Context, req resource.Metadata
 }
 
 func (r *VirtVolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "virt.volume.query", virtVolumeIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &VirtVolumeResource{}
+
+// virtVolumeIdentity lists the API fields that identify a virt_volume
+var virtVolumeIdentity = []identityKey{{Name: "name"}}
+
+func (r *VirtVolumeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(virtVolumeIdentity)
+}
+
+func (r *VirtVolumeResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *VirtVolumeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "TrueNAS virt_volume resource",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -50,19 +65,24 @@ func (r *VirtVolumeResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"content_type": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "",
+				Default:       stringdefault.StaticString("BLOCK"),
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"size": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "New size for the volume in MB (minimum 512MB).",
+				Default:     int64default.StaticInt64(1024),
 			},
 			"storage_pool": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Storage pool in which to create the volume. This must be one of pools listed     in virt.global.conf",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 		},
 	}
@@ -88,16 +108,16 @@ func (r *VirtVolumeResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	params := map[string]interface{}{}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.ContentType.IsNull() {
+	if !data.ContentType.IsNull() && !data.ContentType.IsUnknown() {
 		params["content_type"] = data.ContentType.ValueString()
 	}
-	if !data.Size.IsNull() {
+	if !data.Size.IsNull() && !data.Size.IsUnknown() {
 		params["size"] = data.Size.ValueInt64()
 	}
-	if !data.StoragePool.IsNull() {
+	if !data.StoragePool.IsNull() && !data.StoragePool.IsUnknown() {
 		params["storage_pool"] = data.StoragePool.ValueString()
 	}
 
@@ -119,6 +139,8 @@ func (r *VirtVolumeResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, virtVolumeIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -167,6 +189,7 @@ func (r *VirtVolumeResource) Read(ctx context.Context, req resource.ReadRequest,
 			data.Name = types.StringValue(fmt.Sprintf("%v", v))
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, virtVolumeIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -189,17 +212,19 @@ func (r *VirtVolumeResource) Update(ctx context.Context, req resource.UpdateRequ
 	id = state.ID.ValueString()
 
 	params := map[string]interface{}{}
-	if !data.Size.IsNull() {
+	if !data.Size.IsNull() && !data.Size.IsUnknown() {
 		params["size"] = data.Size.ValueInt64()
 	}
 
-	_, err = r.client.Call("virt.volume.update", []interface{}{id, params})
+	result, err := r.client.Call("virt.volume.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update virt_volume: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, virtVolumeIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -220,3 +245,54 @@ func (r *VirtVolumeResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *VirtVolumeResource) setComputed(result interface{}, data *VirtVolumeResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.ContentType.IsUnknown() {
+		data.ContentType = types.StringNull()
+		if v, ok := resultMap["content_type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.ContentType = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.ContentType = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.ContentType = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Size.IsUnknown() {
+		data.Size = types.Int64Null()
+		if v, ok := resultMap["size"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Size = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Size = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.StoragePool.IsUnknown() {
+		data.StoragePool = types.StringNull()
+		if v, ok := resultMap["storage_pool"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.StoragePool = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.StoragePool = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.StoragePool = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"strconv"
 	"time"
 
@@ -38,8 +40,13 @@ func (r *VmDeviceResource) ImportState(ctx context.Context, req resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *VmDeviceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *VmDeviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "TrueNAS vm_device resource",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -54,9 +61,11 @@ func (r *VmDeviceResource) Schema(ctx context.Context, req resource.SchemaReques
 				Description: "ID of the virtual machine this device belongs to.",
 			},
 			"order": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Boot order priority for this device. `null` for automatic assignment.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Boot order priority for this device. `null` for automatic assignment.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
 			},
 		},
 	}
@@ -82,7 +91,7 @@ func (r *VmDeviceResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	params := map[string]interface{}{}
-	if !data.Attributes.IsNull() {
+	if !data.Attributes.IsNull() && !data.Attributes.IsUnknown() {
 		var attributesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Attributes.ValueString()), &attributesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse attributes: %s", err))
@@ -90,10 +99,10 @@ func (r *VmDeviceResource) Create(ctx context.Context, req resource.CreateReques
 		}
 		params["attributes"] = attributesObj
 	}
-	if !data.Vm.IsNull() {
+	if !data.Vm.IsNull() && !data.Vm.IsUnknown() {
 		params["vm"] = data.Vm.ValueInt64()
 	}
-	if !data.Order.IsNull() {
+	if !data.Order.IsNull() && !data.Order.IsUnknown() {
 		params["order"] = data.Order.ValueInt64()
 	}
 
@@ -108,6 +117,7 @@ func (r *VmDeviceResource) Create(ctx context.Context, req resource.CreateReques
 			data.ID = types.StringValue(fmt.Sprintf("%v", id))
 		}
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -149,7 +159,7 @@ func (r *VmDeviceResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	params := map[string]interface{}{}
-	if !data.Attributes.IsNull() {
+	if !data.Attributes.IsNull() && !data.Attributes.IsUnknown() {
 		var attributesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Attributes.ValueString()), &attributesObj); err != nil {
 			resp.Diagnostics.AddError("JSON Parse Error", fmt.Sprintf("Failed to parse attributes: %s", err))
@@ -157,10 +167,10 @@ func (r *VmDeviceResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 		params["attributes"] = attributesObj
 	}
-	if !data.Vm.IsNull() {
+	if !data.Vm.IsNull() && !data.Vm.IsUnknown() {
 		params["vm"] = data.Vm.ValueInt64()
 	}
-	if !data.Order.IsNull() {
+	if !data.Order.IsNull() && !data.Order.IsUnknown() {
 		params["order"] = data.Order.ValueInt64()
 	}
 
@@ -171,7 +181,7 @@ func (r *VmDeviceResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	_, err = r.client.Call("vm.device.update", []interface{}{resourceID, params})
+	result, err := r.client.Call("vm.device.update", []interface{}{resourceID, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
@@ -179,6 +189,7 @@ func (r *VmDeviceResource) Update(ctx context.Context, req resource.UpdateReques
 
 	// Preserve the ID in the new state
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -223,7 +234,7 @@ func (r *VmDeviceResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	// Delete zvol if it was created by this device
-	if !data.Attributes.IsNull() {
+	if !data.Attributes.IsNull() && !data.Attributes.IsUnknown() {
 		var attributesObj map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Attributes.ValueString()), &attributesObj); err == nil {
 			if createZvol, ok := attributesObj["create_zvol"].(bool); ok && createZvol {
@@ -239,3 +250,24 @@ func (r *VmDeviceResource) Delete(ctx context.Context, req resource.DeleteReques
 		}
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *VmDeviceResource) setComputed(result interface{}, data *VmDeviceResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.Order.IsUnknown() {
+		data.Order = types.Int64Null()
+		if v, ok := resultMap["order"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Order = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Order = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+}
@@ -4,12 +4,18 @@ import (
 	"context"
 	"fmt"
 	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"strconv"
 	"strings"
@@ -59,14 +65,30 @@ func NewVmResource() resource.Resource {
 
 func (r *VmResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_vm"
+	// name can be changed in place, changing the identity
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *VmResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIdentity(ctx, r.client, "vm.query", vmIdentity, req, resp)
+}
+
+var _ resource.ResourceWithIdentity = &VmResource{}
+
+// vmIdentity lists the API fields that identify a vm
+var vmIdentity = []identityKey{{Name: "name"}}
+
+func (r *VmResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(vmIdentity)
+}
+
+func (r *VmResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
 }
 
 func (r *VmResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create a Virtual Machine (VM).",
 		Attributes: map[string]schema.Attribute{
 			"id":              schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -74,17 +96,24 @@ func (r *VmResource) Schema(ctx context.Context, req resource.SchemaRequest, res
 			"command_line_args": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Additional command line arguments passed to the VM hypervisor.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"cpu_mode": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "CPU virtualization mode.  * `CUSTOM`: Use specified model. * `HOST-MODEL`: Mirror host CPU. * `HOST-",
+				Default:     stringdefault.StaticString("CUSTOM"),
+				Validators:  []validator.String{stringvalidator.OneOf("CUSTOM", "HOST-MODEL", "HOST-PASSTHROUGH")},
 			},
 			"cpu_model": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Specific CPU model to emulate. `null` to use hypervisor default.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Specific CPU model to emulate. `null` to use hypervisor default.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -94,123 +123,175 @@ func (r *VmResource) Schema(ctx context.Context, req resource.SchemaRequest, res
 			"description": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Optional description or notes about the virtual machine.",
+				Default:     stringdefault.StaticString(""),
 			},
 			"vcpus": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Number of virtual CPUs allocated to the VM.",
+				Default:     int64default.StaticInt64(1),
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
 			},
 			"cores": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Number of CPU cores per socket.",
+				Default:     int64default.StaticInt64(1),
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
 			},
 			"threads": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Number of threads per CPU core.",
+				Default:     int64default.StaticInt64(1),
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
 			},
 			"cpuset": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Set of host CPU cores to pin VM CPUs to. `null` for no pinning.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Set of host CPU cores to pin VM CPUs to. `null` for no pinning.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"nodeset": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Set of NUMA nodes to constrain VM memory allocation. `null` for no constraints.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Set of NUMA nodes to constrain VM memory allocation. `null` for no constraints.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"enable_cpu_topology_extension": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to expose detailed CPU topology information to the guest OS.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"pin_vcpus": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to pin virtual CPUs to specific host CPU cores. Improves performance but reduces host flexib",
+				Default:     booldefault.StaticBool(false),
 			},
 			"suspend_on_snapshot": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to suspend the VM when taking snapshots.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"trusted_platform_module": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to enable virtual Trusted Platform Module (TPM) for the VM.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"memory": schema.Int64Attribute{
 				Required:    true,
 				Optional:    false,
 				Description: "Amount of memory allocated to the VM in megabytes.",
+				Validators:  []validator.Int64{int64validator.AtLeast(20)},
 			},
 			"min_memory": schema.Int64Attribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Minimum memory allocation for dynamic memory ballooning in megabytes. Allows VM memory to shrink    ",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Minimum memory allocation for dynamic memory ballooning in megabytes. Allows VM memory to shrink    ",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators:    []validator.Int64{int64validator.AtLeast(20)},
 			},
 			"hyperv_enlightenments": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to enable Hyper-V enlightenments for improved Windows guest performance.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"bootloader": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Boot firmware type. `UEFI` for modern UEFI, `UEFI_CSM` for legacy BIOS compatibility.",
+				Default:     stringdefault.StaticString("UEFI"),
+				Validators:  []validator.String{stringvalidator.OneOf("UEFI_CSM", "UEFI")},
 			},
 			"bootloader_ovmf": schema.StringAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "OVMF firmware file to use for UEFI boot.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 			},
 			"autostart": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to automatically start the VM when the host system boots.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"hide_from_msr": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to hide hypervisor signatures from guest OS MSR access.",
+				Default:     booldefault.StaticBool(false),
 			},
 			"ensure_display_device": schema.BoolAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Whether to ensure at least one display device is configured for the VM.",
+				Default:     booldefault.StaticBool(true),
 			},
 			"time": schema.StringAttribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Guest OS time zone reference. `LOCAL` uses host timezone, `UTC` uses coordinated universal time.",
+				Default:     stringdefault.StaticString("LOCAL"),
+				Validators:  []validator.String{stringvalidator.OneOf("LOCAL", "UTC")},
 			},
 			"shutdown_timeout": schema.Int64Attribute{
 				Required:    false,
 				Optional:    true,
+				Computed:    true,
 				Description: "Maximum time in seconds to wait for graceful shutdown before forcing power off. Default 90s balances",
+				Default:     int64default.StaticInt64(90),
+				Validators:  []validator.Int64{int64validator.Between(5, 300)},
 			},
 			"arch_type": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Guest architecture type. `null` to use hypervisor default.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Guest architecture type. `null` to use hypervisor default.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"machine_type": schema.StringAttribute{
-				Required:    false,
-				Optional:    true,
-				Description: "Virtual machine type/chipset. `null` to use hypervisor default.",
+				Required:      false,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Virtual machine type/chipset. `null` to use hypervisor default.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"uuid": schema.StringAttribute{
-				Optional:    true,
-				Computed:    true,
-				Description: "Unique UUID for the VM. `null` to auto-generate.",
+				Optional:      true,
+				Computed:      true,
+				Description:   "Unique UUID for the VM. `null` to auto-generate.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"enable_secure_boot": schema.BoolAttribute{
 				Required:      false,
 				Optional:      true,
+				Computed:      true,
 				Description:   "Whether to enable UEFI Secure Boot for enhanced security.",
+				Default:       booldefault.StaticBool(false),
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 			},
 		},
@@ -237,88 +318,88 @@ func (r *VmResource) Create(ctx context.Context, req resource.CreateRequest, res
 	}
 
 	params := map[string]interface{}{}
-	if !data.CommandLineArgs.IsNull() {
+	if !data.CommandLineArgs.IsNull() && !data.CommandLineArgs.IsUnknown() {
 		params["command_line_args"] = data.CommandLineArgs.ValueString()
 	}
-	if !data.CpuMode.IsNull() {
+	if !data.CpuMode.IsNull() && !data.CpuMode.IsUnknown() {
 		params["cpu_mode"] = data.CpuMode.ValueString()
 	}
-	if !data.CpuModel.IsNull() {
+	if !data.CpuModel.IsNull() && !data.CpuModel.IsUnknown() {
 		params["cpu_model"] = data.CpuModel.ValueString()
 	}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Vcpus.IsNull() {
+	if !data.Vcpus.IsNull() && !data.Vcpus.IsUnknown() {
 		params["vcpus"] = data.Vcpus.ValueInt64()
 	}
-	if !data.Cores.IsNull() {
+	if !data.Cores.IsNull() && !data.Cores.IsUnknown() {
 		params["cores"] = data.Cores.ValueInt64()
 	}
-	if !data.Threads.IsNull() {
+	if !data.Threads.IsNull() && !data.Threads.IsUnknown() {
 		params["threads"] = data.Threads.ValueInt64()
 	}
-	if !data.Cpuset.IsNull() {
+	if !data.Cpuset.IsNull() && !data.Cpuset.IsUnknown() {
 		params["cpuset"] = data.Cpuset.ValueString()
 	}
-	if !data.Nodeset.IsNull() {
+	if !data.Nodeset.IsNull() && !data.Nodeset.IsUnknown() {
 		params["nodeset"] = data.Nodeset.ValueString()
 	}
-	if !data.EnableCpuTopologyExtension.IsNull() {
+	if !data.EnableCpuTopologyExtension.IsNull() && !data.EnableCpuTopologyExtension.IsUnknown() {
 		params["enable_cpu_topology_extension"] = data.EnableCpuTopologyExtension.ValueBool()
 	}
-	if !data.PinVcpus.IsNull() {
+	if !data.PinVcpus.IsNull() && !data.PinVcpus.IsUnknown() {
 		params["pin_vcpus"] = data.PinVcpus.ValueBool()
 	}
-	if !data.SuspendOnSnapshot.IsNull() {
+	if !data.SuspendOnSnapshot.IsNull() && !data.SuspendOnSnapshot.IsUnknown() {
 		params["suspend_on_snapshot"] = data.SuspendOnSnapshot.ValueBool()
 	}
-	if !data.TrustedPlatformModule.IsNull() {
+	if !data.TrustedPlatformModule.IsNull() && !data.TrustedPlatformModule.IsUnknown() {
 		params["trusted_platform_module"] = data.TrustedPlatformModule.ValueBool()
 	}
-	if !data.Memory.IsNull() {
+	if !data.Memory.IsNull() && !data.Memory.IsUnknown() {
 		params["memory"] = data.Memory.ValueInt64()
 	}
-	if !data.MinMemory.IsNull() {
+	if !data.MinMemory.IsNull() && !data.MinMemory.IsUnknown() {
 		params["min_memory"] = data.MinMemory.ValueInt64()
 	}
-	if !data.HypervEnlightenments.IsNull() {
+	if !data.HypervEnlightenments.IsNull() && !data.HypervEnlightenments.IsUnknown() {
 		params["hyperv_enlightenments"] = data.HypervEnlightenments.ValueBool()
 	}
-	if !data.Bootloader.IsNull() {
+	if !data.Bootloader.IsNull() && !data.Bootloader.IsUnknown() {
 		params["bootloader"] = data.Bootloader.ValueString()
 	}
-	if !data.BootloaderOvmf.IsNull() {
+	if !data.BootloaderOvmf.IsNull() && !data.BootloaderOvmf.IsUnknown() {
 		params["bootloader_ovmf"] = data.BootloaderOvmf.ValueString()
 	}
-	if !data.Autostart.IsNull() {
+	if !data.Autostart.IsNull() && !data.Autostart.IsUnknown() {
 		params["autostart"] = data.Autostart.ValueBool()
 	}
-	if !data.HideFromMsr.IsNull() {
+	if !data.HideFromMsr.IsNull() && !data.HideFromMsr.IsUnknown() {
 		params["hide_from_msr"] = data.HideFromMsr.ValueBool()
 	}
-	if !data.EnsureDisplayDevice.IsNull() {
+	if !data.EnsureDisplayDevice.IsNull() && !data.EnsureDisplayDevice.IsUnknown() {
 		params["ensure_display_device"] = data.EnsureDisplayDevice.ValueBool()
 	}
-	if !data.Time.IsNull() {
+	if !data.Time.IsNull() && !data.Time.IsUnknown() {
 		params["time"] = data.Time.ValueString()
 	}
-	if !data.ShutdownTimeout.IsNull() {
+	if !data.ShutdownTimeout.IsNull() && !data.ShutdownTimeout.IsUnknown() {
 		params["shutdown_timeout"] = data.ShutdownTimeout.ValueInt64()
 	}
-	if !data.ArchType.IsNull() {
+	if !data.ArchType.IsNull() && !data.ArchType.IsUnknown() {
 		params["arch_type"] = data.ArchType.ValueString()
 	}
-	if !data.MachineType.IsNull() {
+	if !data.MachineType.IsNull() && !data.MachineType.IsUnknown() {
 		params["machine_type"] = data.MachineType.ValueString()
 	}
-	if !data.Uuid.IsNull() {
+	if !data.Uuid.IsNull() && !data.Uuid.IsUnknown() {
 		params["uuid"] = data.Uuid.ValueString()
 	}
-	if !data.EnableSecureBoot.IsNull() {
+	if !data.EnableSecureBoot.IsNull() && !data.EnableSecureBoot.IsUnknown() {
 		params["enable_secure_boot"] = data.EnableSecureBoot.ValueBool()
 	}
 
@@ -340,6 +421,8 @@ func (r *VmResource) Create(ctx context.Context, req resource.CreateRequest, res
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, vmIdentity, result)...)
 
 	startOnCreate := true
 	if !data.StartOnCreate.IsNull() {
@@ -414,6 +497,7 @@ func (r *VmResource) Read(ctx context.Context, req resource.ReadRequest, resp *r
 			}
 		}
 	}
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, vmIdentity, result)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -440,92 +524,94 @@ func (r *VmResource) Update(ctx context.Context, req resource.UpdateRequest, res
 	}
 
 	params := map[string]interface{}{}
-	if !data.CommandLineArgs.IsNull() {
+	if !data.CommandLineArgs.IsNull() && !data.CommandLineArgs.IsUnknown() {
 		params["command_line_args"] = data.CommandLineArgs.ValueString()
 	}
-	if !data.CpuMode.IsNull() {
+	if !data.CpuMode.IsNull() && !data.CpuMode.IsUnknown() {
 		params["cpu_mode"] = data.CpuMode.ValueString()
 	}
-	if !data.CpuModel.IsNull() {
+	if !data.CpuModel.IsNull() && !data.CpuModel.IsUnknown() {
 		params["cpu_model"] = data.CpuModel.ValueString()
 	}
-	if !data.Name.IsNull() {
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		params["name"] = data.Name.ValueString()
 	}
-	if !data.Description.IsNull() {
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
 		params["description"] = data.Description.ValueString()
 	}
-	if !data.Vcpus.IsNull() {
+	if !data.Vcpus.IsNull() && !data.Vcpus.IsUnknown() {
 		params["vcpus"] = data.Vcpus.ValueInt64()
 	}
-	if !data.Cores.IsNull() {
+	if !data.Cores.IsNull() && !data.Cores.IsUnknown() {
 		params["cores"] = data.Cores.ValueInt64()
 	}
-	if !data.Threads.IsNull() {
+	if !data.Threads.IsNull() && !data.Threads.IsUnknown() {
 		params["threads"] = data.Threads.ValueInt64()
 	}
-	if !data.Cpuset.IsNull() {
+	if !data.Cpuset.IsNull() && !data.Cpuset.IsUnknown() {
 		params["cpuset"] = data.Cpuset.ValueString()
 	}
-	if !data.Nodeset.IsNull() {
+	if !data.Nodeset.IsNull() && !data.Nodeset.IsUnknown() {
 		params["nodeset"] = data.Nodeset.ValueString()
 	}
-	if !data.EnableCpuTopologyExtension.IsNull() {
+	if !data.EnableCpuTopologyExtension.IsNull() && !data.EnableCpuTopologyExtension.IsUnknown() {
 		params["enable_cpu_topology_extension"] = data.EnableCpuTopologyExtension.ValueBool()
 	}
-	if !data.PinVcpus.IsNull() {
+	if !data.PinVcpus.IsNull() && !data.PinVcpus.IsUnknown() {
 		params["pin_vcpus"] = data.PinVcpus.ValueBool()
 	}
-	if !data.SuspendOnSnapshot.IsNull() {
+	if !data.SuspendOnSnapshot.IsNull() && !data.SuspendOnSnapshot.IsUnknown() {
 		params["suspend_on_snapshot"] = data.SuspendOnSnapshot.ValueBool()
 	}
-	if !data.TrustedPlatformModule.IsNull() {
+	if !data.TrustedPlatformModule.IsNull() && !data.TrustedPlatformModule.IsUnknown() {
 		params["trusted_platform_module"] = data.TrustedPlatformModule.ValueBool()
 	}
-	if !data.Memory.IsNull() {
+	if !data.Memory.IsNull() && !data.Memory.IsUnknown() {
 		params["memory"] = data.Memory.ValueInt64()
 	}
-	if !data.MinMemory.IsNull() {
+	if !data.MinMemory.IsNull() && !data.MinMemory.IsUnknown() {
 		params["min_memory"] = data.MinMemory.ValueInt64()
 	}
-	if !data.HypervEnlightenments.IsNull() {
+	if !data.HypervEnlightenments.IsNull() && !data.HypervEnlightenments.IsUnknown() {
 		params["hyperv_enlightenments"] = data.HypervEnlightenments.ValueBool()
 	}
-	if !data.Bootloader.IsNull() {
+	if !data.Bootloader.IsNull() && !data.Bootloader.IsUnknown() {
 		params["bootloader"] = data.Bootloader.ValueString()
 	}
-	if !data.Autostart.IsNull() {
+	if !data.Autostart.IsNull() && !data.Autostart.IsUnknown() {
 		params["autostart"] = data.Autostart.ValueBool()
 	}
-	if !data.HideFromMsr.IsNull() {
+	if !data.HideFromMsr.IsNull() && !data.HideFromMsr.IsUnknown() {
 		params["hide_from_msr"] = data.HideFromMsr.ValueBool()
 	}
-	if !data.EnsureDisplayDevice.IsNull() {
+	if !data.EnsureDisplayDevice.IsNull() && !data.EnsureDisplayDevice.IsUnknown() {
 		params["ensure_display_device"] = data.EnsureDisplayDevice.ValueBool()
 	}
-	if !data.Time.IsNull() {
+	if !data.Time.IsNull() && !data.Time.IsUnknown() {
 		params["time"] = data.Time.ValueString()
 	}
-	if !data.ShutdownTimeout.IsNull() {
+	if !data.ShutdownTimeout.IsNull() && !data.ShutdownTimeout.IsUnknown() {
 		params["shutdown_timeout"] = data.ShutdownTimeout.ValueInt64()
 	}
-	if !data.ArchType.IsNull() {
+	if !data.ArchType.IsNull() && !data.ArchType.IsUnknown() {
 		params["arch_type"] = data.ArchType.ValueString()
 	}
-	if !data.MachineType.IsNull() {
+	if !data.MachineType.IsNull() && !data.MachineType.IsUnknown() {
 		params["machine_type"] = data.MachineType.ValueString()
 	}
-	if !data.Uuid.IsNull() {
+	if !data.Uuid.IsNull() && !data.Uuid.IsUnknown() {
 		params["uuid"] = data.Uuid.ValueString()
 	}
 
-	_, err = r.client.Call("vm.update", []interface{}{id, params})
+	result, err := r.client.Call("vm.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update vm: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, vmIdentity, result)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -554,3 +640,336 @@ func (r *VmResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *VmResource) setComputed(result interface{}, data *VmResourceModel) {
+	resultMap, _ := result.(map[string]interface{})
+	if data.CommandLineArgs.IsUnknown() {
+		data.CommandLineArgs = types.StringNull()
+		if v, ok := resultMap["command_line_args"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.CommandLineArgs = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.CommandLineArgs = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.CommandLineArgs = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.CpuMode.IsUnknown() {
+		data.CpuMode = types.StringNull()
+		if v, ok := resultMap["cpu_mode"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.CpuMode = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.CpuMode = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.CpuMode = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.CpuModel.IsUnknown() {
+		data.CpuModel = types.StringNull()
+		if v, ok := resultMap["cpu_model"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.CpuModel = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.CpuModel = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.CpuModel = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Description.IsUnknown() {
+		data.Description = types.StringNull()
+		if v, ok := resultMap["description"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Description = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Description = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Description = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Vcpus.IsUnknown() {
+		data.Vcpus = types.Int64Null()
+		if v, ok := resultMap["vcpus"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Vcpus = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Vcpus = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Cores.IsUnknown() {
+		data.Cores = types.Int64Null()
+		if v, ok := resultMap["cores"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Cores = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Cores = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Threads.IsUnknown() {
+		data.Threads = types.Int64Null()
+		if v, ok := resultMap["threads"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.Threads = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.Threads = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.Cpuset.IsUnknown() {
+		data.Cpuset = types.StringNull()
+		if v, ok := resultMap["cpuset"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Cpuset = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Cpuset = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Cpuset = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Nodeset.IsUnknown() {
+		data.Nodeset = types.StringNull()
+		if v, ok := resultMap["nodeset"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Nodeset = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Nodeset = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Nodeset = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.EnableCpuTopologyExtension.IsUnknown() {
+		data.EnableCpuTopologyExtension = types.BoolNull()
+		if v, ok := resultMap["enable_cpu_topology_extension"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.EnableCpuTopologyExtension = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.PinVcpus.IsUnknown() {
+		data.PinVcpus = types.BoolNull()
+		if v, ok := resultMap["pin_vcpus"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.PinVcpus = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.SuspendOnSnapshot.IsUnknown() {
+		data.SuspendOnSnapshot = types.BoolNull()
+		if v, ok := resultMap["suspend_on_snapshot"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.SuspendOnSnapshot = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.TrustedPlatformModule.IsUnknown() {
+		data.TrustedPlatformModule = types.BoolNull()
+		if v, ok := resultMap["trusted_platform_module"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.TrustedPlatformModule = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.MinMemory.IsUnknown() {
+		data.MinMemory = types.Int64Null()
+		if v, ok := resultMap["min_memory"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.MinMemory = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.MinMemory = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.HypervEnlightenments.IsUnknown() {
+		data.HypervEnlightenments = types.BoolNull()
+		if v, ok := resultMap["hyperv_enlightenments"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.HypervEnlightenments = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Bootloader.IsUnknown() {
+		data.Bootloader = types.StringNull()
+		if v, ok := resultMap["bootloader"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Bootloader = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Bootloader = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Bootloader = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.BootloaderOvmf.IsUnknown() {
+		data.BootloaderOvmf = types.StringNull()
+		if v, ok := resultMap["bootloader_ovmf"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.BootloaderOvmf = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.BootloaderOvmf = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.BootloaderOvmf = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Autostart.IsUnknown() {
+		data.Autostart = types.BoolNull()
+		if v, ok := resultMap["autostart"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.Autostart = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.HideFromMsr.IsUnknown() {
+		data.HideFromMsr = types.BoolNull()
+		if v, ok := resultMap["hide_from_msr"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.HideFromMsr = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.EnsureDisplayDevice.IsUnknown() {
+		data.EnsureDisplayDevice = types.BoolNull()
+		if v, ok := resultMap["ensure_display_device"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.EnsureDisplayDevice = types.BoolValue(bv)
+			}
+		}
+	}
+	if data.Time.IsUnknown() {
+		data.Time = types.StringNull()
+		if v, ok := resultMap["time"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Time = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Time = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Time = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.ShutdownTimeout.IsUnknown() {
+		data.ShutdownTimeout = types.Int64Null()
+		if v, ok := resultMap["shutdown_timeout"]; ok && v != nil {
+			switch val := v.(type) {
+			case float64:
+				data.ShutdownTimeout = types.Int64Value(int64(val))
+			case map[string]interface{}:
+				if parsed, ok := val["parsed"]; ok && parsed != nil {
+					if fv, ok := parsed.(float64); ok {
+						data.ShutdownTimeout = types.Int64Value(int64(fv))
+					}
+				}
+			}
+		}
+	}
+	if data.ArchType.IsUnknown() {
+		data.ArchType = types.StringNull()
+		if v, ok := resultMap["arch_type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.ArchType = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.ArchType = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.ArchType = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.MachineType.IsUnknown() {
+		data.MachineType = types.StringNull()
+		if v, ok := resultMap["machine_type"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.MachineType = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.MachineType = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.MachineType = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.Uuid.IsUnknown() {
+		data.Uuid = types.StringNull()
+		if v, ok := resultMap["uuid"]; ok && v != nil {
+			switch val := v.(type) {
+			case string:
+				data.Uuid = types.StringValue(val)
+			case map[string]interface{}:
+				if strVal, ok := val["value"]; ok && strVal != nil {
+					data.Uuid = types.StringValue(fmt.Sprintf("%v", strVal))
+				}
+			default:
+				data.Uuid = types.StringValue(fmt.Sprintf("%v", v))
+			}
+		}
+	}
+	if data.EnableSecureBoot.IsUnknown() {
+		data.EnableSecureBoot = types.BoolNull()
+		if v, ok := resultMap["enable_secure_boot"]; ok && v != nil {
+			if bv, ok := v.(bool); ok {
+				data.EnableSecureBoot = types.BoolValue(bv)
+			}
+		}
+	}
+}
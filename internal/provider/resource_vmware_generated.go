@@ -37,8 +37,13 @@ func (r *VmwareResource) ImportState(ctx context.Context, req resource.ImportSta
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *VmwareResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
 func (r *VmwareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             0,
 		MarkdownDescription: "Create VMWare snapshot.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{Computed: true, Description: "Resource ID"},
@@ -91,19 +96,19 @@ func (r *VmwareResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	params := map[string]interface{}{}
-	if !data.Datastore.IsNull() {
+	if !data.Datastore.IsNull() && !data.Datastore.IsUnknown() {
 		params["datastore"] = data.Datastore.ValueString()
 	}
-	if !data.Filesystem.IsNull() {
+	if !data.Filesystem.IsNull() && !data.Filesystem.IsUnknown() {
 		params["filesystem"] = data.Filesystem.ValueString()
 	}
-	if !data.Hostname.IsNull() {
+	if !data.Hostname.IsNull() && !data.Hostname.IsUnknown() {
 		params["hostname"] = data.Hostname.ValueString()
 	}
-	if !data.Username.IsNull() {
+	if !data.Username.IsNull() && !data.Username.IsUnknown() {
 		params["username"] = data.Username.ValueString()
 	}
-	if !data.Password.IsNull() {
+	if !data.Password.IsNull() && !data.Password.IsUnknown() {
 		params["password"] = data.Password.ValueString()
 	}
 
@@ -125,6 +130,7 @@ func (r *VmwareResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("Create Error", "API did not return a valid ID")
 		return
 	}
+	r.setComputed(result, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -251,29 +257,30 @@ func (r *VmwareResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	params := map[string]interface{}{}
-	if !data.Datastore.IsNull() {
+	if !data.Datastore.IsNull() && !data.Datastore.IsUnknown() {
 		params["datastore"] = data.Datastore.ValueString()
 	}
-	if !data.Filesystem.IsNull() {
+	if !data.Filesystem.IsNull() && !data.Filesystem.IsUnknown() {
 		params["filesystem"] = data.Filesystem.ValueString()
 	}
-	if !data.Hostname.IsNull() {
+	if !data.Hostname.IsNull() && !data.Hostname.IsUnknown() {
 		params["hostname"] = data.Hostname.ValueString()
 	}
-	if !data.Username.IsNull() {
+	if !data.Username.IsNull() && !data.Username.IsUnknown() {
 		params["username"] = data.Username.ValueString()
 	}
-	if !data.Password.IsNull() {
+	if !data.Password.IsNull() && !data.Password.IsUnknown() {
 		params["password"] = data.Password.ValueString()
 	}
 
-	_, err = r.client.Call("vmware.update", []interface{}{id, params})
+	result, err := r.client.Call("vmware.update", []interface{}{id, params})
 	if err != nil {
 		resp.Diagnostics.AddError("Update Error", fmt.Sprintf("Unable to update vmware: %s", err))
 		return
 	}
 
 	data.ID = state.ID
+	r.setComputed(result, &data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -298,3 +305,9 @@ func (r *VmwareResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 }
+
+// setComputed fills attributes the plan left unknown from the API response, so
+// server-side defaults are recorded in state instead of surfacing as drift.
+func (r *VmwareResource) setComputed(result interface{}, data *VmwareResourceModel) {
+	_ = result // No computed fields
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// upgradeStateFromJSON migrates state written by an older schema version of a
+// generated resource to the current schema. generate.py bumps the schema version
+// whenever an attribute changes type between TrueNAS API releases; values are
+// coerced to the type the attribute has now (a JSON string becomes a list or
+// object, a number becomes a string, ...) and attributes that no longer exist
+// are dropped. Values that cannot be converted are cleared with a warning so
+// the next plan sets them from configuration again.
+func upgradeStateFromJSON(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError("State Upgrade Error", "Prior state is not available in JSON format")
+		return
+	}
+
+	var prior map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+	dec.UseNumber()
+	if err := dec.Decode(&prior); err != nil {
+		resp.Diagnostics.AddError("State Upgrade Error", fmt.Sprintf("Unable to decode prior state: %s", err))
+		return
+	}
+
+	objType, ok := resp.State.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		resp.Diagnostics.AddError("State Upgrade Error", "Resource schema is not an object")
+		return
+	}
+
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		val, err := convertStateValue(typ, prior[name])
+		if err != nil {
+			resp.Diagnostics.AddWarning("State Upgrade Warning", fmt.Sprintf("Attribute %q was cleared: %s", name, err))
+			val = tftypes.NewValue(typ, nil)
+		}
+		attrs[name] = val
+	}
+	resp.State.Raw = tftypes.NewValue(objType, attrs)
+}
+
+// convertStateValue converts a decoded JSON state value to the given type.
+func convertStateValue(typ tftypes.Type, v interface{}) (tftypes.Value, error) {
+	if v == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch t := typ.(type) {
+	case tftypes.List:
+		elems, err := convertStateElems(t.ElementType, v)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		return tftypes.NewValue(typ, elems), nil
+	case tftypes.Set:
+		elems, err := convertStateElems(t.ElementType, v)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		return tftypes.NewValue(typ, elems), nil
+	case tftypes.Map:
+		m, err := decodeStateObject(v)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		vals := make(map[string]tftypes.Value, len(m))
+		for k, e := range m {
+			if vals[k], err = convertStateValue(t.ElementType, e); err != nil {
+				return tftypes.Value{}, err
+			}
+		}
+		return tftypes.NewValue(typ, vals), nil
+	case tftypes.Object:
+		m, err := decodeStateObject(v)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		vals := make(map[string]tftypes.Value, len(t.AttributeTypes))
+		for k, at := range t.AttributeTypes {
+			if vals[k], err = convertStateValue(at, m[k]); err != nil {
+				return tftypes.Value{}, err
+			}
+		}
+		return tftypes.NewValue(typ, vals), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		switch x := v.(type) {
+		case string:
+			return tftypes.NewValue(typ, x), nil
+		case json.Number:
+			return tftypes.NewValue(typ, x.String()), nil
+		case bool:
+			return tftypes.NewValue(typ, strconv.FormatBool(x)), nil
+		default:
+			b, err := json.Marshal(x)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			return tftypes.NewValue(typ, string(b)), nil
+		}
+	case typ.Is(tftypes.Number):
+		var s string
+		switch x := v.(type) {
+		case json.Number:
+			s = x.String()
+		case string:
+			s = x
+		case bool:
+			s = "0"
+			if x {
+				s = "1"
+			}
+		default:
+			return tftypes.Value{}, fmt.Errorf("cannot convert %T to a number", v)
+		}
+		f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("cannot convert %q to a number", s)
+		}
+		return tftypes.NewValue(typ, f), nil
+	case typ.Is(tftypes.Bool):
+		switch x := v.(type) {
+		case bool:
+			return tftypes.NewValue(typ, x), nil
+		case string:
+			b, err := strconv.ParseBool(x)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("cannot convert %q to a bool", x)
+			}
+			return tftypes.NewValue(typ, b), nil
+		case json.Number:
+			return tftypes.NewValue(typ, x.String() != "0"), nil
+		}
+		return tftypes.Value{}, fmt.Errorf("cannot convert %T to a bool", v)
+	}
+	return tftypes.Value{}, fmt.Errorf("unsupported attribute type %s", typ)
+}
+
+// convertStateElems converts a list value, accepting a JSON-encoded list or a
+// single scalar that used to be stored on its own.
+func convertStateElems(elemType tftypes.Type, v interface{}) ([]tftypes.Value, error) {
+	items, ok := v.([]interface{})
+	if !ok {
+		if s, isString := v.(string); isString && json.Valid([]byte(s)) {
+			var decoded interface{}
+			dec := json.NewDecoder(bytes.NewReader([]byte(s)))
+			dec.UseNumber()
+			if err := dec.Decode(&decoded); err == nil {
+				if list, isList := decoded.([]interface{}); isList {
+					items, ok = list, true
+				}
+			}
+		}
+		if !ok {
+			items = []interface{}{v}
+		}
+	}
+	elems := make([]tftypes.Value, 0, len(items))
+	for _, item := range items {
+		e, err := convertStateValue(elemType, item)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, e)
+	}
+	return elems, nil
+}
+
+// decodeStateObject returns v as an object, decoding it first if it was stored
+// as a JSON string.
+func decodeStateObject(v interface{}) (map[string]interface{}, error) {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		return x, nil
+	case string:
+		var m map[string]interface{}
+		dec := json.NewDecoder(bytes.NewReader([]byte(x)))
+		dec.UseNumber()
+		if err := dec.Decode(&m); err != nil {
+			return nil, fmt.Errorf("cannot decode %q as an object", x)
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("cannot convert %T to an object", v)
+}
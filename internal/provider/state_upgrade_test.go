@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpgradeStateFromJSON_TypeChanges(t *testing.T) {
+	ctx := context.Background()
+	r := NewUserResource()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	upgraders := r.(resource.ResourceWithUpgradeState).UpgradeState(ctx)
+	if schemaResp.Schema.Version == 0 && len(upgraders) != 0 {
+		t.Fatal("Expected no upgraders for schema version 0")
+	}
+
+	// State written by a release where these attributes had different types
+	raw := `{
+		"id": "5",
+		"username": "alice",
+		"uid": "1001",
+		"groups": "[\"wheel\", \"builtin\"]",
+		"locked": "true",
+		"sshpubkey": 42,
+		"removed_attribute": "x"
+	}`
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(raw)}}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgradeStateFromJSON(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+
+	var data UserResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Upgraded state does not match schema: %v", resp.Diagnostics)
+	}
+
+	if data.Uid.ValueInt64() != 1001 {
+		t.Errorf("Expected uid 1001, got %d", data.Uid.ValueInt64())
+	}
+	if len(data.Groups.Elements()) != 2 {
+		t.Errorf("Expected JSON string to become a 2-element list, got %v", data.Groups)
+	}
+	if !data.Locked.ValueBool() {
+		t.Error("Expected locked to be true")
+	}
+	if data.Sshpubkey.ValueString() != "42" {
+		t.Errorf("Expected sshpubkey \"42\", got %q", data.Sshpubkey.ValueString())
+	}
+	if !data.Email.IsNull() {
+		t.Error("Expected missing attribute to be null")
+	}
+}
+
+func TestUpgradeStateFromJSON_UnconvertibleValue(t *testing.T) {
+	ctx := context.Background()
+	r := NewUserResource()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(`{"id": "5", "uid": "not-a-number"}`)}}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	upgradeStateFromJSON(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected one warning, got %d", resp.Diagnostics.WarningsCount())
+	}
+
+	var data UserResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+	if !data.Uid.IsNull() {
+		t.Error("Expected unconvertible uid to be cleared")
+	}
+	if data.ID.ValueString() != "5" {
+		t.Error("Expected other attributes to be preserved")
+	}
+}
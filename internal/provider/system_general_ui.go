@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/bmanojlovic/terraform-provider-truenas/internal/client"
+)
+
+// uiSettings are the system.general fields that only take effect once the web
+// UI restarts
+var uiSettings = []string{
+	"ui_address",
+	"ui_allowlist",
+	"ui_certificate",
+	"ui_httpsport",
+	"ui_httpsprotocols",
+	"ui_httpsredirect",
+	"ui_port",
+	"ui_v6address",
+}
+
+// uiRestartDelay is how long system.general.ui_restart waits before restarting
+const uiRestartDelay = 3 * time.Second
+
+// uiReconnectTimeout bounds how long to wait for the UI to accept connections
+// again after a restart
+const uiReconnectTimeout = 2 * time.Minute
+
+// changedUISettings lists the UI settings that differ between two
+// system.general configs
+func changedUISettings(before, after interface{}) []string {
+	b, _ := before.(map[string]interface{})
+	a, _ := after.(map[string]interface{})
+	var changed []string
+	for _, k := range uiSettings {
+		if !reflect.DeepEqual(b[k], a[k]) {
+			changed = append(changed, k)
+		}
+	}
+	return changed
+}
+
+// restartUIIfChanged restarts the web UI when system.general.update changed a
+// UI setting, reconnects once it is back and checks the endpoint it reached
+// serves the new settings. The provider talks to the same web server, so its
+// connection drops too.
+func restartUIIfChanged(c *client.Client, before, after interface{}) error {
+	changed := changedUISettings(before, after)
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if _, err := c.Call("system.general.ui_restart", []interface{}{int(uiRestartDelay.Seconds())}); err != nil {
+		return fmt.Errorf("unable to restart the web UI after changing %v: %s", changed, err)
+	}
+	time.Sleep(uiRestartDelay + 2*time.Second)
+
+	if err := c.Reconnect(uiReconnectTimeout); err != nil {
+		return fmt.Errorf("web UI did not come back after changing %v. If the port, address or certificate changed, update the provider host to match: %s", changed, err)
+	}
+	return checkUIServed(c, before, after, changed)
+}
+
+// checkUIServed checks the live web UI rather than the stored settings: the
+// connection must not still reach the previous HTTPS port, and must present
+// the new certificate
+func checkUIServed(c *client.Client, before, after interface{}, changed []string) error {
+	b, _ := before.(map[string]interface{})
+	a, _ := after.(map[string]interface{})
+	for _, k := range changed {
+		switch k {
+		case "ui_httpsport":
+			port := hostPort(c.Host())
+			oldPort, newPort := uiSettingID(b[k]), uiSettingID(a[k])
+			if port == oldPort && port != newPort {
+				return fmt.Errorf("web UI restarted but still answers on HTTPS port %d instead of %d", oldPort, newPort)
+			}
+		case "ui_certificate":
+			if err := checkUICertificate(c, uiSettingID(a[k])); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkUICertificate checks the web UI presents certificate id
+func checkUICertificate(c *client.Client, id int64) error {
+	cert, err := queryMatch(c, "certificate.query", []interface{}{[]interface{}{"id", "=", id}})
+	if err != nil {
+		return fmt.Errorf("unable to read certificate %d after the web UI restart: %s", id, err)
+	}
+	m, _ := cert.(map[string]interface{})
+	pemData, _ := m["certificate"].(string)
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil {
+		return fmt.Errorf("certificate %d has no PEM data to compare with the web UI", id)
+	}
+	served := c.PeerCertificate()
+	if served == nil || !bytes.Equal(served.Raw, block.Bytes) {
+		return fmt.Errorf("web UI restarted but does not serve certificate %d", id)
+	}
+	return nil
+}
+
+// uiSettingID reads a numeric UI setting. Config expands references such as
+// the certificate into objects, so their ID is used.
+func uiSettingID(v interface{}) int64 {
+	if ref, ok := v.(map[string]interface{}); ok {
+		v = ref["id"]
+	}
+	f, _ := v.(float64)
+	return int64(f)
+}
+
+// hostPort is the port of a provider host, 443 when it has none
+func hostPort(host string) int64 {
+	_, p, err := net.SplitHostPort(host)
+	if err != nil {
+		return 443
+	}
+	port, err := strconv.ParseInt(p, 10, 64)
+	if err != nil {
+		return 443
+	}
+	return port
+}
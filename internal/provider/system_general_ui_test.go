@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"encoding/pem"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestChangedUISettings(t *testing.T) {
+	before := map[string]interface{}{
+		"ui_port":        float64(80),
+		"ui_httpsport":   float64(443),
+		"ui_address":     []interface{}{"0.0.0.0"},
+		"ui_certificate": map[string]interface{}{"id": float64(1), "name": "freenas_default"},
+		"timezone":       "UTC",
+	}
+	after := map[string]interface{}{
+		"ui_port":        float64(80),
+		"ui_httpsport":   float64(8443),
+		"ui_address":     []interface{}{"0.0.0.0"},
+		"ui_certificate": map[string]interface{}{"id": float64(2), "name": "acme"},
+		"timezone":       "Europe/Berlin",
+	}
+
+	got := changedUISettings(before, after)
+	want := []string{"ui_certificate", "ui_httpsport"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	// Timezone, language and other settings apply without a UI restart
+	after["ui_httpsport"] = float64(443)
+	after["ui_certificate"] = before["ui_certificate"]
+	if got := changedUISettings(before, after); len(got) != 0 {
+		t.Errorf("Expected no UI changes, got %v", got)
+	}
+}
+
+func TestCheckUIServed(t *testing.T) {
+	var served atomic.Value
+	served.Store("")
+	c, _ := newFakeClient(t, func(method string, params []interface{}) (interface{}, error) {
+		return []interface{}{map[string]interface{}{"id": float64(2), "certificate": served.Load()}}, nil
+	})
+	if _, err := c.Call("system.general.config", []interface{}{}); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	served.Store(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.PeerCertificate().Raw})))
+	port := float64(hostPort(c.Host()))
+
+	before := map[string]interface{}{"ui_httpsport": float64(443), "ui_certificate": map[string]interface{}{"id": float64(1)}}
+	after := map[string]interface{}{"ui_httpsport": port, "ui_certificate": map[string]interface{}{"id": float64(2)}}
+	if err := checkUIServed(c, before, after, []string{"ui_certificate", "ui_httpsport"}); err != nil {
+		t.Errorf("Expected the new certificate and port to check out, got %v", err)
+	}
+
+	// Still reachable on the port that was changed away from
+	before["ui_httpsport"], after["ui_httpsport"] = port, float64(8443)
+	if err := checkUIServed(c, before, after, []string{"ui_httpsport"}); err == nil || !strings.Contains(err.Error(), "still answers") {
+		t.Errorf("Expected an error for the old port, got %v", err)
+	}
+
+	// Serving some other certificate than the configured one
+	served.Store(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("other")})))
+	if err := checkUIServed(c, before, after, []string{"ui_certificate"}); err == nil || !strings.Contains(err.Error(), "does not serve certificate 2") {
+		t.Errorf("Expected an error for the old certificate, got %v", err)
+	}
+}